@@ -0,0 +1,197 @@
+// Package cmd implements the CLI commands for ktn-linter.
+package cmd
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"github.com/kodflow/ktn-linter/pkg/rules"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// configCmd groups configuration file helpers.
+	configCmd *cobra.Command = &cobra.Command{
+		Use:   "config",
+		Short: "Inspect and validate the configuration file",
+		Long: `Helpers for the .ktn-linter.yaml configuration file.
+
+Examples:
+  ktn-linter config schema -o ktn-linter.schema.json   Write the JSON Schema
+  ktn-linter config validate                          Validate the discovered config
+  ktn-linter config validate path/to/.ktn-linter.yaml Validate a specific file`,
+	}
+
+	// configSchemaCmd prints the JSON Schema of the configuration file.
+	configSchemaCmd *cobra.Command = &cobra.Command{
+		Use:   "schema",
+		Short: "Print the JSON Schema of the configuration file",
+		Long: `Print a JSON Schema describing .ktn-linter.yaml.
+
+The schema is generated from the rule registry: every rule code is listed
+with its options, and rules with a threshold expose their default value.
+Point your editor's YAML language server at it for completion and checks.`,
+		Args: cobra.NoArgs,
+		Run:  runConfigSchema,
+	}
+
+	// configValidateCmd strictly validates a configuration file.
+	configValidateCmd *cobra.Command = &cobra.Command{
+		Use:   "validate [file]",
+		Short: "Strictly validate a configuration file",
+		Long: `Strictly validate a configuration file.
+
+Unknown keys, unknown rule codes and mistyped values are reported with
+their line and column. When no file is given, the --config flag or the
+default config file lookup is used.`,
+		Args: cobra.MaximumNArgs(1),
+		Run:  runConfigValidate,
+	}
+)
+
+// init registers the config command with root.
+//
+// Params: none
+//
+// Returns: none
+func init() {
+	configCmd.AddCommand(configSchemaCmd)
+	configCmd.AddCommand(configValidateCmd)
+	rootCmd.AddCommand(configCmd)
+}
+
+// runConfigSchema executes the config schema command.
+//
+// Params:
+//   - cmd: cobra command
+//   - _: command arguments (unused)
+//
+// Returns: none
+func runConfigSchema(cmd *cobra.Command, _ []string) {
+	outputPath, _ := cmd.Flags().GetString(flagOutput)
+	writer, cleanup := getOutputWriter(outputPath)
+	// Close output file when done
+	if cleanup != nil {
+		defer cleanup()
+	}
+
+	// Write schema
+	if err := writeSchema(writer); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing schema: %v\n", err)
+		OsExit(1)
+	}
+}
+
+// writeSchema writes the indented JSON Schema to a writer.
+//
+// Params:
+//   - w: destination writer
+//
+// Returns:
+//   - error: encoding error if any
+func writeSchema(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	// Encode generated schema
+	return encoder.Encode(rules.GenerateSchema())
+}
+
+// runConfigValidate executes the config validate command.
+//
+// Params:
+//   - cmd: cobra command
+//   - args: optional config file path
+//
+// Returns: none
+func runConfigValidate(cmd *cobra.Command, args []string) {
+	path := resolveConfigPath(cmd, args)
+	// Check if a config file was found
+	if path == "" {
+		fmt.Fprintln(os.Stderr, "No configuration file found")
+		OsExit(1)
+		// Exit when nothing to validate
+		return
+	}
+
+	// Validate and exit with failure on errors
+	if !validateConfigFile(os.Stdout, path) {
+		OsExit(1)
+	}
+}
+
+// resolveConfigPath returns the config file to validate.
+//
+// Params:
+//   - cmd: cobra command
+//   - args: optional config file path
+//
+// Returns:
+//   - string: path to validate, empty if none found
+func resolveConfigPath(cmd *cobra.Command, args []string) string {
+	// Explicit argument wins
+	if len(args) > 0 {
+		// Return argument path
+		return args[0]
+	}
+
+	configPath, _ := cmd.Flags().GetString(flagConfig)
+	// Use --config when set, else fall back to default lookup
+	return cmp.Or(configPath, config.FindConfigFile())
+}
+
+// validateConfigFile validates a file and prints its errors.
+//
+// Params:
+//   - w: destination writer for the report
+//   - path: config file path
+//
+// Returns:
+//   - bool: true when the file is valid
+func validateConfigFile(w io.Writer, path string) bool {
+	data, err := os.ReadFile(path)
+	// Check read error
+	if err != nil {
+		fmt.Fprintf(w, "%s: %v\n", path, err)
+		// Unreadable file is invalid
+		return false
+	}
+
+	errs := config.ValidateStrict(data, rules.RuleCatalog())
+	// Print each error with the file path
+	for _, verr := range errs {
+		fmt.Fprintf(w, "%s:%s\n", path, formatValidationError(verr))
+	}
+
+	// Check result
+	if len(errs) > 0 {
+		fmt.Fprintf(w, "%d error(s) found\n", len(errs))
+		// Report failure
+		return false
+	}
+
+	fmt.Fprintf(w, "%s: configuration is valid\n", path)
+	// Report success
+	return true
+}
+
+// formatValidationError formats an error for the path:line:col: layout.
+//
+// Params:
+//   - verr: validation error
+//
+// Returns:
+//   - string: error text without the file path
+func formatValidationError(verr config.ValidationError) string {
+	// Check if position is known
+	if verr.Line == 0 {
+		// Keep separator before message
+		return " " + verr.Message
+	}
+	// Return positioned message
+	return verr.Error()
+}
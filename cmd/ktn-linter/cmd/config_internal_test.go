@@ -0,0 +1,69 @@
+// Internal tests for the config command.
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Test_validateConfigFile tests the validateConfigFile function.
+func Test_validateConfigFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		want     bool
+		contains string
+	}{
+		{name: "valid file", content: "version: 1\n", want: true, contains: "configuration is valid"},
+		{name: "unknown key", content: "verison: 1\n", want: false, contains: ":1:1: unknown field"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), ".ktn-linter.yaml")
+			// Write fixture
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatalf("write fixture: %v", err)
+			}
+			var buf bytes.Buffer
+			// Verify result
+			if got := validateConfigFile(&buf, path); got != tt.want {
+				t.Errorf("validateConfigFile() = %v, want %v", got, tt.want)
+			}
+			// Verify output
+			if !strings.Contains(buf.String(), tt.contains) {
+				t.Errorf("output %q does not contain %q", buf.String(), tt.contains)
+			}
+		})
+	}
+}
+
+// Test_writeSchema tests the writeSchema function.
+func Test_writeSchema(t *testing.T) {
+	tests := []struct {
+		name     string
+		contains string
+	}{
+		{name: "contains draft", contains: "draft-07"},
+		{name: "contains rule code", contains: "KTN-FUNC-005"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			// Verify no error
+			if err := writeSchema(&buf); err != nil {
+				t.Fatalf("writeSchema() error: %v", err)
+			}
+			// Verify content
+			if !strings.Contains(buf.String(), tt.contains) {
+				t.Errorf("schema does not contain %q", tt.contains)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+// Package cmd implements the CLI commands for ktn-linter.
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn/ktnpattern"
+	"github.com/kodflow/ktn-linter/pkg/config"
+)
+
+// registerCustomRules compiles the custom rules of the loaded configuration
+// into analyzers, exiting on declarations that cannot be registered.
+//
+// Returns: none
+func registerCustomRules() {
+	// Register declarative rules
+	if err := ktnpattern.Register(config.Get().CustomRules); err != nil {
+		fmt.Fprintf(os.Stderr, "Error registering custom rules: %v\n", err)
+		OsExit(1)
+	}
+}
@@ -0,0 +1,51 @@
+// Internal tests for custom rule registration.
+package cmd
+
+import (
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn"
+	"github.com/kodflow/ktn-linter/pkg/config"
+)
+
+// Test_registerCustomRules tests registration of declared custom rules.
+func Test_registerCustomRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		rules    []config.CustomRuleConfig
+		wantExit bool
+	}{
+		{
+			name:     "valid rule is registered",
+			rules:    []config.CustomRuleConfig{{Code: "CMD-SLEEP-001", Message: "no sleep", Pattern: config.CustomPattern{Call: "time.Sleep"}}},
+			wantExit: false,
+		},
+		{
+			name:     "conflicting rule exits",
+			rules:    []config.CustomRuleConfig{{Code: "KTN-FUNC-001", Message: "taken", Pattern: config.CustomPattern{Call: "time.Sleep"}}},
+			wantExit: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			restore := mockExitInCmd(t)
+			defer restore()
+			cfg := config.DefaultConfig()
+			cfg.CustomRules = tt.rules
+			config.Set(cfg)
+			defer config.Reset()
+
+			code, didExit := catchExitInCmd(t, registerCustomRules)
+			// Verify exit behavior
+			if didExit != tt.wantExit {
+				t.Fatalf("didExit = %v (code %d), want %v", didExit, code, tt.wantExit)
+			}
+			// Verify valid rules are selectable
+			if !tt.wantExit && ktn.GetRuleByCode(tt.rules[0].Code) == nil {
+				t.Errorf("rule %s not registered", tt.rules[0].Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+// Package cmd implements the CLI commands for ktn-linter.
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kodflow/ktn-linter/pkg/reportdiff"
+	"github.com/kodflow/ktn-linter/pkg/severity"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagDiffFormat is the flag name for the diff output format.
+	flagDiffFormat string = "format"
+	// flagDiffFailOn is the flag name for the lowest failing severity.
+	flagDiffFailOn string = "fail-on"
+	// defaultDiffFailOn fails on any new finding.
+	defaultDiffFailOn string = "info"
+	// diffReportCount is the number of reports compared.
+	diffReportCount int = 2
+)
+
+var (
+	// diffFormats are the accepted diff output formats.
+	diffFormats map[string]bool = map[string]bool{
+		"text":     true,
+		"markdown": true,
+		"md":       true,
+		"json":     true,
+	}
+
+	// diffCmd represents the diff command.
+	diffCmd *cobra.Command = &cobra.Command{
+		Use:   "diff <old-report.json> <new-report.json>",
+		Short: "Compare two JSON reports",
+		Long: `Diff compares two reports written by lint --json and lists new, fixed
+and moved findings with the change of each rule.
+
+Findings are matched on rule, file, enclosing function or type and message,
+so findings shifted by edits elsewhere in the file are reported as moved,
+not as new. Files are compared relative to the directory each report was
+written from, so reports of distinct checkouts match. Reports without
+enclosing symbols are matched on rule, file and message only.
+
+The command exits with code 1 when new findings at or above --fail-on appear.
+
+Examples:
+  ktn-linter diff v1.2.json v1.3.json                          Text summary
+  ktn-linter diff --format=markdown -o diff.md old.json new.json  PR comment
+  ktn-linter diff --fail-on=error old.json new.json            Fail on new errors only`,
+		Args: cobra.ExactArgs(diffReportCount),
+		Run:  runDiff,
+	}
+)
+
+// diffOptions holds the diff command settings.
+type diffOptions struct {
+	Format     string
+	OutputPath string
+	FailOn     severity.Level
+}
+
+// init registers the diff command with root.
+//
+// Params: none
+//
+// Returns: none
+func init() {
+	rootCmd.AddCommand(diffCmd)
+	diffCmd.Flags().String(flagDiffFormat, defaultRulesFormat, "Output format: text, markdown, json")
+	diffCmd.Flags().String(flagDiffFailOn, defaultDiffFailOn, "Lowest severity of new findings failing the command: info, warning, error")
+}
+
+// runDiff executes the diff command.
+//
+// Params:
+//   - cmd: Cobra command (used to get flags)
+//   - args: old and new report paths
+//
+// Returns: none
+func runDiff(cmd *cobra.Command, args []string) {
+	opts, err := parseDiffOptions(cmd)
+	// Check for invalid flags
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		OsExit(1)
+		// Exit on error
+		return
+	}
+
+	before, err := reportdiff.ReadReport(args[0])
+	// Check for old report error
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		OsExit(1)
+		// Exit on error
+		return
+	}
+	after, err := reportdiff.ReadReport(args[1])
+	// Check for new report error
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		OsExit(1)
+		// Exit on error
+		return
+	}
+	report := reportdiff.Compare(before, after)
+
+	writer, cleanup := getOutputWriter(opts.OutputPath)
+	// Close output file when done
+	if cleanup != nil {
+		defer cleanup()
+	}
+
+	// Write comparison
+	if err := writeDiffReport(writer, report, opts.Format); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		OsExit(1)
+		// Exit on error
+		return
+	}
+	// Fail on new findings at or above the threshold
+	if report.CountNew(opts.FailOn) > 0 {
+		OsExit(1)
+	}
+}
+
+// parseDiffOptions extracts diff options from Cobra flags.
+//
+// Params:
+//   - cmd: Cobra command with flags
+//
+// Returns:
+//   - diffOptions: extracted options
+//   - error: unknown format or severity error if any
+func parseDiffOptions(cmd *cobra.Command) (diffOptions, error) {
+	outputPath, _ := rootCmd.PersistentFlags().GetString(flagOutput)
+	format, _ := cmd.Flags().GetString(flagDiffFormat)
+	failOn, _ := cmd.Flags().GetString(flagDiffFailOn)
+
+	format = strings.ToLower(format)
+	// Reject unknown formats
+	if !diffFormats[format] {
+		// Return format error
+		return diffOptions{}, fmt.Errorf("unknown format %q (want text, markdown or json)", format)
+	}
+	level, ok := severity.ParseLevel(failOn)
+	// Reject unknown severities
+	if !ok {
+		// Return severity error
+		return diffOptions{}, fmt.Errorf("unknown severity %q (want info, warning or error)", failOn)
+	}
+	// Return parsed options
+	return diffOptions{
+		Format:     format,
+		OutputPath: outputPath,
+		FailOn:     level,
+	}, nil
+}
+
+// writeDiffReport writes a comparison in the selected format.
+//
+// Params:
+//   - w: destination writer
+//   - report: comparison to write
+//   - format: output format
+//
+// Returns:
+//   - error: write or unknown format error if any
+func writeDiffReport(w io.Writer, report *reportdiff.Report, format string) error {
+	// Select output format
+	switch format {
+	// JSON format
+	case "json":
+		// Write JSON comparison
+		return reportdiff.WriteJSON(w, report)
+	// Markdown format
+	case "markdown", "md":
+		// Write Markdown comparison
+		return reportdiff.WriteMarkdown(w, report)
+	// Text format
+	case "text":
+		// Write text comparison
+		return reportdiff.WriteText(w, report)
+	// Unknown format
+	default:
+		// Return format error
+		return fmt.Errorf("unknown format %q (want text, markdown or json)", format)
+	}
+}
@@ -0,0 +1,120 @@
+// Internal tests for the diff command.
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/reportdiff"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// oldDiffReport holds one warning and one error.
+	oldDiffReport string = `{"results":[
+{"ruleId":"KTN-FUNC-001","level":"warning","message":"too long","location":{"file":"a.go","line":10,"column":1},"symbol":"Run"},
+{"ruleId":"KTN-VAR-001","level":"error","message":"bad name","location":{"file":"a.go","line":20,"column":1},"symbol":"Run"}]}`
+	// newDiffReport moves the warning, fixes the error and adds a warning.
+	newDiffReport string = `{"results":[
+{"ruleId":"KTN-FUNC-001","level":"warning","message":"too long","location":{"file":"a.go","line":13,"column":1},"symbol":"Run"},
+{"ruleId":"KTN-VAR-002","level":"warning","message":"shadowed","location":{"file":"a.go","line":30,"column":1},"symbol":"Stop"}]}`
+)
+
+// Test_runDiff tests the runDiff function.
+func Test_runDiff(t *testing.T) {
+	dir := t.TempDir()
+	oldPath := filepath.Join(dir, "old.json")
+	newPath := filepath.Join(dir, "new.json")
+	// Write old report
+	if err := os.WriteFile(oldPath, []byte(oldDiffReport), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// Write new report
+	if err := os.WriteFile(newPath, []byte(newDiffReport), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		args     []string
+		format   string
+		failOn   string
+		wantExit int
+		want     string
+	}{
+		{name: "new warning fails by default", args: []string{oldPath, newPath}, format: "text", failOn: "info", wantExit: 1, want: "New: 1, fixed: 1, moved: 1"},
+		{name: "new warning below threshold", args: []string{oldPath, newPath}, format: "markdown", failOn: "error", wantExit: -1, want: "## ktn-linter diff"},
+		{name: "new error at threshold", args: []string{newPath, oldPath}, format: "json", failOn: "error", wantExit: 1, want: `"fixed"`},
+		{name: "same report", args: []string{oldPath, oldPath}, format: "text", failOn: "info", wantExit: -1, want: "unchanged: 2"},
+		{name: "unknown format", args: []string{oldPath, newPath}, format: "xml", failOn: "info", wantExit: 1, want: ""},
+		{name: "uppercase format", args: []string{oldPath, newPath}, format: "Markdown", failOn: "error", wantExit: -1, want: "## ktn-linter diff"},
+		{name: "unknown severity", args: []string{oldPath, newPath}, format: "text", failOn: "fatal", wantExit: 1, want: ""},
+		{name: "missing report", args: []string{filepath.Join(dir, "missing.json"), newPath}, format: "text", failOn: "info", wantExit: 1, want: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			origExit := OsExit
+			outputPath := filepath.Join(t.TempDir(), "diff.out")
+			// Restore exit and output flag after test
+			defer func() {
+				OsExit = origExit
+				rootCmd.PersistentFlags().Set(flagOutput, "")
+			}()
+			exitCode := -1
+			OsExit = func(code int) {
+				exitCode = code
+			}
+			rootCmd.PersistentFlags().Set(flagOutput, outputPath)
+
+			cmd := &cobra.Command{}
+			cmd.Flags().String(flagDiffFormat, tt.format, "")
+			cmd.Flags().String(flagDiffFailOn, tt.failOn, "")
+			runDiff(cmd, tt.args)
+
+			// Check exit code
+			if exitCode != tt.wantExit {
+				t.Errorf("runDiff() exit code = %d, want %d", exitCode, tt.wantExit)
+			}
+			output, _ := os.ReadFile(outputPath)
+			// Check output
+			if !strings.Contains(string(output), tt.want) {
+				t.Errorf("runDiff() output = %q, want %q", output, tt.want)
+			}
+		})
+	}
+}
+
+// Test_writeDiffReport tests the writeDiffReport function.
+func Test_writeDiffReport(t *testing.T) {
+	tests := []struct {
+		name       string
+		format     string
+		wantPrefix string
+		wantErr    bool
+	}{
+		{name: "text", format: "text", wantPrefix: "New: 0"},
+		{name: "markdown", format: "markdown", wantPrefix: "## ktn-linter diff"},
+		{name: "md alias", format: "md", wantPrefix: "## ktn-linter diff"},
+		{name: "json", format: "json", wantPrefix: "{"},
+		{name: "unknown format", format: "xml", wantPrefix: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			// Check write error
+			if err := writeDiffReport(&buf, &reportdiff.Report{}, tt.format); (err != nil) != tt.wantErr {
+				t.Fatalf("writeDiffReport() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// Verify format
+			if !strings.HasPrefix(buf.String(), tt.wantPrefix) {
+				t.Errorf("output = %q, want prefix %q", buf.String(), tt.wantPrefix)
+			}
+		})
+	}
+}
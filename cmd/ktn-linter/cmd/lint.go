@@ -2,10 +2,13 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"go/token"
 	"io"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/kodflow/ktn-linter/pkg/config"
 	"github.com/kodflow/ktn-linter/pkg/formatter"
@@ -15,16 +18,27 @@ import (
 	"golang.org/x/tools/go/packages"
 )
 
+// findingDetails provides the metadata of extracted findings reported by
+// output formats.
+type findingDetails interface {
+	Symbol(pos token.Position) string
+	CPUShare(pos token.Position) float64
+	HotPath(pos token.Position) string
+}
+
 // lintOrchestrator defines the interface for linting orchestration.
 // Abstracts the orchestrator for testability.
 type lintOrchestrator interface {
-	LoadPackages(patterns []string) ([]*packages.Package, error)
+	findingDetails
+	LoadPackagesContext(ctx context.Context, dir string, patterns []string) ([]*packages.Package, error)
 	SelectAnalyzers(opts orchestrator.Options) ([]*analysis.Analyzer, error)
-	RunAnalyzers(pkgs []*packages.Package, analyzers []*analysis.Analyzer) []orchestrator.DiagnosticResult
+	RunAnalyzersContext(ctx context.Context, pkgs []*packages.Package, analyzers []*analysis.Analyzer) ([]orchestrator.DiagnosticResult, error)
 	FilterDiagnostics(diagnostics []orchestrator.DiagnosticResult) []orchestrator.DiagnosticResult
 	ExtractDiagnostics(diagnostics []orchestrator.DiagnosticResult) []analysis.Diagnostic
 	DiscoverModules(paths []string) ([]string, error)
-	RunMultiModule(paths []string, opts orchestrator.Options) ([]orchestrator.DiagnosticResult, error)
+	RunMultiModuleContext(ctx context.Context, paths []string, opts orchestrator.Options) ([]orchestrator.DiagnosticResult, error)
+	StreamAnalyzersContext(ctx context.Context, pkgs []*packages.Package, analyzers []*analysis.Analyzer, emit func([]orchestrator.DiagnosticResult)) error
+	StreamMultiModuleContext(ctx context.Context, paths []string, opts orchestrator.Options, emit func([]orchestrator.DiagnosticResult)) error
 }
 
 // lintCmd represents the lint command.
@@ -42,6 +56,30 @@ const (
 	flagSarif string = "sarif"
 	// flagJSON is the flag name for JSON output.
 	flagJSON string = "json"
+	// flagWatch is the flag name for watch mode.
+	flagWatch string = "watch"
+	// flagWatchInterval is the flag name for the watch polling interval.
+	flagWatchInterval string = "watch-interval"
+	// flagStrictLoad is the flag name for failing on package load errors.
+	flagStrictLoad string = "strict-load"
+	// flagProfile is the flag name for the timing report.
+	flagProfile string = "profile"
+	// flagCPUProfile is the flag name for the pprof CPU profile file.
+	flagCPUProfile string = "cpuprofile"
+	// flagMemProfile is the flag name for the pprof heap profile file.
+	flagMemProfile string = "memprofile"
+	// flagTrace is the flag name for the execution trace file.
+	flagTrace string = "trace"
+	// flagTimeout is the flag name for the overall analysis timeout.
+	flagTimeout string = "timeout"
+	// flagPGO is the flag name for the CPU profile ranking performance findings.
+	flagPGO string = "pgo"
+	// flagJobs is the flag name for the number of modules analyzed at once.
+	flagJobs string = "jobs"
+	// flagStream is the flag name for displaying findings package by package.
+	flagStream string = "stream"
+	// defaultWatchInterval is the default watch polling interval.
+	defaultWatchInterval time.Duration = 500 * time.Millisecond
 )
 
 // init registers the lint command with root.
@@ -54,6 +92,20 @@ func init() {
 	// Add lint-specific flags
 	lintCmd.Flags().Bool(flagSarif, false, "Output in SARIF format (for IDE integration)")
 	lintCmd.Flags().Bool(flagJSON, false, "Output in JSON format")
+	lintCmd.Flags().Bool(flagWatch, false, "Watch .go files and config, re-analyze changed packages")
+	lintCmd.Flags().Duration(flagWatchInterval, defaultWatchInterval, "Polling interval for --watch")
+	lintCmd.Flags().Bool(flagStrictLoad, false, "Abort on the first package load or type error instead of reporting KTN-LOAD findings")
+	lintCmd.Flags().Bool(flagProfile, false, "Report the slowest analyzers and packages on stderr")
+	lintCmd.Flags().String(flagCPUProfile, "", "Write a pprof CPU profile to file")
+	lintCmd.Flags().String(flagMemProfile, "", "Write a pprof heap profile to file")
+	lintCmd.Flags().String(flagTrace, "", "Write a runtime execution trace to file")
+	lintCmd.Flags().Duration(flagTimeout, 0, "Stop the analysis after this duration and report partial results (0 = no limit)")
+	lintCmd.Flags().String(flagPGO, "", "CPU profile ranking performance findings by CPU share (default: the module's default.pgo)")
+	lintCmd.Flags().Int(flagJobs, 0, "Modules loaded and analyzed concurrently (0 = GOMAXPROCS)")
+	lintCmd.Flags().Bool(flagStream, false, "Display text findings as each package is analyzed")
+	lintCmd.Flags().String(flagTags, "", "Comma-separated build tags to analyze (overrides build_matrix)")
+	lintCmd.Flags().String(flagGOOS, "", "Target GOOS to analyze (overrides build_matrix)")
+	lintCmd.Flags().String(flagGOARCH, "", "Target GOARCH to analyze (overrides build_matrix)")
 }
 
 // runLint executes the linting analysis.
@@ -68,26 +120,85 @@ func runLint(cmd *cobra.Command, args []string) {
 
 	// Load configuration
 	loadConfiguration(opts.Options)
+	registerCustomRules()
 
 	// Propagate verbose flag to config
 	config.Get().Verbose = opts.Verbose
 
 	// Create orchestrator
 	orch := orchestrator.NewOrchestrator(os.Stderr, opts.Verbose)
-
-	// Run the linting pipeline
-	diags, fset, err := runPipeline(orch, args, opts.Options)
-	// Check for error
+	builds := buildMatrix(&opts.Build, config.Get())
+	orch.SetBuildMatrix(builds)
+	orch.SetStrictLoad(opts.StrictLoad)
+	orch.SetPGOProfile(opts.PGOProfile)
+	orch.SetJobs(opts.Jobs)
+
+	profiling, err := startProfiling(orch, opts.Profiling)
+	// Check profiling setup error
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		OsExit(1)
+		// Exit on setup error
+		return
+	}
+
+	// Check for watch mode
+	if opts.Watch {
+		runWatch(orch, args, opts)
+		stopProfiling(profiling)
+		// Watch mode ends on interrupt
+		return
+	}
+
+	// Run the linting pipeline until done, interrupted or timed out
+	ctx, stop := lintContext(opts.Timeout)
+	defer stop()
+
+	// Display findings as packages complete when the output allows it
+	if canStream(&opts, builds) {
+		count, err := runStreamPipeline(ctx, orch, args, &opts)
+		stopProfiling(profiling)
+		reportPipelineError(err)
+		exitLint(count, err)
+		// Streamed results already displayed
+		return
 	}
 
+	diags, fset, err := runPipeline(ctx, orch, args, opts.Options)
+	stopProfiling(profiling)
+	reportPipelineError(err)
+
 	// Format and display results
-	formatAndDisplay(diags, fset, opts)
+	formatAndDisplay(diags, fset, &opts, orch)
+	exitLint(len(diags), err)
+}
 
+// reportPipelineError reports a pipeline error on stderr. Interrupted runs
+// keep their partial results; other failures exit with code 1.
+//
+// Params:
+//   - err: pipeline error, nil on success
+func reportPipelineError(err error) {
+	// Check for error
+	switch {
+	// Interrupted run: report partial results
+	case isInterrupted(err):
+		fmt.Fprintf(os.Stderr, "analysis interrupted (%v): partial results\n", err)
+	// Pipeline failure
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		OsExit(1)
+	}
+}
+
+// exitLint exits with code 1 when issues were found or the run failed.
+//
+// Params:
+//   - count: number of issues found
+//   - err: pipeline error, nil on success
+func exitLint(count int, err error) {
 	// Exit with appropriate code
-	if len(diags) > 0 {
+	if count > 0 || err != nil {
 		OsExit(1)
 	}
 	OsExit(0)
@@ -96,8 +207,17 @@ func runLint(cmd *cobra.Command, args []string) {
 // lintOptions extends orchestrator options with CLI-specific settings.
 type lintOptions struct {
 	orchestrator.Options
-	Format     formatter.OutputFormat
-	OutputPath string
+	Format        formatter.OutputFormat
+	OutputPath    string
+	Watch         bool
+	StrictLoad    bool
+	WatchInterval time.Duration
+	Profiling     profileOptions
+	Build         config.BuildConfig
+	Timeout       time.Duration
+	PGOProfile    string
+	Jobs          int
+	Stream        bool
 }
 
 // parseOptions extracts options from Cobra flags.
@@ -115,13 +235,25 @@ func parseOptions(cmd *cobra.Command) lintOptions {
 	onlyRule, _ := flags.GetString(flagOnlyRule)
 	configPath, _ := flags.GetString(flagConfig)
 	outputPath, _ := flags.GetString(flagOutput)
+	format, _ := flags.GetString(flagFormat)
 
 	// Check lint-specific format flags (--sarif, --json)
 	sarifMode, _ := cmd.Flags().GetBool(flagSarif)
 	jsonMode, _ := cmd.Flags().GetBool(flagJSON)
-
-	// Determine output format
-	outputFormat := formatter.FormatText
+	watch, _ := cmd.Flags().GetBool(flagWatch)
+	watchInterval, _ := cmd.Flags().GetDuration(flagWatchInterval)
+	strictLoad, _ := cmd.Flags().GetBool(flagStrictLoad)
+	profile, _ := cmd.Flags().GetBool(flagProfile)
+	cpuProfile, _ := cmd.Flags().GetString(flagCPUProfile)
+	memProfile, _ := cmd.Flags().GetString(flagMemProfile)
+	tracePath, _ := cmd.Flags().GetString(flagTrace)
+	timeout, _ := cmd.Flags().GetDuration(flagTimeout)
+	pgoProfile, _ := cmd.Flags().GetString(flagPGO)
+	jobs, _ := cmd.Flags().GetInt(flagJobs)
+	stream, _ := cmd.Flags().GetBool(flagStream)
+
+	// Determine output format, --sarif and --json overriding --format
+	outputFormat := formatter.ParseOutputFormat(strings.ToLower(format))
 	// Check for SARIF format
 	if sarifMode {
 		outputFormat = formatter.FormatSARIF
@@ -138,8 +270,22 @@ func parseOptions(cmd *cobra.Command) lintOptions {
 			OnlyRule:   onlyRule,
 			ConfigPath: configPath,
 		},
-		Format:     outputFormat,
-		OutputPath: outputPath,
+		Format:        outputFormat,
+		OutputPath:    outputPath,
+		Watch:         watch,
+		StrictLoad:    strictLoad,
+		WatchInterval: watchInterval,
+		Profiling: profileOptions{
+			Report:     profile,
+			CPUProfile: cpuProfile,
+			MemProfile: memProfile,
+			TracePath:  tracePath,
+		},
+		Build:      parseBuildFlags(cmd),
+		Timeout:    timeout,
+		PGOProfile: pgoProfile,
+		Jobs:       jobs,
+		Stream:     stream,
 	}
 }
 
@@ -175,8 +321,11 @@ func loadConfiguration(opts orchestrator.Options) {
 }
 
 // runPipeline runs the complete linting pipeline.
+// When ctx is cancelled, the results gathered so far are returned with the
+// context error.
 //
 // Params:
+//   - ctx: run context (interrupt and overall timeout)
 //   - orch: linting orchestrator interface
 //   - args: package patterns or paths
 //   - opts: linting options
@@ -185,50 +334,21 @@ func loadConfiguration(opts orchestrator.Options) {
 //   - []analysis.Diagnostic: found issues
 //   - *token.FileSet: first fileset for formatting
 //   - error: pipeline error if any
-func runPipeline(orch lintOrchestrator, args []string, opts orchestrator.Options) ([]analysis.Diagnostic, *token.FileSet, error) {
+func runPipeline(ctx context.Context, orch lintOrchestrator, args []string, opts orchestrator.Options) ([]analysis.Diagnostic, *token.FileSet, error) {
 	// Check if we need multi-module discovery
-	if needsModuleDiscovery(args) {
+	if orchestrator.NeedsModuleDiscovery(args) {
 		// Use multi-module approach
-		return runMultiModulePipeline(orch, args, opts)
+		return runMultiModulePipeline(ctx, orch, args, opts)
 	}
 
 	// Use standard single-module approach
-	return runSingleModulePipeline(orch, args, opts)
-}
-
-// needsModuleDiscovery checks if args require module discovery.
-//
-// Params:
-//   - args: command line arguments
-//
-// Returns:
-//   - bool: true if discovery needed
-func needsModuleDiscovery(args []string) bool {
-	// Check each arg
-	for _, arg := range args {
-		// Skip standard Go patterns
-		if arg == "./..." || arg == "." {
-			continue
-		}
-		// Check if path exists as directory
-		info, err := os.Stat(arg)
-		// Skip if not accessible
-		if err != nil {
-			continue
-		}
-		// Check if directory
-		if info.IsDir() {
-			// Directory found, discovery needed
-			return true
-		}
-	}
-	// No directory found, no discovery needed
-	return false
+	return runSingleModulePipeline(ctx, orch, args, opts)
 }
 
 // runMultiModulePipeline runs analysis across multiple modules.
 //
 // Params:
+//   - ctx: run context
 //   - orch: linting orchestrator interface
 //   - args: paths to analyze
 //   - opts: linting options
@@ -237,35 +357,25 @@ func needsModuleDiscovery(args []string) bool {
 //   - []analysis.Diagnostic: found issues
 //   - *token.FileSet: first fileset for formatting
 //   - error: pipeline error if any
-func runMultiModulePipeline(orch lintOrchestrator, args []string, opts orchestrator.Options) ([]analysis.Diagnostic, *token.FileSet, error) {
+func runMultiModulePipeline(ctx context.Context, orch lintOrchestrator, args []string, opts orchestrator.Options) ([]analysis.Diagnostic, *token.FileSet, error) {
 	// Run multi-module analysis
-	rawDiags, err := orch.RunMultiModule(args, opts)
-	// Check for error
-	if err != nil {
+	rawDiags, err := orch.RunMultiModuleContext(ctx, args, opts)
+	// Check for error other than an interruption
+	if err != nil && !isInterrupted(err) {
 		// Return error from multi-module analysis
 		return []analysis.Diagnostic{}, nil, err
 	}
 
-	// Filter diagnostics
-	filtered := orch.FilterDiagnostics(rawDiags)
-
-	// Get first fset for formatting
-	var fset *token.FileSet
-	// Check if diagnostics exist
-	if len(filtered) > 0 {
-		fset = filtered[0].Fset
-	}
-
-	// Extract and deduplicate
-	diags := orch.ExtractDiagnostics(filtered)
-
+	// Filter and extract (partial) results
+	diags, fset := collectResults(orch, rawDiags)
 	// Return results
-	return diags, fset, nil
+	return diags, fset, err
 }
 
 // runSingleModulePipeline runs analysis for a single module.
 //
 // Params:
+//   - ctx: run context
 //   - orch: linting orchestrator interface
 //   - args: package patterns
 //   - opts: linting options
@@ -274,9 +384,9 @@ func runMultiModulePipeline(orch lintOrchestrator, args []string, opts orchestra
 //   - []analysis.Diagnostic: found issues
 //   - *token.FileSet: first fileset for formatting
 //   - error: pipeline error if any
-func runSingleModulePipeline(orch lintOrchestrator, args []string, opts orchestrator.Options) ([]analysis.Diagnostic, *token.FileSet, error) {
+func runSingleModulePipeline(ctx context.Context, orch lintOrchestrator, args []string, opts orchestrator.Options) ([]analysis.Diagnostic, *token.FileSet, error) {
 	// Load packages
-	pkgs, err := orch.LoadPackages(args)
+	pkgs, err := orch.LoadPackagesContext(ctx, "", args)
 	// Check for error
 	if err != nil {
 		// Return error
@@ -291,9 +401,25 @@ func runSingleModulePipeline(orch lintOrchestrator, args []string, opts orchestr
 		return []analysis.Diagnostic{}, nil, err
 	}
 
-	// Run analyzers
-	rawDiags := orch.RunAnalyzers(pkgs, analyzers)
+	// Run analyzers, keeping partial results on interruption
+	rawDiags, err := orch.RunAnalyzersContext(ctx, pkgs, analyzers)
 
+	// Filter and extract (partial) results
+	diags, fset := collectResults(orch, rawDiags)
+	// Return results
+	return diags, fset, err
+}
+
+// collectResults filters and deduplicates raw analyzer results.
+//
+// Params:
+//   - orch: linting orchestrator interface
+//   - rawDiags: raw analyzer results
+//
+// Returns:
+//   - []analysis.Diagnostic: found issues
+//   - *token.FileSet: first fileset for formatting
+func collectResults(orch lintOrchestrator, rawDiags []orchestrator.DiagnosticResult) ([]analysis.Diagnostic, *token.FileSet) {
 	// Filter diagnostics
 	filtered := orch.FilterDiagnostics(rawDiags)
 
@@ -305,10 +431,7 @@ func runSingleModulePipeline(orch lintOrchestrator, args []string, opts orchestr
 	}
 
 	// Extract and deduplicate
-	diags := orch.ExtractDiagnostics(filtered)
-
-	// Return results
-	return diags, fset, nil
+	return orch.ExtractDiagnostics(filtered), fset
 }
 
 // formatAndDisplay formats and displays diagnostics.
@@ -317,9 +440,10 @@ func runSingleModulePipeline(orch lintOrchestrator, args []string, opts orchestr
 //   - diagnostics: diagnostics to display
 //   - fset: fileset for positions
 //   - opts: lint options including format and output path
+//   - details: metadata of the diagnostics (nil for none)
 //
 // Returns: none
-func formatAndDisplay(diagnostics []analysis.Diagnostic, fset *token.FileSet, opts lintOptions) {
+func formatAndDisplay(diagnostics []analysis.Diagnostic, fset *token.FileSet, opts *lintOptions, details findingDetails) {
 	// Get output writer
 	writer, cleanup := getOutputWriter(opts.OutputPath)
 	// Defer cleanup
@@ -327,18 +451,8 @@ func formatAndDisplay(diagnostics []analysis.Diagnostic, fset *token.FileSet, op
 		defer cleanup()
 	}
 
-	// Create formatter options
-	// SimpleMode désactivé : on affiche toujours le format complet
-	// VerboseMode n'affecte plus les messages (toujours longs)
-	fmtOpts := formatter.FormatterOptions{
-		AIMode:      false,
-		NoColor:     opts.OutputPath != "",
-		SimpleMode:  false,
-		VerboseMode: false,
-	}
-
 	// Create formatter based on format
-	fmtr := formatter.NewFormatterByFormat(opts.Format, writer, fmtOpts)
+	fmtr := formatter.NewFormatterByFormat(opts.Format, writer, formatterOptions(opts, details))
 
 	// Check if empty
 	if len(diagnostics) == 0 {
@@ -350,6 +464,36 @@ func formatAndDisplay(diagnostics []analysis.Diagnostic, fset *token.FileSet, op
 	fmtr.Format(fset, diagnostics)
 }
 
+// formatterOptions returns the formatter options of the lint output.
+//
+// Params:
+//   - opts: lint options including output path
+//   - details: metadata of the diagnostics (nil for none)
+//
+// Returns:
+//   - formatter.FormatterOptions: options for the output formatter
+func formatterOptions(opts *lintOptions, details findingDetails) formatter.FormatterOptions {
+	// Relative files of reports from distinct checkouts match in diff
+	baseDir, _ := os.Getwd()
+	// SimpleMode désactivé : on affiche toujours le format complet
+	// VerboseMode n'affecte plus les messages (toujours longs)
+	fmtOpts := formatter.FormatterOptions{
+		AIMode:      false,
+		NoColor:     opts.OutputPath != "",
+		SimpleMode:  false,
+		VerboseMode: false,
+		BaseDir:     baseDir,
+	}
+	// Report the metadata of extracted findings
+	if details != nil {
+		fmtOpts.Symbols = details.Symbol
+		fmtOpts.CPUShares = details.CPUShare
+		fmtOpts.HotPaths = details.HotPath
+	}
+	// Return options
+	return fmtOpts
+}
+
 // getOutputWriter returns the writer for output and optional cleanup function.
 //
 // Params:
@@ -0,0 +1,74 @@
+// Package cmd implements the CLI commands for ktn-linter.
+package cmd
+
+import (
+	"strings"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+// Build configuration flag names for lint command.
+const (
+	// flagTags is the flag name for additional build tags.
+	flagTags string = "tags"
+	// flagGOOS is the flag name for the target operating system.
+	flagGOOS string = "goos"
+	// flagGOARCH is the flag name for the target architecture.
+	flagGOARCH string = "goarch"
+)
+
+// parseBuildFlags extracts the build configuration from lint flags.
+//
+// Params:
+//   - cmd: Cobra command with flags
+//
+// Returns:
+//   - config.BuildConfig: requested configuration (host when no flag is set)
+func parseBuildFlags(cmd *cobra.Command) config.BuildConfig {
+	tags, _ := cmd.Flags().GetString(flagTags)
+	goos, _ := cmd.Flags().GetString(flagGOOS)
+	goarch, _ := cmd.Flags().GetString(flagGOARCH)
+
+	var tagList []string
+	// Split comma or space separated tags like go build -tags
+	for tag := range strings.FieldsFuncSeq(tags, isTagSeparator) {
+		tagList = append(tagList, tag)
+	}
+
+	// Return requested configuration
+	return config.BuildConfig{GOOS: goos, GOARCH: goarch, Tags: tagList}
+}
+
+// isTagSeparator reports whether r separates build tags.
+//
+// Params:
+//   - r: rune to check
+//
+// Returns:
+//   - bool: true for commas and spaces
+func isTagSeparator(r rune) bool {
+	// Accept the separators of go build -tags
+	return r == ',' || r == ' '
+}
+
+// buildMatrix returns the build configurations to analyze.
+// Command line flags select a single configuration and take precedence
+// over the build_matrix of the configuration file.
+//
+// Params:
+//   - build: configuration requested on the command line
+//   - cfg: loaded configuration
+//
+// Returns:
+//   - []config.BuildConfig: configurations, nil for the host only
+func buildMatrix(build *config.BuildConfig, cfg *config.Config) []config.BuildConfig {
+	// Command line flags override the configuration file
+	if !build.IsHost() {
+		// Return the single requested configuration
+		return []config.BuildConfig{*build}
+	}
+
+	// Use the configured matrix
+	return cfg.BuildConfigurations()
+}
@@ -0,0 +1,96 @@
+// Internal tests for the lint build configuration flags.
+package cmd
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+// Test_parseBuildFlags tests the parseBuildFlags function.
+func Test_parseBuildFlags(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantGOOS string
+		wantTags []string
+	}{
+		{name: "defaults", args: []string{}, wantGOOS: "", wantTags: nil},
+		{name: "goos and tags", args: []string{"--goos=windows", "--tags=integration, e2e"}, wantGOOS: "windows", wantTags: []string{"integration", "e2e"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{Use: "lint"}
+			cmd.Flags().String(flagTags, "", "")
+			cmd.Flags().String(flagGOOS, "", "")
+			cmd.Flags().String(flagGOARCH, "", "")
+			// Check parse error
+			if err := cmd.Flags().Parse(tt.args); err != nil {
+				t.Fatal(err)
+			}
+			got := parseBuildFlags(cmd)
+			// Verify parsed configuration
+			if got.GOOS != tt.wantGOOS || !slices.Equal(got.Tags, tt.wantTags) {
+				t.Errorf("parseBuildFlags() = %+v, want goos %q and tags %v", got, tt.wantGOOS, tt.wantTags)
+			}
+		})
+	}
+}
+
+// Test_isTagSeparator tests the isTagSeparator function.
+func Test_isTagSeparator(t *testing.T) {
+	tests := []struct {
+		name string
+		r    rune
+		want bool
+	}{
+		{name: "comma", r: ',', want: true},
+		{name: "space", r: ' ', want: true},
+		{name: "letter", r: 'a', want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify separator detection
+			if got := isTagSeparator(tt.r); got != tt.want {
+				t.Errorf("isTagSeparator(%q) = %v, want %v", tt.r, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_buildMatrix tests the buildMatrix function.
+func Test_buildMatrix(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.BuildMatrix = []config.BuildConfig{{GOOS: "windows"}}
+	tests := []struct {
+		name  string
+		build config.BuildConfig
+		cfg   *config.Config
+		want  []string
+	}{
+		{name: "host without matrix", build: config.BuildConfig{}, cfg: config.DefaultConfig(), want: nil},
+		{name: "configured matrix", build: config.BuildConfig{}, cfg: cfg, want: []string{"host", "windows"}},
+		{name: "flags override matrix", build: config.BuildConfig{Tags: []string{"integration"}}, cfg: cfg, want: []string{"tags=integration"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			// Collect labels
+			for _, build := range buildMatrix(&tt.build, tt.cfg) {
+				got = append(got, build.Label())
+			}
+			// Verify configurations
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("buildMatrix() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"go/token"
 	"io"
 	"os"
@@ -230,6 +231,98 @@ func Test_parseOptions_FormatFlags(t *testing.T) {
 	}
 }
 
+// Test_parseOptions_PGOFlag tests the --pgo flag.
+func Test_parseOptions_PGOFlag(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantPGO string
+	}{
+		{name: "default uses the module default.pgo", value: "", wantPGO: ""},
+		{name: "explicit profile", value: "/tmp/cpu.pprof", wantPGO: "/tmp/cpu.pprof"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			lintCmd.Flags().Set(flagPGO, tt.value)
+			// Reset the flag after the test
+			defer lintCmd.Flags().Set(flagPGO, "")
+			opts := parseOptions(lintCmd)
+
+			// Verify profile path
+			if opts.PGOProfile != tt.wantPGO {
+				t.Errorf("PGOProfile = %q, want %q", opts.PGOProfile, tt.wantPGO)
+			}
+		})
+	}
+}
+
+// Test_parseOptions_FormatFlag tests the output format selection.
+func Test_parseOptions_FormatFlag(t *testing.T) {
+	tests := []struct {
+		name       string
+		format     string
+		json       string
+		wantFormat formatter.OutputFormat
+	}{
+		{name: "default text", format: "text", json: "false", wantFormat: formatter.FormatText},
+		{name: "jsonl format", format: "jsonl", json: "false", wantFormat: formatter.FormatJSONL},
+		{name: "case insensitive", format: "JSONL", json: "false", wantFormat: formatter.FormatJSONL},
+		{name: "unknown format", format: "xml", json: "false", wantFormat: formatter.FormatText},
+		{name: "json flag overrides format", format: "jsonl", json: "true", wantFormat: formatter.FormatJSON},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			rootCmd.PersistentFlags().Set(flagFormat, tt.format)
+			lintCmd.Flags().Set(flagSarif, "false")
+			lintCmd.Flags().Set(flagJSON, tt.json)
+			// Reset the flags after the test
+			defer rootCmd.PersistentFlags().Set(flagFormat, "text")
+			defer lintCmd.Flags().Set(flagJSON, "false")
+			opts := parseOptions(lintCmd)
+
+			// Verify selected format
+			if opts.Format != tt.wantFormat {
+				t.Errorf("Format = %q, want %q", opts.Format, tt.wantFormat)
+			}
+		})
+	}
+}
+
+// Test_parseOptions_StreamFlags tests the --jobs and --stream flags.
+func Test_parseOptions_StreamFlags(t *testing.T) {
+	tests := []struct {
+		name       string
+		jobs       string
+		stream     string
+		wantJobs   int
+		wantStream bool
+	}{
+		{name: "defaults", jobs: "0", stream: "false", wantJobs: 0, wantStream: false},
+		{name: "explicit values", jobs: "4", stream: "true", wantJobs: 4, wantStream: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			lintCmd.Flags().Set(flagJobs, tt.jobs)
+			lintCmd.Flags().Set(flagStream, tt.stream)
+			// Reset the flags after the test
+			defer lintCmd.Flags().Set(flagJobs, "0")
+			defer lintCmd.Flags().Set(flagStream, "false")
+			opts := parseOptions(lintCmd)
+
+			// Verify parsed values
+			if opts.Jobs != tt.wantJobs || opts.Stream != tt.wantStream {
+				t.Errorf("Jobs, Stream = %d, %v; want %d, %v", opts.Jobs, opts.Stream, tt.wantJobs, tt.wantStream)
+			}
+		})
+	}
+}
+
 // Test_loadConfiguration tests the loadConfiguration function.
 func Test_loadConfiguration(t *testing.T) {
 	tests := []struct {
@@ -383,7 +476,7 @@ func Test_runPipeline(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			orch := orchestrator.NewOrchestrator(os.Stderr, tt.opts.Verbose)
 
-			diags, fset, err := runPipeline(orch, tt.packages, tt.opts)
+			diags, fset, err := runPipeline(context.Background(), orch, tt.packages, tt.opts)
 
 			// Verify error expectation
 			if tt.expectError && err == nil {
@@ -447,7 +540,7 @@ func Test_formatAndDisplay(t *testing.T) {
 			r, w, _ := os.Pipe()
 			os.Stdout = w
 
-			formatAndDisplay(tt.diagnostics, tt.fset, tt.opts)
+			formatAndDisplay(tt.diagnostics, tt.fset, &tt.opts, nil)
 
 			w.Close()
 			var stdout bytes.Buffer
@@ -642,57 +735,6 @@ func Test_getOutputWriter(t *testing.T) {
 	}
 }
 
-// Test_needsModuleDiscovery tests the needsModuleDiscovery function.
-func Test_needsModuleDiscovery(t *testing.T) {
-	tests := []struct {
-		name     string
-		args     []string
-		expected bool
-	}{
-		{
-			name:     "standard pattern ./...",
-			args:     []string{"./..."},
-			expected: false,
-		},
-		{
-			name:     "single dot pattern",
-			args:     []string{"."},
-			expected: false,
-		},
-		{
-			name:     "package path pattern",
-			args:     []string{"github.com/example/pkg"},
-			expected: false,
-		},
-		{
-			name:     "nonexistent directory",
-			args:     []string{"/nonexistent/path/to/dir"},
-			expected: false,
-		},
-		{
-			name:     "multiple standard patterns",
-			args:     []string{"./...", "."},
-			expected: false,
-		},
-		{
-			name:     "empty args",
-			args:     []string{},
-			expected: false,
-		},
-	}
-
-	for _, tt := range tests {
-		tt := tt // Capture range variable
-		t.Run(tt.name, func(t *testing.T) {
-			result := needsModuleDiscovery(tt.args)
-			// Verify result
-			if result != tt.expected {
-				t.Errorf("needsModuleDiscovery(%v) = %v, want %v", tt.args, result, tt.expected)
-			}
-		})
-	}
-}
-
 // Test_runMultiModulePipeline tests the runMultiModulePipeline function.
 func Test_runMultiModulePipeline(t *testing.T) {
 	tests := []struct {
@@ -726,7 +768,7 @@ func Test_runMultiModulePipeline(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			orch := orchestrator.NewOrchestrator(os.Stderr, false)
 
-			diags, fset, err := runMultiModulePipeline(orch, tt.args, tt.opts)
+			diags, fset, err := runMultiModulePipeline(context.Background(), orch, tt.args, tt.opts)
 
 			if tt.expectError {
 				if err == nil {
@@ -797,7 +839,7 @@ func Test_runSingleModulePipeline(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			orch := orchestrator.NewOrchestrator(os.Stderr, false)
 
-			diags, fset, err := runSingleModulePipeline(orch, tt.packages, tt.opts)
+			diags, fset, err := runSingleModulePipeline(context.Background(), orch, tt.packages, tt.opts)
 
 			// Verify error expectation
 			if tt.expectError && err == nil {
@@ -816,3 +858,22 @@ func Test_runSingleModulePipeline(t *testing.T) {
 		})
 	}
 }
+
+// Test_runSingleModulePipeline_cancelled tests that an interrupted run
+// returns its (partial) results with the context error.
+func Test_runSingleModulePipeline_cancelled(t *testing.T) {
+	orch := orchestrator.NewOrchestrator(io.Discard, false)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	diags, _, err := runSingleModulePipeline(ctx, orch, []string{"../../../pkg/formatter"}, orchestrator.Options{})
+
+	// Verify the interruption is reported
+	if !isInterrupted(err) {
+		t.Fatalf("expected interruption error, got %v", err)
+	}
+	// Verify results are still returned
+	if diags == nil {
+		t.Error("expected non-nil diagnostics")
+	}
+}
@@ -0,0 +1,49 @@
+// Package cmd implements the CLI commands for ktn-linter.
+package cmd
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"time"
+)
+
+// lintContext returns the context of a lint run, cancelled on SIGINT or
+// when the timeout expires. After a first interrupt, the default signal
+// handling is restored so a second one terminates the process.
+//
+// Params:
+//   - timeout: overall analysis timeout (0 = no limit)
+//
+// Returns:
+//   - context.Context: run context
+//   - context.CancelFunc: releases the signal handler and timer
+func lintContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	context.AfterFunc(ctx, stop)
+	// Check for a timeout
+	if timeout <= 0 {
+		// Return interruptible context
+		return ctx, stop
+	}
+	timed, cancel := context.WithTimeout(ctx, timeout)
+	// Return interruptible context with deadline
+	return timed, func() {
+		cancel()
+		stop()
+	}
+}
+
+// isInterrupted reports whether a pipeline error comes from an interrupt or
+// the overall timeout, in which case partial results are still reported.
+//
+// Params:
+//   - err: pipeline error
+//
+// Returns:
+//   - bool: true for cancellation and timeout errors
+func isInterrupted(err error) bool {
+	// Check context errors
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,81 @@
+// Internal tests for lint run interruption.
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// Test_lintContext tests the run context with and without timeout.
+func Test_lintContext(t *testing.T) {
+	tests := []struct {
+		name         string
+		timeout      time.Duration
+		wantDeadline bool
+	}{
+		{name: "no timeout", timeout: 0, wantDeadline: false},
+		{name: "negative timeout", timeout: -time.Second, wantDeadline: false},
+		{name: "with timeout", timeout: time.Hour, wantDeadline: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, stop := lintContext(tt.timeout)
+			_, hasDeadline := ctx.Deadline()
+			// Verify deadline
+			if hasDeadline != tt.wantDeadline {
+				t.Errorf("deadline = %v, want %v", hasDeadline, tt.wantDeadline)
+			}
+			// Verify context is live before stop
+			if ctx.Err() != nil {
+				t.Errorf("unexpected early cancellation: %v", ctx.Err())
+			}
+			stop()
+			// Verify stop cancels the context
+			if ctx.Err() == nil {
+				t.Error("expected context cancelled after stop")
+			}
+		})
+	}
+}
+
+// Test_lintContext_expires tests that the timeout cancels the run.
+func Test_lintContext_expires(t *testing.T) {
+	ctx, stop := lintContext(time.Millisecond)
+	defer stop()
+
+	<-ctx.Done()
+	// Verify deadline error
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		t.Errorf("err = %v, want deadline exceeded", ctx.Err())
+	}
+}
+
+// Test_isInterrupted tests interruption error detection.
+func Test_isInterrupted(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "other error", err: errors.New("boom"), want: false},
+		{name: "canceled", err: context.Canceled, want: true},
+		{name: "deadline", err: context.DeadlineExceeded, want: true},
+		{name: "wrapped", err: fmt.Errorf("loading: %w", context.Canceled), want: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify detection
+			if got := isInterrupted(tt.err); got != tt.want {
+				t.Errorf("isInterrupted(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
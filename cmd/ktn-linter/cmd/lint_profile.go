@@ -0,0 +1,142 @@
+// Package cmd implements the CLI commands for ktn-linter.
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+)
+
+// profileTopEntries is the number of analyzers and packages shown by --profile.
+const profileTopEntries int = 15
+
+// startProfiling enables the requested profiling outputs.
+//
+// Params:
+//   - orch: orchestrator to instrument
+//   - opts: profiling options
+//
+// Returns:
+//   - *profileSession: session to stop once analysis is done
+//   - error: profile file creation error if any
+func startProfiling(orch *orchestrator.Orchestrator, opts profileOptions) (*profileSession, error) {
+	session := &profileSession{memPath: opts.MemProfile}
+	// Record analyzer and package timings
+	if opts.Report {
+		session.profiler = orch.EnableProfiling()
+	}
+
+	// Start CPU profile
+	if opts.CPUProfile != "" {
+		file, err := os.Create(opts.CPUProfile)
+		// Check creation error
+		if err != nil {
+			// Return creation error
+			return session, fmt.Errorf("creating CPU profile: %w", err)
+		}
+		session.cpuFile = file
+		// Check start error
+		if err := pprof.StartCPUProfile(file); err != nil {
+			// Return start error
+			return session, errors.Join(fmt.Errorf("starting CPU profile: %w", err), session.stop(io.Discard))
+		}
+	}
+
+	// Start execution trace
+	if opts.TracePath != "" {
+		file, err := os.Create(opts.TracePath)
+		// Check creation error
+		if err != nil {
+			// Return creation error
+			return session, errors.Join(fmt.Errorf("creating trace: %w", err), session.stop(io.Discard))
+		}
+		session.traceFile = file
+		// Check start error
+		if err := trace.Start(file); err != nil {
+			// Return start error
+			return session, errors.Join(fmt.Errorf("starting trace: %w", err), session.stop(io.Discard))
+		}
+	}
+
+	// Return running session
+	return session, nil
+}
+
+// stop finalizes profile files and prints the timing report.
+//
+// Params:
+//   - w: destination of the timing report
+//
+// Returns:
+//   - error: first error while writing profiles
+func (s *profileSession) stop(w io.Writer) error {
+	var errs []error
+	// Finalize CPU profile
+	if s.cpuFile != nil {
+		pprof.StopCPUProfile()
+		errs = append(errs, s.cpuFile.Close())
+		s.cpuFile = nil
+	}
+	// Finalize execution trace
+	if s.traceFile != nil {
+		trace.Stop()
+		errs = append(errs, s.traceFile.Close())
+		s.traceFile = nil
+	}
+	// Write heap profile
+	if s.memPath != "" {
+		errs = append(errs, writeHeapProfile(s.memPath))
+		s.memPath = ""
+	}
+	// Print timing report
+	if s.profiler != nil {
+		fmt.Fprintln(w, "\nProfile (wall time):")
+		errs = append(errs, s.profiler.Report().WriteText(w, profileTopEntries))
+		s.profiler = nil
+	}
+	// Return collected errors
+	return errors.Join(errs...)
+}
+
+// writeHeapProfile writes an up-to-date heap profile.
+//
+// Params:
+//   - path: destination file
+//
+// Returns:
+//   - error: creation or write error if any
+func writeHeapProfile(path string) error {
+	file, err := os.Create(path)
+	// Check creation error
+	if err != nil {
+		// Return creation error
+		return fmt.Errorf("creating memory profile: %w", err)
+	}
+	// Collect garbage so the profile reflects live memory
+	runtime.GC()
+	// Check write error
+	if err := pprof.WriteHeapProfile(file); err != nil {
+		_ = file.Close()
+		// Return write error
+		return fmt.Errorf("writing memory profile: %w", err)
+	}
+	// Return close error
+	return file.Close()
+}
+
+// stopProfiling stops a profiling session and reports errors on stderr.
+//
+// Params:
+//   - session: session returned by startProfiling
+func stopProfiling(session *profileSession) {
+	// Report profile write errors without failing the lint run
+	if err := session.stop(os.Stderr); err != nil {
+		fmt.Fprintf(os.Stderr, "Profiling: %v\n", err)
+	}
+}
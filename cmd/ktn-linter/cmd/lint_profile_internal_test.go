@@ -0,0 +1,99 @@
+// Internal tests for lint profiling.
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+)
+
+// Test_startProfiling tests starting and stopping profiling outputs.
+func Test_startProfiling(t *testing.T) {
+	tests := []struct {
+		name       string
+		profile    bool
+		files      []string
+		wantReport bool
+	}{
+		{name: "nothing requested", profile: false, files: []string{}, wantReport: false},
+		{name: "timing report", profile: true, files: []string{}, wantReport: true},
+		{name: "pprof files", profile: false, files: []string{"cpu", "mem", "trace"}, wantReport: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			opts := profileOptions{Report: tt.profile}
+			// Map requested files to options
+			for _, kind := range tt.files {
+				path := filepath.Join(dir, kind+".out")
+				// Select option for file kind
+				switch kind {
+				// CPU profile
+				case "cpu":
+					opts.CPUProfile = path
+				// Heap profile
+				case "mem":
+					opts.MemProfile = path
+				// Execution trace
+				case "trace":
+					opts.TracePath = path
+				}
+			}
+
+			orch := orchestrator.NewOrchestrator(&bytes.Buffer{}, false)
+			session, err := startProfiling(orch, opts)
+			// Check start error
+			if err != nil {
+				t.Fatalf("startProfiling() error = %v", err)
+			}
+			var buf bytes.Buffer
+			// Check stop error
+			if err := session.stop(&buf); err != nil {
+				t.Fatalf("stop() error = %v", err)
+			}
+
+			// Verify timing report
+			if strings.Contains(buf.String(), "Profile (wall time)") != tt.wantReport {
+				t.Errorf("report presence mismatch:\n%s", buf.String())
+			}
+			// Verify files were written
+			for _, kind := range tt.files {
+				info, err := os.Stat(filepath.Join(dir, kind+".out"))
+				// Check file content
+				if err != nil || info.Size() == 0 {
+					t.Errorf("%s profile not written: %v", kind, err)
+				}
+			}
+		})
+	}
+}
+
+// Test_startProfiling_badPath tests an unwritable profile path.
+func Test_startProfiling_badPath(t *testing.T) {
+	tests := []struct {
+		name string
+		opts profileOptions
+	}{
+		{name: "cpu profile", opts: profileOptions{CPUProfile: "/nonexistent/dir/cpu.out"}},
+		{name: "trace", opts: profileOptions{TracePath: "/nonexistent/dir/trace.out"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			orch := orchestrator.NewOrchestrator(&bytes.Buffer{}, false)
+			session, err := startProfiling(orch, tt.opts)
+			// Verify error
+			if err == nil {
+				t.Error("expected error for unwritable path")
+			}
+			_ = session.stop(&bytes.Buffer{})
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Package cmd implements the CLI commands for ktn-linter.
+package cmd
+
+import (
+	"context"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"github.com/kodflow/ktn-linter/pkg/formatter"
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+)
+
+// canStream reports whether findings can be displayed package by package:
+// text output with --stream, and JSON Lines output. Build matrix runs merge
+// the findings of every configuration, so they are displayed once the whole
+// matrix is analyzed.
+//
+// Params:
+//   - opts: lint options including stream flag and format
+//   - builds: build configurations of the run
+//
+// Returns:
+//   - bool: true when the run streams its findings
+func canStream(opts *lintOptions, builds []config.BuildConfig) bool {
+	// Return whether streaming was requested and is possible
+	return (opts.Stream || opts.Format == formatter.FormatJSONL) && opts.Format.CanStream() && len(builds) <= 1
+}
+
+// runStreamPipeline runs the linting pipeline, displaying the findings of
+// each package as soon as it is analyzed.
+//
+// Params:
+//   - ctx: run context (interrupt and overall timeout)
+//   - orch: linting orchestrator interface
+//   - args: package patterns or paths
+//   - opts: lint options including format and output path
+//
+// Returns:
+//   - int: number of issues displayed
+//   - error: pipeline error if any
+func runStreamPipeline(ctx context.Context, orch lintOrchestrator, args []string, opts *lintOptions) (int, error) {
+	writer, cleanup := getOutputWriter(opts.OutputPath)
+	// Defer cleanup
+	if cleanup != nil {
+		defer cleanup()
+	}
+	out, _ := formatter.NewFormatterByFormat(opts.Format, writer, formatterOptions(opts, orch)).(formatter.StreamFormatter)
+
+	total := 0
+	emit := func(batch []orchestrator.DiagnosticResult) {
+		diags, fset := collectResults(orch, batch)
+		total += len(diags)
+		out.FormatPackage(fset, diags)
+	}
+	err := streamPipeline(ctx, orch, args, opts.Options, emit)
+	out.Finish(total)
+
+	// Return displayed count
+	return total, err
+}
+
+// streamPipeline runs the analysis, handing the findings of each package to
+// emit.
+//
+// Params:
+//   - ctx: run context
+//   - orch: linting orchestrator interface
+//   - args: package patterns or paths
+//   - opts: linting options
+//   - emit: receives the findings of each package
+//
+// Returns:
+//   - error: pipeline error if any
+func streamPipeline(ctx context.Context, orch lintOrchestrator, args []string, opts orchestrator.Options, emit func([]orchestrator.DiagnosticResult)) error {
+	// Check if we need multi-module discovery
+	if orchestrator.NeedsModuleDiscovery(args) {
+		// Use multi-module approach
+		return orch.StreamMultiModuleContext(ctx, args, opts, emit)
+	}
+
+	// Load packages
+	pkgs, err := orch.LoadPackagesContext(ctx, "", args)
+	// Check for error
+	if err != nil {
+		// Return error
+		return err
+	}
+
+	// Select analyzers
+	analyzers, err := orch.SelectAnalyzers(opts)
+	// Check for error
+	if err != nil {
+		// Return error
+		return err
+	}
+
+	// Run analyzers
+	return orch.StreamAnalyzersContext(ctx, pkgs, analyzers, emit)
+}
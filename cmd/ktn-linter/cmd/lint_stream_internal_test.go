@@ -0,0 +1,90 @@
+// Internal tests for streaming lint output.
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"github.com/kodflow/ktn-linter/pkg/formatter"
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+)
+
+// Test_canStream tests when findings are displayed package by package.
+func Test_canStream(t *testing.T) {
+	matrix := []config.BuildConfig{{GOOS: "linux"}, {GOOS: "windows"}}
+	tests := []struct {
+		name   string
+		opts   lintOptions
+		builds []config.BuildConfig
+		want   bool
+	}{
+		{name: "text stream", opts: lintOptions{Stream: true, Format: formatter.FormatText}, want: true},
+		{name: "single build", opts: lintOptions{Stream: true, Format: formatter.FormatText}, builds: matrix[:1], want: true},
+		{name: "not requested", opts: lintOptions{Format: formatter.FormatText}, want: false},
+		{name: "json document", opts: lintOptions{Stream: true, Format: formatter.FormatJSON}, want: false},
+		{name: "build matrix", opts: lintOptions{Stream: true, Format: formatter.FormatText}, builds: matrix, want: false},
+		{name: "jsonl streams by default", opts: lintOptions{Format: formatter.FormatJSONL}, want: true},
+		{name: "jsonl build matrix", opts: lintOptions{Format: formatter.FormatJSONL}, builds: matrix, want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify the decision
+			if got := canStream(&tt.opts, tt.builds); got != tt.want {
+				t.Errorf("canStream() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_runStreamPipeline tests that streamed output matches the batch run.
+func Test_runStreamPipeline(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		opts        orchestrator.Options
+		format      formatter.OutputFormat
+		marker      string
+		extraLines  int
+		expectError bool
+	}{
+		{name: "package pattern", args: []string{"github.com/kodflow/ktn-linter/pkg/formatter"}, opts: orchestrator.Options{Category: "func"}, format: formatter.FormatText, marker: "Code:"},
+		{name: "directory discovery", args: []string{"../../../pkg/severity"}, opts: orchestrator.Options{}, format: formatter.FormatText, marker: "Code:"},
+		{name: "jsonl lines", args: []string{"../../../pkg/severity"}, opts: orchestrator.Options{}, format: formatter.FormatJSONL, marker: "\n", extraLines: 1},
+		{name: "invalid category returns error", args: []string{"../../../pkg/formatter"}, opts: orchestrator.Options{Category: "nonexistent"}, format: formatter.FormatText, marker: "Code:", expectError: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			want, _, _ := runPipeline(context.Background(), orchestrator.NewOrchestrator(&bytes.Buffer{}, false), tt.args, tt.opts)
+			output := filepath.Join(t.TempDir(), "lint.txt")
+			opts := lintOptions{Options: tt.opts, Format: tt.format, OutputPath: output, Stream: true}
+
+			count, err := runStreamPipeline(context.Background(), orchestrator.NewOrchestrator(&bytes.Buffer{}, false), tt.args, &opts)
+			// Check error expectation
+			if (err != nil) != tt.expectError {
+				t.Fatalf("runStreamPipeline() error = %v, expectError %v", err, tt.expectError)
+			}
+			// Verify the batch findings are streamed
+			if count != len(want) {
+				t.Errorf("runStreamPipeline() = %d findings, want %d", count, len(want))
+			}
+			content, err := os.ReadFile(output)
+			// Check output file
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Verify each finding is displayed
+			if got := strings.Count(string(content), tt.marker); got != count+tt.extraLines {
+				t.Errorf("output holds %d markers, want %d", got, count+tt.extraLines)
+			}
+		})
+	}
+}
@@ -0,0 +1,370 @@
+// Package cmd implements the CLI commands for ktn-linter.
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn/ktnpattern"
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"github.com/kodflow/ktn-linter/pkg/cpuprofile"
+	"github.com/kodflow/ktn-linter/pkg/formatter"
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+)
+
+const (
+	// ansiClearScreen moves the cursor home and clears the terminal.
+	ansiClearScreen string = "\033[H\033[2J"
+	// ansiRed starts red output for new findings.
+	ansiRed string = "\033[31m"
+	// ansiGreen starts green output for resolved findings.
+	ansiGreen string = "\033[32m"
+	// ansiReset resets terminal colors.
+	ansiReset string = "\033[0m"
+	// watchTimeLayout is the timestamp layout of watch headers.
+	watchTimeLayout string = "15:04:05"
+)
+
+// runWatch runs the initial analysis then re-analyzes on every change.
+//
+// Params:
+//   - orch: orchestrator used by the sessions
+//   - args: package patterns or paths
+//   - opts: lint options
+//
+// Returns: none
+func runWatch(orch *orchestrator.Orchestrator, args []string, opts lintOptions) {
+	sessions, roots, err := newWatchSessions(orch, args, opts.Options)
+	// Check for error
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		OsExit(1)
+		// Exit on setup error
+		return
+	}
+
+	configPath := opts.ConfigPath
+	// Fall back to the discovered config file
+	if configPath == "" {
+		configPath = config.FindConfigFile()
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	watcher := orchestrator.NewFileWatcher(roots, []string{configPath})
+	current := collectWatchFindings(sessions)
+	renderWatch(os.Stdout, watchState{
+		Header:  "initial analysis",
+		Current: current,
+		Initial: true,
+		Clear:   isTerminal(os.Stdout),
+	})
+
+	ticker := time.NewTicker(opts.WatchInterval)
+	defer ticker.Stop()
+	// Poll until interrupted
+	for {
+		select {
+		// Stop on interrupt
+		case <-ctx.Done():
+			// Exit watch loop
+			return
+		// Check for changes on each tick
+		case <-ticker.C:
+			current = pollWatch(watcher, sessions, configPath, opts, current)
+		}
+	}
+}
+
+// newWatchSessions creates one incremental session per module and runs them once.
+//
+// Params:
+//   - orch: orchestrator used by the sessions
+//   - args: package patterns or paths
+//   - opts: analyzer selection options
+//
+// Returns:
+//   - []*orchestrator.IncrementalSession: initialized sessions
+//   - []string: directories to watch
+//   - error: discovery or loading error if any
+func newWatchSessions(orch *orchestrator.Orchestrator, args []string, opts orchestrator.Options) ([]*orchestrator.IncrementalSession, []string, error) {
+	sessions := []*orchestrator.IncrementalSession{orch.NewIncrementalSession("", args, opts)}
+	roots := []string{"."}
+
+	// Use module discovery for directory arguments
+	if orchestrator.NeedsModuleDiscovery(args) {
+		modules, err := orch.DiscoverModules(args)
+		// Check for error
+		if err != nil {
+			// Return discovery error
+			return []*orchestrator.IncrementalSession{}, []string{}, err
+		}
+		// Keep current directory session when no module was found
+		if len(modules) > 0 {
+			sessions = make([]*orchestrator.IncrementalSession, 0, len(modules))
+			roots = modules
+			// Create one session per module
+			for _, module := range modules {
+				sessions = append(sessions, orch.NewIncrementalSession(module, []string{"./..."}, opts))
+			}
+		}
+	}
+
+	// Run the initial analysis
+	for _, session := range sessions {
+		// Check for error
+		if err := session.Full(); err != nil {
+			// Return initial analysis error
+			return []*orchestrator.IncrementalSession{}, []string{}, err
+		}
+	}
+
+	// Return ready sessions
+	return sessions, roots, nil
+}
+
+// pollWatch handles one polling tick and redraws on change.
+//
+// Params:
+//   - watcher: file watcher
+//   - sessions: incremental sessions
+//   - configPath: tracked config file (may be empty)
+//   - opts: lint options
+//   - previous: findings displayed so far
+//
+// Returns:
+//   - []orchestrator.DiagnosticResult: findings after the tick
+func pollWatch(watcher *orchestrator.FileWatcher, sessions []*orchestrator.IncrementalSession, configPath string, opts lintOptions, previous []orchestrator.DiagnosticResult) []orchestrator.DiagnosticResult {
+	changed := watcher.Poll()
+	// Check if anything changed
+	if len(changed) == 0 {
+		// Keep current findings
+		return previous
+	}
+
+	reloaded, err := applyWatchChanges(changed, sessions, configPath, opts)
+	// Check for analysis error
+	if err != nil {
+		renderWatch(os.Stdout, watchState{
+			Header:  fmt.Sprintf("%d file(s) changed, analysis failed: %v", len(changed), err),
+			Current: previous,
+			Clear:   isTerminal(os.Stdout),
+		})
+		// Keep previous findings on error
+		return previous
+	}
+
+	current := collectWatchFindings(sessions)
+	added, resolved := orchestrator.DiffFindings(previous, current)
+	renderWatch(os.Stdout, watchState{
+		Header:   fmt.Sprintf("%d file(s) changed, %d package(s) re-analyzed", len(changed), reloaded),
+		Current:  current,
+		Added:    added,
+		Resolved: resolved,
+		Clear:    isTerminal(os.Stdout),
+	})
+	// Return new findings
+	return current
+}
+
+// applyWatchChanges reloads config or packages affected by changed files.
+//
+// Params:
+//   - changed: changed file paths
+//   - sessions: incremental sessions
+//   - configPath: tracked config file (may be empty)
+//   - opts: lint options
+//
+// Returns:
+//   - int: number of packages re-analyzed
+//   - error: config or loading error if any
+func applyWatchChanges(changed []string, sessions []*orchestrator.IncrementalSession, configPath string, opts lintOptions) (int, error) {
+	// A config change invalidates every result
+	if configPath != "" && containsPath(changed, configPath) {
+		// Check config reload error
+		if err := config.LoadAndSet(configPath); err != nil {
+			// Return config error
+			return 0, err
+		}
+		config.Get().Verbose = opts.Verbose
+		// Compile newly declared custom rules
+		if err := ktnpattern.Register(config.Get().CustomRules); err != nil {
+			// Return registration error
+			return 0, err
+		}
+		// Re-analyze everything
+		return rerunAll(sessions)
+	}
+
+	total := 0
+	// Dispatch changed files to the session owning them
+	for _, session := range sessions {
+		owned := make([]string, 0, len(changed))
+		// Keep files of this session
+		for _, path := range changed {
+			// Check ownership
+			if session.Owns(path) {
+				owned = append(owned, path)
+			}
+		}
+		// Skip sessions without changes
+		if len(owned) == 0 {
+			continue
+		}
+		count, err := session.Update(owned)
+		// Check for error
+		if err != nil {
+			// Return loading error
+			return total, err
+		}
+		total += count
+	}
+	// Return reloaded package count
+	return total, nil
+}
+
+// rerunAll runs a full analysis on every session.
+//
+// Params:
+//   - sessions: incremental sessions
+//
+// Returns:
+//   - int: number of packages re-analyzed
+//   - error: loading error if any
+func rerunAll(sessions []*orchestrator.IncrementalSession) (int, error) {
+	total := 0
+	// Run each session fully
+	for _, session := range sessions {
+		// Check for error
+		if err := session.Full(); err != nil {
+			// Return loading error
+			return total, err
+		}
+		total += session.PackageCount()
+	}
+	// Full runs succeeded
+	return total, nil
+}
+
+// collectWatchFindings merges the findings of every session.
+//
+// Params:
+//   - sessions: incremental sessions
+//
+// Returns:
+//   - []orchestrator.DiagnosticResult: all findings
+func collectWatchFindings(sessions []*orchestrator.IncrementalSession) []orchestrator.DiagnosticResult {
+	var all []orchestrator.DiagnosticResult
+	// Collect findings of each session
+	for _, session := range sessions {
+		all = append(all, session.Diagnostics()...)
+	}
+	// Return merged findings
+	return all
+}
+
+// renderWatch draws the watch screen.
+//
+// Params:
+//   - w: destination writer
+//   - state: screen content
+//
+// Returns: none
+func renderWatch(w io.Writer, state watchState) {
+	// Clear previous screen on terminals
+	if state.Clear {
+		fmt.Fprint(w, ansiClearScreen)
+	}
+	fmt.Fprintf(w, "[%s] %s\n", time.Now().Format(watchTimeLayout), state.Header)
+
+	// Show new findings first
+	for i := range state.Added {
+		writeWatchFinding(w, "+", ansiRed, &state.Added[i], state.Clear)
+	}
+	// Then resolved findings
+	for i := range state.Resolved {
+		writeWatchFinding(w, "-", ansiGreen, &state.Resolved[i], state.Clear)
+	}
+
+	// List every finding on the initial screen
+	if state.Initial {
+		// Print each current finding
+		for i := range state.Current {
+			writeWatchFinding(w, " ", "", &state.Current[i], false)
+		}
+	}
+
+	fmt.Fprintf(w, "%d new, %d resolved, %d total. Watching for changes (Ctrl+C to stop)...\n",
+		len(state.Added), len(state.Resolved), len(state.Current))
+}
+
+// writeWatchFinding prints one finding line.
+//
+// Params:
+//   - w: destination writer
+//   - mark: diff marker
+//   - color: ANSI color for the line
+//   - diag: finding to print
+//   - colored: whether colors are enabled
+//
+// Returns: none
+func writeWatchFinding(w io.Writer, mark, color string, diag *orchestrator.DiagnosticResult, colored bool) {
+	pos := diag.Position()
+	// Keep only the summary line of verbose messages
+	message, _, _ := strings.Cut(diag.Diag.Message, "\n")
+	// Show why escalated findings are hot
+	if diag.HotPath != "" {
+		message += " " + formatter.HotPathLabel(diag.HotPath)
+	}
+	// Show the CPU share of ranked findings
+	if diag.CPUShare > 0 {
+		message += " " + cpuprofile.Label(diag.CPUShare)
+	}
+	// Print without color
+	if !colored || color == "" {
+		fmt.Fprintf(w, "%s %s:%d:%d: %s\n", mark, pos.Filename, pos.Line, pos.Column, message)
+		// Done
+		return
+	}
+	fmt.Fprintf(w, "%s%s %s:%d:%d: %s%s\n", color, mark, pos.Filename, pos.Line, pos.Column, message, ansiReset)
+}
+
+// containsPath reports whether a path list contains a file.
+//
+// Params:
+//   - paths: absolute paths
+//   - path: path to look for (relative paths are resolved)
+//
+// Returns:
+//   - bool: true if found
+func containsPath(paths []string, path string) bool {
+	abs, err := filepath.Abs(path)
+	// Check for error
+	if err != nil {
+		// Unresolvable path is never found
+		return false
+	}
+	// Search the list
+	return slices.Contains(paths, abs)
+}
+
+// isTerminal reports whether a file is an interactive terminal.
+//
+// Params:
+//   - f: file to inspect
+//
+// Returns:
+//   - bool: true for character devices
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	// Check character device mode
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
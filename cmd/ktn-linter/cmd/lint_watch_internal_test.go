@@ -0,0 +1,133 @@
+// Internal tests for the lint watch mode.
+package cmd
+
+import (
+	"bytes"
+	"go/token"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+	"golang.org/x/tools/go/analysis"
+)
+
+// Test_renderWatch tests the renderWatch function.
+func Test_renderWatch(t *testing.T) {
+	fset := token.NewFileSet()
+	file := fset.AddFile("/src/a.go", -1, 100)
+	finding := orchestrator.DiagnosticResult{
+		Diag: analysis.Diagnostic{Pos: file.Pos(1), Message: "KTN-VAR-001: summary\nlong explanation"},
+		Fset: fset,
+	}
+	ranked := finding
+	ranked.CPUShare = 0.25
+	escalated := ranked
+	escalated.HotPath = "http.Handler"
+	tests := []struct {
+		name        string
+		state       watchState
+		contains    []string
+		notContains string
+	}{
+		{
+			name:        "initial screen lists findings",
+			state:       watchState{Header: "initial analysis", Current: []orchestrator.DiagnosticResult{finding}, Initial: true},
+			contains:    []string{"initial analysis", "  /src/a.go:1:2: KTN-VAR-001: summary", "1 total"},
+			notContains: "long explanation",
+		},
+		{
+			name: "diff screen shows markers",
+			state: watchState{
+				Header:   "1 file(s) changed",
+				Current:  []orchestrator.DiagnosticResult{finding},
+				Added:    []orchestrator.DiagnosticResult{finding},
+				Resolved: []orchestrator.DiagnosticResult{finding},
+			},
+			contains:    []string{"+ /src/a.go", "- /src/a.go", "1 new, 1 resolved, 1 total"},
+			notContains: "\033[",
+		},
+		{
+			name:        "ranked finding shows its CPU share",
+			state:       watchState{Header: "initial analysis", Current: []orchestrator.DiagnosticResult{ranked}, Initial: true},
+			contains:    []string{"KTN-VAR-001: summary [cpu: 25.0%]"},
+			notContains: "long explanation",
+		},
+		{
+			name:        "escalated finding shows its hot path",
+			state:       watchState{Header: "initial analysis", Current: []orchestrator.DiagnosticResult{escalated}, Initial: true},
+			contains:    []string{"KTN-VAR-001: summary [hot path: http.Handler] [cpu: 25.0%]"},
+			notContains: "long explanation",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			renderWatch(&buf, tt.state)
+			output := buf.String()
+			// Verify expected fragments
+			for _, want := range tt.contains {
+				// Check each fragment
+				if !strings.Contains(output, want) {
+					t.Errorf("output %q does not contain %q", output, want)
+				}
+			}
+			// Verify excluded fragment
+			if strings.Contains(output, tt.notContains) {
+				t.Errorf("output %q should not contain %q", output, tt.notContains)
+			}
+		})
+	}
+}
+
+// Test_containsPath tests the containsPath function.
+func Test_containsPath(t *testing.T) {
+	abs, _ := filepath.Abs(".ktn-linter.yaml")
+	tests := []struct {
+		name  string
+		paths []string
+		path  string
+		want  bool
+	}{
+		{name: "relative path resolved", paths: []string{abs}, path: ".ktn-linter.yaml", want: true},
+		{name: "missing path", paths: []string{"/other.go"}, path: ".ktn-linter.yaml", want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify result
+			if got := containsPath(tt.paths, tt.path); got != tt.want {
+				t.Errorf("containsPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_applyWatchChanges tests the applyWatchChanges function.
+func Test_applyWatchChanges(t *testing.T) {
+	tests := []struct {
+		name    string
+		changed []string
+		want    int
+	}{
+		{name: "no sessions", changed: []string{"/src/a.go"}, want: 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := applyWatchChanges(tt.changed, nil, "", lintOptions{})
+			// Verify no error
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// Verify count
+			if got != tt.want {
+				t.Errorf("applyWatchChanges() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+// Package cmd implements the CLI commands for ktn-linter.
+package cmd
+
+// profileOptions holds the profiling flags of the lint command.
+// Empty paths disable the matching pprof output.
+type profileOptions struct {
+	Report     bool
+	CPUProfile string
+	MemProfile string
+	TracePath  string
+}
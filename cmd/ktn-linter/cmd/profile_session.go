@@ -0,0 +1,17 @@
+// Package cmd implements the CLI commands for ktn-linter.
+package cmd
+
+import (
+	"os"
+
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+)
+
+// profileSession holds the profiling outputs opened for one lint run.
+// Fields are nil or empty when the matching flag was not given.
+type profileSession struct {
+	profiler  *orchestrator.Profiler
+	cpuFile   *os.File
+	traceFile *os.File
+	memPath   string
+}
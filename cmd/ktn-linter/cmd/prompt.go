@@ -2,9 +2,11 @@
 package cmd
 
 import (
+	"cmp"
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/kodflow/ktn-linter/pkg/config"
 	"github.com/kodflow/ktn-linter/pkg/orchestrator"
@@ -12,6 +14,22 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// Flag names and defaults for prompt command.
+const (
+	// flagMaxTokens is the flag name for the token budget per task.
+	flagMaxTokens string = "max-tokens"
+	// flagChunkBy is the flag name for the task grouping unit.
+	flagChunkBy string = "chunk-by"
+	// defaultTaskDir is the task directory when --output is not set.
+	defaultTaskDir string = "ktn-tasks"
+	// promptFormatJSON selects the JSON task plan with --format.
+	promptFormatJSON string = "json"
+	// flagVerify is the flag name for the task to verify.
+	flagVerify string = "verify"
+	// flagState is the flag name for the task state file.
+	flagState string = "state"
+)
+
 // promptCmd represents the prompt command.
 var promptCmd *cobra.Command = &cobra.Command{
 	Use:   "prompt [packages...]",
@@ -27,8 +45,24 @@ The output is organized into phases:
 Each rule includes:
   - Description and category
   - Good example from testdata
-  - List of all files/lines to fix as checkboxes`,
-	Args: cobra.MinimumNArgs(1),
+  - List of all files/lines to fix as checkboxes
+
+With --chunk-by or --max-tokens, the prompt is split into numbered task files
+(index.md, task-001.md, ...) written to the --output directory. Each task is
+self-contained: rule explanation, good example and a source excerpt around
+each violation.
+
+With --format json, the prompt is written as a task plan for agent
+orchestrators: phases with their gating state, tasks with dependencies,
+rule metadata and the enclosing function of each violation.
+
+Task files and JSON plans also record their findings in a state file
+(--state, .ktn-prompt-state.json by default). Once a task is fixed,
+--verify <task-id> re-lints only the packages of that task and compares its
+rules and files with the record: resolved, still present and newly
+introduced findings. The verdict is printed, the task is marked done or
+failed in the state file, and the exit code is 0 only on a pass.`,
+	Args: promptArgs,
 	Run:  runPrompt,
 }
 
@@ -39,6 +73,30 @@ Each rule includes:
 // Returns: none
 func init() {
 	rootCmd.AddCommand(promptCmd)
+	// Add prompt-specific flags
+	promptCmd.Flags().Int(flagMaxTokens, 0, "Split the prompt into task files of at most this many estimated tokens")
+	promptCmd.Flags().String(flagChunkBy, "", "Split the prompt into task files by file, package or rule")
+	promptCmd.Flags().String(flagVerify, "", "Re-lint the packages of a task and compare them with the state file")
+	promptCmd.Flags().String(flagState, prompt.StateFileName, "Task state file written with task files and read by --verify")
+}
+
+// promptArgs requires package patterns unless a task is verified.
+//
+// Params:
+//   - cmd: Cobra command holding the prompt flags
+//   - args: command line arguments
+//
+// Returns:
+//   - error: validation error if any
+func promptArgs(cmd *cobra.Command, args []string) error {
+	verify, _ := cmd.Flags().GetString(flagVerify)
+	// Verification reads its packages from the state file
+	if verify != "" {
+		// Return no-argument validation
+		return cobra.NoArgs(cmd, args)
+	}
+	// Return pattern validation
+	return cobra.MinimumNArgs(1)(cmd, args)
 }
 
 // runPrompt executes the prompt generation.
@@ -48,11 +106,12 @@ func init() {
 //   - args: package patterns to analyze
 //
 // Returns: none
-func runPrompt(_ *cobra.Command, args []string) {
-	opts := parsePromptOptions()
+func runPrompt(cmd *cobra.Command, args []string) {
+	opts := parsePromptOptions(cmd)
 
 	// Load configuration
 	loadPromptConfiguration(opts.Options)
+	registerCustomRules()
 
 	// Propagate verbose flag to config
 	config.Get().Verbose = opts.Verbose
@@ -60,6 +119,13 @@ func runPrompt(_ *cobra.Command, args []string) {
 	// Create prompt generator
 	gen := prompt.NewGenerator(os.Stderr, opts.Verbose)
 
+	// Verify a task instead of generating a prompt
+	if opts.VerifyTask != "" {
+		verifyPromptTask(gen, opts)
+		// Exit after verification
+		return
+	}
+
 	// Generate prompt
 	output, err := gen.Generate(args, opts.Options)
 	// Check for error
@@ -68,8 +134,36 @@ func runPrompt(_ *cobra.Command, args []string) {
 		OsExit(1)
 	}
 
+	// Render in the requested format
+	switch {
+	// JSON task plan for agent orchestrators
+	case opts.Format == promptFormatJSON:
+		writePromptPlan(output, opts)
+	// Task files when chunking is requested
+	case opts.chunked():
+		writePromptTasks(output, opts)
+	// Single markdown document otherwise
+	default:
+		writePromptDocument(output, opts.OutputPath)
+	}
+
+	// Exit with appropriate code
+	if output.TotalViolations > 0 {
+		OsExit(1)
+	}
+	OsExit(0)
+}
+
+// writePromptDocument writes the prompt as a single markdown document.
+//
+// Params:
+//   - output: generated prompt output
+//   - outputPath: path to output file (empty for stdout)
+//
+// Returns: none
+func writePromptDocument(output *prompt.PromptOutput, outputPath string) {
 	// Get output writer
-	writer, cleanup := getPromptOutputWriter(opts.OutputPath)
+	writer, cleanup := getPromptOutputWriter(outputPath)
 	// Defer cleanup
 	if cleanup != nil {
 		defer cleanup()
@@ -78,25 +172,177 @@ func runPrompt(_ *cobra.Command, args []string) {
 	// Format and display
 	formatter := prompt.NewMarkdownFormatter(writer)
 	formatter.Format(output)
+}
 
-	// Exit with appropriate code
-	if output.TotalViolations > 0 {
+// writePromptTasks splits the prompt into task files.
+//
+// Params:
+//   - output: generated prompt output
+//   - opts: prompt options with chunking settings
+//
+// Returns: none
+func writePromptTasks(output *prompt.PromptOutput, opts promptOptions) {
+	tasks := splitPromptTasks(output, opts)
+	savePromptState(tasks, opts.StatePath)
+	dir := cmp.Or(opts.OutputPath, defaultTaskDir)
+	// Write index and task files
+	if err := prompt.WriteTaskFiles(dir, output, tasks); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		OsExit(1)
+		// Exit on error
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Wrote %d task(s) to %s\n", len(tasks), dir)
+}
+
+// writePromptPlan writes the prompt as a JSON task plan.
+//
+// Params:
+//   - output: generated prompt output
+//   - opts: prompt options with chunking settings
+//
+// Returns: none
+func writePromptPlan(output *prompt.PromptOutput, opts promptOptions) {
+	tasks := splitPromptTasks(output, opts)
+	savePromptState(tasks, opts.StatePath)
+	// Get output writer
+	writer, cleanup := getPromptOutputWriter(opts.OutputPath)
+	// Defer cleanup
+	if cleanup != nil {
+		defer cleanup()
+	}
+
+	// Encode the plan
+	if err := prompt.NewJSONFormatter(writer).Format(output, tasks); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		OsExit(1)
+	}
+}
+
+// splitPromptTasks splits the prompt with the chunking settings.
+//
+// Params:
+//   - output: generated prompt output
+//   - opts: prompt options with chunking settings
+//
+// Returns:
+//   - []prompt.Task: ordered tasks
+func splitPromptTasks(output *prompt.PromptOutput, opts promptOptions) []prompt.Task {
+	tasks, err := prompt.SplitTasks(output, prompt.ChunkOptions{By: opts.ChunkBy, MaxTokens: opts.MaxTokens})
+	// Check for invalid chunking settings
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		OsExit(1)
+	}
+	// Return ordered tasks
+	return tasks
+}
+
+// savePromptState records the tasks in the state file.
+//
+// Params:
+//   - tasks: ordered tasks
+//   - statePath: state file path (empty to skip)
+//
+// Returns: none
+func savePromptState(tasks []prompt.Task, statePath string) {
+	// Skip without state file
+	if statePath == "" {
+		// Nothing to record
+		return
+	}
+	// Write pending tasks
+	if err := prompt.NewState(tasks).Save(statePath); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		OsExit(1)
+	}
+}
+
+// verifyPromptTask re-lints a task, records the verdict and exits with it.
+//
+// Params:
+//   - gen: prompt generator
+//   - opts: prompt options with the task and state file
+//
+// Returns: none
+func verifyPromptTask(gen *prompt.Generator, opts promptOptions) {
+	state, err := prompt.LoadState(opts.StatePath)
+	// Check state file
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		OsExit(1)
+		// Exit on error
+		return
+	}
+	record := state.Task(opts.VerifyTask)
+	// Check task identifier
+	if record == nil {
+		fmt.Fprintf(os.Stderr, "Error: unknown task %q in %s\n", opts.VerifyTask, opts.StatePath)
+		OsExit(1)
+		// Exit on error
+		return
+	}
+
+	verification, err := gen.Verify(state, record, opts.Options)
+	// Check linter error
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		OsExit(1)
+		// Exit on error
+		return
+	}
+
+	record.Status = prompt.TaskFailed
+	// Record a pass
+	if verification.Passed() {
+		record.Status = prompt.TaskDone
+	}
+	// Persist the verdict
+	if err := state.Save(opts.StatePath); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		OsExit(1)
+		// Exit on error
+		return
+	}
+	prompt.WriteVerdict(os.Stdout, verification, state.Progress())
+
+	// Exit with the verdict
+	if !verification.Passed() {
 		OsExit(1)
+		// Exit on failure
+		return
 	}
 	OsExit(0)
 }
 
-// promptOptions extends orchestrator options with output path.
+// promptOptions extends orchestrator options with output and chunking settings.
 type promptOptions struct {
 	orchestrator.Options
 	OutputPath string
+	Format     string
+	MaxTokens  int
+	ChunkBy    string
+	VerifyTask string
+	StatePath  string
+}
+
+// chunked reports whether the prompt is split into task files.
+//
+// Returns:
+//   - bool: true if a grouping unit or token budget is set
+func (o promptOptions) chunked() bool {
+	// Split on any chunking setting
+	return o.ChunkBy != "" || o.MaxTokens != 0
 }
 
 // parsePromptOptions extracts options from Cobra flags.
 //
+// Params:
+//   - cmd: Cobra command holding the prompt flags
+//
 // Returns:
 //   - promptOptions: extracted options
-func parsePromptOptions() promptOptions {
+func parsePromptOptions(cmd *cobra.Command) promptOptions {
 	flags := rootCmd.PersistentFlags()
 
 	verbose, _ := flags.GetBool(flagVerbose)
@@ -104,6 +350,11 @@ func parsePromptOptions() promptOptions {
 	onlyRule, _ := flags.GetString(flagOnlyRule)
 	configPath, _ := flags.GetString(flagConfig)
 	outputPath, _ := flags.GetString(flagOutput)
+	format, _ := flags.GetString(flagFormat)
+	maxTokens, _ := cmd.Flags().GetInt(flagMaxTokens)
+	chunkBy, _ := cmd.Flags().GetString(flagChunkBy)
+	verifyTask, _ := cmd.Flags().GetString(flagVerify)
+	statePath, _ := cmd.Flags().GetString(flagState)
 
 	// Return parsed options
 	return promptOptions{
@@ -114,6 +365,11 @@ func parsePromptOptions() promptOptions {
 			ConfigPath: configPath,
 		},
 		OutputPath: outputPath,
+		Format:     strings.ToLower(format),
+		MaxTokens:  maxTokens,
+		ChunkBy:    chunkBy,
+		VerifyTask: verifyTask,
+		StatePath:  statePath,
 	}
 }
 
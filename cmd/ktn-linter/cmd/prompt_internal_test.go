@@ -3,12 +3,15 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+	"github.com/kodflow/ktn-linter/pkg/prompt"
 )
 
 // Test_runPrompt tests the runPrompt function with different scenarios.
@@ -170,6 +173,40 @@ func Test_parsePromptOptions(t *testing.T) {
 				},
 				OutputPath: "/tmp/output.md",
 			},
+		}, {
+			name: "with chunking",
+			setup: func() {
+				flags := rootCmd.PersistentFlags()
+				flags.Set(flagVerbose, "false")
+				flags.Set(flagCategory, "")
+				flags.Set(flagOnlyRule, "")
+				flags.Set(flagConfig, "")
+				flags.Set(flagOutput, "")
+				promptCmd.Flags().Set(flagMaxTokens, "4000")
+				promptCmd.Flags().Set(flagChunkBy, "package")
+				flags.Set(flagFormat, "JSON")
+			},
+			wantOpts: promptOptions{
+				Format:    "json",
+				MaxTokens: 4000,
+				ChunkBy:   "package",
+			},
+		}, {
+			name: "with verification",
+			setup: func() {
+				flags := rootCmd.PersistentFlags()
+				flags.Set(flagVerbose, "false")
+				flags.Set(flagCategory, "")
+				flags.Set(flagOnlyRule, "")
+				flags.Set(flagConfig, "")
+				flags.Set(flagOutput, "")
+				promptCmd.Flags().Set(flagVerify, "task-002")
+				promptCmd.Flags().Set(flagState, "plan-state.json")
+			},
+			wantOpts: promptOptions{
+				VerifyTask: "task-002",
+				StatePath:  "plan-state.json",
+			},
 		},
 	}
 
@@ -178,7 +215,7 @@ func Test_parsePromptOptions(t *testing.T) {
 		tt := tt // Capture range variable
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
-			opts := parsePromptOptions()
+			opts := parsePromptOptions(promptCmd)
 
 			// Verify all fields
 			if opts.Verbose != tt.wantOpts.Verbose {
@@ -204,6 +241,24 @@ func Test_parsePromptOptions(t *testing.T) {
 			if opts.OutputPath != tt.wantOpts.OutputPath {
 				t.Errorf("OutputPath = %v, want %v", opts.OutputPath, tt.wantOpts.OutputPath)
 			}
+
+			// Verify chunking settings
+			if opts.MaxTokens != tt.wantOpts.MaxTokens || opts.ChunkBy != tt.wantOpts.ChunkBy {
+				t.Errorf("chunking = %d/%q, want %d/%q", opts.MaxTokens, opts.ChunkBy, tt.wantOpts.MaxTokens, tt.wantOpts.ChunkBy)
+			}
+			// Verify format when set
+			if tt.wantOpts.Format != "" && opts.Format != tt.wantOpts.Format {
+				t.Errorf("Format = %q, want %q", opts.Format, tt.wantOpts.Format)
+			}
+			// Verify verification settings when set
+			if tt.wantOpts.VerifyTask != "" && (opts.VerifyTask != tt.wantOpts.VerifyTask || opts.StatePath != tt.wantOpts.StatePath) {
+				t.Errorf("verify = %q/%q, want %q/%q", opts.VerifyTask, opts.StatePath, tt.wantOpts.VerifyTask, tt.wantOpts.StatePath)
+			}
+			promptCmd.Flags().Set(flagMaxTokens, "0")
+			promptCmd.Flags().Set(flagChunkBy, "")
+			promptCmd.Flags().Set(flagVerify, "")
+			promptCmd.Flags().Set(flagState, prompt.StateFileName)
+			rootCmd.PersistentFlags().Set(flagFormat, "text")
 		})
 	}
 }
@@ -427,6 +482,297 @@ func Test_getPromptOutputWriter(t *testing.T) {
 	}
 }
 
+// Test_promptOptions_chunked tests chunking detection.
+//
+// Params:
+//   - t: testing object
+func Test_promptOptions_chunked(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     promptOptions
+		expected bool
+	}{
+		{name: "single document", opts: promptOptions{}, expected: false},
+		{name: "grouping unit", opts: promptOptions{ChunkBy: "file"}, expected: true},
+		{name: "token budget", opts: promptOptions{MaxTokens: 4000}, expected: true},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify detection
+			if got := tt.opts.chunked(); got != tt.expected {
+				t.Errorf("chunked() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// Test_writePromptTasks tests writing split task files.
+//
+// Params:
+//   - t: testing object
+func Test_writePromptTasks(t *testing.T) {
+	output := &prompt.PromptOutput{
+		TotalViolations: 1,
+		TotalRules:      1,
+		Phases: []prompt.PhaseGroup{{
+			Name:  "Local Fixes",
+			Rules: []prompt.RuleViolations{{Code: "KTN-FUNC-001", Violations: []prompt.Violation{{FilePath: "a.go", Line: 1}}}},
+		}},
+	}
+	tests := []struct {
+		name       string
+		chunkBy    string
+		expectExit bool
+		wantFile   string
+	}{
+		{name: "writes index and tasks", chunkBy: "file", expectExit: false, wantFile: "task-001.md"},
+		{name: "unknown unit exits", chunkBy: "line", expectExit: true, wantFile: ""},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			restore := mockExitInCmd(t)
+			defer restore()
+			dir := filepath.Join(t.TempDir(), "tasks")
+
+			// Silence stderr
+			oldStderr := os.Stderr
+			_, w, _ := os.Pipe()
+			os.Stderr = w
+			exitCode, didExit := catchExitInCmd(t, func() {
+				writePromptTasks(output, promptOptions{OutputPath: dir, ChunkBy: tt.chunkBy})
+			})
+			w.Close()
+			os.Stderr = oldStderr
+
+			// Verify exit expectation
+			if didExit != tt.expectExit || (didExit && exitCode != 1) {
+				t.Fatalf("exit = %v (code %d), want exit %v", didExit, exitCode, tt.expectExit)
+			}
+			// Verify task file
+			if tt.wantFile != "" {
+				// Check file presence
+				if _, err := os.Stat(filepath.Join(dir, tt.wantFile)); err != nil {
+					t.Errorf("expected %s: %v", tt.wantFile, err)
+				}
+			}
+		})
+	}
+}
+
+// Test_writePromptPlan tests writing the JSON task plan.
+//
+// Params:
+//   - t: testing object
+func Test_writePromptPlan(t *testing.T) {
+	output := &prompt.PromptOutput{
+		TotalViolations: 1,
+		TotalRules:      1,
+		Phases: []prompt.PhaseGroup{{
+			Name:  "Local Fixes",
+			Rules: []prompt.RuleViolations{{Code: "KTN-FUNC-001", Violations: []prompt.Violation{{FilePath: "a.go", Line: 1}}}},
+		}},
+	}
+	tests := []struct {
+		name       string
+		chunkBy    string
+		expectExit bool
+		wantTasks  int
+	}{
+		{name: "plan written to file", chunkBy: "", expectExit: false, wantTasks: 1},
+		{name: "unknown unit exits", chunkBy: "line", expectExit: true, wantTasks: 0},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			restore := mockExitInCmd(t)
+			defer restore()
+			path := filepath.Join(t.TempDir(), "plan.json")
+
+			// Silence stderr
+			oldStderr := os.Stderr
+			_, w, _ := os.Pipe()
+			os.Stderr = w
+			_, didExit := catchExitInCmd(t, func() {
+				writePromptPlan(output, promptOptions{OutputPath: path, Format: promptFormatJSON, ChunkBy: tt.chunkBy})
+			})
+			w.Close()
+			os.Stderr = oldStderr
+
+			// Verify exit expectation
+			if didExit != tt.expectExit {
+				t.Fatalf("exit = %v, want %v", didExit, tt.expectExit)
+			}
+			// Verify written plan
+			if !tt.expectExit {
+				data, _ := os.ReadFile(path)
+				var plan prompt.JSONPlan
+				// Check decoded plan
+				if err := json.Unmarshal(data, &plan); err != nil || len(plan.Tasks) != tt.wantTasks {
+					t.Errorf("plan = %s, err %v, want %d tasks", data, err, tt.wantTasks)
+				}
+			}
+		})
+	}
+}
+
+// Test_promptArgs tests the argument validation of the prompt command.
+//
+// Params:
+//   - t: testing object
+func Test_promptArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		verify  string
+		args    []string
+		wantErr bool
+	}{
+		{name: "patterns required", verify: "", args: nil, wantErr: true},
+		{name: "patterns given", verify: "", args: []string{"./..."}, wantErr: false},
+		{name: "verification without patterns", verify: "task-001", args: nil, wantErr: false},
+		{name: "verification rejects patterns", verify: "task-001", args: []string{"./..."}, wantErr: true},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			promptCmd.Flags().Set(flagVerify, tt.verify)
+			defer promptCmd.Flags().Set(flagVerify, "")
+			// Verify validation
+			if err := promptArgs(promptCmd, tt.args); (err != nil) != tt.wantErr {
+				t.Errorf("promptArgs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// Test_savePromptState tests recording the tasks in the state file.
+//
+// Params:
+//   - t: testing object
+func Test_savePromptState(t *testing.T) {
+	tasks := []prompt.Task{{ID: "task-001", Rules: []prompt.RuleViolations{{Code: "KTN-FUNC-001", Violations: []prompt.Violation{{FilePath: "a.go", Line: 1}}}}}}
+	tests := []struct {
+		name       string
+		statePath  func(dir string) string
+		expectExit bool
+		wantState  bool
+	}{
+		{name: "state written", statePath: func(dir string) string { return filepath.Join(dir, prompt.StateFileName) }, expectExit: false, wantState: true},
+		{name: "empty path skips", statePath: func(string) string { return "" }, expectExit: false, wantState: false},
+		{name: "write error exits", statePath: func(dir string) string { return filepath.Join(dir, "missing", "state.json") }, expectExit: true, wantState: false},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			restore := mockExitInCmd(t)
+			defer restore()
+			path := tt.statePath(t.TempDir())
+
+			// Silence stderr
+			oldStderr := os.Stderr
+			_, w, _ := os.Pipe()
+			os.Stderr = w
+			_, didExit := catchExitInCmd(t, func() {
+				savePromptState(tasks, path)
+			})
+			w.Close()
+			os.Stderr = oldStderr
+
+			// Verify exit expectation
+			if didExit != tt.expectExit {
+				t.Fatalf("exit = %v, want %v", didExit, tt.expectExit)
+			}
+			// Verify state file
+			if tt.wantState {
+				state, err := prompt.LoadState(path)
+				// Check recorded task
+				if err != nil || state.Task("task-001") == nil {
+					t.Errorf("LoadState() = %+v, %v", state, err)
+				}
+			}
+		})
+	}
+}
+
+// Test_verifyPromptTask tests verifying a task against the state file.
+//
+// Params:
+//   - t: testing object
+func Test_verifyPromptTask(t *testing.T) {
+	moduleDir := t.TempDir()
+	// Create a standalone module
+	if err := os.WriteFile(filepath.Join(moduleDir, "go.mod"), []byte("module example.com/tmp\n\ngo 1.24\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(moduleDir, "tmp.go")
+	// Create an already fixed source file
+	if err := os.WriteFile(file, []byte("package tmp\n\nvar V int = 1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tasks := []prompt.Task{{ID: "task-001", Rules: []prompt.RuleViolations{{Code: "KTN-VAR-001", Violations: []prompt.Violation{{FilePath: file, Line: 3, Message: "untyped"}}}}}}
+	statePath := filepath.Join(moduleDir, prompt.StateFileName)
+	// Record the plan
+	if err := prompt.NewState(tasks).Save(statePath); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		task       string
+		statePath  string
+		exitCode   int
+		wantStatus string
+	}{
+		{name: "fixed task passes", task: "task-001", statePath: statePath, exitCode: 0, wantStatus: prompt.TaskDone},
+		{name: "unknown task exits", task: "task-009", statePath: statePath, exitCode: 1, wantStatus: ""},
+		{name: "missing state exits", task: "task-001", statePath: filepath.Join(moduleDir, "missing.json"), exitCode: 1, wantStatus: ""},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			restore := mockExitInCmd(t)
+			defer restore()
+
+			// Silence stdout and stderr
+			oldStdout, oldStderr := os.Stdout, os.Stderr
+			_, w, _ := os.Pipe()
+			os.Stdout, os.Stderr = w, w
+			gen := prompt.NewGenerator(io.Discard, false)
+			exitCode, didExit := catchExitInCmd(t, func() {
+				verifyPromptTask(gen, promptOptions{Options: orchestrator.Options{OnlyRule: "KTN-VAR-001"}, VerifyTask: tt.task, StatePath: tt.statePath})
+			})
+			w.Close()
+			os.Stdout, os.Stderr = oldStdout, oldStderr
+
+			// Verify exit code
+			if !didExit || exitCode != tt.exitCode {
+				t.Fatalf("exit = %v (code %d), want code %d", didExit, exitCode, tt.exitCode)
+			}
+			// Verify recorded verdict
+			if tt.wantStatus != "" {
+				state, err := prompt.LoadState(statePath)
+				// Check task status
+				if err != nil || state.Task(tt.task).Status != tt.wantStatus {
+					t.Errorf("state = %+v, %v, want status %q", state, err, tt.wantStatus)
+				}
+			}
+		})
+	}
+}
+
 // TestPromptCommand_Registered tests that the prompt command is registered in rootCmd.
 //
 // Params:
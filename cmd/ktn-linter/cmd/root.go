@@ -95,6 +95,6 @@ func init() {
 	pf.String(flagCategory, "", "Run only rules from specific category (func, var, error, etc.)")
 	pf.String(flagOnlyRule, "", "Run only a specific rule by code (e.g., KTN-FUNC-001)")
 	pf.StringP(flagConfig, "c", "", "Path to configuration file (.ktn-linter.yaml)")
-	pf.String(flagFormat, "text", "Output format: text, json, or sarif")
+	pf.String(flagFormat, "text", "Output format: text, json, sarif, or jsonl")
 	pf.StringP(flagOutput, "o", "", "Output file path (default: stdout)")
 }
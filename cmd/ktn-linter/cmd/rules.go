@@ -7,6 +7,8 @@ import (
 	"os"
 	"strings"
 
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"github.com/kodflow/ktn-linter/pkg/rulecode"
 	"github.com/kodflow/ktn-linter/pkg/rules"
 	"github.com/spf13/cobra"
 )
@@ -92,6 +94,11 @@ func runRules(cmd *cobra.Command, args []string) {
 	// Parse command-specific flags
 	opts := parseRulesOptions(cmd)
 
+	// List the custom rules of the default configuration too
+	if config.LoadAndSet("") == nil {
+		registerCustomRules()
+	}
+
 	// Create formatter for selected format
 	formatter := NewRulesFormatter(opts.Format)
 
@@ -126,8 +133,8 @@ func displayCategories(formatter RulesFormatter) {
 //   - formatter: rules formatter to use
 //   - opts: rules options
 func handleSingleArg(arg string, formatter RulesFormatter, opts rulesOptions) {
-	// Check if it's a full rule code (KTN-XXX-YYY)
-	if strings.HasPrefix(strings.ToUpper(arg), "KTN-") {
+	// Check if it's a full rule code (PREFIX-XXX-YYY)
+	if rulecode.IsCode(strings.ToUpper(arg)) {
 		// Display single rule details
 		displayRuleDetails(strings.ToUpper(arg), formatter, opts)
 		return
@@ -171,8 +178,48 @@ func handleCategoryAndRule(category, ruleNum string, formatter RulesFormatter, o
 	}
 
 	// Build full rule code
-	code := fmt.Sprintf("KTN-%s-%s", strings.ToUpper(category), ruleNum)
-	displayRuleDetails(code, formatter, opts)
+	displayRuleDetails(categoryRuleCode(category, ruleNum), formatter, opts)
+}
+
+// categoryRuleCode builds the full code of a rule from its category and number.
+// Rules registered with a custom prefix are looked up in the category first.
+//
+// Params:
+//   - category: category name (e.g., "func")
+//   - ruleNum: rule number (e.g., "001")
+//
+// Returns:
+//   - string: full rule code (e.g., "KTN-FUNC-001")
+func categoryRuleCode(category, ruleNum string) string {
+	suffix := "-" + strings.ToUpper(category) + "-" + ruleNum
+	// Search the category for a matching rule
+	for _, info := range rules.GetRuleInfosByCategory(strings.ToLower(category)) {
+		// Match the category and number whatever the prefix
+		if strings.HasSuffix(info.Code, suffix) {
+			// Return registered code
+			return info.Code
+		}
+	}
+	// Fall back to the KTN prefix
+	return rulecode.DefaultPrefix + suffix
+}
+
+// categoryTitle returns the title of a category listing.
+//
+// Params:
+//   - category: category name
+//   - catRules: rules of the category
+//
+// Returns:
+//   - string: code prefix and category (e.g., "KTN-FUNC")
+func categoryTitle(category string, catRules []rules.RuleInfo) string {
+	prefix := rulecode.DefaultPrefix
+	// Use the prefix of the category rules
+	if len(catRules) > 0 && rulecode.Prefix(catRules[0].Code) != "" {
+		prefix = rulecode.Prefix(catRules[0].Code)
+	}
+	// Return prefixed category
+	return prefix + "-" + strings.ToUpper(category)
 }
 
 // isValidRuleNumber checks if a rule number has the correct format.
@@ -285,7 +332,7 @@ func (f *textRulesFormatter) DisplayCategories(categories []string) {
 //   - category: category name
 //   - catRules: list of rules in the category
 func (f *textRulesFormatter) DisplayCategoryRules(category string, catRules []rules.RuleInfo) {
-	fmt.Printf("KTN-%s Rules\n", strings.ToUpper(category))
+	fmt.Printf("%s Rules\n", categoryTitle(category, catRules))
 	fmt.Println(strings.Repeat("=", 20))
 	fmt.Println()
 	// Iterate rules
@@ -306,6 +353,7 @@ func (f *textRulesFormatter) DisplayRuleDetails(info rules.RuleInfo) {
 	fmt.Println()
 	fmt.Printf("Category: %s\n", info.Category)
 	fmt.Printf("Description: %s\n", info.Description)
+	fmt.Printf("Remediation effort: %d min\n", info.RemediationMinutes)
 	// Show example if available
 	if info.GoodExample != "" {
 		fmt.Println()
@@ -346,7 +394,7 @@ func (f *markdownRulesFormatter) DisplayCategories(categories []string) {
 //   - category: category name
 //   - catRules: list of rules
 func (f *markdownRulesFormatter) DisplayCategoryRules(category string, catRules []rules.RuleInfo) {
-	fmt.Printf("# KTN-%s Rules\n\n", strings.ToUpper(category))
+	fmt.Printf("# %s Rules\n\n", categoryTitle(category, catRules))
 	// Iterate rules
 	for _, rule := range catRules {
 		fmt.Printf("- **%s**: %s\n", rule.Code, rule.Description)
@@ -360,6 +408,7 @@ func (f *markdownRulesFormatter) DisplayCategoryRules(category string, catRules
 func (f *markdownRulesFormatter) DisplayRuleDetails(info rules.RuleInfo) {
 	fmt.Printf("# %s\n\n", info.Code)
 	fmt.Printf("**Category**: %s\n\n", info.Category)
+	fmt.Printf("**Remediation effort**: %d min\n\n", info.RemediationMinutes)
 	fmt.Printf("%s\n\n", info.Description)
 	// Show example if available
 	if info.GoodExample != "" {
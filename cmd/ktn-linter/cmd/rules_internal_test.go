@@ -5,6 +5,9 @@ import (
 	"os"
 	"strings"
 	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/rulecode"
+	"github.com/kodflow/ktn-linter/pkg/rules"
 )
 
 func Test_parseRulesOptions(t *testing.T) {
@@ -760,3 +763,82 @@ func TestRulesCmdStructure(t *testing.T) {
 		})
 	}
 }
+
+func Test_categoryRuleCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		category string
+		ruleNum  string
+		expected string
+	}{
+		{
+			name:     "registered rule",
+			category: "func",
+			ruleNum:  "001",
+			expected: "KTN-FUNC-001",
+		},
+		{
+			name:     "upper case category",
+			category: "FUNC",
+			ruleNum:  "002",
+			expected: "KTN-FUNC-002",
+		},
+		{
+			name:     "unknown category falls back to KTN",
+			category: "nonexistent",
+			ruleNum:  "001",
+			expected: "KTN-NONEXISTENT-001",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			result := categoryRuleCode(tt.category, tt.ruleNum)
+			// Verify result
+			if result != tt.expected {
+				t.Errorf("categoryRuleCode(%q, %q) = %q, want %q", tt.category, tt.ruleNum, result, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_categoryTitle(t *testing.T) {
+	tests := []struct {
+		name     string
+		category string
+		catRules []rules.RuleInfo
+		expected string
+	}{
+		{
+			name:     "builtin rules",
+			category: "func",
+			catRules: []rules.RuleInfo{{Code: "KTN-FUNC-001"}},
+			expected: "KTN-FUNC",
+		},
+		{
+			name:     "custom prefix",
+			category: "sec",
+			catRules: []rules.RuleInfo{{Code: "ACME-SEC-001"}},
+			expected: "ACME-SEC",
+		},
+		{
+			name:     "no rules",
+			category: "empty",
+			catRules: []rules.RuleInfo{},
+			expected: "KTN-EMPTY",
+		},
+	}
+	rulecode.RegisterPrefix("ACME")
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			result := categoryTitle(tt.category, tt.catRules)
+			// Verify result
+			if result != tt.expected {
+				t.Errorf("categoryTitle(%q) = %q, want %q", tt.category, result, tt.expected)
+			}
+		})
+	}
+}
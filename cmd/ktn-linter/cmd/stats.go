@@ -0,0 +1,278 @@
+// Package cmd implements the CLI commands for ktn-linter.
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+	"github.com/kodflow/ktn-linter/pkg/stats"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagStatsFormat is the flag name for the stats output format.
+	flagStatsFormat string = "format"
+	// flagStatsTop is the flag name for the size of top lists.
+	flagStatsTop string = "top"
+	// flagStatsHistory is the flag name for the history file.
+	flagStatsHistory string = "history"
+	// defaultStatsTop is the default size of top lists.
+	defaultStatsTop int = 10
+)
+
+var (
+	// statsFormats are the accepted stats output formats.
+	statsFormats map[string]bool = map[string]bool{
+		"text": true,
+		"json": true,
+		"csv":  true,
+	}
+
+	// statsCmd represents the stats command.
+	statsCmd *cobra.Command = &cobra.Command{
+		Use:   "stats [packages...]",
+		Short: "Summarize findings and technical debt",
+		Long: `Stats analyzes Go packages and summarizes findings instead of listing them.
+
+The report contains counts per rule, category, package and severity, the
+density of findings per 1000 lines, the most affected files and functions,
+and the estimated remediation effort declared for each rule.
+
+With --history, a dated snapshot is appended to the given file and the
+report shows the trend since the previous snapshot.
+
+Examples:
+  ktn-linter stats ./...                                 Text summary
+  ktn-linter stats --format=csv -o debt.csv ./...        CSV export
+  ktn-linter stats --history .ktn-stats.jsonl ./...      Track debt over time`,
+		Args: cobra.MinimumNArgs(1),
+		Run:  runStats,
+	}
+)
+
+// statsOptions holds the stats command settings.
+type statsOptions struct {
+	orchestrator.Options
+	Format      string
+	OutputPath  string
+	Top         int
+	HistoryPath string
+}
+
+// init registers the stats command with root.
+//
+// Params: none
+//
+// Returns: none
+func init() {
+	rootCmd.AddCommand(statsCmd)
+	statsCmd.Flags().String(flagStatsFormat, defaultRulesFormat, "Output format: text, json, csv")
+	statsCmd.Flags().Int(flagStatsTop, defaultStatsTop, "Number of files and functions in top lists (0 = all)")
+	statsCmd.Flags().String(flagStatsHistory, "", "History file to append a dated snapshot to and compare with")
+}
+
+// runStats executes the stats command.
+//
+// Params:
+//   - cmd: Cobra command (used to get flags)
+//   - args: package patterns or paths
+//
+// Returns: none
+func runStats(cmd *cobra.Command, args []string) {
+	opts, err := parseStatsOptions(cmd)
+	// Check for invalid flags
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		OsExit(1)
+		// Exit on error
+		return
+	}
+	loadConfiguration(opts.Options)
+	registerCustomRules()
+	config.Get().Verbose = opts.Verbose
+
+	orch := orchestrator.NewOrchestrator(os.Stderr, opts.Verbose)
+	orch.SetBuildMatrix(config.Get().BuildConfigurations())
+	results, err := collectStatsResults(orch, args, opts.Options)
+	// Check for analysis error
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		OsExit(1)
+		// Exit on error
+		return
+	}
+
+	files, lines := orch.SourceLines()
+	cwd, _ := os.Getwd()
+	report := stats.Collect(results, stats.Options{
+		Files:   files,
+		Lines:   lines,
+		Top:     opts.Top,
+		BaseDir: cwd,
+	})
+
+	writer, cleanup := getOutputWriter(opts.OutputPath)
+	// Close output file when done
+	if cleanup != nil {
+		defer cleanup()
+	}
+
+	// Write report and update history
+	if err := writeStatsReport(writer, report, opts); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		OsExit(1)
+	}
+}
+
+// parseStatsOptions extracts stats options from Cobra flags.
+//
+// Params:
+//   - cmd: Cobra command with flags
+//
+// Returns:
+//   - statsOptions: extracted options
+//   - error: unknown format error if any
+func parseStatsOptions(cmd *cobra.Command) (statsOptions, error) {
+	flags := rootCmd.PersistentFlags()
+	verbose, _ := flags.GetBool(flagVerbose)
+	category, _ := flags.GetString(flagCategory)
+	onlyRule, _ := flags.GetString(flagOnlyRule)
+	configPath, _ := flags.GetString(flagConfig)
+	outputPath, _ := flags.GetString(flagOutput)
+	format, _ := cmd.Flags().GetString(flagStatsFormat)
+	top, _ := cmd.Flags().GetInt(flagStatsTop)
+	historyPath, _ := cmd.Flags().GetString(flagStatsHistory)
+
+	format = strings.ToLower(format)
+	// Reject unknown formats
+	if !statsFormats[format] {
+		// Return format error
+		return statsOptions{}, fmt.Errorf("unknown format %q (want text, json or csv)", format)
+	}
+	// Return parsed options
+	return statsOptions{
+		Options: orchestrator.Options{
+			Verbose:    verbose,
+			Category:   category,
+			OnlyRule:   onlyRule,
+			ConfigPath: configPath,
+		},
+		Format:      format,
+		OutputPath:  outputPath,
+		Top:         top,
+		HistoryPath: historyPath,
+	}, nil
+}
+
+// collectStatsResults runs the analysis and returns normalized findings.
+//
+// Params:
+//   - orch: orchestrator to run
+//   - args: package patterns or paths
+//   - opts: analyzer selection options
+//
+// Returns:
+//   - []orchestrator.DiagnosticResult: filtered and deduplicated findings
+//   - error: pipeline error if any
+func collectStatsResults(orch *orchestrator.Orchestrator, args []string, opts orchestrator.Options) ([]orchestrator.DiagnosticResult, error) {
+	var raw []orchestrator.DiagnosticResult
+	// Use multi-module approach for directory arguments
+	if orchestrator.NeedsModuleDiscovery(args) {
+		diags, err := orch.RunMultiModule(args, opts)
+		// Check for error
+		if err != nil {
+			// Return pipeline error
+			return []orchestrator.DiagnosticResult{}, err
+		}
+		raw = diags
+	} else {
+		// Use single-module approach for package patterns
+		pkgs, err := orch.LoadPackages(args)
+		// Check for error
+		if err != nil {
+			// Return loading error
+			return []orchestrator.DiagnosticResult{}, err
+		}
+		analyzers, err := orch.SelectAnalyzers(opts)
+		// Check for error
+		if err != nil {
+			// Return selection error
+			return []orchestrator.DiagnosticResult{}, err
+		}
+		raw = orch.RunAnalyzers(pkgs, analyzers)
+	}
+	// Return filtered and deduplicated findings
+	return orch.NormalizeDiagnostics(orch.FilterDiagnostics(raw)), nil
+}
+
+// writeStatsReport writes a report in the selected format and records history
+// once the report is written.
+//
+// Params:
+//   - w: destination writer
+//   - report: report to write
+//   - opts: stats options
+//
+// Returns:
+//   - error: history, write or unknown format error if any
+func writeStatsReport(w io.Writer, report *stats.Report, opts statsOptions) error {
+	// Write a plain report without history
+	if opts.HistoryPath == "" {
+		// Return write error
+		return writeStatsFormat(w, report, opts.Format, nil)
+	}
+	history, err := stats.ReadHistory(opts.HistoryPath)
+	// Check for error
+	if err != nil {
+		// Return history error
+		return err
+	}
+	var previous *stats.Snapshot
+	// Keep the most recent snapshot
+	if len(history) > 0 {
+		previous = &history[len(history)-1]
+	}
+	// Check for write error
+	if err := writeStatsFormat(w, report, opts.Format, previous); err != nil {
+		// Return write error without recording the run
+		return err
+	}
+	// Record the run
+	return stats.AppendHistory(opts.HistoryPath, report.Snapshot())
+}
+
+// writeStatsFormat writes a report in the selected format.
+//
+// Params:
+//   - w: destination writer
+//   - report: report to write
+//   - format: output format
+//   - previous: last recorded snapshot, nil without history
+//
+// Returns:
+//   - error: write or unknown format error if any
+func writeStatsFormat(w io.Writer, report *stats.Report, format string, previous *stats.Snapshot) error {
+	// Select output format
+	switch format {
+	// JSON format
+	case "json":
+		// Write JSON report
+		return stats.WriteJSON(w, report)
+	// CSV format
+	case "csv":
+		// Write CSV report
+		return stats.WriteCSV(w, report)
+	// Text format
+	case "text":
+		// Write text report
+		return stats.WriteText(w, report, previous)
+	// Unknown format
+	default:
+		// Return format error
+		return fmt.Errorf("unknown format %q (want text, json or csv)", format)
+	}
+}
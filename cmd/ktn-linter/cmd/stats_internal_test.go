@@ -0,0 +1,177 @@
+// Internal tests for the stats command.
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/stats"
+)
+
+// Test_writeStatsReport tests the writeStatsReport function.
+func Test_writeStatsReport(t *testing.T) {
+	tests := []struct {
+		name       string
+		format     string
+		history    bool
+		wantPrefix string
+		wantTrend  bool
+	}{
+		{name: "text", format: "text", history: false, wantPrefix: "Findings:", wantTrend: false},
+		{name: "json", format: "json", history: false, wantPrefix: "{", wantTrend: false},
+		{name: "csv", format: "csv", history: false, wantPrefix: "section,name", wantTrend: false},
+		{name: "text with history", format: "text", history: true, wantPrefix: "Findings:", wantTrend: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			report := &stats.Report{Total: 1, Rules: []stats.RuleStat{{Code: "KTN-VAR-001", Count: 1}}}
+			opts := statsOptions{Format: tt.format}
+			// Seed history with a previous run
+			if tt.history {
+				opts.HistoryPath = filepath.Join(t.TempDir(), "history.jsonl")
+				// Check seed error
+				if err := stats.AppendHistory(opts.HistoryPath, stats.Snapshot{Date: "2026-01-01", Total: 4}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var buf bytes.Buffer
+			// Check write error
+			if err := writeStatsReport(&buf, report, opts); err != nil {
+				t.Fatalf("writeStatsReport() error = %v", err)
+			}
+			// Verify format
+			if !strings.HasPrefix(buf.String(), tt.wantPrefix) {
+				t.Errorf("output starts with %q, want %q", buf.String(), tt.wantPrefix)
+			}
+			// Verify trend line
+			if strings.Contains(buf.String(), "-3 findings") != tt.wantTrend {
+				t.Errorf("trend presence = %v, want %v:\n%s", !tt.wantTrend, tt.wantTrend, buf.String())
+			}
+			// Verify snapshot was appended
+			if tt.history {
+				history, err := stats.ReadHistory(opts.HistoryPath)
+				// Check history content
+				if err != nil || len(history) != 2 {
+					t.Errorf("history = %v, %v, want 2 snapshots", history, err)
+				}
+			}
+		})
+	}
+}
+
+// Test_writeStatsReport_failedWrite tests that failed reports are not recorded.
+func Test_writeStatsReport_failedWrite(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		closed bool
+	}{
+		{name: "write error", format: "json", closed: true},
+		{name: "unknown format", format: "yaml", closed: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "history.jsonl")
+			// Check seed error
+			if err := stats.AppendHistory(path, stats.Snapshot{Date: "2026-01-01", Total: 4}); err != nil {
+				t.Fatal(err)
+			}
+			out, err := os.Create(filepath.Join(dir, "report.json"))
+			// Check fixture error
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer out.Close()
+			// Make writes fail
+			if tt.closed {
+				out.Close()
+			}
+			report := &stats.Report{Total: 1, Rules: []stats.RuleStat{{Code: "KTN-VAR-001", Count: 1}}}
+			// Verify error
+			if err := writeStatsReport(out, report, statsOptions{Format: tt.format, HistoryPath: path}); err == nil {
+				t.Error("expected error")
+			}
+			history, err := stats.ReadHistory(path)
+			// Verify the run was not recorded
+			if err != nil || len(history) != 1 {
+				t.Errorf("history = %v, %v, want the seed snapshot only", history, err)
+			}
+		})
+	}
+}
+
+// Test_writeStatsReport_badHistory tests an unreadable history file.
+func Test_writeStatsReport_badHistory(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{name: "corrupted history"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "history.jsonl")
+			// Check fixture error
+			if err := os.WriteFile(path, []byte("not json\n"), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			var buf bytes.Buffer
+			err := writeStatsReport(&buf, &stats.Report{}, statsOptions{Format: "text", HistoryPath: path})
+			// Verify error
+			if err == nil {
+				t.Error("expected error for corrupted history")
+			}
+		})
+	}
+}
+
+// Test_parseStatsOptions tests the parseStatsOptions function.
+func Test_parseStatsOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantFmt string
+		wantTop int
+		wantErr bool
+	}{
+		{name: "defaults", args: []string{}, wantFmt: "text", wantTop: defaultStatsTop},
+		{name: "explicit values", args: []string{"--format=CSV", "--top=3"}, wantFmt: "csv", wantTop: 3},
+		{name: "unknown format", args: []string{"--format=yaml"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Reset flags between cases
+			statsCmd.Flags().Set(flagStatsFormat, defaultRulesFormat)
+			statsCmd.Flags().Set(flagStatsTop, "10")
+			// Check parse error
+			if err := statsCmd.Flags().Parse(tt.args); err != nil {
+				t.Fatal(err)
+			}
+			opts, err := parseStatsOptions(statsCmd)
+			// Verify format validation
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseStatsOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// Skip option checks of rejected flags
+			if tt.wantErr {
+				return
+			}
+			// Verify options
+			if opts.Format != tt.wantFmt || opts.Top != tt.wantTop {
+				t.Errorf("parseStatsOptions() = %+v, want format %s and top %d", opts, tt.wantFmt, tt.wantTop)
+			}
+		})
+	}
+}
@@ -2,16 +2,27 @@
 package cmd
 
 import (
+	"cmp"
 	"fmt"
+	"os"
 
+	"github.com/kodflow/ktn-linter/pkg/config"
 	"github.com/kodflow/ktn-linter/pkg/updater"
 	"github.com/spf13/cobra"
 )
 
-// Flag for check-only mode.
+// Flags for check-only, rollback, pinned and channel modes.
 const (
 	// flagCheck is the flag name for check-only mode.
 	flagCheck string = "check"
+	// flagRollback is the flag name for restoring the previous binary.
+	flagRollback string = "rollback"
+	// flagTo is the flag name for installing a pinned version.
+	flagTo string = "to"
+	// flagChannel is the flag name for the release channel.
+	flagChannel string = "channel"
+	// bytesPerMB converts update.max_size_mb to bytes.
+	bytesPerMB int64 = 1 << 20
 )
 
 // upgradeCmd represents the upgrade command.
@@ -21,11 +32,21 @@ var upgradeCmd *cobra.Command = &cobra.Command{
 	Long: `Upgrade ktn-linter to the latest version from GitHub releases.
 
 This command checks for a newer version and downloads/replaces the
-current binary if an update is available.
+current binary if an update is available. The download is checked against
+the release checksums.txt (and its signature when a release key is built
+in) and the replaced binary is kept as ktn-linter.prev.
+
+Releases come from GitHub unless the update section of the configuration
+or the KTN_LINTER_UPDATE_SOURCE environment variable names a mirror: the
+base URL of an HTTP directory serving index.json and <version>/<asset>,
+or a local directory (or file:// URL) with the same layout.
 
 Examples:
-  ktn-linter upgrade          Check and upgrade to latest version
-  ktn-linter upgrade --check  Only check for updates without upgrading`,
+  ktn-linter upgrade                       Check and upgrade to latest version
+  ktn-linter upgrade --check               Only check for updates without upgrading
+  ktn-linter upgrade --to v1.4.2           Install a specific version (also downgrades)
+  ktn-linter upgrade --channel prerelease  Include release candidates
+  ktn-linter upgrade --rollback            Restore the binary replaced by the last upgrade`,
 	Run: runUpgrade,
 }
 
@@ -33,6 +54,9 @@ Examples:
 func init() {
 	rootCmd.AddCommand(upgradeCmd)
 	upgradeCmd.Flags().Bool(flagCheck, false, "Only check for updates without upgrading")
+	upgradeCmd.Flags().Bool(flagRollback, false, "Restore the binary replaced by the last upgrade")
+	upgradeCmd.Flags().String(flagTo, "", "Install this version instead of the latest one")
+	upgradeCmd.Flags().String(flagChannel, "", "Release channel: stable or prerelease (default from config, else stable)")
 }
 
 // runUpgrade executes the upgrade command.
@@ -41,8 +65,34 @@ func init() {
 //   - cmd: cobra command
 //   - args: command arguments
 func runUpgrade(cmd *cobra.Command, args []string) {
-	// Create updater with current version
-	upd := updater.NewUpdater(version)
+	// Check if rollback mode
+	rollback, _ := cmd.Flags().GetBool(flagRollback)
+
+	// Handle rollback mode, which never downloads
+	if rollback {
+		handleRollback(updater.NewUpdater(version))
+		// Exit after rollback mode
+		return
+	}
+
+	opts, err := upgradeOptions(cmd)
+	// Check for configuration errors
+	if err != nil {
+		fmt.Printf("Error configuring updates: %v\n", err)
+		OsExit(1)
+		// Exit after error
+		return
+	}
+
+	// Create updater with current version and release source
+	upd, err := updater.NewUpdaterWithOptions(version, opts)
+	// Check for invalid update settings
+	if err != nil {
+		fmt.Printf("Error configuring updates: %v\n", err)
+		OsExit(1)
+		// Exit after error
+		return
+	}
 
 	// Check if check-only mode
 	checkOnly, _ := cmd.Flags().GetBool(flagCheck)
@@ -54,8 +104,38 @@ func runUpgrade(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	target, _ := cmd.Flags().GetString(flagTo)
 	// Perform upgrade
-	handleUpgrade(upd)
+	handleUpgrade(upd, target)
+}
+
+// upgradeOptions builds the updater options from the update section of the
+// configuration, the KTN_LINTER_UPDATE_SOURCE environment variable and the
+// --channel flag, in increasing precedence.
+//
+// Params:
+//   - cmd: cobra command
+//
+// Returns:
+//   - updater.Options: release source settings
+//   - error: configuration loading error
+func upgradeOptions(cmd *cobra.Command) (updater.Options, error) {
+	configPath, _ := cmd.Flags().GetString(flagConfig)
+	cfg, err := config.Load(configPath)
+	// Check configuration loading
+	if err != nil {
+		// Return loading error
+		return updater.Options{}, err
+	}
+	channel, _ := cmd.Flags().GetString(flagChannel)
+
+	// Return merged options
+	return updater.Options{
+		Source:  cmp.Or(os.Getenv(updater.SourceEnv), cfg.Update.Source),
+		Channel: cmp.Or(channel, cfg.Update.Channel),
+		Proxy:   cfg.Update.Proxy,
+		MaxSize: int64(cfg.Update.MaxSizeMB) * bytesPerMB,
+	}, nil
 }
 
 // handleCheckOnly checks for updates without upgrading.
@@ -89,10 +169,19 @@ func handleCheckOnly(upd *updater.Updater) {
 //
 // Params:
 //   - upd: updater instance
-func handleUpgrade(upd *updater.Updater) {
-	fmt.Println("Checking for updates...")
-
-	info, err := upd.Upgrade()
+//   - target: pinned version, empty for the latest one
+func handleUpgrade(upd *updater.Updater, target string) {
+	var info updater.UpdateInfo
+	var err error
+	// Check if a version is pinned
+	if target != "" {
+		fmt.Printf("Installing %s...\n", target)
+		info, err = upd.UpgradeTo(target)
+	} else {
+		// Follow the configured channel
+		fmt.Println("Checking for updates...")
+		info, err = upd.Upgrade()
+	}
 	// Check for errors
 	if err != nil {
 		fmt.Printf("Error upgrading: %v\n", err)
@@ -110,3 +199,21 @@ func handleUpgrade(upd *updater.Updater) {
 		fmt.Printf("Already up to date: %s\n", info.CurrentVersion)
 	}
 }
+
+// handleRollback restores the binary replaced by the last upgrade.
+//
+// Params:
+//   - upd: updater instance
+func handleRollback(upd *updater.Updater) {
+	restored, err := upd.Rollback()
+	// Check for errors
+	if err != nil {
+		fmt.Printf("Error rolling back: %v\n", err)
+		OsExit(1)
+		// Exit after error
+		return
+	}
+
+	// Display result
+	fmt.Printf("Restored previous binary: %s\n", restored)
+}
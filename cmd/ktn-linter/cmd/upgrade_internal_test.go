@@ -3,8 +3,11 @@ package cmd
 
 import (
 	"bytes"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/kodflow/ktn-linter/pkg/updater"
 	"github.com/spf13/cobra"
 )
 
@@ -54,6 +57,9 @@ func TestUpgradeCmdFlags(t *testing.T) {
 		expectValue string
 	}{
 		{name: "verifies check flag exists with correct type and default", flagName: flagCheck, expectType: "bool", expectValue: "false"},
+		{name: "verifies rollback flag exists with correct type and default", flagName: flagRollback, expectType: "bool", expectValue: "false"},
+		{name: "verifies to flag exists with correct type and default", flagName: flagTo, expectType: "string", expectValue: ""},
+		{name: "verifies channel flag exists with correct type and default", flagName: flagChannel, expectType: "string", expectValue: ""},
 	}
 
 	// Run all test cases
@@ -165,3 +171,145 @@ func TestRunUpgradeDevBuild(t *testing.T) {
 		})
 	}
 }
+
+// TestRunUpgradeRollback tests rollback without a previous binary.
+func TestRunUpgradeRollback(t *testing.T) {
+	// Define test cases for rollback behavior
+	tests := []struct {
+		name         string
+		expectedExit int
+	}{
+		{name: "exits with code 1 when no backup exists", expectedExit: 1},
+	}
+
+	// Run all test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			origExit := OsExit
+			// Restore after test
+			defer func() {
+				OsExit = origExit
+			}()
+
+			// Track exit code
+			var exitCode int
+			OsExit = func(code int) {
+				exitCode = code
+			}
+
+			// Create test command
+			cmd := &cobra.Command{}
+			cmd.Flags().Bool(flagRollback, true, "")
+
+			// Run command
+			runUpgrade(cmd, []string{})
+
+			// Check exit code
+			if exitCode != tt.expectedExit {
+				t.Errorf("runUpgrade() exit code = %d, want %d", exitCode, tt.expectedExit)
+			}
+		})
+	}
+}
+
+// TestUpgradeOptions tests the precedence of update settings.
+func TestUpgradeOptions(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, ".ktn-linter.yaml")
+	content := "update:\n  source: https://mirror.example.com/ktn\n  channel: prerelease\n  proxy: http://proxy.example.com:3128\n  max_size_mb: 64\n"
+	// Write the configuration
+	if err := os.WriteFile(configPath, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		env     string
+		channel string
+		want    updater.Options
+	}{
+		{
+			name: "configuration only",
+			want: updater.Options{Source: "https://mirror.example.com/ktn", Channel: "prerelease", Proxy: "http://proxy.example.com:3128", MaxSize: 64 << 20},
+		},
+		{
+			name:    "environment and flag win",
+			env:     dir,
+			channel: "stable",
+			want:    updater.Options{Source: dir, Channel: "stable", Proxy: "http://proxy.example.com:3128", MaxSize: 64 << 20},
+		},
+	}
+
+	// Run all test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(updater.SourceEnv, tt.env)
+			cmd := &cobra.Command{}
+			cmd.Flags().String(flagConfig, configPath, "")
+			cmd.Flags().String(flagChannel, tt.channel, "")
+
+			got, err := upgradeOptions(cmd)
+			// Check options
+			if err != nil || got != tt.want {
+				t.Errorf("upgradeOptions() = %+v, %v; want %+v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+// TestRunUpgradeOptions tests upgrade failures caused by update settings.
+func TestRunUpgradeOptions(t *testing.T) {
+	dir := t.TempDir()
+	// Write an empty local release index
+	if err := os.WriteFile(filepath.Join(dir, "index.json"), []byte(`{"releases":[]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name         string
+		config       string
+		channel      string
+		to           string
+		expectedExit int
+	}{
+		{name: "exits with code 1 on a missing config file", config: filepath.Join(dir, "missing.yaml"), expectedExit: 1},
+		{name: "exits with code 1 on an unknown channel", channel: "nightly", expectedExit: 1},
+		{name: "exits with code 1 when the pinned release is missing", to: "v0.0.1", expectedExit: 1},
+	}
+
+	// Run all test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			origVersion := version
+			origExit := OsExit
+			// Restore after test
+			defer func() {
+				version = origVersion
+				OsExit = origExit
+			}()
+			version = "v1.0.0"
+			t.Setenv(updater.SourceEnv, dir)
+
+			// Track exit code
+			var exitCode int
+			OsExit = func(code int) {
+				exitCode = code
+			}
+
+			// Create test command
+			cmd := &cobra.Command{}
+			cmd.Flags().String(flagConfig, tt.config, "")
+			cmd.Flags().String(flagChannel, tt.channel, "")
+			cmd.Flags().String(flagTo, tt.to, "")
+
+			// Run command
+			runUpgrade(cmd, []string{})
+
+			// Check exit code
+			if exitCode != tt.expectedExit {
+				t.Errorf("runUpgrade() exit code = %d, want %d", exitCode, tt.expectedExit)
+			}
+		})
+	}
+}
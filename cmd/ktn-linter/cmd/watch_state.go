@@ -0,0 +1,15 @@
+// Package cmd implements the CLI commands for ktn-linter.
+package cmd
+
+import "github.com/kodflow/ktn-linter/pkg/orchestrator"
+
+// watchState is the content of one watch mode screen.
+// Added and Resolved hold the diff against the previous screen.
+type watchState struct {
+	Header   string
+	Current  []orchestrator.DiagnosticResult
+	Added    []orchestrator.DiagnosticResult
+	Resolved []orchestrator.DiagnosticResult
+	Initial  bool
+	Clear    bool
+}
@@ -5,6 +5,7 @@ go 1.25.5
 require (
 	github.com/owenrumney/go-sarif/v3 v3.3.0
 	github.com/spf13/cobra v1.10.2
+	golang.org/x/mod v0.31.0
 	golang.org/x/tools v0.40.0
 	gopkg.in/yaml.v3 v3.0.1
 )
@@ -16,6 +17,5 @@ require (
 	github.com/xeipuuv/gojsonpointer v0.0.0-20180127040702-4e3ac2762d5f // indirect
 	github.com/xeipuuv/gojsonreference v0.0.0-20180127040603-bd5ef7bd5415 // indirect
 	github.com/xeipuuv/gojsonschema v1.2.0 // indirect
-	golang.org/x/mod v0.31.0 // indirect
 	golang.org/x/sync v0.19.0 // indirect
 )
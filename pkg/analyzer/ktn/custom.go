@@ -0,0 +1,241 @@
+// Package ktn provides the master registry for all KTN lint rules.
+package ktn
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/kodflow/ktn-linter/pkg/rulecode"
+	"github.com/kodflow/ktn-linter/pkg/severity"
+)
+
+var (
+	// customCategories liste les catégories tierces, dans l'ordre d'enregistrement.
+	customCategories []string
+	// customRules liste les règles tierces, dans l'ordre d'enregistrement.
+	customRules []*RuleSpec
+)
+
+// RegisterCategory déclare une catégorie de règles tierces.
+// Elle devient utilisable avec --category, rules et RuleSpec.Category.
+// Non sûr en concurrence : à appeler depuis une fonction init.
+//
+// Params:
+//   - name: nom de la catégorie (ex: "sec"), converti en minuscules
+//
+// Returns:
+//   - error: nom vide ou catégorie déjà existante
+func RegisterCategory(name string) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+	// Vérification du nom
+	if name == "" {
+		// Nom manquant
+		return errors.New("ktn: empty category name")
+	}
+	// Vérification de l'unicité
+	if HasCategory(name) {
+		// Catégorie déjà connue
+		return fmt.Errorf("ktn: category %q already registered", name)
+	}
+	customCategories = append(customCategories, name)
+	// Catégorie enregistrée
+	return nil
+}
+
+// HasCategory indique si une catégorie, intégrée ou tierce, existe.
+//
+// Params:
+//   - name: nom de la catégorie, insensible à la casse
+//
+// Returns:
+//   - bool: true si la catégorie existe
+func HasCategory(name string) bool {
+	_, exists := categoryAnalyzers()[strings.ToLower(strings.TrimSpace(name))]
+	// Retour de l'existence
+	return exists
+}
+
+// RegisterRule enregistre une règle tierce à côté des règles KTN.
+// La règle est ensuite sélectionnable, listée par rules et classée par
+// sévérité comme une règle KTN. Le runner applique enabled et exclude de sa
+// configuration ; l'analyseur lit son seuil avec config.ForPass(pass).GetThreshold.
+// Son préfixe de code (ex: "ACME") est reconnu dans les messages.
+// Non sûr en concurrence : à appeler depuis une fonction init.
+//
+// Params:
+//   - spec: description de la règle, copiée à l'enregistrement
+//
+// Returns:
+//   - error: spécification invalide ou règle déjà enregistrée
+func RegisterRule(spec *RuleSpec) error {
+	rule, err := normalizeSpec(spec)
+	// Vérification de la spécification
+	if err != nil {
+		// Spécification invalide
+		return err
+	}
+	// Vérification de l'unicité du code et du nom d'analyseur
+	if err := checkDuplicate(rule); err != nil {
+		// Règle déjà connue
+		return err
+	}
+
+	prefix, _, _ := strings.Cut(rule.Code, "-")
+	rulecode.RegisterPrefix(prefix)
+	severity.Register(rule.Code, rule.Severity)
+	customRules = append(customRules, rule)
+	// Règle enregistrée
+	return nil
+}
+
+// normalizeSpec valide une spécification et en retourne une copie complétée.
+//
+// Params:
+//   - spec: spécification fournie
+//
+// Returns:
+//   - *RuleSpec: copie normalisée
+//   - error: spécification invalide
+func normalizeSpec(spec *RuleSpec) (*RuleSpec, error) {
+	// Vérification de la présence de l'analyseur
+	if spec == nil || spec.Analyzer == nil {
+		// Analyseur manquant
+		return nil, errors.New("ktn: rule spec without analyzer")
+	}
+	rule := *spec
+	rule.Code = strings.ToUpper(rule.Code)
+	// Vérification du format du code
+	if !rulecode.IsWellFormed(rule.Code) {
+		// Code invalide
+		return nil, fmt.Errorf("ktn: invalid rule code %q (expected PREFIX-CATEGORY-ID)", spec.Code)
+	}
+	rule.Category = strings.ToLower(rule.Category)
+	// Catégorie par défaut tirée du code
+	if rule.Category == "" {
+		rule.Category = strings.ToLower(strings.Split(rule.Code, "-")[1])
+	}
+	// Vérification de l'existence de la catégorie
+	if _, exists := categoryAnalyzers()[rule.Category]; !exists {
+		// Catégorie inconnue
+		return nil, fmt.Errorf("ktn: rule %s: unknown category %q (see RegisterCategory)", rule.Code, rule.Category)
+	}
+	// Description par défaut tirée de la doc de l'analyseur
+	if rule.Description == "" {
+		rule.Description = rule.Analyzer.Doc
+	}
+	// Retour de la copie normalisée
+	return &rule, nil
+}
+
+// checkDuplicate vérifie qu'aucune règle n'utilise déjà le code ou l'analyseur.
+//
+// Params:
+//   - rule: règle normalisée
+//
+// Returns:
+//   - error: code ou nom d'analyseur déjà utilisé
+func checkDuplicate(rule *RuleSpec) error {
+	// Vérification du code
+	if GetRuleByCode(rule.Code) != nil {
+		// Code déjà utilisé
+		return fmt.Errorf("ktn: rule %s already registered", rule.Code)
+	}
+	// Vérification du nom d'analyseur
+	if slices.ContainsFunc(GetAllRules(), func(a *analysis.Analyzer) bool { return a.Name == rule.Analyzer.Name }) {
+		// Nom déjà utilisé
+		return fmt.Errorf("ktn: rule %s: analyzer name %q already used", rule.Code, rule.Analyzer.Name)
+	}
+	// Aucun doublon
+	return nil
+}
+
+// GetCustomCategories retourne les catégories tierces enregistrées.
+//
+// Returns:
+//   - []string: catégories, dans l'ordre d'enregistrement
+func GetCustomCategories() []string {
+	// Retour d'une copie
+	return append([]string{}, customCategories...)
+}
+
+// GetCustomRules retourne les règles tierces enregistrées.
+//
+// Returns:
+//   - []*RuleSpec: règles, dans l'ordre d'enregistrement
+func GetCustomRules() []*RuleSpec {
+	// Retour d'une copie de la liste
+	return append([]*RuleSpec{}, customRules...)
+}
+
+// CustomRuleCode retourne le code de la règle tierce d'un analyseur.
+//
+// Params:
+//   - analyzer: analyseur à rechercher
+//
+// Returns:
+//   - string: code de la règle, vide pour un analyseur non enregistré
+func CustomRuleCode(analyzer *analysis.Analyzer) string {
+	// Recherche par analyseur
+	for _, rule := range customRules {
+		// Comparaison des analyseurs
+		if rule.Analyzer == analyzer {
+			// Règle trouvée
+			return rule.Code
+		}
+	}
+	// Analyseur non enregistré
+	return ""
+}
+
+// customAnalyzers retourne les analyseurs des règles tierces d'une catégorie.
+//
+// Params:
+//   - category: catégorie, vide pour toutes
+//
+// Returns:
+//   - []*analysis.Analyzer: analyseurs des règles
+func customAnalyzers(category string) []*analysis.Analyzer {
+	analyzers := make([]*analysis.Analyzer, 0, len(customRules))
+	// Sélection des règles de la catégorie
+	for _, rule := range customRules {
+		// Filtrage par catégorie
+		if category == "" || rule.Category == category {
+			analyzers = append(analyzers, rule.Analyzer)
+		}
+	}
+	// Retour des analyseurs
+	return analyzers
+}
+
+// customRuleByCode retourne la règle tierce d'un code.
+//
+// Params:
+//   - code: code de la règle
+//
+// Returns:
+//   - *RuleSpec: règle trouvée ou nil
+func customRuleByCode(code string) *RuleSpec {
+	// Recherche par code
+	for _, rule := range customRules {
+		// Comparaison des codes
+		if rule.Code == code {
+			// Règle trouvée
+			return rule
+		}
+	}
+	// Règle inconnue
+	return nil
+}
+
+// noAnalyzers retourne les analyseurs intégrés d'une catégorie tierce.
+//
+// Returns:
+//   - []*analysis.Analyzer: liste vide, les règles tierces sont ajoutées à part
+func noAnalyzers() []*analysis.Analyzer {
+	// Aucune règle intégrée
+	return []*analysis.Analyzer{}
+}
@@ -0,0 +1,170 @@
+// External tests for third-party rule registration.
+package ktn_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn"
+	"github.com/kodflow/ktn-linter/pkg/rulecode"
+	"github.com/kodflow/ktn-linter/pkg/severity"
+	"golang.org/x/tools/go/analysis"
+)
+
+// secThreshold is the default threshold of the registered test rule.
+var secThreshold int = 3
+
+// secEffort is the remediation effort of the registered test rule.
+var secEffort int = 45
+
+// secAnalyzer is the analyzer of the registered test rule.
+var secAnalyzer *analysis.Analyzer = &analysis.Analyzer{
+	Name: "acmesec001",
+	Doc:  "ACME-SEC-001: no hardcoded secrets",
+	Run:  func(*analysis.Pass) (any, error) { return nil, nil },
+}
+
+// init registers the custom category and rule shared by the tests.
+func init() {
+	// Register the custom category
+	if err := ktn.RegisterCategory("Sec"); err != nil {
+		panic(err)
+	}
+	// Register the custom rule
+	if err := ktn.RegisterRule(&ktn.RuleSpec{
+		Code:               "acme-sec-001",
+		Analyzer:           secAnalyzer,
+		Severity:           severity.SeverityError,
+		Threshold:          &secThreshold,
+		RemediationMinutes: secEffort,
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// TestRegisterCategory tests category registration errors.
+func TestRegisterCategory(t *testing.T) {
+	tests := []struct {
+		name     string
+		category string
+	}{
+		{name: "empty name", category: " "},
+		{name: "built-in category", category: "func"},
+		{name: "already registered", category: "sec"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify registration is rejected
+			if err := ktn.RegisterCategory(tt.category); err == nil {
+				t.Errorf("RegisterCategory(%q) expected error", tt.category)
+			}
+		})
+	}
+}
+
+// TestHasCategory tests category lookup.
+func TestHasCategory(t *testing.T) {
+	tests := []struct {
+		name     string
+		category string
+		want     bool
+	}{
+		{name: "built-in category", category: "func", want: true},
+		{name: "registered category", category: "SEC", want: true},
+		{name: "unknown category", category: "net", want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify lookup
+			if got := ktn.HasCategory(tt.category); got != tt.want {
+				t.Errorf("HasCategory(%q) = %v, want %v", tt.category, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRegisterRule tests rule registration errors.
+func TestRegisterRule(t *testing.T) {
+	run := func(*analysis.Pass) (any, error) { return nil, nil }
+	tests := []struct {
+		name string
+		spec *ktn.RuleSpec
+	}{
+		{name: "nil spec", spec: nil},
+		{name: "no analyzer", spec: &ktn.RuleSpec{Code: "ACME-SEC-002"}},
+		{name: "invalid code", spec: &ktn.RuleSpec{Code: "SEC-002", Analyzer: &analysis.Analyzer{Name: "x", Run: run}}},
+		{name: "unknown category", spec: &ktn.RuleSpec{Code: "ACME-NET-001", Analyzer: &analysis.Analyzer{Name: "x", Run: run}}},
+		{name: "duplicate code", spec: &ktn.RuleSpec{Code: "ACME-SEC-001", Analyzer: &analysis.Analyzer{Name: "x", Run: run}}},
+		{name: "ktn code", spec: &ktn.RuleSpec{Code: "KTN-FUNC-001", Analyzer: &analysis.Analyzer{Name: "x", Run: run}}},
+		{name: "duplicate analyzer", spec: &ktn.RuleSpec{Code: "ACME-SEC-002", Analyzer: &analysis.Analyzer{Name: "ktnfunc001", Run: run}}},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify registration is rejected
+			if err := ktn.RegisterRule(tt.spec); err == nil {
+				t.Error("RegisterRule() expected error")
+			}
+		})
+	}
+}
+
+// TestGetCustomRules tests that registered rules are first-class rules.
+func TestGetCustomRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func() bool
+	}{
+		{name: "listed with normalized code and category", check: func() bool {
+			rules := ktn.GetCustomRules()
+			return len(rules) == 1 && rules[0].Code == "ACME-SEC-001" && rules[0].Category == "sec" && rules[0].Description == secAnalyzer.Doc
+		}},
+		{name: "category listed", check: func() bool { return slices.Equal(ktn.GetCustomCategories(), []string{"sec"}) }},
+		{name: "in all rules", check: func() bool { return slices.Contains(ktn.GetAllRules(), secAnalyzer) }},
+		{name: "in its category", check: func() bool {
+			return slices.Equal(ktn.GetRulesByCategory("sec"), []*analysis.Analyzer{secAnalyzer})
+		}},
+		{name: "found by code", check: func() bool { return ktn.GetRuleByCode("ACME-SEC-001") == secAnalyzer }},
+		{name: "threshold exposed", check: func() bool { return ktn.GetRuleThresholds()["ACME-SEC-001"] == secThreshold }},
+		{name: "remediation exposed", check: func() bool { return ktn.GetRuleRemediation()["ACME-SEC-001"] == secEffort }},
+		{name: "severity registered", check: func() bool { return severity.GetSeverity("ACME-SEC-001") == severity.SeverityError }},
+		{name: "prefix recognized", check: func() bool { return rulecode.FromMessage("ACME-SEC-001: leak") == "ACME-SEC-001" }},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify registry state
+			if !tt.check() {
+				t.Error("custom rule not registered as expected")
+			}
+		})
+	}
+}
+
+// TestCustomRuleCode tests the lookup of a custom rule code by analyzer.
+func TestCustomRuleCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		analyzer *analysis.Analyzer
+		want     string
+	}{
+		{name: "registered analyzer", analyzer: secAnalyzer, want: "ACME-SEC-001"},
+		{name: "built-in analyzer", analyzer: ktn.GetRuleByCode("KTN-FUNC-001"), want: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify lookup
+			if got := ktn.CustomRuleCode(tt.analyzer); got != tt.want {
+				t.Errorf("CustomRuleCode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Internal tests for third-party rule registration.
+package ktn
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Test_normalizeSpec tests spec validation and defaults.
+func Test_normalizeSpec(t *testing.T) {
+	a := &analysis.Analyzer{Name: "acmevar001", Doc: "checks vars"}
+	tests := []struct {
+		name         string
+		spec         *RuleSpec
+		wantErr      bool
+		wantCategory string
+	}{
+		{name: "category from code", spec: &RuleSpec{Code: "acme-var-001", Analyzer: a}, wantCategory: "var"},
+		{name: "explicit category", spec: &RuleSpec{Code: "ACME-X-001", Category: "FUNC", Analyzer: a}, wantCategory: "func"},
+		{name: "nil analyzer", spec: &RuleSpec{Code: "ACME-VAR-001"}, wantErr: true},
+		{name: "unknown category", spec: &RuleSpec{Code: "ACME-NOPE-001", Analyzer: a}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeSpec(tt.spec)
+			// Verify error expectation
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizeSpec() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// Verify defaults
+			if !tt.wantErr && (got.Category != tt.wantCategory || got.Description != a.Doc || got == tt.spec) {
+				t.Errorf("normalizeSpec() = %+v", got)
+			}
+		})
+	}
+}
+
+// Test_customAnalyzers tests analyzer selection by category.
+func Test_customAnalyzers(t *testing.T) {
+	saved := customRules
+	t.Cleanup(func() { customRules = saved })
+	a := &analysis.Analyzer{Name: "a"}
+	b := &analysis.Analyzer{Name: "b"}
+	customRules = []*RuleSpec{{Code: "X-FUNC-001", Category: "func", Analyzer: a}, {Code: "X-VAR-001", Category: "var", Analyzer: b}}
+
+	tests := []struct {
+		name     string
+		category string
+		want     int
+	}{
+		{name: "all categories", category: "", want: 2},
+		{name: "one category", category: "var", want: 1},
+		{name: "no rule", category: "const", want: 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify selection
+			if got := customAnalyzers(tt.category); len(got) != tt.want {
+				t.Errorf("customAnalyzers(%q) = %d analyzers, want %d", tt.category, len(got), tt.want)
+			}
+		})
+	}
+}
+
+// Test_customRuleByCode tests rule lookup by code.
+func Test_customRuleByCode(t *testing.T) {
+	saved := customRules
+	t.Cleanup(func() { customRules = saved })
+	rule := &RuleSpec{Code: "X-FUNC-001"}
+	customRules = []*RuleSpec{rule}
+
+	tests := []struct {
+		name string
+		code string
+		want *RuleSpec
+	}{
+		{name: "known code", code: "X-FUNC-001", want: rule},
+		{name: "unknown code", code: "X-FUNC-002", want: nil},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify lookup
+			if got := customRuleByCode(tt.code); got != tt.want {
+				t.Errorf("customRuleByCode(%q) = %v, want %v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_checkDuplicate tests duplicate detection.
+func Test_checkDuplicate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    *RuleSpec
+		wantErr bool
+	}{
+		{name: "new rule", rule: &RuleSpec{Code: "X-FUNC-001", Analyzer: &analysis.Analyzer{Name: "xfunc001"}}, wantErr: false},
+		{name: "ktn code", rule: &RuleSpec{Code: "KTN-FUNC-001", Analyzer: &analysis.Analyzer{Name: "xfunc001"}}, wantErr: true},
+		{name: "ktn analyzer name", rule: &RuleSpec{Code: "X-FUNC-001", Analyzer: &analysis.Analyzer{Name: "ktnfunc001"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify detection
+			if err := checkDuplicate(tt.rule); (err != nil) != tt.wantErr {
+				t.Errorf("checkDuplicate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// Test_noAnalyzers vérifie la liste intégrée vide des catégories tierces.
+func Test_noAnalyzers(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{name: "empty list"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := noAnalyzers()
+			// Vérification de la liste vide non nil
+			if got == nil || len(got) != 0 {
+				t.Errorf("noAnalyzers() = %v, want empty list", got)
+			}
+		})
+	}
+}
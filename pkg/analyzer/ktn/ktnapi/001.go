@@ -89,7 +89,7 @@ type paramInfo struct {
 //   - any: résultat
 //   - error: erreur éventuelle
 func runAPI001(pass *analysis.Pass) (any, error) {
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeAPI001) {
@@ -616,7 +616,7 @@ func reportDiagnostics(pass *analysis.Pass, funcDecl *ast.FuncDecl, params map[*
 			"%s: %s",
 			ruleCodeAPI001,
 			msg.Format(
-				config.Get().Verbose,
+				config.ForPass(pass).Verbose,
 				info.ident.Name,    // param name
 				typeName,           // type name
 				ifaceName,          // suggested interface name
@@ -5,6 +5,12 @@ package ktnapi
 
 import "golang.org/x/tools/go/analysis"
 
+const (
+	// RemediationMinutes est l'effort de correction par défaut d'une violation,
+	// en minutes (extraire une interface côté consumer)
+	RemediationMinutes int = 20
+)
+
 // Analyzers returns all analyzers in the ktnapi package.
 //
 // Returns:
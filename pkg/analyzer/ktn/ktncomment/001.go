@@ -45,7 +45,7 @@ var (
 //   - error: analysis error if any
 func runComment001(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeComment001) {
@@ -110,7 +110,7 @@ func runComment001(pass *analysis.Pass) (any, error) {
 						comment.Pos(),
 						"%s: %s",
 						ruleCodeComment001,
-						msg.Format(config.Get().Verbose, len(text), maxLength),
+						msg.Format(config.ForPass(pass).Verbose, len(text), maxLength),
 					)
 				}
 			}
@@ -157,7 +157,7 @@ func checkMultiLineComment(pass *analysis.Pass, comment *ast.Comment, text strin
 				comment.Pos(),
 				"%s: %s",
 				ruleCodeComment001,
-				msg.Format(config.Get().Verbose, len(trimmed), maxLength),
+				msg.Format(config.ForPass(pass).Verbose, len(trimmed), maxLength),
 			)
 			// Only report once per block comment
 			return
@@ -36,7 +36,7 @@ var Analyzer003 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runComment003(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeComment003) {
@@ -36,7 +36,7 @@ var Analyzer004 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runComment004(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeComment004) {
@@ -134,7 +134,7 @@ func reportMissingVarDoc(pass *analysis.Pass, valueSpec *ast.ValueSpec) {
 			name.Pos(),
 			"%s: %s",
 			ruleCodeComment004,
-			msg.Format(config.Get().Verbose, name.Name),
+			msg.Format(config.ForPass(pass).Verbose, name.Name),
 		)
 	}
 }
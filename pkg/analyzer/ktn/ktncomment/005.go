@@ -38,7 +38,7 @@ var Analyzer005 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runComment005(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeComment005) {
@@ -51,7 +51,7 @@ var (
 //   - error: erreur éventuelle
 func runComment006(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeComment006) {
@@ -99,7 +99,7 @@ func runComment006(pass *analysis.Pass) (any, error) {
 				funcDecl.Name.Pos(),
 				"%s: %s",
 				ruleCodeComment006,
-				msg.Format(config.Get().Verbose, "documentation", funcDecl.Name.Name),
+				msg.Format(config.ForPass(pass).Verbose, "documentation", funcDecl.Name.Name),
 			)
 			// Retour de la fonction
 			return
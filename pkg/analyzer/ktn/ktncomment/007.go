@@ -36,7 +36,7 @@ var Analyzer007 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runComment007(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeComment007) {
@@ -156,7 +156,7 @@ func checkIfStmt(pass *analysis.Pass, stmt *ast.IfStmt) {
 			stmt.Pos(),
 			"%s: %s",
 			ruleCodeComment007,
-			msg.Format(config.Get().Verbose, "if"),
+			msg.Format(config.ForPass(pass).Verbose, "if"),
 		)
 	}
 
@@ -169,7 +169,7 @@ func checkIfStmt(pass *analysis.Pass, stmt *ast.IfStmt) {
 				stmt.Else.Pos(),
 				"%s: %s",
 				ruleCodeComment007,
-				msg.Format(config.Get().Verbose, "else"),
+				msg.Format(config.ForPass(pass).Verbose, "else"),
 			)
 		}
 	}
@@ -186,7 +186,7 @@ func checkSwitchStmt(pass *analysis.Pass, stmt *ast.SwitchStmt) {
 			stmt.Pos(),
 			"%s: %s",
 			ruleCodeComment007,
-			msg.Format(config.Get().Verbose, "switch"),
+			msg.Format(config.ForPass(pass).Verbose, "switch"),
 		)
 	}
 
@@ -203,7 +203,7 @@ func checkSwitchStmt(pass *analysis.Pass, stmt *ast.SwitchStmt) {
 						clause.Pos(),
 						"%s: %s",
 						ruleCodeComment007,
-						msg.Format(config.Get().Verbose, "case"),
+						msg.Format(config.ForPass(pass).Verbose, "case"),
 					)
 				}
 			}
@@ -222,7 +222,7 @@ func checkTypeSwitchStmt(pass *analysis.Pass, stmt *ast.TypeSwitchStmt) {
 			stmt.Pos(),
 			"%s: %s",
 			ruleCodeComment007,
-			msg.Format(config.Get().Verbose, "switch (type)"),
+			msg.Format(config.ForPass(pass).Verbose, "switch (type)"),
 		)
 	}
 
@@ -239,7 +239,7 @@ func checkTypeSwitchStmt(pass *analysis.Pass, stmt *ast.TypeSwitchStmt) {
 						clause.Pos(),
 						"%s: %s",
 						ruleCodeComment007,
-						msg.Format(config.Get().Verbose, "case"),
+						msg.Format(config.ForPass(pass).Verbose, "case"),
 					)
 				}
 			}
@@ -260,7 +260,7 @@ func checkLoopStmt(pass *analysis.Pass, stmt ast.Node) {
 			stmt.Pos(),
 			"%s: %s",
 			ruleCodeComment007,
-			msg.Format(config.Get().Verbose, "for/range"),
+			msg.Format(config.ForPass(pass).Verbose, "for/range"),
 		)
 	}
 }
@@ -278,7 +278,7 @@ func checkReturnStmt(pass *analysis.Pass, stmt *ast.ReturnStmt) {
 			stmt.Pos(),
 			"%s: %s",
 			ruleCodeComment007,
-			msg.Format(config.Get().Verbose, "return"),
+			msg.Format(config.ForPass(pass).Verbose, "return"),
 		)
 	}
 }
@@ -3,6 +3,12 @@ package ktncomment
 
 import "golang.org/x/tools/go/analysis"
 
+const (
+	// RemediationMinutes est l'effort de correction par défaut d'une violation,
+	// en minutes (écrire un commentaire)
+	RemediationMinutes int = 2
+)
+
 // Analyzers returns all comment-related analyzers.
 //
 // Returns:
@@ -19,3 +25,16 @@ func Analyzers() []*analysis.Analyzer {
 		Analyzer007,
 	}
 }
+
+// Thresholds returns the default thresholds of configurable comment rules.
+//
+// Returns:
+//   - map[string]int: default threshold keyed by rule code
+func Thresholds() map[string]int {
+	// Retourne les seuils déclarés par les analyseurs de commentaires
+	return map[string]int{
+		ruleCodeComment001: defaultMaxCommentLength,
+		ruleCodeComment002: defaultMinPackageCommentLength,
+		ruleCodeComment005: defaultMinStructDocLines,
+	}
+}
@@ -35,7 +35,7 @@ var Analyzer001 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runConst001(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeConst001) {
@@ -47,7 +47,7 @@ type fileDeclarations struct {
 //   - error: potential error
 func runConst002(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeConst002) {
@@ -217,7 +217,7 @@ func checkConstOrder(pass *analysis.Pass, decls *fileDeclarations) {
 			pos,
 			"%s: %s",
 			ruleCodeConst002,
-			msg.Format(config.Get().Verbose),
+			msg.Format(config.ForPass(pass).Verbose),
 		)
 	}
 }
@@ -37,7 +37,7 @@ var Analyzer003 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: potential error
 func runConst003(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeConst003) {
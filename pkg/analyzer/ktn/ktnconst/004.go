@@ -37,7 +37,7 @@ var Analyzer004 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: potential error
 func runConst004(pass *analysis.Pass) (any, error) {
 	// Recuperation de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Verifier si la regle est activee
 	if !cfg.IsRuleEnabled(ruleCodeConst004) {
@@ -97,7 +97,7 @@ var Analyzer006 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: potential error
 func runConst006(pass *analysis.Pass) (any, error) {
 	// Recuperation de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Verifier si la regle est activee
 	if !cfg.IsRuleEnabled(ruleCodeConst006) {
@@ -3,6 +3,12 @@ package ktnconst
 
 import "golang.org/x/tools/go/analysis"
 
+const (
+	// RemediationMinutes est l'effort de correction par défaut d'une violation,
+	// en minutes (renommer ou typer une constante)
+	RemediationMinutes int = 2
+)
+
 // GetAnalyzers retourne tous les analyseurs relatifs aux constantes.
 //
 // Returns:
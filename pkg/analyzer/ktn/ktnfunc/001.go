@@ -58,7 +58,7 @@ func validateErrorInReturns(pass *analysis.Pass, funcType *ast.FuncType) {
 			funcType.Results.Pos(),
 			"%s: %s",
 			ruleCodeFunc001,
-			msg.Format(config.Get().Verbose, len(errorPositions)),
+			msg.Format(config.ForPass(pass).Verbose, len(errorPositions)),
 		)
 	}
 
@@ -74,7 +74,7 @@ func validateErrorInReturns(pass *analysis.Pass, funcType *ast.FuncType) {
 					funcType.Results.Pos(),
 					"%s: %s",
 					ruleCodeFunc001,
-					msg.Format(config.Get().Verbose, pos+1),
+					msg.Format(config.ForPass(pass).Verbose, pos+1),
 				)
 				// Retour après premier rapport
 				return
@@ -93,7 +93,7 @@ func validateErrorInReturns(pass *analysis.Pass, funcType *ast.FuncType) {
 //   - error: erreur éventuelle
 func runFunc001(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeFunc001) {
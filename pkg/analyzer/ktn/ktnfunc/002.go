@@ -36,7 +36,7 @@ var Analyzer002 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runFunc002(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeFunc002) {
@@ -132,7 +132,7 @@ func reportMultipleContexts(pass *analysis.Pass, funcDecl *ast.FuncDecl, context
 			funcDecl.Type.Params.Pos(),
 			"%s: %s",
 			ruleCodeFunc002,
-			msg.Format(config.Get().Verbose, contextCount),
+			msg.Format(config.ForPass(pass).Verbose, contextCount),
 		)
 	}
 }
@@ -152,7 +152,7 @@ func reportMisplacedContext(pass *analysis.Pass, funcDecl *ast.FuncDecl, context
 			funcDecl.Type.Params.List[contextParamIndex].Pos(),
 			"%s: %s",
 			ruleCodeFunc002,
-			msg.Format(config.Get().Verbose, contextParamIndex+1),
+			msg.Format(config.ForPass(pass).Verbose, contextParamIndex+1),
 		)
 	}
 }
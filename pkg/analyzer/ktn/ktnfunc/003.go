@@ -34,7 +34,7 @@ var Analyzer003 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runFunc003(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeFunc003) {
@@ -86,7 +86,7 @@ func runFunc003(pass *analysis.Pass) (any, error) {
 				ifStmt.Else.Pos(),
 				"%s: %s",
 				ruleCodeFunc003,
-				msg.Format(config.Get().Verbose, elseType, exitType),
+				msg.Format(config.ForPass(pass).Verbose, elseType, exitType),
 			)
 		}
 	})
@@ -47,7 +47,7 @@ type privateFuncInfo struct {
 //   - error: erreur éventuelle
 func runFunc004(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeFunc004) {
@@ -331,7 +331,7 @@ func reportUnusedFunc(pass *analysis.Pass, info *privateFuncInfo) {
 		pass.Reportf(info.pos,
 			"%s: %s",
 			ruleCodeFunc004,
-			msg.Format(config.Get().Verbose, info.receiverType+"."+info.name))
+			msg.Format(config.ForPass(pass).Verbose, info.receiverType+"."+info.name))
 		// Fin du traitement pour les méthodes
 		return
 	}
@@ -339,7 +339,7 @@ func reportUnusedFunc(pass *analysis.Pass, info *privateFuncInfo) {
 	pass.Reportf(info.pos,
 		"%s: %s",
 		ruleCodeFunc004,
-		msg.Format(config.Get().Verbose, info.name))
+		msg.Format(config.ForPass(pass).Verbose, info.name))
 }
 
 // extractReceiverType extrait le nom du type du receiver.
@@ -37,7 +37,7 @@ var Analyzer005 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runFunc005(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeFunc005) {
@@ -94,7 +94,7 @@ func runFunc005(pass *analysis.Pass) (any, error) {
 				funcDecl.Name.Pos(),
 				"%s: %s",
 				ruleCodeFunc005,
-				msg.Format(config.Get().Verbose, funcName, stmtCount, maxStmts),
+				msg.Format(config.ForPass(pass).Verbose, funcName, stmtCount, maxStmts),
 			)
 		}
 	})
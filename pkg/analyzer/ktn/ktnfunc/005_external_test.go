@@ -39,3 +39,20 @@ func TestFunc005(t *testing.T) {
 		})
 	}
 }
+
+// TestFunc005_threshold teste le seuil KTN-FUNC-005 lu depuis ktn.yaml.
+func TestFunc005_threshold(t *testing.T) {
+	tests := []struct {
+		name           string
+		testdataFolder string
+	}{
+		{name: "threshold from fixture config", testdataFolder: "func005_threshold"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			testhelper.TestWant(t, ktnfunc.Analyzer005, tt.testdataFolder)
+		})
+	}
+}
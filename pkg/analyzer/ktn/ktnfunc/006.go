@@ -37,7 +37,7 @@ var Analyzer006 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runFunc006(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeFunc006) {
@@ -112,7 +112,7 @@ func analyzeFuncParams(pass *analysis.Pass, n ast.Node, maxParams int) {
 			pos.Pos(),
 			"%s: %s",
 			ruleCodeFunc006,
-			msg.Format(config.Get().Verbose, name, paramCount, maxParams),
+			msg.Format(config.ForPass(pass).Verbose, name, paramCount, maxParams),
 		)
 	}
 }
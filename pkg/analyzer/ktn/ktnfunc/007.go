@@ -38,7 +38,7 @@ var Analyzer007 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runFunc007(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeFunc007) {
@@ -131,7 +131,7 @@ func reportAssignSideEffect(pass *analysis.Pass, stmt *ast.AssignStmt, funcName
 				stmt.Pos(),
 				"%s: %s",
 				ruleCodeFunc007,
-				msg.Format(config.Get().Verbose, funcName, "assignation détectée"),
+				msg.Format(config.ForPass(pass).Verbose, funcName, "assignation détectée"),
 			)
 		}
 	}
@@ -151,7 +151,7 @@ func reportIncDecSideEffect(pass *analysis.Pass, stmt *ast.IncDecStmt, funcName
 			stmt.Pos(),
 			"%s: %s",
 			ruleCodeFunc007,
-			msg.Format(config.Get().Verbose, funcName, "incrémentation/décrémentation détectée"),
+			msg.Format(config.ForPass(pass).Verbose, funcName, "incrémentation/décrémentation détectée"),
 		)
 	}
 }
@@ -48,7 +48,7 @@ var Analyzer008 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runFunc008(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeFunc008) {
@@ -163,7 +163,7 @@ func reportUnusedWithBypass(pass *analysis.Pass, pos token.Pos, name string) {
 		pos,
 		"%s: %s",
 		ruleCodeFunc008,
-		msg.Format(config.Get().Verbose, name),
+		msg.Format(config.ForPass(pass).Verbose, name),
 	)
 }
 
@@ -180,7 +180,7 @@ func reportUnusedParam(pass *analysis.Pass, pos token.Pos, name string) {
 		pos,
 		"%s: %s",
 		ruleCodeFunc008,
-		msg.Format(config.Get().Verbose, name),
+		msg.Format(config.ForPass(pass).Verbose, name),
 	)
 }
 
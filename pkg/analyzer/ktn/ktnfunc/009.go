@@ -37,7 +37,7 @@ var Analyzer009 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runFunc009(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeFunc009) {
@@ -158,7 +158,7 @@ func checkMagicNumbers(insp *inspector.Inspector, pass *analysis.Pass, allowedVa
 			lit.Pos(),
 			"%s: %s",
 			ruleCodeFunc009,
-			msg.Format(config.Get().Verbose, lit.Value),
+			msg.Format(config.ForPass(pass).Verbose, lit.Value),
 		)
 	})
 }
@@ -37,7 +37,7 @@ var Analyzer010 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runFunc010(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeFunc010) {
@@ -121,7 +121,7 @@ func analyzeNakedReturns(pass *analysis.Pass, funcDecl *ast.FuncDecl, maxLinesNa
 					ret.Pos(),
 					"%s: %s",
 					ruleCodeFunc010,
-					msg.Format(config.Get().Verbose, funcName, stmtCount, maxLinesNaked-1),
+					msg.Format(config.ForPass(pass).Verbose, funcName, stmtCount, maxLinesNaked-1),
 				)
 			}
 		}
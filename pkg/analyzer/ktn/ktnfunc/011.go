@@ -37,7 +37,7 @@ var Analyzer011 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runFunc011(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeFunc011) {
@@ -89,7 +89,7 @@ func runFunc011(pass *analysis.Pass) (any, error) {
 				funcDecl.Name.Pos(),
 				"%s: %s",
 				ruleCodeFunc011,
-				msg.Format(config.Get().Verbose, funcName, complexity, maxComplexity),
+				msg.Format(config.ForPass(pass).Verbose, funcName, complexity, maxComplexity),
 			)
 		}
 	})
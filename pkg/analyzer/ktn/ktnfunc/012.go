@@ -37,7 +37,7 @@ var Analyzer012 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runFunc012(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeFunc012) {
@@ -105,7 +105,7 @@ func runFunc012(pass *analysis.Pass) (any, error) {
 				funcDecl.Type.Results.Pos(),
 				"%s: %s",
 				ruleCodeFunc012,
-				msg.Format(config.Get().Verbose, funcName, returnCount, maxUnnamed),
+				msg.Format(config.ForPass(pass).Verbose, funcName, returnCount, maxUnnamed),
 			)
 		}
 	})
@@ -34,7 +34,7 @@ var Analyzer013 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: analysis error if any
 func runFunc013(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeFunc013) {
@@ -141,7 +141,7 @@ func checkNilReturnsFunc013(pass *analysis.Pass, funcDecl *ast.FuncDecl) {
 						retStmt.Pos(),
 						"%s: %s",
 						ruleCodeFunc013,
-						msg.Format(config.Get().Verbose, typeInfo, typeInfo),
+						msg.Format(config.ForPass(pass).Verbose, typeInfo, typeInfo),
 					)
 				}
 			}
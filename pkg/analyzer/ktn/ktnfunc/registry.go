@@ -3,6 +3,20 @@ package ktnfunc
 
 import "golang.org/x/tools/go/analysis"
 
+const (
+	// RemediationMinutes est l'effort de correction par défaut d'une violation,
+	// en minutes (retoucher une signature ou un corps de fonction)
+	RemediationMinutes int = 10
+	// remediationTrivial est l'effort pour renommer un paramètre inutilisé
+	remediationTrivial int = 2
+	// remediationSmall est l'effort pour extraire une constante nommée
+	remediationSmall int = 3
+	// remediationDeadCode est l'effort pour supprimer du code mort
+	remediationDeadCode int = 5
+	// remediationRefactor est l'effort pour découper ou simplifier une fonction
+	remediationRefactor int = 30
+)
+
 // GetAnalyzers retourne tous les analyseurs relatifs aux fonctions.
 //
 // Returns:
@@ -25,3 +39,34 @@ func GetAnalyzers() []*analysis.Analyzer {
 		Analyzer013, // Prefer empty slice/map over nil
 	}
 }
+
+// Thresholds retourne les seuils par défaut des règles configurables.
+//
+// Returns:
+//   - map[string]int: seuil par défaut indexé par code de règle
+func Thresholds() map[string]int {
+	// Retourne les seuils déclarés par les analyseurs de fonctions
+	return map[string]int{
+		ruleCodeFunc005: defaultMaxStatements,
+		ruleCodeFunc006: defaultMaxParams,
+		ruleCodeFunc010: defaultMaxLinesForNakedReturn,
+		ruleCodeFunc011: defaultMaxCyclomaticComplexity,
+		ruleCodeFunc012: defaultMaxUnnamedReturns,
+	}
+}
+
+// Remediation retourne l'effort de correction, en minutes par violation, des
+// règles dont la correction s'écarte de RemediationMinutes.
+//
+// Returns:
+//   - map[string]int: effort indexé par code de règle
+func Remediation() map[string]int {
+	// Retourne les efforts déclarés par les analyseurs de fonctions
+	return map[string]int{
+		ruleCodeFunc004: remediationDeadCode,
+		ruleCodeFunc005: remediationRefactor,
+		ruleCodeFunc008: remediationTrivial,
+		ruleCodeFunc009: remediationSmall,
+		ruleCodeFunc011: remediationRefactor,
+	}
+}
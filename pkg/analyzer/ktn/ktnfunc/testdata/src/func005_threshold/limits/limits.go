@@ -0,0 +1,14 @@
+// Package limits est importé par les fixtures func005_threshold.
+package limits
+
+// Max est une constante partagée.
+const Max int = 3
+
+// Clamp dépasse aussi le seuil configuré.
+func Clamp(value int) int { // want `KTN-FUNC-005: .*Clamp`
+	low := 0
+	high := Max
+	result := min(max(value, low), high)
+	// Retour de la valeur bornée
+	return result
+}
@@ -0,0 +1,12 @@
+package func005_threshold
+
+import "func005_threshold/limits"
+
+// Long dépasse le seuil configuré mais pas le seuil par défaut.
+func Long() int { // want `KTN-FUNC-005: .*Long`
+	first := limits.Max
+	second := first + 1
+	third := second + 1
+	// Retour de la somme
+	return first + second + third
+}
@@ -0,0 +1,12 @@
+// Package func005_threshold exerce un seuil KTN-FUNC-005 abaissé à 3.
+package func005_threshold
+
+import "func005_threshold/limits"
+
+// Short reste sous le seuil configuré.
+func Short() int {
+	first := limits.Max
+	second := first + 1
+	// Retour de la somme
+	return first + second
+}
@@ -35,7 +35,7 @@ var Analyzer001 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur eventuelle
 func runGeneric001(pass *analysis.Pass) (any, error) {
 	// Recuperation de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Verifier si la regle est activee
 	if !cfg.IsRuleEnabled(ruleCodeGeneric001) {
@@ -278,7 +278,7 @@ func reportIfUsesAnyTypeParam(
 	reported[funcName] = true
 
 	// Reporter l'erreur
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 	msg, _ := messages.Get(ruleCodeGeneric001)
 	pass.Reportf(
 		funcDecl.Pos(),
@@ -35,7 +35,7 @@ var Analyzer002 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur eventuelle
 func runGeneric002(pass *analysis.Pass) (any, error) {
 	// Recuperation de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Verifier si la regle est activee
 	if !cfg.IsRuleEnabled(ruleCodeGeneric002) {
@@ -350,7 +350,7 @@ func reportUnnecessaryGeneric(
 	// Obtenir le nom de la contrainte
 	constraintName := extractConstraintName(constraintExpr)
 	// Construire le message
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 	msg, _ := messages.Get(ruleCodeGeneric002)
 	// Reporter l'erreur
 	pass.Reportf(
@@ -36,7 +36,7 @@ var Analyzer003 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur eventuelle
 func runGeneric003(pass *analysis.Pass) (any, error) {
 	// Recuperation de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Verifier si la regle est activee
 	if !cfg.IsRuleEnabled(ruleCodeGeneric003) {
@@ -94,7 +94,7 @@ func checkDeprecatedConstraintsImport(pass *analysis.Pass, importSpec *ast.Impor
 	}
 
 	// Reporter l'erreur
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 	msg, _ := messages.Get(ruleCodeGeneric003)
 	pass.Reportf(
 		importSpec.Pos(),
@@ -62,7 +62,7 @@ var Analyzer005 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur eventuelle
 func runGeneric005(pass *analysis.Pass) (any, error) {
 	// Recuperation de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Verifier si la regle est activee
 	if !cfg.IsRuleEnabled(ruleCodeGeneric005) {
@@ -166,7 +166,7 @@ func reportShadowing(pass *analysis.Pass, name *ast.Ident) {
 	}
 
 	// Recuperer le message
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 	msg, _ := messages.Get(ruleCodeGeneric005)
 
 	// Reporter l'erreur
@@ -35,7 +35,7 @@ var Analyzer006 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur eventuelle
 func runGeneric006(pass *analysis.Pass) (any, error) {
 	// Recuperation de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Verifier si la regle est activee
 	if !cfg.IsRuleEnabled(ruleCodeGeneric006) {
@@ -339,7 +339,7 @@ func reportIfUsesAnyTypeParamOrdered(
 	reported[funcName] = true
 
 	// Reporter l'erreur
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 	msg, _ := messages.Get(ruleCodeGeneric006)
 	pass.Reportf(
 		funcDecl.Pos(),
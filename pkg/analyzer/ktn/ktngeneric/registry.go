@@ -3,6 +3,12 @@ package ktngeneric
 
 import "golang.org/x/tools/go/analysis"
 
+const (
+	// RemediationMinutes est l'effort de correction par défaut d'une violation,
+	// en minutes (revoir les contraintes de type)
+	RemediationMinutes int = 10
+)
+
 // Analyzers retourne tous les analyseurs relatifs aux fonctions generiques.
 //
 // Returns:
@@ -49,7 +49,7 @@ var Analyzer001 *analysis.Analyzer = &analysis.Analyzer{
 //   - any: toujours nil
 //   - error: erreur éventuelle
 func runInterface001(pass *analysis.Pass) (any, error) {
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCode) {
@@ -529,7 +529,7 @@ func reportUnused(pass *analysis.Pass, interfaces map[string]*ast.TypeSpec, used
 			typeSpec.Pos(),
 			"%s: %s",
 			ruleCode,
-			msg.Format(config.Get().Verbose, name),
+			msg.Format(config.ForPass(pass).Verbose, name),
 		)
 	}
 }
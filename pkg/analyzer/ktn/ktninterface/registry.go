@@ -3,6 +3,12 @@ package ktninterface
 
 import "golang.org/x/tools/go/analysis"
 
+const (
+	// RemediationMinutes est l'effort de correction par défaut d'une violation,
+	// en minutes (redécouper une interface)
+	RemediationMinutes int = 15
+)
+
 // Analyzers returns all interface-related analyzers.
 //
 // Returns:
@@ -0,0 +1,93 @@
+// Package ktnpattern compile les règles déclaratives en analyseurs.
+package ktnpattern
+
+import (
+	"go/ast"
+	"strings"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	// analyzerPrefix préfixe les noms des analyseurs déclaratifs.
+	analyzerPrefix string = "ktnpattern"
+	// codePartsCount nombre de parties d'un code de règle.
+	codePartsCount int = 3
+)
+
+// newAnalyzer crée l'analyseur d'une règle déclarative.
+//
+// Params:
+//   - code: code de la règle (ex: "ACME-LOG-001")
+//   - doc: description de la règle
+//
+// Returns:
+//   - *analysis.Analyzer: analyseur de la règle
+func newAnalyzer(code, doc string) *analysis.Analyzer {
+	// Retour de l'analyseur
+	return &analysis.Analyzer{
+		Name: analyzerName(code),
+		Doc:  code + ": " + doc,
+		Run: func(pass *analysis.Pass) (any, error) {
+			// Exécution de la règle
+			return nil, runPattern(pass, code)
+		},
+	}
+}
+
+// analyzerName dérive un nom d'analyseur valide d'un code de règle.
+//
+// Params:
+//   - code: code de la règle (ex: "ACME-LOG-001")
+//
+// Returns:
+//   - string: nom de l'analyseur (ex: "ktnpattern_acme_log_001")
+func analyzerName(code string) string {
+	// Retour du nom en minuscules sans tirets
+	return analyzerPrefix + "_" + strings.ToLower(strings.ReplaceAll(code, "-", "_"))
+}
+
+// runPattern rapporte les correspondances d'une règle déclarative.
+// La déclaration est relue dans la configuration de l'exécution.
+//
+// Params:
+//   - pass: contexte d'analyse
+//   - code: code de la règle
+//
+// Returns:
+//   - error: motif invalide
+func runPattern(pass *analysis.Pass, code string) error {
+	cfg := config.ForPass(pass)
+	rule := cfg.CustomRule(code)
+	// Vérification de la déclaration et de l'activation
+	if rule == nil || !cfg.IsRuleEnabled(code) {
+		// Règle absente ou désactivée
+		return nil
+	}
+
+	match, err := compile(&rule.Pattern)
+	// Vérification du motif
+	if err != nil {
+		// Motif invalide
+		return err
+	}
+
+	// Parcours des fichiers dans le périmètre de la règle
+	for _, file := range pass.Files {
+		// Vérification du périmètre
+		if !cfg.CustomRuleApplies(rule, pass.Fset.Position(file.Pos()).Filename) {
+			continue
+		}
+		ast.Inspect(file, func(node ast.Node) bool {
+			// Rapport de la correspondance
+			if text, ok := match(pass, node); ok {
+				pass.Reportf(node.Pos(), "%s", rule.Format(text))
+			}
+			// Poursuite du parcours
+			return true
+		})
+	}
+	// Analyse terminée
+	return nil
+}
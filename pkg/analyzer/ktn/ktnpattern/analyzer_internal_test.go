@@ -0,0 +1,65 @@
+// Internal tests for declarative rule analyzers.
+package ktnpattern
+
+import (
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn/testhelper"
+	"github.com/kodflow/ktn-linter/pkg/config"
+)
+
+// Test_analyzerName tests analyzer name derivation.
+func Test_analyzerName(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want string
+	}{
+		{name: "simple code", code: "ACME-LOG-001", want: "ktnpattern_acme_log_001"},
+		{name: "underscore code", code: "MY_CO-NO_SLEEP-002", want: "ktnpattern_my_co_no_sleep_002"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify name
+			if got := analyzerName(tt.code); got != tt.want {
+				t.Errorf("analyzerName(%q) = %q, want %q", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_runPattern tests messages and undeclared rules.
+func Test_runPattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		want    int
+		message string
+	}{
+		{name: "declared rule", code: "ACME-RUN-001", want: 2, message: "ACME-RUN-001: fmt.Println is forbidden"},
+		{name: "undeclared rule", code: "ACME-RUN-002", want: 0},
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.CustomRules = []config.CustomRuleConfig{
+		{Code: "ACME-RUN-001", Message: "{match} is forbidden", Pattern: config.CustomPattern{Call: "fmt.Println"}},
+	}
+	config.Set(cfg)
+	t.Cleanup(config.Reset)
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			diags := testhelper.RunAnalyzer(t, newAnalyzer(tt.code, "doc"), "testdata/src/call/bad.go")
+			// Verify count
+			if len(diags) != tt.want {
+				t.Fatalf("got %d diagnostics, want %d", len(diags), tt.want)
+			}
+			// Verify message
+			if tt.want > 0 && diags[0].Message != tt.message {
+				t.Errorf("message = %q, want %q", diags[0].Message, tt.message)
+			}
+		})
+	}
+}
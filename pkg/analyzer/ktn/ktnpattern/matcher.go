@@ -0,0 +1,232 @@
+// Package ktnpattern compile les règles déclaratives en analyseurs.
+package ktnpattern
+
+import (
+	"errors"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// subPackagesSuffix étend un motif d'import aux sous-packages.
+const subPackagesSuffix string = "/..."
+
+// matcher teste un noeud et retourne le texte correspondant.
+type matcher func(pass *analysis.Pass, node ast.Node) (string, bool)
+
+// compile construit le matcher d'un motif.
+//
+// Params:
+//   - pattern: motif déclaré
+//
+// Returns:
+//   - matcher: fonction de correspondance
+//   - error: motif vide ou expression invalide
+func compile(pattern *config.CustomPattern) (matcher, error) {
+	// Sélection du type de motif
+	switch {
+	// Appel de fonction ou de méthode
+	case pattern.Call != "":
+		// Retour du matcher d'appel
+		return callMatcher(qualifiedName(pattern.Call)), nil
+	// Sélection sur un type
+	case pattern.Selector != "":
+		// Retour du matcher de sélection
+		return selectorMatcher(qualifiedName(pattern.Selector)), nil
+	// Chemin d'import
+	case pattern.Import != "":
+		// Retour du matcher d'import
+		return importMatcher(pattern.Import), nil
+	// Expression sur les identifiants déclarés
+	case pattern.Ident != "":
+		re, err := regexp.Compile(pattern.Ident)
+		// Vérification de l'expression
+		if err != nil {
+			// Expression invalide
+			return nil, err
+		}
+		// Retour du matcher d'identifiant
+		return identMatcher(re), nil
+	// Comparaison sur un type
+	case pattern.Compare != "":
+		// Retour du matcher de comparaison
+		return compareMatcher(pattern.Compare), nil
+	// Aucun motif
+	default:
+		// Retour d'erreur
+		return nil, errors.New("empty pattern")
+	}
+}
+
+// qualifiedName normalise un nom qualifié de fonction, méthode ou champ.
+//
+// Params:
+//   - name: nom qualifié (ex: "(*database/sql.DB).Query")
+//
+// Returns:
+//   - string: nom sans parenthèses ni pointeur (ex: "database/sql.DB.Query")
+func qualifiedName(name string) string {
+	// Retour du nom normalisé
+	return strings.NewReplacer("(", "", ")", "", "*", "").Replace(name)
+}
+
+// callMatcher crée le matcher des appels à une fonction qualifiée.
+//
+// Params:
+//   - want: nom qualifié normalisé
+//
+// Returns:
+//   - matcher: fonction de correspondance
+func callMatcher(want string) matcher {
+	// Retour du matcher
+	return func(pass *analysis.Pass, node ast.Node) (string, bool) {
+		call, ok := node.(*ast.CallExpr)
+		// Vérification du type de noeud
+		if !ok {
+			// Pas un appel
+			return "", false
+		}
+		fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+		// Vérification de la fonction appelée
+		if !ok || qualifiedName(fn.FullName()) != want {
+			// Autre fonction
+			return "", false
+		}
+		// Retour du nom de la fonction
+		return fn.FullName(), true
+	}
+}
+
+// selectorMatcher crée le matcher des sélections sur un type nommé.
+//
+// Params:
+//   - want: nom qualifié normalisé du membre (ex: "net/http.Request.Body")
+//
+// Returns:
+//   - matcher: fonction de correspondance
+func selectorMatcher(want string) matcher {
+	// Retour du matcher
+	return func(pass *analysis.Pass, node ast.Node) (string, bool) {
+		sel, ok := node.(*ast.SelectorExpr)
+		// Vérification du type de noeud
+		if !ok {
+			// Pas une sélection
+			return "", false
+		}
+		selection := pass.TypesInfo.Selections[sel]
+		// Vérification de la sélection sur une valeur
+		if selection == nil {
+			// Identifiant qualifié par un package
+			return "", false
+		}
+		recv := types.TypeString(selection.Recv(), nil)
+		// Vérification du membre sélectionné
+		if qualifiedName(recv)+"."+sel.Sel.Name != want {
+			// Autre membre
+			return "", false
+		}
+		// Retour du membre sélectionné
+		return recv + "." + sel.Sel.Name, true
+	}
+}
+
+// importMatcher crée le matcher des imports d'un chemin.
+//
+// Params:
+//   - want: chemin importé, suivi de "/..." pour inclure les sous-packages
+//
+// Returns:
+//   - matcher: fonction de correspondance
+func importMatcher(want string) matcher {
+	// Retour du matcher
+	return func(_ *analysis.Pass, node ast.Node) (string, bool) {
+		spec, ok := node.(*ast.ImportSpec)
+		// Vérification du type de noeud
+		if !ok {
+			// Pas un import
+			return "", false
+		}
+		path, err := strconv.Unquote(spec.Path.Value)
+		// Vérification du chemin
+		if err != nil || !matchesImport(path, want) {
+			// Autre chemin
+			return "", false
+		}
+		// Retour du chemin importé
+		return path, true
+	}
+}
+
+// matchesImport teste un chemin d'import contre un motif.
+//
+// Params:
+//   - path: chemin importé
+//   - want: chemin attendu, suivi de "/..." pour inclure les sous-packages
+//
+// Returns:
+//   - bool: true si le chemin correspond
+func matchesImport(path, want string) bool {
+	root, recursive := strings.CutSuffix(want, subPackagesSuffix)
+	// Retour de la correspondance exacte ou sur un sous-package
+	return path == root || (recursive && strings.HasPrefix(path, root+"/"))
+}
+
+// identMatcher crée le matcher des identifiants déclarés.
+//
+// Params:
+//   - re: expression des noms interdits
+//
+// Returns:
+//   - matcher: fonction de correspondance
+func identMatcher(re *regexp.Regexp) matcher {
+	// Retour du matcher
+	return func(pass *analysis.Pass, node ast.Node) (string, bool) {
+		ident, ok := node.(*ast.Ident)
+		// Vérification d'une déclaration correspondante
+		if !ok || pass.TypesInfo.Defs[ident] == nil || !re.MatchString(ident.Name) {
+			// Pas un identifiant déclaré correspondant
+			return "", false
+		}
+		// Retour du nom déclaré
+		return ident.Name, true
+	}
+}
+
+// compareMatcher crée le matcher des comparaisons == et != sur un type.
+//
+// Params:
+//   - want: type comparé (ex: "error", "net/http.Header")
+//
+// Returns:
+//   - matcher: fonction de correspondance
+func compareMatcher(want string) matcher {
+	// Retour du matcher
+	return func(pass *analysis.Pass, node ast.Node) (string, bool) {
+		bin, ok := node.(*ast.BinaryExpr)
+		// Vérification de l'opérateur
+		if !ok || (bin.Op != token.EQL && bin.Op != token.NEQ) {
+			// Pas une comparaison d'égalité
+			return "", false
+		}
+		left, right := pass.TypesInfo.Types[bin.X], pass.TypesInfo.Types[bin.Y]
+		// Les comparaisons à nil restent autorisées
+		if left.IsNil() || right.IsNil() || left.Type == nil || right.Type == nil {
+			// Comparaison à nil ou non typée
+			return "", false
+		}
+		// Vérification du type des opérandes
+		if types.TypeString(left.Type, nil) != want && types.TypeString(right.Type, nil) != want {
+			// Autre type
+			return "", false
+		}
+		// Retour de l'expression comparée
+		return types.ExprString(bin), true
+	}
+}
@@ -0,0 +1,90 @@
+// Internal tests for pattern matchers.
+package ktnpattern
+
+import (
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+)
+
+// Test_compile tests matcher compilation errors.
+func Test_compile(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern config.CustomPattern
+		wantErr bool
+	}{
+		{name: "call", pattern: config.CustomPattern{Call: "time.Sleep"}, wantErr: false},
+		{name: "selector", pattern: config.CustomPattern{Selector: "net/http.Request.Body"}, wantErr: false},
+		{name: "import", pattern: config.CustomPattern{Import: "io/ioutil"}, wantErr: false},
+		{name: "ident", pattern: config.CustomPattern{Ident: "^tmp"}, wantErr: false},
+		{name: "compare", pattern: config.CustomPattern{Compare: "error"}, wantErr: false},
+		{name: "invalid ident", pattern: config.CustomPattern{Ident: "("}, wantErr: true},
+		{name: "empty pattern", pattern: config.CustomPattern{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			match, err := compile(&tt.pattern)
+			// Verify error
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("compile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// Verify matcher presence
+			if !tt.wantErr && match == nil {
+				t.Error("compile() returned nil matcher")
+			}
+		})
+	}
+}
+
+// Test_qualifiedName tests qualified name normalization.
+func Test_qualifiedName(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "function", input: "time.Sleep", want: "time.Sleep"},
+		{name: "pointer method", input: "(*database/sql.DB).Query", want: "database/sql.DB.Query"},
+		{name: "value method", input: "(time.Time).Unix", want: "time.Time.Unix"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify normalization
+			if got := qualifiedName(tt.input); got != tt.want {
+				t.Errorf("qualifiedName(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_matchesImport tests import path matching.
+func Test_matchesImport(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+		ok   bool
+	}{
+		{name: "exact path", path: "io/ioutil", want: "io/ioutil", ok: true},
+		{name: "other path", path: "io", want: "io/ioutil", ok: false},
+		{name: "sub-package", path: "github.com/pkg/errors/sub", want: "github.com/pkg/errors/...", ok: true},
+		{name: "root of recursive pattern", path: "github.com/pkg/errors", want: "github.com/pkg/errors/...", ok: true},
+		{name: "sibling prefix", path: "github.com/pkg/errorsx", want: "github.com/pkg/errors/...", ok: false},
+		{name: "sub-package without pattern", path: "io/ioutil/sub", want: "io/ioutil", ok: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify matching
+			if got := matchesImport(tt.path, tt.want); got != tt.ok {
+				t.Errorf("matchesImport(%q, %q) = %v, want %v", tt.path, tt.want, got, tt.ok)
+			}
+		})
+	}
+}
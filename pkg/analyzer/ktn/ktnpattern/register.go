@@ -0,0 +1,97 @@
+// Package ktnpattern compile les règles déclaratives de la section
+// custom_rules de la configuration en analyseurs.
+//
+// Chaque règle devient un analyseur enregistré dans le registre KTN comme une
+// règle tierce : elle est sélectionnable, configurable et classée par
+// sévérité comme une règle intégrée. L'analyseur relit sa déclaration dans la
+// configuration de chaque exécution, si bien qu'un rechargement de la
+// configuration modifie le motif, le message et les chemins sans
+// réenregistrement.
+package ktnpattern
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn"
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"github.com/kodflow/ktn-linter/pkg/severity"
+)
+
+// registered liste les codes des règles déjà compilées et enregistrées.
+var registered map[string]bool = map[string]bool{}
+
+// Register compile et enregistre les règles déclaratives.
+// Les règles déjà enregistrées ne mettent à jour que leur sévérité ; leur
+// catégorie est créée si elle n'existe pas encore.
+// Non sûr en concurrence : à appeler au démarrage, avant l'analyse.
+//
+// Params:
+//   - customRules: règles déclarées dans la configuration
+//
+// Returns:
+//   - error: règle invalide ou en conflit avec une règle existante
+func Register(customRules []config.CustomRuleConfig) error {
+	// Enregistrement de chaque règle
+	for i := range customRules {
+		rule := &customRules[i]
+		// Règle déjà compilée : seule la sévérité peut changer
+		if registered[rule.Code] {
+			severity.Register(rule.Code, rule.Level())
+			continue
+		}
+		// Enregistrement de la nouvelle règle
+		if err := registerRule(rule); err != nil {
+			// Retour de l'erreur contextualisée
+			return fmt.Errorf("custom rule %s: %w", rule.Code, err)
+		}
+		registered[rule.Code] = true
+	}
+	// Règles enregistrées
+	return nil
+}
+
+// registerRule enregistre une règle déclarative et sa catégorie.
+//
+// Params:
+//   - rule: règle déclarée
+//
+// Returns:
+//   - error: erreur d'enregistrement éventuelle
+func registerRule(rule *config.CustomRuleConfig) error {
+	parts := strings.Split(rule.Code, "-")
+	// Vérification de la forme du code
+	if len(parts) != codePartsCount {
+		// Code invalide
+		return fmt.Errorf("invalid code %q", rule.Code)
+	}
+	category := strings.ToLower(parts[1])
+	// Création de la catégorie si nécessaire
+	if !ktn.HasCategory(category) {
+		// Vérification de l'enregistrement de la catégorie
+		if err := ktn.RegisterCategory(category); err != nil {
+			// Retour de l'erreur de catégorie
+			return err
+		}
+	}
+	// Retour du résultat de l'enregistrement
+	return ktn.RegisterRule(&ktn.RuleSpec{
+		Code:        rule.Code,
+		Category:    category,
+		Analyzer:    newAnalyzer(rule.Code, description(rule)),
+		Severity:    rule.Level(),
+		Description: description(rule),
+	})
+}
+
+// description retourne la description d'une règle déclarative.
+//
+// Params:
+//   - rule: règle déclarée
+//
+// Returns:
+//   - string: message dont le motif remplace "{match}"
+func description(rule *config.CustomRuleConfig) string {
+	// Retour du message générique
+	return strings.TrimPrefix(rule.Format(rule.Pattern.Value()), rule.Code+": ")
+}
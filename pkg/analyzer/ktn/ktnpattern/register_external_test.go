@@ -0,0 +1,145 @@
+// External tests for declarative rules.
+package ktnpattern_test
+
+import (
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn"
+	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn/ktnpattern"
+	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn/testhelper"
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"github.com/kodflow/ktn-linter/pkg/severity"
+)
+
+// customRules declares one rule per pattern kind.
+var customRules []config.CustomRuleConfig = []config.CustomRuleConfig{
+	{Code: "ACME-CALL-001", Message: "{match} is forbidden", Pattern: config.CustomPattern{Call: "fmt.Println"}},
+	{Code: "ACME-CALL-002", Message: "use WriteByte", Pattern: config.CustomPattern{Call: "(*strings.Builder).WriteString"}},
+	{Code: "ACME-SELECTOR-001", Message: "stream {match}", Severity: "error", Pattern: config.CustomPattern{Selector: "net/http.Request.Body"}},
+	{Code: "ACME-IMPORT-001", Message: "{match} is deprecated", Pattern: config.CustomPattern{Import: "io/ioutil"}},
+	{Code: "ACME-IDENT-001", Message: "rename {match}", Severity: "info", Pattern: config.CustomPattern{Ident: "^tmp"}},
+	{Code: "ACME-COMPARE-001", Message: "use errors.Is", Pattern: config.CustomPattern{Compare: "error"}},
+	{Code: "ACME-CALL-003", Message: "scoped", Pattern: config.CustomPattern{Call: "fmt.Println"}, Paths: []string{"**/good.go"}},
+	{Code: "ACME-CALL-004", Message: "excluded", Pattern: config.CustomPattern{Call: "fmt.Println"}, Exclude: []string{"bad.go"}},
+}
+
+// setup registers the declarative rules and makes them the active config.
+//
+// Params:
+//   - t: testing context
+func setup(t *testing.T) {
+	t.Helper()
+	// Registration is idempotent across test runs
+	if err := ktnpattern.Register(customRules); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	cfg := config.DefaultConfig()
+	cfg.CustomRules = customRules
+	config.Set(cfg)
+	t.Cleanup(config.Reset)
+}
+
+// TestRegister tests pattern matching through the registered analyzers.
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		dir      string
+		expected int
+	}{
+		{name: "function call", code: "ACME-CALL-001", dir: "call", expected: 2},
+		{name: "method call", code: "ACME-CALL-002", dir: "method", expected: 1},
+		{name: "field selector", code: "ACME-SELECTOR-001", dir: "selector", expected: 1},
+		{name: "import path", code: "ACME-IMPORT-001", dir: "import", expected: 1},
+		{name: "identifier regexp", code: "ACME-IDENT-001", dir: "ident", expected: 2},
+		{name: "error comparison", code: "ACME-COMPARE-001", dir: "compare", expected: 2},
+		{name: "paths scope", code: "ACME-CALL-003", dir: "call", expected: 0},
+		{name: "exclude scope", code: "ACME-CALL-004", dir: "call", expected: 0},
+	}
+
+	setup(t)
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			analyzer := ktn.GetRuleByCode(tt.code)
+			// Verify the rule is registered
+			if analyzer == nil {
+				t.Fatalf("GetRuleByCode(%q) = nil", tt.code)
+			}
+			testhelper.TestGoodBad(t, analyzer, tt.dir, tt.expected)
+		})
+	}
+}
+
+// TestRegister_firstClass tests that registered rules behave like built-in ones.
+func TestRegister_firstClass(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		category string
+		level    severity.Level
+	}{
+		{name: "default severity", code: "ACME-CALL-001", category: "call", level: severity.SeverityWarning},
+		{name: "error severity", code: "ACME-SELECTOR-001", category: "selector", level: severity.SeverityError},
+		{name: "info severity", code: "ACME-IDENT-001", category: "ident", level: severity.SeverityInfo},
+	}
+
+	setup(t)
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify severity
+			if got := severity.GetSeverity(tt.code); got != tt.level {
+				t.Errorf("GetSeverity(%q) = %v, want %v", tt.code, got, tt.level)
+			}
+			// Verify category selection
+			if len(ktn.GetRulesByCategory(tt.category)) == 0 {
+				t.Errorf("GetRulesByCategory(%q) is empty", tt.category)
+			}
+		})
+	}
+}
+
+// TestRegister_disabled tests that disabled rules report nothing.
+func TestRegister_disabled(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		dir  string
+	}{
+		{name: "disabled call rule", code: "ACME-CALL-001", dir: "call"},
+	}
+
+	setup(t)
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			cfg.CustomRules = customRules
+			cfg.Rules[tt.code] = &config.RuleConfig{Enabled: config.Bool(false)}
+			config.Set(cfg)
+			testhelper.TestGoodBad(t, ktn.GetRuleByCode(tt.code), tt.dir, 0)
+		})
+	}
+}
+
+// TestRegister_conflict tests that codes of other rules are rejected.
+func TestRegister_conflict(t *testing.T) {
+	tests := []struct {
+		name string
+		rule config.CustomRuleConfig
+	}{
+		{name: "built-in code", rule: config.CustomRuleConfig{Code: "KTN-FUNC-001", Message: "m", Pattern: config.CustomPattern{Call: "fmt.Println"}}},
+		{name: "malformed code", rule: config.CustomRuleConfig{Code: "ACME", Message: "m", Pattern: config.CustomPattern{Call: "fmt.Println"}}},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify registration is rejected
+			if err := ktnpattern.Register([]config.CustomRuleConfig{tt.rule}); err == nil {
+				t.Errorf("Register(%s) expected error", tt.rule.Code)
+			}
+		})
+	}
+}
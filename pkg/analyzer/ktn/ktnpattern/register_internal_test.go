@@ -0,0 +1,60 @@
+// Internal tests for declarative rule registration.
+package ktnpattern
+
+import (
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+)
+
+// Test_description tests rule descriptions.
+func Test_description(t *testing.T) {
+	tests := []struct {
+		name string
+		rule config.CustomRuleConfig
+		want string
+	}{
+		{
+			name: "placeholder",
+			rule: config.CustomRuleConfig{Code: "ACME-LOG-001", Message: "{match} is forbidden", Pattern: config.CustomPattern{Call: "fmt.Println"}},
+			want: "fmt.Println is forbidden",
+		},
+		{
+			name: "plain message",
+			rule: config.CustomRuleConfig{Code: "ACME-LOG-002", Message: "use errors.Is", Pattern: config.CustomPattern{Compare: "error"}},
+			want: "use errors.Is",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify description
+			if got := description(&tt.rule); got != tt.want {
+				t.Errorf("description() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_registerRule tests registration of malformed codes.
+func Test_registerRule(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+	}{
+		{name: "two parts", code: "ACME-LOG"},
+		{name: "four parts", code: "ACME-LOG-001-X"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			rule := config.CustomRuleConfig{Code: tt.code, Message: "m", Pattern: config.CustomPattern{Call: "fmt.Println"}}
+			// Verify registration is rejected
+			if err := registerRule(&rule); err == nil {
+				t.Errorf("registerRule(%q) expected error", tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+// Package call provides bad test cases.
+package call
+
+import "fmt"
+
+// Debug prints with the forbidden function.
+func Debug() {
+	fmt.Println("debug")
+	fmt.Println("again")
+}
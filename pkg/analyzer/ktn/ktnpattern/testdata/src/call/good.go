@@ -0,0 +1,15 @@
+// Package call provides good test cases.
+package call
+
+import "fmt"
+
+// Println shadows the forbidden function locally.
+func Println(value string) string {
+	return value
+}
+
+// Report prints without fmt.Println.
+func Report() {
+	fmt.Print("report\n")
+	_ = Println("local")
+}
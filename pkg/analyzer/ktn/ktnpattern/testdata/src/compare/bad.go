@@ -0,0 +1,15 @@
+// Package compare provides bad test cases.
+package compare
+
+import (
+	"errors"
+	"io"
+)
+
+// errDone is a sentinel error.
+var errDone error = errors.New("done")
+
+// IsEnd compares errors with == and !=.
+func IsEnd(err error) bool {
+	return err == io.EOF || err != errDone
+}
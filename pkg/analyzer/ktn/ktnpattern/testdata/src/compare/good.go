@@ -0,0 +1,12 @@
+// Package compare provides good test cases.
+package compare
+
+import (
+	"errors"
+	"io"
+)
+
+// IsEOF compares errors with errors.Is.
+func IsEOF(err error) bool {
+	return err != nil && errors.Is(err, io.EOF)
+}
@@ -0,0 +1,10 @@
+// Package ident provides bad test cases.
+package ident
+
+// tmpValue uses a forbidden prefix.
+var tmpValue int = 1
+
+// tmpCompute uses a forbidden prefix.
+func tmpCompute() int {
+	return tmpValue
+}
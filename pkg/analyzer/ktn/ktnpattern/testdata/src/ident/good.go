@@ -0,0 +1,11 @@
+// Package ident provides good test cases.
+package ident
+
+// value is a regular name.
+var value int = 1
+
+// compute uses regular names.
+func compute() int {
+	temporary := value
+	return temporary
+}
@@ -0,0 +1,12 @@
+// Package imports provides bad test cases.
+package imports
+
+import (
+	"io"
+	"io/ioutil"
+)
+
+// Drain reads everything.
+func Drain(r io.Reader) ([]byte, error) {
+	return ioutil.ReadAll(r)
+}
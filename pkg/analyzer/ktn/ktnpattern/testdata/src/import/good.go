@@ -0,0 +1,9 @@
+// Package imports provides good test cases.
+package imports
+
+import "io"
+
+// Drain reads everything.
+func Drain(r io.Reader) ([]byte, error) {
+	return io.ReadAll(r)
+}
@@ -0,0 +1,11 @@
+// Package method provides bad test cases.
+package method
+
+import "strings"
+
+// Build writes strings.
+func Build() string {
+	var b strings.Builder
+	b.WriteString("x")
+	return b.String()
+}
@@ -0,0 +1,11 @@
+// Package method provides good test cases.
+package method
+
+import "strings"
+
+// Build writes single bytes.
+func Build() string {
+	var b strings.Builder
+	b.WriteByte('x')
+	return b.String()
+}
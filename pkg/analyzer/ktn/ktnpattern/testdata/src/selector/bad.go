@@ -0,0 +1,9 @@
+// Package selector provides bad test cases.
+package selector
+
+import "net/http"
+
+// Handle reads the raw body.
+func Handle(r *http.Request) bool {
+	return r.Body != nil
+}
@@ -0,0 +1,9 @@
+// Package selector provides good test cases.
+package selector
+
+import "net/http"
+
+// Handle reads headers only.
+func Handle(r *http.Request) string {
+	return r.Header.Get("X-Id")
+}
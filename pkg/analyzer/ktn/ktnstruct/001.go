@@ -47,7 +47,7 @@ type methodInfo struct {
 //   - error: erreur éventuelle
 func runStruct001(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeStruct001) {
@@ -336,7 +336,7 @@ func checkGetterFieldMismatch(pass *analysis.Pass, method methodInfo, structInfo
 			method.funcDecl.Pos(),
 			"%s: %s",
 			ruleCodeStruct001,
-			msg.Format(config.Get().Verbose, method.name, expectedGetter, fieldName),
+			msg.Format(config.ForPass(pass).Verbose, method.name, expectedGetter, fieldName),
 		)
 	}
 }
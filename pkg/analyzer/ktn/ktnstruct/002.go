@@ -36,7 +36,7 @@ var Analyzer002 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runStruct002(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeStruct002) {
@@ -90,7 +90,7 @@ func runStruct002(pass *analysis.Pass) (any, error) {
 					s.node.Pos(),
 					"%s: %s",
 					ruleCodeStruct002,
-					msg.Format(config.Get().Verbose, s.name, expectedName),
+					msg.Format(config.ForPass(pass).Verbose, s.name, expectedName),
 				)
 			}
 		}
@@ -41,7 +41,7 @@ var Analyzer003 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runStruct003(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeStruct003) {
@@ -80,7 +80,7 @@ func runStruct003(pass *analysis.Pass) (any, error) {
 			funcDecl.Name.Pos(),
 			"%s: %s",
 			ruleCodeStruct003,
-			msg.Format(config.Get().Verbose, methodName, suggestedName, suggestedName),
+			msg.Format(config.ForPass(pass).Verbose, methodName, suggestedName, suggestedName),
 		)
 	})
 
@@ -39,7 +39,7 @@ type structInfo struct {
 //   - error: erreur éventuelle
 func runStruct004(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeStruct004) {
@@ -81,7 +81,7 @@ func runStruct004(pass *analysis.Pass) (any, error) {
 					s.node.Pos(),
 					"%s: %s",
 					ruleCodeStruct004,
-					msg.Format(config.Get().Verbose, len(structs), len(structs)),
+					msg.Format(config.ForPass(pass).Verbose, len(structs), len(structs)),
 				)
 			}
 		}
@@ -36,7 +36,7 @@ var Analyzer005 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runStruct005(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeStruct005) {
@@ -122,7 +122,7 @@ func checkFieldOrder(pass *analysis.Pass, structType *ast.StructType) {
 				f.pos,
 				"%s: %s",
 				ruleCodeStruct005,
-				msg.Format(config.Get().Verbose),
+				msg.Format(config.ForPass(pass).Verbose),
 			)
 		}
 
@@ -36,7 +36,7 @@ var Analyzer006 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runStruct006(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeStruct006) {
@@ -111,7 +111,7 @@ func checkPrivateFieldsWithTags(pass *analysis.Pass, structType *ast.StructType)
 					field.Pos(),
 					"%s: %s",
 					ruleCodeStruct006,
-					msg.Format(config.Get().Verbose, name.Name),
+					msg.Format(config.ForPass(pass).Verbose, name.Name),
 				)
 			}
 		}
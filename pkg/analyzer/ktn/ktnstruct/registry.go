@@ -3,6 +3,14 @@ package ktnstruct
 
 import "golang.org/x/tools/go/analysis"
 
+const (
+	// RemediationMinutes est l'effort de correction par défaut d'une violation,
+	// en minutes (déplacer ou réorganiser une struct)
+	RemediationMinutes int = 10
+	// remediationSplitFile est l'effort pour scinder un fichier multi-structs
+	remediationSplitFile int = 15
+)
+
 // GetAnalyzers retourne tous les analyseurs relatifs aux structures.
 //
 // Returns:
@@ -18,3 +26,15 @@ func GetAnalyzers() []*analysis.Analyzer {
 		Analyzer006,
 	}
 }
+
+// Remediation retourne l'effort de correction, en minutes par violation, des
+// règles dont la correction s'écarte de RemediationMinutes.
+//
+// Returns:
+//   - map[string]int: effort indexé par code de règle
+func Remediation() map[string]int {
+	// Retourne les efforts déclarés par les analyseurs de structures
+	return map[string]int{
+		ruleCodeStruct004: remediationSplitFile,
+	}
+}
@@ -32,7 +32,7 @@ var Analyzer001 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: analysis error if any
 func runTest001(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeTest001) {
@@ -38,7 +38,7 @@ var Analyzer002 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runTest002(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeTest002) {
@@ -49,7 +49,7 @@ var Analyzer003 *analysis.Analyzer = &analysis.Analyzer{
 //   - testedFuncs: map des fonctions testées
 func collectFunctions(pass *analysis.Pass, funcs *[]funcInfo, testedFuncs map[string]bool) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Parcourir tous les fichiers du pass
 	for _, file := range pass.Files {
@@ -107,7 +107,7 @@ func collectFunctions(pass *analysis.Pass, funcs *[]funcInfo, testedFuncs map[st
 //   - error: erreur éventuelle
 func runTest003(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeTest003) {
@@ -334,7 +334,7 @@ func reportMissingTest(pass *analysis.Pass, fn funcInfo) {
 		fn.pos,
 		"%s: %s",
 		ruleCodeTest003,
-		msg.Format(config.Get().Verbose, fn.name),
+		msg.Format(config.ForPass(pass).Verbose, fn.name),
 	)
 }
 
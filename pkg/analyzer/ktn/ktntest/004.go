@@ -36,7 +36,7 @@ var Analyzer004 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runTest004(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeTest004) {
@@ -80,7 +80,7 @@ func runTest004(pass *analysis.Pass) (any, error) {
 				funcDecl.Pos(),
 				"%s: %s",
 				ruleCodeTest004,
-				msg.Format(config.Get().Verbose, funcDecl.Name.Name),
+				msg.Format(config.ForPass(pass).Verbose, funcDecl.Name.Name),
 			)
 		}
 	})
@@ -36,7 +36,7 @@ var Analyzer005 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runTest005(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeTest005) {
@@ -97,7 +97,7 @@ func runTest005(pass *analysis.Pass) (any, error) {
 			callExpr.Pos(),
 			"%s: %s",
 			ruleCodeTest005,
-			msg.Format(config.Get().Verbose, ident.Name+"."+methodName+"()"),
+			msg.Format(config.ForPass(pass).Verbose, ident.Name+"."+methodName+"()"),
 		)
 	})
 
@@ -48,7 +48,7 @@ type fileAnalysisResult struct {
 //   - error: erreur éventuelle
 func runTest006(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeTest006) {
@@ -438,7 +438,7 @@ func reportMixedFunctionsIssues(pass *analysis.Pass, file *ast.File, _result *fi
 		pass.Reportf(file.Name.Pos(),
 			"%s: %s",
 			ruleCodeTest006,
-			msg.Format(config.Get().Verbose, status.baseName, bothFiles))
+			msg.Format(config.ForPass(pass).Verbose, status.baseName, bothFiles))
 		// Retour après signalement
 		return
 	}
@@ -449,7 +449,7 @@ func reportMixedFunctionsIssues(pass *analysis.Pass, file *ast.File, _result *fi
 		pass.Reportf(file.Name.Pos(),
 			"%s: %s",
 			ruleCodeTest006,
-			msg.Format(config.Get().Verbose, status.baseName, status.fileBase+"_internal_test.go"))
+			msg.Format(config.ForPass(pass).Verbose, status.baseName, status.fileBase+"_internal_test.go"))
 	}
 	// Vérification fichier external manquant
 	if !status.hasExternal {
@@ -457,7 +457,7 @@ func reportMixedFunctionsIssues(pass *analysis.Pass, file *ast.File, _result *fi
 		pass.Reportf(file.Name.Pos(),
 			"%s: %s",
 			ruleCodeTest006,
-			msg.Format(config.Get().Verbose, status.baseName, status.fileBase+"_external_test.go"))
+			msg.Format(config.ForPass(pass).Verbose, status.baseName, status.fileBase+"_external_test.go"))
 	}
 }
 
@@ -476,7 +476,7 @@ func reportPublicOnlyIssues(pass *analysis.Pass, file *ast.File, _result *fileAn
 		pass.Reportf(file.Name.Pos(),
 			"%s: %s",
 			ruleCodeTest006,
-			msg.Format(config.Get().Verbose, status.baseName, status.fileBase+"_external_test.go"))
+			msg.Format(config.ForPass(pass).Verbose, status.baseName, status.fileBase+"_external_test.go"))
 		// Retour après signalement
 		return
 	}
@@ -488,7 +488,7 @@ func reportPublicOnlyIssues(pass *analysis.Pass, file *ast.File, _result *fileAn
 		pass.Reportf(file.Name.Pos(),
 			"%s: %s",
 			ruleCodeTest006,
-			msg.Format(config.Get().Verbose, status.baseName, status.fileBase+"_internal_test.go"))
+			msg.Format(config.ForPass(pass).Verbose, status.baseName, status.fileBase+"_internal_test.go"))
 	}
 }
 
@@ -507,7 +507,7 @@ func reportPrivateOnlyIssues(pass *analysis.Pass, file *ast.File, _result *fileA
 		pass.Reportf(file.Name.Pos(),
 			"%s: %s",
 			ruleCodeTest006,
-			msg.Format(config.Get().Verbose, status.baseName, status.fileBase+"_internal_test.go"))
+			msg.Format(config.ForPass(pass).Verbose, status.baseName, status.fileBase+"_internal_test.go"))
 		// Retour après signalement
 		return
 	}
@@ -519,7 +519,7 @@ func reportPrivateOnlyIssues(pass *analysis.Pass, file *ast.File, _result *fileA
 		pass.Reportf(file.Name.Pos(),
 			"%s: %s",
 			ruleCodeTest006,
-			msg.Format(config.Get().Verbose, status.baseName, status.fileBase+"_external_test.go"))
+			msg.Format(config.ForPass(pass).Verbose, status.baseName, status.fileBase+"_external_test.go"))
 	}
 }
 
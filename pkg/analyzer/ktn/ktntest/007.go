@@ -38,7 +38,7 @@ var Analyzer007 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runTest007(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeTest007) {
@@ -145,7 +145,7 @@ func addPublicFunction(funcDecl *ast.FuncDecl, publicFunctions map[string]bool)
 //   - publicFunctions: map des fonctions publiques
 func checkInternalTestsForPublicFunctions(pass *analysis.Pass, insp *inspector.Inspector, publicFunctions map[string]bool) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	nodeFilter := []ast.Node{(*ast.FuncDecl)(nil)}
 
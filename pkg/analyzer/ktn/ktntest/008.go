@@ -38,7 +38,7 @@ var Analyzer008 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runTest008(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeTest008) {
@@ -145,7 +145,7 @@ func addPrivateFunction(funcDecl *ast.FuncDecl, privateFunctions map[string]bool
 //   - privateFunctions: map des fonctions privées
 func checkExternalTestsForPrivateFunctions(pass *analysis.Pass, insp *inspector.Inspector, privateFunctions map[string]bool) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	nodeFilter := []ast.Node{(*ast.FuncDecl)(nil)}
 
@@ -36,7 +36,7 @@ var Analyzer009 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runTest009(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeTest009) {
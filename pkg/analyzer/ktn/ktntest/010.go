@@ -53,7 +53,7 @@ var (
 //   - error: erreur éventuelle
 func runTest010(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeTest010) {
@@ -117,7 +117,7 @@ func runTest010(pass *analysis.Pass) (any, error) {
 				funcDecl.Pos(),
 				"%s: %s",
 				ruleCodeTest010,
-				msg.Format(config.Get().Verbose, funcDecl.Name.Name),
+				msg.Format(config.ForPass(pass).Verbose, funcDecl.Name.Name),
 			)
 		}
 	})
@@ -50,7 +50,7 @@ var Analyzer011 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runTest011(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeTest011) {
@@ -406,7 +406,7 @@ func analyzeTestFunction(
 			testFunc.Pos(),
 			"%s: %s",
 			ruleCodeTest011,
-			msg.Format(config.Get().Verbose, testFunc.Name.Name),
+			msg.Format(config.ForPass(pass).Verbose, testFunc.Name.Name),
 		)
 	}
 }
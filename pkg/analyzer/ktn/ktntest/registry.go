@@ -3,6 +3,17 @@ package ktntest
 
 import "golang.org/x/tools/go/analysis"
 
+const (
+	// RemediationMinutes est l'effort de correction par défaut d'une violation,
+	// en minutes (écrire ou restructurer un test)
+	RemediationMinutes int = 10
+	// remediationNewTest est l'effort pour écrire ou convertir en table-driven
+	// le test d'une fonction
+	remediationNewTest int = 15
+	// remediationNewFile est l'effort pour créer un fichier de test
+	remediationNewFile int = 20
+)
+
 // Analyzers retourne tous les analyseurs de la catégorie TEST.
 //
 // Returns:
@@ -23,3 +34,17 @@ func Analyzers() []*analysis.Analyzer {
 		Analyzer011, // Mock function detection
 	}
 }
+
+// Remediation retourne l'effort de correction, en minutes par violation, des
+// règles dont la correction s'écarte de RemediationMinutes.
+//
+// Returns:
+//   - map[string]int: effort indexé par code de règle
+func Remediation() map[string]int {
+	// Retourne les efforts déclarés par les analyseurs de tests
+	return map[string]int{
+		ruleCodeTest003: remediationNewTest,
+		ruleCodeTest004: remediationNewTest,
+		ruleCodeTest006: remediationNewFile,
+	}
+}
@@ -35,7 +35,7 @@ var Analyzer001 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar001(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar001) {
@@ -113,7 +113,7 @@ func checkVarSpec(pass *analysis.Pass, valueSpec *ast.ValueSpec) {
 				name.Pos(),
 				"%s: %s",
 				ruleCodeVar001,
-				msg.Format(config.Get().Verbose, name.Name),
+				msg.Format(config.ForPass(pass).Verbose, name.Name),
 			)
 		}
 	}
@@ -35,7 +35,7 @@ var Analyzer002 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar002(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar002) {
@@ -82,7 +82,7 @@ func runVar002(pass *analysis.Pass) (any, error) {
 					genDecl.Pos(),
 					"%s: %s",
 					ruleCodeVar002,
-					msg.Format(config.Get().Verbose),
+					msg.Format(config.ForPass(pass).Verbose),
 				)
 			}
 		}
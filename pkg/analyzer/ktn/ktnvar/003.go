@@ -37,7 +37,7 @@ var Analyzer003 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar003(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar003) {
@@ -102,7 +102,7 @@ func checkVar003Names(pass *analysis.Pass, valueSpec *ast.ValueSpec) {
 				name.Pos(),
 				"%s: %s",
 				ruleCodeVar003,
-				msg.Format(config.Get().Verbose, varName),
+				msg.Format(config.ForPass(pass).Verbose, varName),
 			)
 		}
 	}
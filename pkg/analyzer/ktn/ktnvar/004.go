@@ -55,7 +55,7 @@ var (
 //   - error: erreur éventuelle
 func runVar004(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar004) {
@@ -283,7 +283,7 @@ func checkVar004Name(pass *analysis.Pass, ident *ast.Ident, isPackageLevel bool)
 			ident.Pos(),
 			"%s: %s",
 			ruleCodeVar004,
-			msg.Format(config.Get().Verbose, varName),
+			msg.Format(config.ForPass(pass).Verbose, varName),
 		)
 		return
 	}
@@ -306,6 +306,6 @@ func checkVar004Name(pass *analysis.Pass, ident *ast.Ident, isPackageLevel bool)
 		ident.Pos(),
 		"%s: %s",
 		ruleCodeVar004,
-		msg.Format(config.Get().Verbose, varName),
+		msg.Format(config.ForPass(pass).Verbose, varName),
 	)
 }
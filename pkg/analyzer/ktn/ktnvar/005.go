@@ -37,7 +37,7 @@ var Analyzer005 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar005(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar005) {
@@ -281,6 +281,6 @@ func checkVar005Name(pass *analysis.Pass, ident *ast.Ident) {
 		ident.Pos(),
 		"%s: %s",
 		ruleCodeVar005,
-		msg.Format(config.Get().Verbose, varName),
+		msg.Format(config.ForPass(pass).Verbose, varName),
 	)
 }
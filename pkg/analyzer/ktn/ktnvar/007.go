@@ -35,7 +35,7 @@ var Analyzer007 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar007(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar007) {
@@ -293,7 +293,7 @@ func reportVarErrors(pass *analysis.Pass, spec *ast.ValueSpec) {
 			name.Pos(),
 			"%s: %s",
 			ruleCodeVar007,
-			msg.Format(config.Get().Verbose),
+			msg.Format(config.ForPass(pass).Verbose),
 		)
 	}
 }
@@ -39,7 +39,7 @@ var Analyzer008 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar008(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar008) {
@@ -135,7 +135,7 @@ func isAppendCall(expr ast.Expr) bool {
 //   - insp: inspecteur AST
 func checkMakeCalls(pass *analysis.Pass, insp *inspector.Inspector) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	nodeFilter := []ast.Node{
 		(*ast.CallExpr)(nil),
@@ -192,7 +192,7 @@ func checkMakeCall(pass *analysis.Pass, call *ast.CallExpr) {
 		call.Pos(),
 		"%s: %s",
 		ruleCodeVar008,
-		msg.Format(config.Get().Verbose),
+		msg.Format(config.ForPass(pass).Verbose),
 	)
 }
 
@@ -214,7 +214,7 @@ func checkEmptySliceLiterals(
 	appendVars map[string]bool,
 ) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	nodeFilter := []ast.Node{
 		(*ast.AssignStmt)(nil),
@@ -352,7 +352,7 @@ func reportVar008Error(pass *analysis.Pass, lit *ast.CompositeLit) {
 		lit.Pos(),
 		"%s: %s",
 		ruleCodeVar008,
-		msg.Format(config.Get().Verbose),
+		msg.Format(config.ForPass(pass).Verbose),
 	)
 }
 
@@ -69,7 +69,7 @@ func checkMakeCallVar008(pass *analysis.Pass, call *ast.CallExpr) {
 		call.Pos(),
 		"%s: %s",
 		ruleCodeVar009,
-		msg.Format(config.Get().Verbose),
+		msg.Format(config.ForPass(pass).Verbose),
 	)
 }
 
@@ -83,7 +83,7 @@ func checkMakeCallVar008(pass *analysis.Pass, call *ast.CallExpr) {
 //   - error: erreur éventuelle
 func runVar009(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar009) {
@@ -34,7 +34,7 @@ var Analyzer010 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar010(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar010) {
@@ -197,7 +197,7 @@ func reportMissingGrow(pass *analysis.Pass, node ast.Node) {
 			node.Pos(),
 			"%s: %s",
 			ruleCodeVar010,
-			msg.Format(config.Get().Verbose),
+			msg.Format(config.ForPass(pass).Verbose),
 		)
 	}
 }
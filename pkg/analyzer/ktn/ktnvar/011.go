@@ -36,7 +36,7 @@ var Analyzer011 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar011(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar011) {
@@ -105,7 +105,7 @@ func checkStringConcatInLoop(pass *analysis.Pass, n ast.Node) {
 						assign.Pos(),
 						"%s: %s",
 						ruleCodeVar011,
-						msg.Format(config.Get().Verbose),
+						msg.Format(config.ForPass(pass).Verbose),
 					)
 				}
 			}
@@ -35,7 +35,7 @@ var Analyzer012 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar012(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar012) {
@@ -134,7 +134,7 @@ func checkAssignForAlloc(pass *analysis.Pass, assign *ast.AssignStmt) {
 				rhs.Pos(),
 				"%s: %s",
 				ruleCodeVar012,
-				msg.Format(config.Get().Verbose),
+				msg.Format(config.ForPass(pass).Verbose),
 			)
 		}
 	}
@@ -173,7 +173,7 @@ func checkDeclForAlloc(pass *analysis.Pass, decl *ast.DeclStmt) {
 					value.Pos(),
 					"%s: %s",
 					ruleCodeVar012,
-					msg.Format(config.Get().Verbose),
+					msg.Format(config.ForPass(pass).Verbose),
 				)
 			}
 		}
@@ -39,7 +39,7 @@ var Analyzer013 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar013(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar013) {
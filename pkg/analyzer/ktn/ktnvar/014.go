@@ -35,7 +35,7 @@ var Analyzer014 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar014(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar014) {
@@ -135,6 +135,6 @@ func checkMakeCallForByteSlice(pass *analysis.Pass, call *ast.CallExpr) {
 		call.Pos(),
 		"%s: %s",
 		ruleCodeVar014,
-		msg.Format(config.Get().Verbose),
+		msg.Format(config.ForPass(pass).Verbose),
 	)
 }
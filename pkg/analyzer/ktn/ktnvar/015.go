@@ -42,7 +42,7 @@ var Analyzer015 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar015(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar015) {
@@ -124,7 +124,7 @@ func checkLoopsForStringConversion(pass *analysis.Pass, body *ast.BlockStmt) {
 				loop.Pos(),
 				"%s: %s",
 				ruleCodeVar015,
-				msg.Format(config.Get().Verbose),
+				msg.Format(config.ForPass(pass).Verbose),
 			)
 		}
 
@@ -271,7 +271,7 @@ func checkMultipleConversions(pass *analysis.Pass, body *ast.BlockStmt, maxConve
 				pos.Pos(),
 				"%s: %s",
 				ruleCodeVar015,
-				msg.Format(config.Get().Verbose, count),
+				msg.Format(config.ForPass(pass).Verbose, count),
 			)
 		}
 	}
@@ -33,7 +33,7 @@ var Analyzer016 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar016(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar016) {
@@ -112,7 +112,7 @@ func checkVarGrouping(pass *analysis.Pass, varGroups []shared.DeclGroup) {
 			varGroups[i].Pos,
 			"%s: %s",
 			ruleCodeVar016,
-			msg.Format(config.Get().Verbose),
+			msg.Format(config.ForPass(pass).Verbose),
 		)
 	}
 }
@@ -35,7 +35,7 @@ var Analyzer017 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar017(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar017) {
@@ -84,7 +84,7 @@ func runVar017(pass *analysis.Pass) (any, error) {
 			callExpr.Pos(),
 			"%s: %s",
 			ruleCodeVar017,
-			msg.Format(config.Get().Verbose),
+			msg.Format(config.ForPass(pass).Verbose),
 		)
 	})
 
@@ -43,7 +43,7 @@ var Analyzer018 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar018(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar018) {
@@ -231,6 +231,6 @@ func reportArraySuggestion(pass *analysis.Pass, call *ast.CallExpr) {
 		call.Pos(),
 		"%s: %s",
 		ruleCodeVar018,
-		msg.Format(config.Get().Verbose),
+		msg.Format(config.ForPass(pass).Verbose),
 	)
 }
@@ -40,7 +40,7 @@ var Analyzer019 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar019(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar019) {
@@ -133,7 +133,7 @@ func collectTypesWithValueReceivers(_pass *analysis.Pass, insp *inspector.Inspec
 //   - typesWithValueRecv: types ayant des receivers par valeur
 func checkStructsWithMutex(pass *analysis.Pass, insp *inspector.Inspector, typesWithValueRecv map[string]bool) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Types de nœuds à analyser
 	nodeFilter := []ast.Node{
@@ -175,7 +175,7 @@ func checkStructsWithMutex(pass *analysis.Pass, insp *inspector.Inspector, types
 					field.Pos(),
 					"%s: %s",
 					ruleCodeVar019,
-					msg.Format(config.Get().Verbose, mutexType),
+					msg.Format(config.ForPass(pass).Verbose, mutexType),
 				)
 			}
 		}
@@ -189,7 +189,7 @@ func checkStructsWithMutex(pass *analysis.Pass, insp *inspector.Inspector, types
 //   - inspect: inspecteur AST
 func checkValueReceivers(pass *analysis.Pass, insp *inspector.Inspector) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Types de nœuds à analyser
 	nodeFilter := []ast.Node{
@@ -231,7 +231,7 @@ func checkValueReceivers(pass *analysis.Pass, insp *inspector.Inspector) {
 					recv.Pos(),
 					"%s: %s",
 					ruleCodeVar019,
-					msg.Format(config.Get().Verbose, mutexType),
+					msg.Format(config.ForPass(pass).Verbose, mutexType),
 				)
 			}
 		}
@@ -245,7 +245,7 @@ func checkValueReceivers(pass *analysis.Pass, insp *inspector.Inspector) {
 //   - inspect: inspecteur AST
 func checkValueParams(pass *analysis.Pass, insp *inspector.Inspector) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Types de nœuds à analyser
 	nodeFilter := []ast.Node{
@@ -279,7 +279,7 @@ func checkValueParams(pass *analysis.Pass, insp *inspector.Inspector) {
 						param.Pos(),
 						"%s: %s",
 						ruleCodeVar019,
-						msg.Format(config.Get().Verbose, mutexType),
+						msg.Format(config.ForPass(pass).Verbose, mutexType),
 					)
 				}
 			}
@@ -294,7 +294,7 @@ func checkValueParams(pass *analysis.Pass, insp *inspector.Inspector) {
 //   - inspect: inspecteur AST
 func checkAssignments(pass *analysis.Pass, insp *inspector.Inspector) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Types de nœuds à analyser
 	nodeFilter := []ast.Node{
@@ -323,7 +323,7 @@ func checkAssignments(pass *analysis.Pass, insp *inspector.Inspector) {
 						assign.Pos(),
 						"%s: %s",
 						ruleCodeVar019,
-						msg.Format(config.Get().Verbose, mutexType),
+						msg.Format(config.ForPass(pass).Verbose, mutexType),
 					)
 				}
 			}
@@ -37,7 +37,7 @@ var Analyzer020 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: any error encountered
 func runVar020(pass *analysis.Pass) (any, error) {
 	// Recuperation de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Verifier si la regle est activee
 	if !cfg.IsRuleEnabled(ruleCodeVar020) {
@@ -116,7 +116,7 @@ func checkEmptySliceLiteral(pass *analysis.Pass, lit *ast.CompositeLit) {
 		lit.Pos(),
 		"%s: %s",
 		ruleCodeVar020,
-		msg.Format(config.Get().Verbose, typeStr),
+		msg.Format(config.ForPass(pass).Verbose, typeStr),
 	)
 }
 
@@ -170,7 +170,7 @@ func checkMakeSliceZero(pass *analysis.Pass, call *ast.CallExpr) {
 		call.Pos(),
 		"%s: %s",
 		ruleCodeVar020,
-		msg.Format(config.Get().Verbose, typeStr),
+		msg.Format(config.ForPass(pass).Verbose, typeStr),
 	)
 }
 
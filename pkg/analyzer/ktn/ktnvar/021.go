@@ -44,7 +44,7 @@ var Analyzer021 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar021(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar021) {
@@ -216,6 +216,6 @@ func reportInconsistency(pass *analysis.Pass, pos ast.Node, typeName string, dom
 		pos.Pos(),
 		"%s: %s",
 		ruleCodeVar021,
-		msg.Format(config.Get().Verbose, typeName, expected),
+		msg.Format(config.ForPass(pass).Verbose, typeName, expected),
 	)
 }
@@ -38,7 +38,7 @@ var Analyzer022 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar022(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar022) {
@@ -243,7 +243,7 @@ func checkPointerToInterface(pass *analysis.Pass, expr ast.Expr) {
 			expr.Pos(),
 			"%s: %s",
 			ruleCodeVar022,
-			msg.Format(config.Get().Verbose, underlyingType.String()),
+			msg.Format(config.ForPass(pass).Verbose, underlyingType.String()),
 		)
 	}
 }
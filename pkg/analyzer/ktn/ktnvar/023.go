@@ -49,7 +49,7 @@ var (
 //   - error: potential error
 func runVar023(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar023) {
@@ -459,6 +459,6 @@ func reportMathRandInSecurity(pass *analysis.Pass, pos token.Pos) {
 		pos,
 		"%s: %s",
 		ruleCodeVar023,
-		msg.Format(config.Get().Verbose),
+		msg.Format(config.ForPass(pass).Verbose),
 	)
 }
@@ -37,7 +37,7 @@ var Analyzer024 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: any error encountered
 func runVar024(pass *analysis.Pass) (any, error) {
 	// Recuperation de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Verifier si la regle est activee
 	if !cfg.IsRuleEnabled(ruleCodeVar024) {
@@ -91,7 +91,7 @@ func checkEmptyInterface(pass *analysis.Pass, interfaceType *ast.InterfaceType)
 		interfaceType.Pos(),
 		"%s: %s",
 		ruleCodeVar024,
-		msg.Format(config.Get().Verbose),
+		msg.Format(config.ForPass(pass).Verbose),
 	)
 }
 
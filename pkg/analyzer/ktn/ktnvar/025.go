@@ -35,7 +35,7 @@ var Analyzer025 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur éventuelle
 func runVar025(pass *analysis.Pass) (any, error) {
 	// Récupération de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Vérifier si la règle est activée
 	if !cfg.IsRuleEnabled(ruleCodeVar025) {
@@ -477,6 +477,6 @@ func reportClearPattern(pass *analysis.Pass, node ast.Node, collectionType strin
 		node.Pos(),
 		"%s: %s",
 		ruleCodeVar025,
-		msg.Format(config.Get().Verbose, collectionType),
+		msg.Format(config.ForPass(pass).Verbose, collectionType),
 	)
 }
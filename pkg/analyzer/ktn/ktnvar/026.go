@@ -40,7 +40,7 @@ var Analyzer026 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: any error encountered
 func runVar026(pass *analysis.Pass) (any, error) {
 	// Recuperation de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Verifier si la regle est activee
 	if !cfg.IsRuleEnabled(ruleCodeVar026) {
@@ -126,7 +126,7 @@ func checkMathMinMax(pass *analysis.Pass, call *ast.CallExpr) {
 		call.Pos(),
 		"%s: %s",
 		ruleCodeVar026,
-		msg.Format(config.Get().Verbose, builtinName, sel.Sel.Name),
+		msg.Format(config.ForPass(pass).Verbose, builtinName, sel.Sel.Name),
 	)
 }
 
@@ -177,7 +177,7 @@ func checkIfMinMaxPattern(pass *analysis.Pass, ifStmt *ast.IfStmt) {
 		ifStmt.Pos(),
 		"%s: %s",
 		ruleCodeVar026,
-		msg.Format(config.Get().Verbose, "pattern"),
+		msg.Format(config.ForPass(pass).Verbose, "pattern"),
 	)
 }
 
@@ -35,7 +35,7 @@ var Analyzer027 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: potential error
 func runVar027(pass *analysis.Pass) (any, error) {
 	// Get configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Check if rule is enabled
 	if !cfg.IsRuleEnabled(ruleCodeVar027) {
@@ -68,7 +68,7 @@ func runVar027(pass *analysis.Pass) (any, error) {
 				forStmt.Pos(),
 				"%s: %s",
 				ruleCodeVar027,
-				msg.Format(config.Get().Verbose),
+				msg.Format(config.ForPass(pass).Verbose),
 			)
 		}
 	})
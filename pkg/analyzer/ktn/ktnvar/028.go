@@ -35,7 +35,7 @@ var Analyzer028 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur eventuelle
 func runVar028(pass *analysis.Pass) (any, error) {
 	// Recuperation de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Verifier si la regle est activee
 	if !cfg.IsRuleEnabled(ruleCodeVar028) {
@@ -216,6 +216,6 @@ func reportLoopVarCopy(pass *analysis.Pass, node ast.Node, varName string) {
 		node.Pos(),
 		"%s: %s",
 		ruleCodeVar028,
-		msg.Format(config.Get().Verbose, varName),
+		msg.Format(config.ForPass(pass).Verbose, varName),
 	)
 }
@@ -52,7 +52,7 @@ type growPatternInfo struct {
 //   - error: any error that occurred
 func runVar029(pass *analysis.Pass) (any, error) {
 	// Get configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Check if rule is enabled
 	if !cfg.IsRuleEnabled(ruleCodeVar029) {
@@ -49,7 +49,7 @@ type makeCloneInfo struct {
 //   - error: any error that occurred
 func runVar030(pass *analysis.Pass) (any, error) {
 	// Get configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Check if rule is enabled
 	if !cfg.IsRuleEnabled(ruleCodeVar030) {
@@ -35,7 +35,7 @@ var Analyzer031 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: possible error
 func runVar031(pass *analysis.Pass) (any, error) {
 	// Get configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Check if rule is enabled
 	if !cfg.IsRuleEnabled(ruleCodeVar031) {
@@ -121,7 +121,7 @@ func analyzeBlockForMapClone(pass *analysis.Pass, block *ast.BlockStmt) {
 				rangeStmt.Pos(),
 				"%s: %s",
 				ruleCodeVar031,
-				msg.Format(config.Get().Verbose),
+				msg.Format(config.ForPass(pass).Verbose),
 			)
 		}
 	}
@@ -40,7 +40,7 @@ var Analyzer033 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: any error that occurred
 func runVar033(pass *analysis.Pass) (any, error) {
 	// Get configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Check if rule is enabled
 	if !cfg.IsRuleEnabled(ruleCodeVar033) {
@@ -40,7 +40,7 @@ var Analyzer034 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: erreur eventuelle
 func runVar034(pass *analysis.Pass) (any, error) {
 	// Recuperation de la configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Verifier si la regle est activee
 	if !cfg.IsRuleEnabled(ruleCodeVar034) {
@@ -404,6 +404,6 @@ func reportVar034(pass *analysis.Pass, stmt ast.Stmt) {
 		stmt.Pos(),
 		"%s: %s",
 		ruleCodeVar034,
-		msg.Format(config.Get().Verbose),
+		msg.Format(config.ForPass(pass).Verbose),
 	)
 }
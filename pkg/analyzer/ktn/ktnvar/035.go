@@ -38,7 +38,7 @@ var Analyzer035 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: any error that occurred
 func runVar035(pass *analysis.Pass) (any, error) {
 	// Get configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Check if rule is enabled
 	if !cfg.IsRuleEnabled(ruleCodeVar035) {
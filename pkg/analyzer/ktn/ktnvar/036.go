@@ -39,7 +39,7 @@ var Analyzer036 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: any error that occurred
 func runVar036(pass *analysis.Pass) (any, error) {
 	// Get configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Check if rule is enabled
 	if !cfg.IsRuleEnabled(ruleCodeVar036) {
@@ -43,7 +43,7 @@ var Analyzer037 *analysis.Analyzer = &analysis.Analyzer{
 //   - error: any error that occurred
 func runVar037(pass *analysis.Pass) (any, error) {
 	// Get configuration
-	cfg := config.Get()
+	cfg := config.ForPass(pass)
 
 	// Check if rule is enabled
 	if !cfg.IsRuleEnabled(ruleCodeVar037) {
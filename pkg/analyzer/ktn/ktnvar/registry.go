@@ -3,6 +3,16 @@ package ktnvar
 
 import "golang.org/x/tools/go/analysis"
 
+const (
+	// RemediationMinutes est l'effort de correction par défaut d'une violation,
+	// en minutes (renommer, typer ou préallouer une variable)
+	RemediationMinutes int = 3
+	// remediationLocal est l'effort pour revoir une déclaration ou un appel
+	remediationLocal int = 10
+	// remediationPool est l'effort pour introduire un sync.Pool
+	remediationPool int = 15
+)
+
 // Analyzers retourne tous les analyseurs de la catégorie VAR.
 //
 // Returns:
@@ -48,3 +58,29 @@ func Analyzers() []*analysis.Analyzer {
 		Analyzer037, // maps.Keys/Values (Go 1.23+)
 	}
 }
+
+// Thresholds retourne les seuils par défaut des règles VAR configurables.
+//
+// Returns:
+//   - map[string]int: seuil par défaut indexé par code de règle
+func Thresholds() map[string]int {
+	// Retourne les seuils déclarés par les analyseurs VAR
+	return map[string]int{
+		ruleCodeVar015: defaultMaxAllowedConversions,
+	}
+}
+
+// Remediation retourne l'effort de correction, en minutes par violation, des
+// règles dont la correction s'écarte de RemediationMinutes.
+//
+// Returns:
+//   - map[string]int: effort indexé par code de règle
+func Remediation() map[string]int {
+	// Retourne les efforts déclarés par les analyseurs de variables
+	return map[string]int{
+		ruleCodeVar013: remediationLocal,
+		ruleCodeVar014: remediationPool,
+		ruleCodeVar019: remediationLocal,
+		ruleCodeVar023: remediationLocal,
+	}
+}
@@ -2,6 +2,7 @@
 package ktn
 
 import (
+	"maps"
 	"strings"
 
 	"golang.org/x/tools/go/analysis"
@@ -23,12 +24,18 @@ const (
 	ktnPrefixLen int = 4
 	// codePartsCount is the expected number of parts in rule code (CATEGORY-NNN).
 	codePartsCount int = 2
+	// defaultThresholdsCapacity is the initial capacity of the thresholds map.
+	defaultThresholdsCapacity int = 16
+	// defaultRemediationCapacity is the initial capacity of the remediation map.
+	defaultRemediationCapacity int = 16
+	// defaultRemediationMinutes is the effort of rules in categories without one.
+	defaultRemediationMinutes int = 5
 )
 
-// GetAllRules retourne toutes les règles KTN disponibles.
+// GetAllRules retourne toutes les règles KTN disponibles, suivies des règles tierces.
 //
 // Returns:
-//   - []*analysis.Analyzer: liste de tous les analyseurs (api + const + func + struct + var + test + package + modernize)
+//   - []*analysis.Analyzer: liste de tous les analyseurs (api + const + func + struct + var + test + package + modernize + tierces)
 func GetAllRules() []*analysis.Analyzer {
 	var all []*analysis.Analyzer
 	// Ajoute les analyseurs d'API/dépendances
@@ -51,17 +58,19 @@ func GetAllRules() []*analysis.Analyzer {
 	all = append(all, ktncomment.Analyzers()...)
 	// Ajoute les analyseurs modernize (golang.org/x/tools)
 	all = append(all, modernize.Analyzers()...)
+	// Ajoute les analyseurs des règles tierces
+	all = append(all, customAnalyzers("")...)
 	// Retourne la liste complète
 	return all
 }
 
-// categoryAnalyzers retourne la map des catégories vers leurs analyseurs.
+// categoryAnalyzers retourne la map des catégories vers leurs analyseurs KTN.
+// Les catégories tierces n'ont pas d'analyseur KTN.
 //
 // Returns:
 //   - map[string]func() []*analysis.Analyzer: map des fonctions d'analyseurs par catégorie
 func categoryAnalyzers() map[string]func() []*analysis.Analyzer {
-	// Retour de la map des catégories
-	return map[string]func() []*analysis.Analyzer{
+	categories := map[string]func() []*analysis.Analyzer{
 		"api":       ktnapi.Analyzers,
 		"const":     ktnconst.GetAnalyzers,
 		"func":      ktnfunc.GetAnalyzers,
@@ -73,12 +82,18 @@ func categoryAnalyzers() map[string]func() []*analysis.Analyzer {
 		"comment":   ktncomment.Analyzers,
 		"modernize": modernize.Analyzers,
 	}
+	// Ajout des catégories tierces
+	for _, name := range customCategories {
+		categories[name] = noAnalyzers
+	}
+	// Retour de la map des catégories
+	return categories
 }
 
 // GetRulesByCategory retourne les règles d'une catégorie spécifique.
 //
 // Params:
-//   - category: nom de la catégorie ("api", "const", "func", "generic", "struct", "var", "test", "interface", "comment" ou "modernize")
+//   - category: nom de la catégorie ("api", "const", "func", "generic", "struct", "var", "test", "interface", "comment", "modernize" ou tierce)
 //
 // Returns:
 //   - []*analysis.Analyzer: liste des analyseurs de la catégorie demandée
@@ -94,8 +109,8 @@ func GetRulesByCategory(category string) []*analysis.Analyzer {
 		return []*analysis.Analyzer{}
 	}
 
-	// Retour des analyseurs de la catégorie
-	return analyzerFunc()
+	// Retour des analyseurs de la catégorie, règles tierces comprises
+	return append(analyzerFunc(), customAnalyzers(category)...)
 }
 
 // GetRuleByCode retourne un analyseur par son code (ex: KTN-FUNC-001).
@@ -106,6 +121,12 @@ func GetRulesByCategory(category string) []*analysis.Analyzer {
 // Returns:
 //   - *analysis.Analyzer: l'analyseur correspondant ou nil si non trouvé
 func GetRuleByCode(code string) *analysis.Analyzer {
+	// Recherche parmi les règles tierces
+	if rule := customRuleByCode(code); rule != nil {
+		// Retourne l'analyseur de la règle tierce
+		return rule.Analyzer
+	}
+
 	// Convertir le code en nom d'analyseur
 	// KTN-FUNC-001 -> ktnfunc001
 	// KTN-VAR-002 -> ktnvar002
@@ -161,3 +182,88 @@ func codeToAnalyzerName(code string) string {
 	// Construire le nom de l'analyseur: ktn<category><number>
 	return "ktn" + category + number
 }
+
+// GetRuleThresholds retourne les seuils par défaut de toutes les règles configurables.
+//
+// Returns:
+//   - map[string]int: seuil par défaut indexé par code de règle
+func GetRuleThresholds() map[string]int {
+	thresholds := make(map[string]int, defaultThresholdsCapacity)
+	// Fusion des seuils déclarés par chaque catégorie
+	for _, source := range []func() map[string]int{
+		ktncomment.Thresholds,
+		ktnfunc.Thresholds,
+		ktnvar.Thresholds,
+	} {
+		// Copie des seuils de la catégorie
+		maps.Copy(thresholds, source())
+	}
+	// Ajout des seuils des règles tierces
+	for _, rule := range customRules {
+		// Règle configurable uniquement
+		if rule.Threshold != nil {
+			thresholds[rule.Code] = *rule.Threshold
+		}
+	}
+	// Retourne la map fusionnée
+	return thresholds
+}
+
+// GetRuleRemediation retourne l'effort de correction déclaré par les règles,
+// en minutes par violation. Les règles absentes prennent l'effort de leur
+// catégorie (voir GetCategoryRemediation).
+//
+// Returns:
+//   - map[string]int: effort indexé par code de règle
+func GetRuleRemediation() map[string]int {
+	remediation := make(map[string]int, defaultRemediationCapacity)
+	// Fusion des efforts déclarés par chaque catégorie
+	for _, source := range []func() map[string]int{
+		ktnfunc.Remediation,
+		ktnstruct.Remediation,
+		ktntest.Remediation,
+		ktnvar.Remediation,
+	} {
+		// Copie des efforts de la catégorie
+		maps.Copy(remediation, source())
+	}
+	// Ajout des efforts des règles tierces
+	for _, rule := range customRules {
+		// Effort déclaré uniquement
+		if rule.RemediationMinutes > 0 {
+			remediation[rule.Code] = rule.RemediationMinutes
+		}
+	}
+	// Retourne la map fusionnée
+	return remediation
+}
+
+// GetCategoryRemediation retourne l'effort de correction par défaut des règles
+// d'une catégorie, en minutes par violation.
+//
+// Params:
+//   - category: catégorie du code de règle (ex: "func", "mdrnz")
+//
+// Returns:
+//   - int: effort déclaré par la catégorie, 5 minutes pour les catégories tierces
+func GetCategoryRemediation(category string) int {
+	minutes, ok := map[string]int{
+		"api":       ktnapi.RemediationMinutes,
+		"comment":   ktncomment.RemediationMinutes,
+		"const":     ktnconst.RemediationMinutes,
+		"func":      ktnfunc.RemediationMinutes,
+		"generic":   ktngeneric.RemediationMinutes,
+		"interface": ktninterface.RemediationMinutes,
+		"mdrnz":     modernize.RemediationMinutes,
+		"struct":    ktnstruct.RemediationMinutes,
+		"test":      ktntest.RemediationMinutes,
+		"var":       ktnvar.RemediationMinutes,
+	}[category]
+	// Vérification de la catégorie
+	if !ok {
+		// Catégorie sans effort déclaré
+		return defaultRemediationMinutes
+	}
+	// Retourne l'effort de la catégorie
+	return minutes
+}
@@ -146,3 +146,102 @@ func TestGetRuleByCode(t *testing.T) {
 		})
 	}
 }
+
+// TestGetRuleThresholds tests that configurable rules expose their defaults.
+func TestGetRuleThresholds(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected int
+		present  bool
+	}{
+		{name: "function length threshold", code: "KTN-FUNC-005", expected: 35, present: true},
+		{name: "comment length threshold", code: "KTN-COMMENT-001", expected: 150, present: true},
+		{name: "string conversion threshold", code: "KTN-VAR-015", expected: 2, present: true},
+		{name: "rule without threshold", code: "KTN-FUNC-001", expected: 0, present: false},
+	}
+
+	thresholds := ktn.GetRuleThresholds()
+
+	// Iteration over test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			value, ok := thresholds[tt.code]
+			// Check presence
+			if ok != tt.present {
+				t.Fatalf("threshold for %s present=%v, want %v", tt.code, ok, tt.present)
+			}
+			// Check default value
+			if value != tt.expected {
+				t.Errorf("threshold for %s = %d, want %d", tt.code, value, tt.expected)
+			}
+		})
+	}
+}
+
+// TestGetRuleRemediation tests that rules expose their declared effort.
+func TestGetRuleRemediation(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected int
+		present  bool
+	}{
+		{name: "function length effort", code: "KTN-FUNC-005", expected: 30, present: true},
+		{name: "sync.Pool effort", code: "KTN-VAR-014", expected: 15, present: true},
+		{name: "rule with category effort", code: "KTN-FUNC-001", expected: 0, present: false},
+	}
+
+	remediation := ktn.GetRuleRemediation()
+
+	// Iteration over test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			minutes, ok := remediation[tt.code]
+			// Check presence
+			if ok != tt.present {
+				t.Fatalf("effort for %s present=%v, want %v", tt.code, ok, tt.present)
+			}
+			// Check declared value
+			if minutes != tt.expected {
+				t.Errorf("effort for %s = %d, want %d", tt.code, minutes, tt.expected)
+			}
+		})
+	}
+
+	// Every declared effort belongs to a known rule
+	for code := range remediation {
+		// Check rule exists
+		if ktn.GetRuleByCode(code) == nil {
+			t.Errorf("effort declared for unknown rule %s", code)
+		}
+	}
+}
+
+// TestGetCategoryRemediation tests the default effort of categories.
+func TestGetCategoryRemediation(t *testing.T) {
+	tests := []struct {
+		name     string
+		category string
+		expected int
+	}{
+		{name: "function category", category: "func", expected: 10},
+		{name: "comment category", category: "comment", expected: 2},
+		{name: "modernize category", category: "mdrnz", expected: 2},
+		{name: "third-party category", category: "sec", expected: 5},
+		{name: "no category", category: "", expected: 5},
+	}
+
+	// Iteration over test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Check category effort
+			if got := ktn.GetCategoryRemediation(tt.category); got != tt.expected {
+				t.Errorf("GetCategoryRemediation(%q) = %d, want %d", tt.category, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Package ktn provides the master registry for all KTN lint rules.
+package ktn
+
+import (
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/kodflow/ktn-linter/pkg/severity"
+)
+
+// RuleSpec décrit une règle tierce enregistrée avec RegisterRule.
+// Les champs optionnels laissés à zéro prennent leur valeur par défaut.
+type RuleSpec struct {
+	Code               string             // Code de la règle (ex: "ACME-SEC-001")
+	Category           string             // Catégorie (défaut: tirée du code, ex: "sec")
+	Analyzer           *analysis.Analyzer // Analyseur rapportant des messages "CODE: ..."
+	Severity           severity.Level     // Sévérité des findings (défaut: SeverityInfo)
+	Description        string             // Description (défaut: Doc de l'analyseur)
+	Threshold          *int               // Seuil par défaut, nil si non configurable
+	RemediationMinutes int                // Effort de correction (0: défaut de la catégorie)
+	GoodExample        string             // Exemple de code conforme (rules, prompt)
+}
@@ -0,0 +1,15 @@
+// Package testhelper provides testing utilities for KTN analyzers.
+package testhelper
+
+import (
+	"go/token"
+	"regexp"
+)
+
+// expectation est une attente "// want" : un diagnostic dont le message
+// correspond à pattern doit être rapporté sur la ligne de position.
+type expectation struct {
+	position token.Position
+	pattern  *regexp.Regexp
+	met      bool
+}
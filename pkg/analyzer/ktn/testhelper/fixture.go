@@ -0,0 +1,200 @@
+// Package testhelper provides testing utilities for KTN analyzers.
+package testhelper
+
+import (
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	// testdataRoot racine GOPATH des fixtures
+	testdataRoot string = "testdata/src"
+	// fixtureConfigName configuration appliquée aux fixtures d'un répertoire
+	fixtureConfigName string = "ktn.yaml"
+)
+
+// TestWant teste un analyzer sur les annotations "// want" d'un répertoire.
+// Chaque sous-répertoire de testdata/src/<testDir> contenant des fichiers .go
+// est un package analysé ; ses imports sont résolus dans testdata/src puis
+// dans la bibliothèque standard. Un diagnostic doit correspondre à une
+// attente de sa ligne, et chaque attente à un diagnostic. Un fichier
+// F.golden fixe le résultat des corrections suggérées sur F. Un ktn.yaml
+// à la racine de testDir remplace la configuration globale.
+//
+// Params:
+//   - t: contexte de test
+//   - analyzer: l'analyzer à tester
+//   - testDir: nom du répertoire de test
+func TestWant(t TestingT, analyzer *analysis.Analyzer, testDir string) {
+	cfg, ok := loadFixtureConfig(t, filepath.Join(testdataRoot, testDir))
+	// Vérification de la configuration
+	if !ok {
+		// Retour anticipé pour les mocks qui ne terminent pas le test
+		return
+	}
+	fset := token.NewFileSet()
+	imp := newFixtureImporter(fset, testdataRoot)
+	// Analyse de chaque package du répertoire
+	for _, path := range fixturePaths(t, testDir) {
+		fixture, err := imp.load(path)
+		// Vérification du chargement
+		if err != nil {
+			t.Fatalf("failed to load %s: %v", path, err)
+			// Retour anticipé pour les mocks qui ne terminent pas le test
+			return
+		}
+		diagnostics, ok := runFixture(t, analyzer, fset, fixture, cfg)
+		// Vérification de l'exécution
+		if !ok {
+			// Retour anticipé pour les mocks qui ne terminent pas le test
+			return
+		}
+		checkWants(t, fset, diagnostics, collectWants(t, fset, fixture.files))
+		checkGolden(t, fset, fixture, diagnostics)
+	}
+}
+
+// loadFixtureConfig charge le ktn.yaml d'un répertoire de fixtures.
+//
+// Params:
+//   - t: contexte de test
+//   - dir: répertoire de fixtures
+//
+// Returns:
+//   - *config.Config: configuration, nil en l'absence de ktn.yaml
+//   - bool: false si le ktn.yaml est invalide
+func loadFixtureConfig(t TestingT, dir string) (*config.Config, bool) {
+	path := filepath.Join(dir, fixtureConfigName)
+	// Absence de configuration dédiée
+	if _, err := os.Stat(path); err != nil {
+		// Retour sans configuration
+		return nil, true
+	}
+	cfg, err := config.Load(path)
+	// Vérification de la configuration
+	if err != nil {
+		t.Fatalf("failed to load %s: %v", path, err)
+		// Retour anticipé pour les mocks qui ne terminent pas le test
+		return nil, false
+	}
+	// Retour de la configuration
+	return cfg, true
+}
+
+// fixturePaths liste les packages d'un répertoire de fixtures.
+//
+// Params:
+//   - t: contexte de test
+//   - testDir: répertoire relatif à testdata/src
+//
+// Returns:
+//   - []string: chemins d'import des répertoires contenant des .go
+func fixturePaths(t TestingT, testDir string) []string {
+	paths := []string{}
+	seen := make(map[string]bool, initialAnalyzerMapCap)
+	err := filepath.WalkDir(filepath.Join(testdataRoot, testDir), func(path string, entry fs.DirEntry, err error) error {
+		// Seuls les fichiers .go désignent un package
+		if err == nil && !entry.IsDir() && strings.HasSuffix(path, ".go") {
+			var rel string
+			rel, err = filepath.Rel(testdataRoot, filepath.Dir(path))
+			// Ajout du package une seule fois
+			if err == nil && !seen[rel] {
+				seen[rel] = true
+				paths = append(paths, filepath.ToSlash(rel))
+			}
+		}
+		// Propagation des erreurs de parcours
+		return err
+	})
+	// Vérification du parcours
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", testDir, err)
+		// Retour anticipé pour les mocks qui ne terminent pas le test
+		return []string{}
+	}
+	// Vérification qu'au moins un package a été trouvé
+	if len(paths) == 0 {
+		t.Fatalf("no .go files found in %s", filepath.Join(testdataRoot, testDir))
+	}
+	// Retour des packages
+	return paths
+}
+
+// runFixture exécute un analyzer et ses dépendances sur un package.
+//
+// Params:
+//   - t: contexte de test
+//   - analyzer: l'analyzer à exécuter
+//   - fset: ensemble de fichiers
+//   - fixture: package analysé
+//   - cfg: configuration des fixtures, nil pour la configuration globale
+//
+// Returns:
+//   - []analysis.Diagnostic: diagnostics de l'analyzer
+//   - bool: false si un analyzer a échoué
+func runFixture(t TestingT, analyzer *analysis.Analyzer, fset *token.FileSet, fixture *fixturePackage, cfg *config.Config) ([]analysis.Diagnostic, bool) {
+	var diagnostics []analysis.Diagnostic
+	pass := createPass(fset, fixture.files[0], fixture.pkg, fixture.info, &diagnostics)
+	pass.Files = fixture.files
+	// Configuration propre aux fixtures
+	if cfg != nil {
+		pass.ResultOf[config.Analyzer] = cfg
+	}
+	// Exécution des dépendances sans collecter leurs diagnostics
+	if err := runRequired(pass, analyzer.Requires); err != nil {
+		t.Fatalf("required analyzer failed: %v", err)
+		// Retour anticipé pour les mocks qui ne terminent pas le test
+		return []analysis.Diagnostic{}, false
+	}
+	pass.Analyzer = analyzer
+	// Exécution de l'analyzer testé
+	if _, err := analyzer.Run(pass); err != nil {
+		t.Fatalf("analyzer failed: %v", err)
+		// Retour anticipé pour les mocks qui ne terminent pas le test
+		return []analysis.Diagnostic{}, false
+	}
+	// Retour des diagnostics
+	return diagnostics, true
+}
+
+// runRequired exécute récursivement les analyzers requis absents de ResultOf.
+//
+// Params:
+//   - pass: pass partagé
+//   - required: analyzers requis
+//
+// Returns:
+//   - error: échec d'un analyzer requis
+func runRequired(pass *analysis.Pass, required []*analysis.Analyzer) error {
+	// Parcours des dépendances
+	for _, req := range required {
+		// Résultat déjà disponible
+		if _, done := pass.ResultOf[req]; done {
+			continue
+		}
+		// Exécution des dépendances de la dépendance
+		if err := runRequired(pass, req.Requires); err != nil {
+			// Retour de l'erreur
+			return err
+		}
+		report := pass.Report
+		pass.Report = func(analysis.Diagnostic) {}
+		pass.Analyzer = req
+		result, err := req.Run(pass)
+		pass.Report = report
+		// Vérification de l'exécution
+		if err != nil {
+			// Retour de l'erreur
+			return err
+		}
+		pass.ResultOf[req] = result
+	}
+	// Succès
+	return nil
+}
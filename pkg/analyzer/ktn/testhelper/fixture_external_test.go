@@ -0,0 +1,102 @@
+package testhelper_test
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn/testhelper"
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// badPrefix préfixe des fonctions signalées par renameAnalyzer.
+const badPrefix string = "bad"
+
+// renameAnalyzer signale les fonctions "bad*" et propose de les renommer.
+var renameAnalyzer *analysis.Analyzer = &analysis.Analyzer{
+	Name:     "rename",
+	Doc:      "reports bad* functions with a rename fix",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run: func(pass *analysis.Pass) (any, error) {
+		insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+		insp.Preorder([]ast.Node{(*ast.FuncDecl)(nil)}, func(node ast.Node) {
+			name := node.(*ast.FuncDecl).Name
+			// Seules les fonctions bad* sont signalées
+			if !strings.HasPrefix(name.Name, badPrefix) {
+				return
+			}
+			pass.Report(analysis.Diagnostic{
+				Pos:     name.Pos(),
+				Message: "TEST-001: rename " + name.Name,
+				SuggestedFixes: []analysis.SuggestedFix{{
+					Message:   "rename",
+					TextEdits: []analysis.TextEdit{{Pos: name.Pos(), End: name.Pos() + token.Pos(len(badPrefix)), NewText: []byte("good")}},
+				}},
+			})
+		})
+		// Retour de la fonction
+		return nil, nil
+	},
+}
+
+// thresholdAnalyzer rapporte le seuil TEST-002 de la configuration du pass.
+var thresholdAnalyzer *analysis.Analyzer = &analysis.Analyzer{
+	Name:     "threshold",
+	Doc:      "reports the configured TEST-002 threshold",
+	Requires: []*analysis.Analyzer{config.Analyzer},
+	Run: func(pass *analysis.Pass) (any, error) {
+		threshold := config.ForPass(pass).GetThreshold("TEST-002", 0)
+		// Un diagnostic par fichier
+		for _, file := range pass.Files {
+			pass.Reportf(file.Name.Pos(), "TEST-002: threshold %d", threshold)
+		}
+		// Retour de la fonction
+		return nil, nil
+	},
+}
+
+// failingAnalyzer échoue systématiquement.
+var failingAnalyzer *analysis.Analyzer = &analysis.Analyzer{
+	Name: "failing",
+	Doc:  "always fails",
+	Run: func(*analysis.Pass) (any, error) {
+		// Retour d'erreur
+		return nil, fmt.Errorf("failure")
+	},
+}
+
+// TestTestWant teste TestWant sur les fixtures de testdata/src.
+func TestTestWant(t *testing.T) {
+	tests := []struct {
+		name        string
+		analyzer    *analysis.Analyzer
+		testDir     string
+		expectError bool
+		expectFatal bool
+	}{
+		{name: "multi-file and multi-package with golden", analyzer: renameAnalyzer, testDir: "want"},
+		{name: "missing and unexpected diagnostics", analyzer: renameAnalyzer, testDir: "missing", expectError: true},
+		{name: "golden mismatch", analyzer: renameAnalyzer, testDir: "broken", expectError: true},
+		{name: "config override", analyzer: thresholdAnalyzer, testDir: "config"},
+		{name: "invalid config override", analyzer: thresholdAnalyzer, testDir: "badconfig", expectFatal: true},
+		{name: "failing analyzer", analyzer: failingAnalyzer, testDir: "config", expectFatal: true},
+		{name: "missing directory", analyzer: renameAnalyzer, testDir: "nonexistent", expectFatal: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockTestingT{}
+			testhelper.TestWant(mock, tt.analyzer, tt.testDir)
+			// Vérification des échecs attendus
+			if mock.errorfCalled != tt.expectError || mock.fatalfCalled != tt.expectFatal {
+				t.Errorf("TestWant(%s) errorf=%v fatalf=%v, want errorf=%v fatalf=%v", tt.testDir, mock.errorfCalled, mock.fatalfCalled, tt.expectError, tt.expectFatal)
+			}
+		})
+	}
+}
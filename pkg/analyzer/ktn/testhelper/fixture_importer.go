@@ -0,0 +1,147 @@
+// Package testhelper provides testing utilities for KTN analyzers.
+package testhelper
+
+import (
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// fixtureImporter résout les imports vers les packages de testdata/src,
+// à la manière de GOPATH, puis vers la bibliothèque standard.
+type fixtureImporter struct {
+	fset     *token.FileSet
+	root     string
+	fallback types.Importer
+	packages map[string]*fixturePackage
+}
+
+// newFixtureImporter crée un importeur enraciné dans un répertoire.
+//
+// Params:
+//   - fset: ensemble de fichiers partagé par les packages chargés
+//   - root: racine des chemins d'import (ex: "testdata/src")
+//
+// Returns:
+//   - *fixtureImporter: importeur créé
+func newFixtureImporter(fset *token.FileSet, root string) *fixtureImporter {
+	// Retour de l'importeur
+	return &fixtureImporter{
+		fset:     fset,
+		root:     root,
+		fallback: importer.Default(),
+		packages: make(map[string]*fixturePackage, initialAnalyzerMapCap),
+	}
+}
+
+// Import implémente types.Importer.
+//
+// Params:
+//   - path: chemin d'import
+//
+// Returns:
+//   - *types.Package: package importé
+//   - error: package introuvable ou invalide
+func (imp *fixtureImporter) Import(path string) (*types.Package, error) {
+	info, err := os.Stat(filepath.Join(imp.root, path))
+	// Les chemins absents de testdata relèvent de la bibliothèque standard
+	if err != nil || !info.IsDir() {
+		// Délégation à l'importeur par défaut
+		return imp.fallback.Import(path)
+	}
+	fixture, err := imp.load(path)
+	// Vérification du chargement
+	if err != nil {
+		// Retour de l'erreur
+		return nil, err
+	}
+	// Retour du package typé
+	return fixture.pkg, nil
+}
+
+// load parse et type un package de testdata une seule fois.
+//
+// Params:
+//   - path: chemin d'import relatif à la racine
+//
+// Returns:
+//   - *fixturePackage: package chargé
+//   - error: erreur de lecture, de parsing ou cycle d'import
+func (imp *fixtureImporter) load(path string) (*fixturePackage, error) {
+	fixture, seen := imp.packages[path]
+	// Package déjà chargé ou en cours de chargement
+	if seen {
+		// Une entrée nil signale un package en cours de chargement
+		if fixture == nil {
+			// Retour d'erreur de cycle
+			return nil, fmt.Errorf("import cycle through %s", path)
+		}
+		// Retour du package en cache
+		return fixture, nil
+	}
+	imp.packages[path] = nil
+	dir := filepath.Join(imp.root, path)
+	files, err := parseDir(imp.fset, dir)
+	// Vérification du parsing
+	if err != nil {
+		delete(imp.packages, path)
+		// Retour de l'erreur
+		return nil, err
+	}
+	conf := &types.Config{
+		Importer: imp,
+		Error:    func(err error) {}, // Ignorer les erreurs de type pour les tests
+	}
+	info := createTypeInfo()
+	pkg, _ := conf.Check(filepath.ToSlash(path), imp.fset, files, info)
+	fixture = &fixturePackage{dir: dir, files: files, pkg: pkg, info: info}
+	imp.packages[path] = fixture
+	// Retour du package chargé
+	return fixture, nil
+}
+
+// parseDir parse les fichiers .go d'un répertoire.
+//
+// Params:
+//   - fset: ensemble de fichiers
+//   - dir: répertoire du package
+//
+// Returns:
+//   - []*ast.File: fichiers parsés
+//   - error: erreur de lecture ou de parsing, ou répertoire sans .go
+func parseDir(fset *token.FileSet, dir string) ([]*ast.File, error) {
+	entries, err := os.ReadDir(dir)
+	// Vérification de la lecture du répertoire
+	if err != nil {
+		// Retour de l'erreur
+		return []*ast.File{}, err
+	}
+	var files []*ast.File
+	// Parcours des fichiers .go
+	for _, entry := range entries {
+		// Ignorer les répertoires et fichiers non-.go
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") {
+			continue
+		}
+		file, parseErr := parser.ParseFile(fset, filepath.Join(dir, entry.Name()), nil, parser.ParseComments)
+		// Vérification du parsing
+		if parseErr != nil {
+			// Retour de l'erreur
+			return []*ast.File{}, parseErr
+		}
+		files = append(files, file)
+	}
+	// Vérification qu'au moins un fichier a été trouvé
+	if len(files) == 0 {
+		// Retour d'erreur
+		return []*ast.File{}, fmt.Errorf("no .go files found in %s", dir)
+	}
+	// Retour des fichiers parsés
+	return files, nil
+}
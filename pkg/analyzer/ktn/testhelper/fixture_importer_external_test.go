@@ -0,0 +1,31 @@
+package testhelper_test
+
+import (
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn/testhelper"
+)
+
+// TestTestWant_imports teste la résolution des imports entre fixtures.
+func TestTestWant_imports(t *testing.T) {
+	tests := []struct {
+		name        string
+		testDir     string
+		expectFatal bool
+	}{
+		{name: "testdata and standard imports", testDir: "want/sub"},
+		{name: "import cycle is tolerated", testDir: "cycle"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockTestingT{}
+			testhelper.TestWant(mock, renameAnalyzer, tt.testDir)
+			// Vérification de l'absence d'échec
+			if mock.fatalfCalled != tt.expectFatal || mock.errorfCalled {
+				t.Errorf("TestWant(%s) fatalf=%v errorf=%v", tt.testDir, mock.fatalfCalled, mock.errorfCalled)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package testhelper
+
+import (
+	"go/token"
+	"testing"
+)
+
+// Test_fixtureImporter teste la résolution des imports de testdata.
+func Test_fixtureImporter(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		expectErr bool
+	}{
+		{name: "testdata package", path: "dep"},
+		{name: "standard library", path: "strings"},
+		{name: "import cycle is reported to the type checker", path: "cycle/x"},
+		{name: "unknown package", path: "does/not/exist", expectErr: true},
+		{name: "directory without go files", path: "cycle", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			imp := newFixtureImporter(token.NewFileSet(), testdataRoot)
+			pkg, err := imp.Import(tt.path)
+			// Vérification de l'erreur
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("Import(%q) error = %v, expectErr %v", tt.path, err, tt.expectErr)
+			}
+			// Vérification du package
+			if err == nil && pkg == nil {
+				t.Errorf("Import(%q) returned nil package", tt.path)
+			}
+		})
+	}
+}
+
+// Test_fixtureImporter_cache teste le chargement unique d'un package.
+func Test_fixtureImporter_cache(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "same package twice", path: "dep"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			imp := newFixtureImporter(token.NewFileSet(), testdataRoot)
+			first, _ := imp.Import(tt.path)
+			second, _ := imp.Import(tt.path)
+			// Vérification de l'identité des packages
+			if first != second {
+				t.Errorf("Import(%q) loaded the package twice", tt.path)
+			}
+		})
+	}
+}
+
+// Test_parseDir teste le parsing d'un répertoire de package.
+func Test_parseDir(t *testing.T) {
+	tests := []struct {
+		name      string
+		dir       string
+		expected  int
+		expectErr bool
+	}{
+		{name: "multi-file package", dir: "testdata/src/want", expected: 2},
+		{name: "missing directory", dir: "testdata/src/none", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			files, err := parseDir(token.NewFileSet(), tt.dir)
+			// Vérification de l'erreur
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("parseDir(%q) error = %v, expectErr %v", tt.dir, err, tt.expectErr)
+			}
+			// Vérification du nombre de fichiers
+			if len(files) != tt.expected {
+				t.Errorf("parseDir(%q) = %d files, want %d", tt.dir, len(files), tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package testhelper
+
+import (
+	"go/token"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+)
+
+// Test_fixturePaths teste la découverte des packages d'un répertoire.
+func Test_fixturePaths(t *testing.T) {
+	tests := []struct {
+		name        string
+		testDir     string
+		expected    []string
+		expectFatal bool
+	}{
+		{name: "nested packages", testDir: "want", expected: []string{"want", "want/sub"}},
+		{name: "missing directory", testDir: "none", expected: []string{}, expectFatal: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			mockT := &MockTestingT{}
+			got := fixturePaths(mockT, tt.testDir)
+			// Vérification de l'échec attendu
+			if mockT.FatalfCalled != tt.expectFatal {
+				t.Errorf("Fatalf called = %v, want %v", mockT.FatalfCalled, tt.expectFatal)
+			}
+			// Vérification des chemins
+			if len(got) != len(tt.expected) {
+				t.Fatalf("fixturePaths(%q) = %v, want %v", tt.testDir, got, tt.expected)
+			}
+			// Comparaison de chaque chemin
+			for index := range got {
+				// Vérification de l'ordre de parcours
+				if got[index] != tt.expected[index] {
+					t.Errorf("fixturePaths(%q)[%d] = %q, want %q", tt.testDir, index, got[index], tt.expected[index])
+				}
+			}
+		})
+	}
+}
+
+// Test_loadFixtureConfig teste le chargement du ktn.yaml des fixtures.
+func Test_loadFixtureConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		dir        string
+		expectCfg  bool
+		expectedOK bool
+	}{
+		{name: "fixture config", dir: "testdata/src/config", expectCfg: true, expectedOK: true},
+		{name: "no fixture config", dir: "testdata/src/want", expectedOK: true},
+		{name: "invalid fixture config", dir: "testdata/src/badconfig"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, ok := loadFixtureConfig(&MockTestingT{}, tt.dir)
+			// Vérification du résultat
+			if (cfg != nil) != tt.expectCfg || ok != tt.expectedOK {
+				t.Errorf("loadFixtureConfig(%q) = %v, %v", tt.dir, cfg != nil, ok)
+			}
+		})
+	}
+}
+
+// Test_runRequired teste l'exécution récursive des dépendances.
+func Test_runRequired(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{name: "nested requirement runs once"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			runs := 0
+			middle := &analysis.Analyzer{
+				Name:     "middle",
+				Doc:      "depends on inspect",
+				Requires: []*analysis.Analyzer{inspect.Analyzer},
+				Run: func(pass *analysis.Pass) (any, error) {
+					runs++
+					pass.Reportf(pass.Files[0].Pos(), "hidden")
+					// Retour de la fonction
+					return nil, nil
+				},
+			}
+			fset := token.NewFileSet()
+			fixture, err := newFixtureImporter(fset, testdataRoot).load("dep")
+			// Vérification du chargement
+			if err != nil {
+				t.Fatalf("load(dep) error = %v", err)
+			}
+			var diagnostics []analysis.Diagnostic
+			pass := createPass(fset, fixture.files[0], fixture.pkg, fixture.info, &diagnostics)
+			// Vérification de l'exécution
+			if err := runRequired(pass, []*analysis.Analyzer{middle, middle}); err != nil {
+				t.Fatalf("runRequired() error = %v", err)
+			}
+			// Vérification de l'unicité et de la dépendance imbriquée
+			if runs != 1 || pass.ResultOf[inspect.Analyzer] == nil || len(diagnostics) != 0 {
+				t.Errorf("runs = %d, inspect = %v, diagnostics = %d", runs, pass.ResultOf[inspect.Analyzer], len(diagnostics))
+			}
+		})
+	}
+}
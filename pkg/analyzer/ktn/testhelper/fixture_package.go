@@ -0,0 +1,15 @@
+// Package testhelper provides testing utilities for KTN analyzers.
+package testhelper
+
+import (
+	"go/ast"
+	"go/types"
+)
+
+// fixturePackage est un package de testdata parsé et typé.
+type fixturePackage struct {
+	dir   string
+	files []*ast.File
+	pkg   *types.Package
+	info  *types.Info
+}
@@ -0,0 +1,130 @@
+// Package testhelper provides testing utilities for KTN analyzers.
+package testhelper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
+	"os"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// goldenSuffix désigne le fichier attendu après application des corrections.
+const goldenSuffix string = ".golden"
+
+// checkGolden compare les corrections suggérées aux fichiers .golden.
+// Pour chaque fichier F accompagné de F.golden, toutes les corrections
+// des diagnostics de F sont appliquées et le résultat formaté doit être
+// identique à F.golden.
+//
+// Params:
+//   - t: contexte de test
+//   - fset: ensemble de fichiers
+//   - fixture: package analysé
+//   - diagnostics: diagnostics rapportés
+func checkGolden(t TestingT, fset *token.FileSet, fixture *fixturePackage, diagnostics []analysis.Diagnostic) {
+	// Parcours des fichiers du package
+	for _, file := range fixture.files {
+		filename := fset.File(file.Pos()).Name()
+		want, err := os.ReadFile(filename + goldenSuffix)
+		// Fichier sans golden
+		if err != nil {
+			continue
+		}
+		src, err := os.ReadFile(filename)
+		// Vérification de la lecture du source
+		if err != nil {
+			t.Errorf("failed to read %s: %v", filename, err)
+			continue
+		}
+		got, err := applyEdits(fset, src, fileEdits(fset, filename, diagnostics))
+		// Vérification de l'application des corrections
+		if err != nil {
+			t.Errorf("%s: %v", filename, err)
+			continue
+		}
+		// Comparaison avec le fichier attendu
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s: suggested fixes do not match %s%s\n--- got ---\n%s\n--- want ---\n%s", filename, filename, goldenSuffix, got, want)
+		}
+	}
+}
+
+// fileEdits rassemble les modifications des corrections portant sur un fichier.
+//
+// Params:
+//   - fset: ensemble de fichiers
+//   - filename: fichier ciblé
+//   - diagnostics: diagnostics rapportés
+//
+// Returns:
+//   - []analysis.TextEdit: modifications du fichier
+func fileEdits(fset *token.FileSet, filename string, diagnostics []analysis.Diagnostic) []analysis.TextEdit {
+	edits := []analysis.TextEdit{}
+	// Parcours des corrections de chaque diagnostic
+	for _, diag := range diagnostics {
+		// Parcours des corrections suggérées
+		for _, fix := range diag.SuggestedFixes {
+			// Sélection des modifications du fichier
+			for _, edit := range fix.TextEdits {
+				// Modification d'un autre fichier
+				if fset.Position(edit.Pos).Filename != filename {
+					continue
+				}
+				edits = append(edits, edit)
+			}
+		}
+	}
+	// Retour des modifications
+	return edits
+}
+
+// applyEdits applique des modifications à un source puis le formate.
+//
+// Params:
+//   - fset: ensemble de fichiers
+//   - src: contenu original
+//   - edits: modifications à appliquer
+//
+// Returns:
+//   - []byte: source modifié et formaté
+//   - error: modifications qui se chevauchent ou résultat invalide
+func applyEdits(fset *token.FileSet, src []byte, edits []analysis.TextEdit) ([]byte, error) {
+	sorted := slices.Clone(edits)
+	slices.SortStableFunc(sorted, func(left, right analysis.TextEdit) int {
+		// Tri par position de début
+		return int(left.Pos - right.Pos)
+	})
+	var out bytes.Buffer
+	last := 0
+	// Application des modifications dans l'ordre
+	for _, edit := range sorted {
+		start := fset.Position(edit.Pos).Offset
+		end := start
+		// Une fin absente désigne une insertion
+		if edit.End.IsValid() {
+			end = fset.Position(edit.End).Offset
+		}
+		// Vérification des bornes et des chevauchements
+		if start < last || end < start || end > len(src) {
+			// Retour d'erreur de modification invalide
+			return []byte{}, fmt.Errorf("overlapping or invalid edit at offset %d", start)
+		}
+		out.Write(src[last:start])
+		out.Write(edit.NewText)
+		last = end
+	}
+	out.Write(src[last:])
+	formatted, err := format.Source(out.Bytes())
+	// Vérification du résultat
+	if err != nil {
+		// Retour de l'erreur de formatage
+		return []byte{}, errors.Join(errors.New("fixed source does not parse"), err)
+	}
+	// Retour du source formaté
+	return formatted, nil
+}
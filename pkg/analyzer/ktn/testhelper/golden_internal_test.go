@@ -0,0 +1,84 @@
+package testhelper
+
+import (
+	"go/token"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Test_applyEdits teste l'application des modifications d'une correction.
+func Test_applyEdits(t *testing.T) {
+	const src string = "package p\n\nvar a = 1\n"
+	tests := []struct {
+		name      string
+		edits     [][3]int
+		texts     []string
+		expected  string
+		expectErr bool
+	}{
+		{name: "no edit", expected: src},
+		{name: "replacement", edits: [][3]int{{15, 16}}, texts: []string{"b"}, expected: "package p\n\nvar b = 1\n"},
+		{name: "insertion and unsorted edits", edits: [][3]int{{19, 20}, {15, -1}}, texts: []string{"2", "x"}, expected: "package p\n\nvar xa = 2\n"},
+		{name: "overlapping edits", edits: [][3]int{{11, 16}, {15, 17}}, texts: []string{"", ""}, expectErr: true},
+		{name: "unparsable result", edits: [][3]int{{0, 7}}, texts: []string{"}"}, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file := fset.AddFile("p.go", -1, len(src))
+			edits := []analysis.TextEdit{}
+			// Conversion des offsets en positions
+			for index, offsets := range tt.edits {
+				edit := analysis.TextEdit{Pos: file.Pos(offsets[0]), NewText: []byte(tt.texts[index])}
+				// Une fin négative désigne une insertion
+				if offsets[1] >= 0 {
+					edit.End = file.Pos(offsets[1])
+				}
+				edits = append(edits, edit)
+			}
+			got, err := applyEdits(fset, []byte(src), edits)
+			// Vérification de l'erreur
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("applyEdits() error = %v, expectErr %v", err, tt.expectErr)
+			}
+			// Vérification du résultat
+			if err == nil && string(got) != tt.expected {
+				t.Errorf("applyEdits() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+// Test_fileEdits teste la sélection des modifications d'un fichier.
+func Test_fileEdits(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		expected int
+	}{
+		{name: "edits of the file", filename: "a.go", expected: 2},
+		{name: "edits of another file", filename: "c.go", expected: 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			fileA := fset.AddFile("a.go", -1, 10)
+			fileB := fset.AddFile("b.go", -1, 10)
+			diagnostics := []analysis.Diagnostic{{
+				SuggestedFixes: []analysis.SuggestedFix{
+					{TextEdits: []analysis.TextEdit{{Pos: fileA.Pos(1)}, {Pos: fileB.Pos(1)}}},
+					{TextEdits: []analysis.TextEdit{{Pos: fileA.Pos(5)}}},
+				},
+			}}
+			// Vérification du nombre de modifications
+			if got := fileEdits(fset, tt.filename, diagnostics); len(got) != tt.expected {
+				t.Errorf("fileEdits(%s) = %d edits, want %d", tt.filename, len(got), tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,3 @@
+package broken
+
+func badBroken() {} // want "TEST-001: rename badBroken"
@@ -0,0 +1 @@
+package config // want "TEST-002: threshold 7"
@@ -0,0 +1,4 @@
+// Package x importe y qui importe x.
+package x
+
+import _ "cycle/y"
@@ -0,0 +1,4 @@
+// Package y importe x qui importe y.
+package y
+
+import _ "cycle/x"
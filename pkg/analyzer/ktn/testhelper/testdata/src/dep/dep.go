@@ -0,0 +1,7 @@
+// Package dep est importé par want/sub.
+package dep
+
+// Value est une valeur partagée.
+const Value int = 1
+
+func badDep() {}
@@ -0,0 +1,6 @@
+// Package missing déclare une attente jamais satisfaite.
+package missing
+
+func okMissing() {} // want "TEST-001"
+
+func badUnexpected() {}
@@ -0,0 +1,6 @@
+// Package want exerce les attentes sur plusieurs fichiers.
+package want
+
+func badOne() {} // want `TEST-001: rename badOne`
+
+func okOne() {}
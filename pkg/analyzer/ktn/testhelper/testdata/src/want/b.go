@@ -0,0 +1,5 @@
+package want
+
+func badTwo() {} // want "TEST-001: rename badTwo"
+
+func badThree() {} // want "rename badThree"
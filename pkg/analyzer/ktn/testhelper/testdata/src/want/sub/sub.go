@@ -0,0 +1,6 @@
+// Package sub importe un package de testdata non analysé.
+package sub
+
+import "dep"
+
+func badSub() int { return dep.Value } // want "TEST-001"
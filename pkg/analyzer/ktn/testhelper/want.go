@@ -0,0 +1,189 @@
+// Package testhelper provides testing utilities for KTN analyzers.
+package testhelper
+
+import (
+	"fmt"
+	"go/ast"
+	"go/scanner"
+	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// directivePrefix introduit les attentes dans un commentaire de ligne.
+const directivePrefix string = "want "
+
+// collectWants extrait les attentes "// want" des fichiers.
+// Un commentaire `// want "re1" "re2"` attend un diagnostic par expression
+// sur sa propre ligne ; les chaînes brutes `re` sont acceptées.
+//
+// Params:
+//   - t: contexte de test
+//   - fset: ensemble de fichiers
+//   - files: fichiers annotés
+//
+// Returns:
+//   - []*expectation: attentes trouvées
+func collectWants(t TestingT, fset *token.FileSet, files []*ast.File) []*expectation {
+	wants := []*expectation{}
+	// Parcours des commentaires de chaque fichier
+	for _, file := range files {
+		// Parcours des groupes de commentaires
+		for _, group := range file.Comments {
+			// Parcours des commentaires du groupe
+			for _, comment := range group.List {
+				text, found := strings.CutPrefix(comment.Text, "//")
+				// Seuls les commentaires de ligne "// want" portent des attentes
+				if !found {
+					continue
+				}
+				rest, found := strings.CutPrefix(strings.TrimSpace(text), directivePrefix)
+				// Commentaire ordinaire
+				if !found {
+					continue
+				}
+				position := fset.Position(comment.Pos())
+				patterns, err := parseWantPatterns(rest)
+				// Vérification de la syntaxe des attentes
+				if err != nil {
+					t.Fatalf("%s: invalid want comment: %v", position, err)
+					// Retour anticipé pour les mocks qui ne terminent pas le test
+					return []*expectation{}
+				}
+				// Ajout d'une attente par expression
+				for _, pattern := range patterns {
+					wants = append(wants, &expectation{position: position, pattern: pattern})
+				}
+			}
+		}
+	}
+	// Retour des attentes
+	return wants
+}
+
+// parseWantPatterns analyse la liste d'expressions d'un commentaire want.
+//
+// Params:
+//   - text: texte suivant "want"
+//
+// Returns:
+//   - []*regexp.Regexp: expressions compilées
+//   - error: chaîne ou expression invalide
+func parseWantPatterns(text string) ([]*regexp.Regexp, error) {
+	var (
+		scan     scanner.Scanner
+		scanErr  error
+		patterns []*regexp.Regexp
+	)
+	fset := token.NewFileSet()
+	file := fset.AddFile("want", -1, len(text))
+	scan.Init(file, []byte(text), func(_ token.Position, msg string) { scanErr = fmt.Errorf("%s", msg) }, 0)
+	// Lecture des chaînes jusqu'à la fin du commentaire
+	for {
+		_, tok, lit := scan.Scan()
+		// Arrêt en fin de texte
+		if tok == token.EOF || tok == token.SEMICOLON && lit == "\n" {
+			break
+		}
+		// Seules des chaînes sont attendues
+		if tok != token.STRING {
+			// Retour d'erreur de syntaxe
+			return []*regexp.Regexp{}, fmt.Errorf("got %s, want a quoted regexp", tok)
+		}
+		pattern, err := compileWant(lit)
+		// Vérification de l'expression
+		if err != nil {
+			// Retour de l'erreur
+			return []*regexp.Regexp{}, err
+		}
+		patterns = append(patterns, pattern)
+	}
+	// Vérification des erreurs du scanner et de la présence d'attentes
+	switch {
+	// Erreur lexicale
+	case scanErr != nil:
+		// Retour de l'erreur lexicale
+		return []*regexp.Regexp{}, scanErr
+	// Commentaire vide
+	case len(patterns) == 0:
+		// Retour d'erreur
+		return []*regexp.Regexp{}, fmt.Errorf("no regexp")
+	// Attentes valides
+	default:
+		// Retour des expressions
+		return patterns, nil
+	}
+}
+
+// compileWant compile une chaîne Go littérale en expression régulière.
+//
+// Params:
+//   - literal: chaîne entre guillemets ou accents graves
+//
+// Returns:
+//   - *regexp.Regexp: expression compilée
+//   - error: chaîne ou expression invalide
+func compileWant(literal string) (*regexp.Regexp, error) {
+	text, err := strconv.Unquote(literal)
+	// Vérification de la chaîne
+	if err != nil {
+		// Retour de l'erreur
+		return nil, err
+	}
+	// Retour de l'expression compilée
+	return regexp.Compile(text)
+}
+
+// checkWants confronte les diagnostics aux attentes.
+// Chaque diagnostic consomme une attente de sa ligne dont l'expression
+// correspond à son message ; les diagnostics et attentes restants sont
+// signalés.
+//
+// Params:
+//   - t: contexte de test
+//   - fset: ensemble de fichiers
+//   - diagnostics: diagnostics rapportés
+//   - wants: attentes à satisfaire
+func checkWants(t TestingT, fset *token.FileSet, diagnostics []analysis.Diagnostic, wants []*expectation) {
+	// Association de chaque diagnostic à une attente
+	for _, diag := range diagnostics {
+		position := fset.Position(diag.Pos)
+		// Diagnostic non attendu
+		if !consumeWant(wants, position, diag.Message) {
+			t.Errorf("%s: unexpected diagnostic: %s", position, diag.Message)
+		}
+	}
+	// Signalement des attentes non satisfaites
+	for _, want := range wants {
+		// Attente sans diagnostic
+		if !want.met {
+			t.Errorf("%s: no diagnostic was reported matching %q", want.position, want.pattern)
+		}
+	}
+}
+
+// consumeWant marque satisfaite la première attente correspondante.
+//
+// Params:
+//   - wants: attentes
+//   - position: position du diagnostic
+//   - message: message du diagnostic
+//
+// Returns:
+//   - bool: true si une attente a été consommée
+func consumeWant(wants []*expectation, position token.Position, message string) bool {
+	// Recherche d'une attente libre sur la même ligne
+	for _, want := range wants {
+		// Vérification de la ligne et du message
+		if !want.met && want.position.Filename == position.Filename && want.position.Line == position.Line && want.pattern.MatchString(message) {
+			want.met = true
+			// Attente consommée
+			return true
+		}
+	}
+	// Aucune attente correspondante
+	return false
+}
@@ -0,0 +1,149 @@
+package testhelper
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Test_parseWantPatterns teste l'analyse des expressions d'un commentaire want.
+func Test_parseWantPatterns(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		expected  []string
+		expectErr bool
+	}{
+		{name: "quoted regexp", text: `"KTN-FUNC-005: .*"`, expected: []string{"KTN-FUNC-005: .*"}},
+		{name: "raw regexps", text: "`first` `second`", expected: []string{"first", "second"}},
+		{name: "escaped quote", text: `"say \"hi\""`, expected: []string{`say "hi"`}},
+		{name: "empty comment", text: "", expectErr: true},
+		{name: "not a string", text: "KTN", expectErr: true},
+		{name: "invalid regexp", text: `"("`, expectErr: true},
+		{name: "unterminated string", text: `"abc`, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			patterns, err := parseWantPatterns(tt.text)
+			// Vérification de l'erreur
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("parseWantPatterns(%q) error = %v, expectErr %v", tt.text, err, tt.expectErr)
+			}
+			// Vérification du nombre d'expressions
+			if len(patterns) != len(tt.expected) {
+				t.Fatalf("parseWantPatterns(%q) = %d patterns, want %d", tt.text, len(patterns), len(tt.expected))
+			}
+			// Vérification de chaque expression
+			for index, pattern := range patterns {
+				// Comparaison du source de l'expression
+				if pattern.String() != tt.expected[index] {
+					t.Errorf("pattern %d = %q, want %q", index, pattern, tt.expected[index])
+				}
+			}
+		})
+	}
+}
+
+// Test_collectWants teste l'extraction des attentes des commentaires.
+func Test_collectWants(t *testing.T) {
+	tests := []struct {
+		name        string
+		src         string
+		expected    int
+		expectFatal bool
+	}{
+		{name: "no want", src: "package p\n\n// Comment ordinaire.\nfunc f() {}\n", expected: 0},
+		{name: "one want per regexp", src: "package p\n\nfunc f() {} // want \"a\" `b`\n", expected: 2},
+		{name: "block comment ignored", src: "package p\n\nfunc f() {} /* want \"a\" */\n", expected: 0},
+		{name: "invalid want", src: "package p\n\nfunc f() {} // want a\n", expectFatal: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "p.go", tt.src, parser.ParseComments)
+			// Vérification du parsing
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			mockT := &MockTestingT{}
+			wants := collectWants(mockT, fset, []*ast.File{file})
+			// Vérification de l'échec attendu
+			if mockT.FatalfCalled != tt.expectFatal {
+				t.Errorf("Fatalf called = %v, want %v", mockT.FatalfCalled, tt.expectFatal)
+			}
+			// Vérification du nombre d'attentes
+			if len(wants) != tt.expected {
+				t.Errorf("collectWants() = %d, want %d", len(wants), tt.expected)
+			}
+		})
+	}
+}
+
+// Test_checkWants teste la confrontation des diagnostics aux attentes.
+func Test_checkWants(t *testing.T) {
+	tests := []struct {
+		name        string
+		line        int
+		message     string
+		pattern     string
+		expectError bool
+	}{
+		{name: "matching diagnostic", line: 3, message: "KTN-FUNC-005: too long", pattern: "FUNC-005"},
+		{name: "other line", line: 4, message: "KTN-FUNC-005: too long", pattern: "FUNC-005", expectError: true},
+		{name: "other message", line: 3, message: "KTN-FUNC-001: error last", pattern: "FUNC-005", expectError: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file := fset.AddFile("p.go", -1, 100)
+			file.SetLines([]int{0, 10, 20, 30, 40})
+			wants := []*expectation{{
+				position: token.Position{Filename: "p.go", Line: 3},
+				pattern:  regexp.MustCompile(tt.pattern),
+			}}
+			diagnostics := []analysis.Diagnostic{{Pos: file.LineStart(tt.line), Message: tt.message}}
+			mockT := &MockTestingT{}
+			checkWants(mockT, fset, diagnostics, wants)
+			// Vérification du signalement
+			if mockT.ErrorfCalled != tt.expectError {
+				t.Errorf("Errorf called = %v, want %v", mockT.ErrorfCalled, tt.expectError)
+			}
+		})
+	}
+}
+
+// Test_consumeWant teste la consommation unique d'une attente.
+func Test_consumeWant(t *testing.T) {
+	tests := []struct {
+		name     string
+		calls    int
+		expected []bool
+	}{
+		{name: "each want matches once", calls: 2, expected: []bool{true, false}},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			position := token.Position{Filename: "p.go", Line: 1}
+			wants := []*expectation{{position: position, pattern: regexp.MustCompile("x")}}
+			// Appels successifs sur la même attente
+			for call := range tt.calls {
+				// Vérification du résultat de chaque appel
+				if got := consumeWant(wants, position, "x"); got != tt.expected[call] {
+					t.Errorf("call %d: consumeWant() = %v, want %v", call, got, tt.expected[call])
+				}
+			}
+		})
+	}
+}
@@ -6,6 +6,10 @@ import (
 	"golang.org/x/tools/go/analysis/passes/modernize"
 )
 
+// RemediationMinutes est l'effort de correction d'une violation, en minutes
+// (appliquer une modernisation mécanique).
+const RemediationMinutes int = 2
+
 // Analyzers retourne tous les analyseurs modernize recommandés.
 //
 // Returns:
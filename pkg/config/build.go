@@ -0,0 +1,112 @@
+// Package config provides configuration management for KTN linter rules.
+package config
+
+import (
+	"strings"
+)
+
+// BuildConfig describes one build configuration analyzed by the linter.
+// Empty fields fall back to the host GOOS, GOARCH and no extra tags.
+type BuildConfig struct {
+	// Name is an optional label shown next to findings of this configuration
+	Name string `yaml:"name,omitempty"`
+
+	// GOOS is the target operating system (e.g. linux, windows)
+	GOOS string `yaml:"goos,omitempty"`
+
+	// GOARCH is the target architecture (e.g. amd64, arm64)
+	GOARCH string `yaml:"goarch,omitempty"`
+
+	// Tags are additional build tags (e.g. integration)
+	Tags []string `yaml:"tags,omitempty"`
+}
+
+// Label returns a short human-readable name for the configuration.
+//
+// Returns:
+//   - string: Name when set, otherwise "goos[/goarch]" and the tags
+func (b *BuildConfig) Label() string {
+	// Prefer the explicit name
+	if b.Name != "" {
+		// Return user-provided label
+		return b.Name
+	}
+
+	parts := make([]string, 0, len(b.Tags)+1)
+	// Describe the target platform when overridden
+	switch {
+	// Operating system and architecture
+	case b.GOARCH != "":
+		parts = append(parts, orHost(b.GOOS)+"/"+b.GOARCH)
+	// Operating system alone, on the host architecture
+	case b.GOOS != "":
+		parts = append(parts, b.GOOS)
+	}
+	// Describe extra build tags
+	if len(b.Tags) > 0 {
+		parts = append(parts, "tags="+strings.Join(b.Tags, ","))
+	}
+
+	// Check for the host configuration
+	if len(parts) == 0 {
+		// Return host label
+		return "host"
+	}
+
+	// Return composed label
+	return strings.Join(parts, " ")
+}
+
+// IsHost reports whether the configuration matches the default host build.
+//
+// Returns:
+//   - bool: true when no platform or tag is overridden
+func (b *BuildConfig) IsHost() bool {
+	// Return true when nothing is overridden
+	return b.GOOS == "" && b.GOARCH == "" && len(b.Tags) == 0
+}
+
+// orHost returns value, or "host" when it is empty.
+//
+// Params:
+//   - value: GOOS value
+//
+// Returns:
+//   - string: value or "host"
+func orHost(value string) string {
+	// Check for empty value
+	if value == "" {
+		// Return host placeholder
+		return "host"
+	}
+
+	// Return value unchanged
+	return value
+}
+
+// BuildConfigurations returns the build configurations to analyze.
+// The host configuration always comes first, followed by build_matrix.
+//
+// Returns:
+//   - []BuildConfig: configurations, empty when build_matrix is empty
+func (c *Config) BuildConfigurations() []BuildConfig {
+	// Host only without a build matrix
+	if len(c.BuildMatrix) == 0 {
+		// Return no configuration to keep the default loading
+		return []BuildConfig{}
+	}
+
+	builds := make([]BuildConfig, 0, len(c.BuildMatrix)+1)
+	builds = append(builds, BuildConfig{})
+	// Drop the implicit host entry when listed explicitly
+	for i := range c.BuildMatrix {
+		// Check for an explicit host configuration
+		if c.BuildMatrix[i].IsHost() {
+			builds = builds[:0]
+			break
+		}
+	}
+
+	// Return host followed by the matrix
+	return append(builds, c.BuildMatrix...)
+}
@@ -0,0 +1,82 @@
+package config_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+)
+
+func TestBuildConfig_Label(t *testing.T) {
+	tests := []struct {
+		name  string
+		build config.BuildConfig
+		want  string
+	}{
+		{"host", config.BuildConfig{}, "host"},
+		{"explicit name", config.BuildConfig{Name: "win", GOOS: "windows"}, "win"},
+		{"goos only", config.BuildConfig{GOOS: "windows"}, "windows"},
+		{"goos and goarch", config.BuildConfig{GOOS: "linux", GOARCH: "arm64"}, "linux/arm64"},
+		{"goarch only", config.BuildConfig{GOARCH: "arm64"}, "host/arm64"},
+		{"tags only", config.BuildConfig{Tags: []string{"integration", "e2e"}}, "tags=integration,e2e"},
+		{"platform and tags", config.BuildConfig{GOOS: "linux", Tags: []string{"integration"}}, "linux tags=integration"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.build.Label(); got != tt.want {
+				t.Errorf("Label() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildConfig_IsHost(t *testing.T) {
+	tests := []struct {
+		name  string
+		build config.BuildConfig
+		want  bool
+	}{
+		{"empty", config.BuildConfig{}, true},
+		{"name only", config.BuildConfig{Name: "default"}, true},
+		{"goos", config.BuildConfig{GOOS: "windows"}, false},
+		{"tags", config.BuildConfig{Tags: []string{"integration"}}, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.build.IsHost(); got != tt.want {
+				t.Errorf("IsHost() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_BuildConfigurations(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix []config.BuildConfig
+		want   []string
+	}{
+		{"no matrix", nil, nil},
+		{"host added first", []config.BuildConfig{{GOOS: "windows"}}, []string{"host", "windows"}},
+		{"explicit host kept in place", []config.BuildConfig{{GOOS: "windows"}, {Name: "native"}}, []string{"windows", "native"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			cfg.BuildMatrix = tt.matrix
+			var got []string
+			for _, build := range cfg.BuildConfigurations() {
+				got = append(got, build.Label())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("BuildConfigurations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
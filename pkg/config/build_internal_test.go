@@ -0,0 +1,23 @@
+package config
+
+import "testing"
+
+func Test_orHost(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"empty", "", "host"},
+		{"set", "linux", "linux"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := orHost(tt.value); got != tt.want {
+				t.Errorf("orHost(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 )
 
 const (
@@ -26,6 +27,12 @@ type Config struct {
 	// Exclude contains global file exclusion patterns (apply to all rules)
 	Exclude []string `yaml:"exclude,omitempty"`
 
+	// SkipDirs lists gitignore-style patterns of directories never linted:
+	// module discovery does not enter them and their packages are dropped.
+	// Anchored patterns are relative to the working directory. Hidden,
+	// vendor and testdata directories are always skipped by discovery.
+	SkipDirs []string `yaml:"skip_dirs,omitempty"`
+
 	// Rules contains per-rule configuration
 	Rules map[string]*RuleConfig `yaml:"rules,omitempty"`
 
@@ -34,6 +41,34 @@ type Config struct {
 	// Set to true to run all rules on test files (useful for debugging).
 	ForceAllRulesOnTests bool `yaml:"force_all_rules_on_tests,omitempty"`
 
+	// BuildMatrix lists build configurations analyzed in addition to the host
+	// one. Each entry is loaded and type-checked separately and findings are
+	// merged, so files behind //go:build constraints are linted too.
+	BuildMatrix []BuildConfig `yaml:"build_matrix,omitempty"`
+
+	// AnalyzerTimeout bounds the run of one analyzer on one package, as a
+	// Go duration (e.g. "30s"). Analyzers running longer are abandoned and
+	// reported as KTN-INTERNAL-002. Empty or "0" disables the deadline.
+	AnalyzerTimeout string `yaml:"analyzer_timeout,omitempty"`
+
+	// Generated selects how files with the official "Code generated ...
+	// DO NOT EDIT." header are linted: "report" (default), "skip" or
+	// "only-comment-rules".
+	Generated string `yaml:"generated,omitempty"`
+
+	// GeneratedSource labels findings in generated files with the
+	// //go:generate directive of their package that produces them.
+	GeneratedSource bool `yaml:"generated_source,omitempty"`
+
+	// CustomRules declares pattern rules compiled into analyzers at startup
+	CustomRules []CustomRuleConfig `yaml:"custom_rules,omitempty"`
+
+	// HotPaths defines where performance findings matter most
+	HotPaths HotPathConfig `yaml:"hot_paths,omitempty"`
+
+	// Update configures the release source of the upgrade command
+	Update UpdateConfig `yaml:"update,omitempty"`
+
 	// Verbose enables verbose message output with examples
 	Verbose bool `yaml:"-"`
 
@@ -54,6 +89,12 @@ type RuleConfig struct {
 
 	// Exclude contains rule-specific file exclusion patterns
 	Exclude []string `yaml:"exclude,omitempty"`
+
+	// ExcludeSymbols contains qualified declarations exempted from the rule
+	ExcludeSymbols []string `yaml:"exclude_symbols,omitempty"`
+
+	// ExcludePackages contains import paths exempted from the rule
+	ExcludePackages []string `yaml:"exclude_packages,omitempty"`
 }
 
 // DefaultConfig returns the default configuration.
@@ -415,12 +456,44 @@ func (c *Config) Merge(other *Config) {
 					existing.Threshold = ruleCfg.Threshold
 				}
 				existing.Exclude = append(existing.Exclude, ruleCfg.Exclude...)
+				existing.ExcludeSymbols = append(existing.ExcludeSymbols, ruleCfg.ExcludeSymbols...)
+				existing.ExcludePackages = append(existing.ExcludePackages, ruleCfg.ExcludePackages...)
 			} else {
 				// Add new rule
 				c.Rules[code] = ruleCfg
 			}
 		}
 	}
+
+	// Merge custom rules, replacing declarations with the same code
+	for _, rule := range other.CustomRules {
+		// Replace existing declaration
+		if existing := c.CustomRule(rule.Code); existing != nil {
+			*existing = rule
+			continue
+		}
+		c.CustomRules = append(c.CustomRules, rule)
+	}
+}
+
+// AnalyzerDeadline returns the per-analyzer deadline.
+//
+// Returns:
+//   - time.Duration: deadline, 0 when disabled or invalid
+func (c *Config) AnalyzerDeadline() time.Duration {
+	// Check for a disabled deadline
+	if c == nil || c.AnalyzerTimeout == "" {
+		// Return no deadline
+		return 0
+	}
+	deadline, err := time.ParseDuration(c.AnalyzerTimeout)
+	// Ignore invalid durations, rejected when the file is loaded
+	if err != nil || deadline < 0 {
+		// Return no deadline
+		return 0
+	}
+	// Return configured deadline
+	return deadline
 }
 
 // Bool is a helper to create a pointer to a bool.
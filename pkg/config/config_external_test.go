@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kodflow/ktn-linter/pkg/config"
 )
@@ -567,3 +568,26 @@ func TestInt(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_AnalyzerDeadline(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *config.Config
+		want time.Duration
+	}{
+		{name: "nil config", cfg: nil, want: 0},
+		{name: "unset", cfg: &config.Config{}, want: 0},
+		{name: "valid", cfg: &config.Config{AnalyzerTimeout: "1m30s"}, want: 90 * time.Second},
+		{name: "invalid", cfg: &config.Config{AnalyzerTimeout: "soon"}, want: 0},
+		{name: "negative", cfg: &config.Config{AnalyzerTimeout: "-5s"}, want: 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.AnalyzerDeadline(); got != tt.want {
+				t.Errorf("AnalyzerDeadline() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
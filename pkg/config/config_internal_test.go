@@ -281,6 +281,41 @@ func TestMerge(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "merge custom rules",
+			base: &Config{
+				CustomRules: []CustomRuleConfig{{Code: "ACME-A-001", Message: "old"}},
+			},
+			other: &Config{
+				CustomRules: []CustomRuleConfig{{Code: "ACME-A-001", Message: "new"}, {Code: "ACME-B-001"}},
+			},
+			check: func(t *testing.T, cfg *Config) {
+				if len(cfg.CustomRules) != 2 {
+					t.Fatalf("Expected 2 custom rules, got %d", len(cfg.CustomRules))
+				}
+				if cfg.CustomRule("ACME-A-001").Message != "new" {
+					t.Error("ACME-A-001 should be replaced")
+				}
+			},
+		},
+		{
+			name: "merge symbol exclusions",
+			base: &Config{
+				Rules: map[string]*RuleConfig{
+					"RULE-1": {ExcludeSymbols: []string{"New*"}},
+				},
+			},
+			other: &Config{
+				Rules: map[string]*RuleConfig{
+					"RULE-1": {ExcludeSymbols: []string{"*.ServeHTTP"}, ExcludePackages: []string{"gen/..."}},
+				},
+			},
+			check: func(t *testing.T, cfg *Config) {
+				if len(cfg.Rules["RULE-1"].ExcludeSymbols) != 2 || len(cfg.Rules["RULE-1"].ExcludePackages) != 1 {
+					t.Errorf("Expected merged exclusions, got %+v", cfg.Rules["RULE-1"])
+				}
+			},
+		},
 		{
 			name: "merge exclusions",
 			base: &Config{
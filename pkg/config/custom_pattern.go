@@ -0,0 +1,63 @@
+// Package config provides configuration management for KTN linter rules.
+package config
+
+import "cmp"
+
+// CustomPattern selects the Go constructs reported by a custom rule.
+// Exactly one field must be set.
+type CustomPattern struct {
+	// Call matches calls to a fully qualified function or method
+	// (e.g. "time.Sleep", "(*database/sql.DB).Query")
+	Call string `yaml:"call,omitempty"`
+
+	// Selector matches a field or method selected on a named type
+	// (e.g. "net/http.Request.Body")
+	Selector string `yaml:"selector,omitempty"`
+
+	// Import matches an import path; a trailing "/..." also matches
+	// sub-packages (e.g. "io/ioutil", "github.com/pkg/errors/...")
+	Import string `yaml:"import,omitempty"`
+
+	// Ident matches declared identifiers against a regular expression
+	// (e.g. "^tmp[A-Z]")
+	Ident string `yaml:"ident,omitempty"`
+
+	// Compare matches == and != between non-nil operands of a type
+	// (e.g. "error", "net/http.Header")
+	Compare string `yaml:"compare,omitempty"`
+}
+
+// Kinds returns the names of the pattern fields that are set.
+//
+// Returns:
+//   - []string: yaml names of the set fields
+func (p *CustomPattern) Kinds() []string {
+	kinds := make([]string, 0, customPatternKindCount)
+	// Collect every set field
+	for _, field := range []struct {
+		name  string
+		value string
+	}{
+		{name: "call", value: p.Call},
+		{name: "selector", value: p.Selector},
+		{name: "import", value: p.Import},
+		{name: "ident", value: p.Ident},
+		{name: "compare", value: p.Compare},
+	} {
+		// Keep set fields only
+		if field.value != "" {
+			kinds = append(kinds, field.name)
+		}
+	}
+	// Return set kinds
+	return kinds
+}
+
+// Value returns the value of the set pattern field.
+//
+// Returns:
+//   - string: first set field value, empty when none is set
+func (p *CustomPattern) Value() string {
+	// Return first set field
+	return cmp.Or(p.Call, p.Selector, p.Import, p.Ident, p.Compare)
+}
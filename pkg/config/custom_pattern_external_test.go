@@ -0,0 +1,51 @@
+package config_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+)
+
+func TestCustomPattern_Kinds(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern config.CustomPattern
+		want    []string
+	}{
+		{"none", config.CustomPattern{}, []string{}},
+		{"call", config.CustomPattern{Call: "time.Sleep"}, []string{"call"}},
+		{"ident", config.CustomPattern{Ident: "^tmp"}, []string{"ident"}},
+		{"several", config.CustomPattern{Import: "io/ioutil", Compare: "error"}, []string{"import", "compare"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pattern.Kinds(); !slices.Equal(got, tt.want) {
+				t.Errorf("Kinds() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCustomPattern_Value(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern config.CustomPattern
+		want    string
+	}{
+		{"none", config.CustomPattern{}, ""},
+		{"selector", config.CustomPattern{Selector: "net/http.Request.Body"}, "net/http.Request.Body"},
+		{"compare", config.CustomPattern{Compare: "error"}, "error"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pattern.Value(); got != tt.want {
+				t.Errorf("Value() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+// Package config provides configuration management for KTN linter rules.
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/kodflow/ktn-linter/pkg/rulecode"
+	"github.com/kodflow/ktn-linter/pkg/severity"
+)
+
+const (
+	// customPatternKindCount is the number of custom pattern kinds.
+	customPatternKindCount int = 5
+	// matchPlaceholder is replaced by the matched text in custom messages.
+	matchPlaceholder string = "{match}"
+)
+
+// CustomRuleConfig declares a rule from a pattern instead of Go code.
+// Custom rules are compiled into analyzers at startup and then behave like
+// built-in rules: rules.<code> options, severity, formatters and prompts.
+type CustomRuleConfig struct {
+	// Code is the rule code (e.g. "ACME-LOG-001"); its middle part is the
+	// category, created when it does not exist yet
+	Code string `yaml:"code"`
+
+	// Message is reported for each match; "{match}" is replaced by the
+	// matched text
+	Message string `yaml:"message"`
+
+	// Severity is "info", "warning" (default) or "error"
+	Severity string `yaml:"severity,omitempty"`
+
+	// Pattern selects the reported Go constructs
+	Pattern CustomPattern `yaml:"pattern"`
+
+	// Paths restricts the rule to files matching one of these patterns
+	// (default: every file)
+	Paths []string `yaml:"paths,omitempty"`
+
+	// Exclude skips files matching one of these patterns
+	Exclude []string `yaml:"exclude,omitempty"`
+}
+
+// Level returns the severity level of the rule.
+//
+// Returns:
+//   - severity.Level: configured level, warning when unset or unknown
+func (r *CustomRuleConfig) Level() severity.Level {
+	level, _ := severity.ParseLevel(r.Severity)
+	// Return parsed level (warning by default)
+	return level
+}
+
+// Format builds the message reported for a match.
+//
+// Params:
+//   - match: matched text
+//
+// Returns:
+//   - string: "CODE: message" with the placeholder replaced
+func (r *CustomRuleConfig) Format(match string) string {
+	// Return prefixed message
+	return r.Code + ": " + strings.ReplaceAll(r.Message, matchPlaceholder, match)
+}
+
+// CustomRule returns the custom rule declared with a code.
+//
+// Params:
+//   - code: rule code
+//
+// Returns:
+//   - *CustomRuleConfig: declared rule, nil when absent
+func (c *Config) CustomRule(code string) *CustomRuleConfig {
+	// Check nil config
+	if c == nil {
+		// No custom rules
+		return nil
+	}
+	// Search declared rules
+	for i := range c.CustomRules {
+		// Match the code
+		if c.CustomRules[i].Code == code {
+			// Return declared rule
+			return &c.CustomRules[i]
+		}
+	}
+	// Rule not declared
+	return nil
+}
+
+// CustomRuleApplies checks whether a custom rule analyzes a file, given its
+// path scopes and the rule and global exclusions.
+//
+// Params:
+//   - rule: custom rule
+//   - filename: file path to check
+//
+// Returns:
+//   - bool: true if the rule analyzes the file
+func (c *Config) CustomRuleApplies(rule *CustomRuleConfig, filename string) bool {
+	// Check configured exclusions and the rule scope
+	if c.IsFileExcluded(rule.Code, filename) || c.matchesAnyPattern(filename, rule.Exclude) {
+		// Excluded file
+		return false
+	}
+	// Return whether the file is in the rule paths
+	return len(rule.Paths) == 0 || c.matchesAnyPattern(filename, rule.Paths)
+}
+
+// validateCustomRules validates the custom rule declarations.
+//
+// Params:
+//   - customRules: declared custom rules
+//
+// Returns:
+//   - error: first invalid declaration, nil when all are valid
+func validateCustomRules(customRules []CustomRuleConfig) error {
+	seen := make(map[string]bool, len(customRules))
+	// Validate each declaration
+	for i := range customRules {
+		rule := &customRules[i]
+		// Check the code is unique
+		if seen[rule.Code] {
+			// Return duplicate error
+			return fmt.Errorf("custom_rules[%d]: duplicate code %s", i, rule.Code)
+		}
+		seen[rule.Code] = true
+		// Check the declaration itself
+		if err := validateCustomRule(rule); err != nil {
+			// Return declaration error
+			return fmt.Errorf("custom_rules[%d]: %w", i, err)
+		}
+	}
+	// All declarations are valid
+	return nil
+}
+
+// validateCustomRule validates one custom rule declaration.
+//
+// Params:
+//   - rule: custom rule
+//
+// Returns:
+//   - error: invalid field, nil when valid
+func validateCustomRule(rule *CustomRuleConfig) error {
+	// Check the code shape
+	if !rulecode.IsWellFormed(rule.Code) {
+		// Return code error
+		return fmt.Errorf("invalid code %q, expected PREFIX-CATEGORY-ID in upper case", rule.Code)
+	}
+	// Check the message
+	if strings.TrimSpace(rule.Message) == "" {
+		// Return message error
+		return fmt.Errorf("%s: empty message", rule.Code)
+	}
+	// Check the severity name
+	if _, ok := severity.ParseLevel(rule.Severity); rule.Severity != "" && !ok {
+		// Return severity error
+		return fmt.Errorf("%s: unknown severity %q, expected info, warning or error", rule.Code, rule.Severity)
+	}
+	// Check path scopes
+	if slices.Contains(rule.Paths, "") || slices.Contains(rule.Exclude, "") {
+		// Return pattern error
+		return fmt.Errorf("%s: empty path pattern", rule.Code)
+	}
+	// Check exactly one pattern is set
+	if kinds := rule.Pattern.Kinds(); len(kinds) != 1 {
+		// Return pattern error
+		return fmt.Errorf("%s: exactly one of call, selector, import, ident or compare must be set, got %d", rule.Code, len(kinds))
+	}
+	// Check the identifier expression compiles
+	if _, err := regexp.Compile(rule.Pattern.Ident); err != nil {
+		// Return expression error
+		return fmt.Errorf("%s: invalid ident pattern: %w", rule.Code, err)
+	}
+	// Valid declaration
+	return nil
+}
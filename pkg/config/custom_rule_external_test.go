@@ -0,0 +1,111 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"github.com/kodflow/ktn-linter/pkg/severity"
+)
+
+func TestCustomRuleConfig_Level(t *testing.T) {
+	tests := []struct {
+		name     string
+		severity string
+		want     severity.Level
+	}{
+		{"default", "", severity.SeverityWarning},
+		{"error", "error", severity.SeverityError},
+		{"info", "INFO", severity.SeverityInfo},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			rule := config.CustomRuleConfig{Severity: tt.severity}
+			if got := rule.Level(); got != tt.want {
+				t.Errorf("Level() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCustomRuleConfig_Format(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		match   string
+		want    string
+	}{
+		{"placeholder", "{match} is forbidden", "time.Sleep", "ACME-TIME-001: time.Sleep is forbidden"},
+		{"plain message", "use a ticker", "time.Sleep", "ACME-TIME-001: use a ticker"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			rule := config.CustomRuleConfig{Code: "ACME-TIME-001", Message: tt.message}
+			if got := rule.Format(tt.match); got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_CustomRule(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.CustomRules = []config.CustomRuleConfig{{Code: "ACME-TIME-001"}, {Code: "ACME-LOG-001"}}
+	tests := []struct {
+		name  string
+		cfg   *config.Config
+		code  string
+		found bool
+	}{
+		{"declared", cfg, "ACME-LOG-001", true},
+		{"undeclared", cfg, "ACME-LOG-002", false},
+		{"nil config", nil, "ACME-LOG-001", false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.cfg.CustomRule(tt.code)
+			if (got != nil) != tt.found {
+				t.Fatalf("CustomRule(%q) = %v, want found %v", tt.code, got, tt.found)
+			}
+			if got != nil && got.Code != tt.code {
+				t.Errorf("CustomRule(%q).Code = %q", tt.code, got.Code)
+			}
+		})
+	}
+}
+
+func TestConfig_CustomRuleApplies(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Exclude = []string{"**/vendor/**"}
+	cfg.Rules["ACME-TIME-001"] = &config.RuleConfig{Exclude: []string{"*_gen.go"}}
+	rule := &config.CustomRuleConfig{
+		Code:    "ACME-TIME-001",
+		Paths:   []string{"internal/**"},
+		Exclude: []string{"internal/legacy/**"},
+	}
+	tests := []struct {
+		name     string
+		filename string
+		want     bool
+	}{
+		{"in paths", "internal/service/run.go", true},
+		{"outside paths", "cmd/main.go", false},
+		{"rule scope exclusion", "internal/legacy/old.go", false},
+		{"rules exclusion", "internal/service/model_gen.go", false},
+		{"global exclusion", "internal/vendor/lib/x.go", false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cfg.CustomRuleApplies(rule, tt.filename); got != tt.want {
+				t.Errorf("CustomRuleApplies(%q) = %v, want %v", tt.filename, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_validateCustomRules(t *testing.T) {
+	valid := CustomRuleConfig{Code: "ACME-TIME-001", Message: "no sleep", Pattern: CustomPattern{Call: "time.Sleep"}}
+	tests := []struct {
+		name    string
+		rules   []CustomRuleConfig
+		wantErr string
+	}{
+		{"no rules", nil, ""},
+		{"valid rule", []CustomRuleConfig{valid}, ""},
+		{"duplicate code", []CustomRuleConfig{valid, valid}, "custom_rules[1]: duplicate code ACME-TIME-001"},
+		{"invalid rule", []CustomRuleConfig{valid, {Code: "time"}}, "custom_rules[1]: invalid code"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCustomRules(tt.rules)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validateCustomRules() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validateCustomRules() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_validateCustomRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    CustomRuleConfig
+		wantErr string
+	}{
+		{"valid", CustomRuleConfig{Code: "ACME-TIME-001", Message: "m", Severity: "error", Pattern: CustomPattern{Call: "time.Sleep"}}, ""},
+		{"lower case code", CustomRuleConfig{Code: "acme-time-001", Message: "m", Pattern: CustomPattern{Call: "time.Sleep"}}, "invalid code"},
+		{"empty message", CustomRuleConfig{Code: "ACME-TIME-001", Message: " ", Pattern: CustomPattern{Call: "time.Sleep"}}, "empty message"},
+		{"unknown severity", CustomRuleConfig{Code: "ACME-TIME-001", Message: "m", Severity: "fatal", Pattern: CustomPattern{Call: "time.Sleep"}}, "unknown severity"},
+		{"empty path", CustomRuleConfig{Code: "ACME-TIME-001", Message: "m", Paths: []string{""}, Pattern: CustomPattern{Call: "time.Sleep"}}, "empty path pattern"},
+		{"no pattern", CustomRuleConfig{Code: "ACME-TIME-001", Message: "m"}, "got 0"},
+		{"two patterns", CustomRuleConfig{Code: "ACME-TIME-001", Message: "m", Pattern: CustomPattern{Call: "time.Sleep", Import: "time"}}, "got 2"},
+		{"invalid ident", CustomRuleConfig{Code: "ACME-TIME-001", Message: "m", Pattern: CustomPattern{Ident: "("}}, "invalid ident pattern"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCustomRule(&tt.rule)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validateCustomRule() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validateCustomRule() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Package config provides configuration management for KTN linter rules.
+package config
+
+import (
+	"cmp"
+	"fmt"
+)
+
+const (
+	// GeneratedReport lints generated files like hand-written ones (default)
+	GeneratedReport string = "report"
+	// GeneratedSkip never lints generated files
+	GeneratedSkip string = "skip"
+	// GeneratedOnlyCommentRules lints generated files with KTN-COMMENT rules only
+	GeneratedOnlyCommentRules string = "only-comment-rules"
+)
+
+// GeneratedPolicy returns how files marked "Code generated ... DO NOT EDIT."
+// are linted.
+//
+// Returns:
+//   - string: GeneratedReport, GeneratedSkip or GeneratedOnlyCommentRules
+func (c *Config) GeneratedPolicy() string {
+	// Check nil config
+	if c == nil {
+		// Return default policy
+		return GeneratedReport
+	}
+	// Return configured policy, report by default
+	return cmp.Or(c.Generated, GeneratedReport)
+}
+
+// validateGenerated checks the generated-code policy.
+//
+// Params:
+//   - policy: configured policy, empty for the default
+//
+// Returns:
+//   - error: unknown policy
+func validateGenerated(policy string) error {
+	// Check known policies
+	switch policy {
+	// Default and known policies
+	case "", GeneratedReport, GeneratedSkip, GeneratedOnlyCommentRules:
+		// Return valid
+		return nil
+	// Unknown policy
+	default:
+		// Return invalid policy error
+		return fmt.Errorf("generated: invalid policy %q (want %s, %s or %s)", policy, GeneratedReport, GeneratedSkip, GeneratedOnlyCommentRules)
+	}
+}
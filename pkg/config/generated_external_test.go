@@ -0,0 +1,29 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+)
+
+func TestConfig_GeneratedPolicy(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *config.Config
+		want string
+	}{
+		{"nil config", nil, config.GeneratedReport},
+		{"default", &config.Config{}, config.GeneratedReport},
+		{"skip", &config.Config{Generated: config.GeneratedSkip}, config.GeneratedSkip},
+		{"only comment rules", &config.Config{Generated: config.GeneratedOnlyCommentRules}, config.GeneratedOnlyCommentRules},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.GeneratedPolicy(); got != tt.want {
+				t.Errorf("GeneratedPolicy() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
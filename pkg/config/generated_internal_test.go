@@ -0,0 +1,26 @@
+package config
+
+import "testing"
+
+func Test_validateGenerated(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		wantErr bool
+	}{
+		{"default", "", false},
+		{"report", GeneratedReport, false},
+		{"skip", GeneratedSkip, false},
+		{"only comment rules", GeneratedOnlyCommentRules, false},
+		{"unknown", "never", true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateGenerated(tt.policy); (err != nil) != tt.wantErr {
+				t.Errorf("validateGenerated(%q) error = %v, wantErr %v", tt.policy, err, tt.wantErr)
+			}
+		})
+	}
+}
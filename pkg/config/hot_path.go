@@ -0,0 +1,118 @@
+// Package config provides configuration management for KTN linter rules.
+package config
+
+import (
+	"cmp"
+	"fmt"
+	"path"
+)
+
+const (
+	// DefaultHotProfileThreshold is the CPU share, in percent, above which a
+	// function of the profile is hot
+	DefaultHotProfileThreshold float64 = 1
+	// percent converts a percentage to a fraction
+	percent float64 = 100
+)
+
+// HotPathConfig defines the hot paths of the performance rules: their
+// findings are escalated inside hot functions and demoted elsewhere.
+// Functions annotated //ktn:hot and HTTP handlers are always hot once a
+// definition is active.
+type HotPathConfig struct {
+	// Enabled turns on classification with annotations and HTTP handlers only
+	Enabled bool `yaml:"enabled,omitempty"`
+
+	// Functions are hot symbol patterns, with the exclude_symbols syntax
+	Functions []string `yaml:"functions,omitempty"`
+
+	// Profile is a pprof CPU profile (e.g. cpu.pprof or default.pgo)
+	Profile string `yaml:"profile,omitempty"`
+
+	// ProfileThreshold is the minimal CPU share in percent (default: 1)
+	ProfileThreshold float64 `yaml:"profile_threshold,omitempty"`
+}
+
+// HotPathsEnabled reports whether performance findings are classified by
+// hot path.
+//
+// Returns:
+//   - bool: true when hot_paths is enabled or defines functions or a profile
+func (c *Config) HotPathsEnabled() bool {
+	// Check nil config
+	if c == nil {
+		// Return disabled
+		return false
+	}
+	hot := c.HotPaths
+	// Return whether a definition is present
+	return hot.Enabled || len(hot.Functions) > 0 || hot.Profile != ""
+}
+
+// HotFunctionPattern returns the hot_paths.functions pattern matching a
+// declaration.
+//
+// Params:
+//   - pkgPath: import path of the analyzed package
+//   - symbol: enclosing declaration ("Type.Method")
+//
+// Returns:
+//   - string: matching pattern, empty when none
+func (c *Config) HotFunctionPattern(pkgPath, symbol string) string {
+	// Check nil config and file-level findings
+	if c == nil || symbol == "" {
+		// Return no match
+		return ""
+	}
+	// Try each pattern
+	for _, pattern := range c.HotPaths.Functions {
+		matched, _ := path.Match(pattern, symbol)
+		// Check bare and qualified symbol
+		if matched || matchesSuffix(pkgPath+"."+symbol, pattern) {
+			// Return matching pattern
+			return pattern
+		}
+	}
+	// Return no match
+	return ""
+}
+
+// HotProfileThreshold returns the CPU share above which a function of the
+// profile is hot.
+//
+// Returns:
+//   - float64: fraction between 0 and 1
+func (c *Config) HotProfileThreshold() float64 {
+	// Check nil config
+	if c == nil {
+		// Return default threshold
+		return DefaultHotProfileThreshold / percent
+	}
+	// Return configured threshold, 1% by default
+	return cmp.Or(c.HotPaths.ProfileThreshold, DefaultHotProfileThreshold) / percent
+}
+
+// validateHotPaths checks the hot path definition.
+//
+// Params:
+//   - hot: configured hot paths
+//
+// Returns:
+//   - error: malformed pattern or threshold out of range
+func validateHotPaths(hot HotPathConfig) error {
+	// Check each pattern
+	for _, pattern := range hot.Functions {
+		// Reject empty and malformed patterns
+		if _, err := path.Match(pattern, ""); pattern == "" || err != nil {
+			// Return pattern error
+			return fmt.Errorf("hot_paths.functions: invalid pattern %q", pattern)
+		}
+	}
+	// Check threshold range
+	if hot.ProfileThreshold < 0 || hot.ProfileThreshold > percent {
+		// Return threshold error
+		return fmt.Errorf("hot_paths.profile_threshold: must be between 0 and 100, got %v", hot.ProfileThreshold)
+	}
+	// Return valid
+	return nil
+}
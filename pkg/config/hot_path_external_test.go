@@ -0,0 +1,77 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+)
+
+func TestConfig_HotPathsEnabled(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *config.Config
+		want bool
+	}{
+		{"nil config", nil, false},
+		{"no definition", &config.Config{}, false},
+		{"enabled", &config.Config{HotPaths: config.HotPathConfig{Enabled: true}}, true},
+		{"functions", &config.Config{HotPaths: config.HotPathConfig{Functions: []string{"Encode*"}}}, true},
+		{"profile", &config.Config{HotPaths: config.HotPathConfig{Profile: "cpu.pprof"}}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.HotPathsEnabled(); got != tt.want {
+				t.Errorf("HotPathsEnabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_HotFunctionPattern(t *testing.T) {
+	cfg := &config.Config{HotPaths: config.HotPathConfig{Functions: []string{"api.*Handler.*", "Encode*"}}}
+	tests := []struct {
+		name    string
+		cfg     *config.Config
+		pkgPath string
+		symbol  string
+		want    string
+	}{
+		{"nil config", nil, "example.com/app/api", "Encode", ""},
+		{"bare symbol", cfg, "example.com/app/codec", "EncodeFrame", "Encode*"},
+		{"qualified symbol", cfg, "example.com/app/api", "UserHandler.List", "api.*Handler.*"},
+		{"other package", cfg, "example.com/app/store", "UserHandler.List", ""},
+		{"file level", cfg, "example.com/app/api", "", ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.HotFunctionPattern(tt.pkgPath, tt.symbol); got != tt.want {
+				t.Errorf("HotFunctionPattern(%q, %q) = %q, want %q", tt.pkgPath, tt.symbol, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_HotProfileThreshold(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *config.Config
+		want float64
+	}{
+		{"nil config", nil, 0.01},
+		{"default", &config.Config{}, 0.01},
+		{"configured", &config.Config{HotPaths: config.HotPathConfig{ProfileThreshold: 5}}, 0.05},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.HotProfileThreshold(); got != tt.want {
+				t.Errorf("HotProfileThreshold() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package config
+
+import "testing"
+
+func Test_validateHotPaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		hot     HotPathConfig
+		wantErr bool
+	}{
+		{"default", HotPathConfig{}, false},
+		{"full definition", HotPathConfig{Enabled: true, Functions: []string{"api.*Handler.*", "Encode*"}, Profile: "cpu.pprof", ProfileThreshold: 2.5}, false},
+		{"empty pattern", HotPathConfig{Functions: []string{""}}, true},
+		{"malformed pattern", HotPathConfig{Functions: []string{"[a-"}}, true},
+		{"negative threshold", HotPathConfig{ProfileThreshold: -1}, true},
+		{"threshold above 100", HotPathConfig{ProfileThreshold: 150}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateHotPaths(tt.hot); (err != nil) != tt.wantErr {
+				t.Errorf("validateHotPaths(%+v) error = %v, wantErr %v", tt.hot, err, tt.wantErr)
+			}
+		})
+	}
+}
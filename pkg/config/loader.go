@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"slices"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -79,45 +80,50 @@ func loadFromFile(path string) (*Config, error) {
 //   - *Config: Loaded configuration or default config
 //   - error: Error if loading fails (returns default config on error)
 func loadFromDefaultLocations() (*Config, error) {
-	cwd, err := os.Getwd()
+	path := FindConfigFile()
+	// Vérification si aucun fichier n'a été trouvé
+	if path == "" {
+		// Retour de la configuration par défaut si aucun fichier trouvé
+		return DefaultConfig(), nil
+	}
+
+	// Chargement depuis le fichier trouvé
+	return loadFromFile(path)
+}
+
+// FindConfigFile searches the current directory and its parents for a config file.
+//
+// Returns:
+//   - string: path of the first config file found, empty if none
+func FindConfigFile() string {
+	dir, err := os.Getwd()
 	// Vérification si impossible d'obtenir le répertoire courant
 	if err != nil {
-		// Retour de la configuration par défaut si erreur
-		return DefaultConfig(), nil
+		// Aucun fichier trouvable sans répertoire courant
+		return ""
 	}
 
-	dir := cwd
 	// Search up the directory tree
 	for {
-		// Try default filename
-		path := filepath.Join(dir, DefaultConfigFileName)
-		// Vérification si le fichier par défaut existe
-		if fileExists(path) {
-			// Chargement depuis le fichier trouvé
-			return loadFromFile(path)
-		}
-
-		// Try alternate filename
-		path = filepath.Join(dir, AlternateConfigFileName)
-		// Vérification si le fichier alternatif existe
-		if fileExists(path) {
-			// Chargement depuis le fichier trouvé
-			return loadFromFile(path)
+		// Try default then alternate filename
+		for _, name := range []string{DefaultConfigFileName, AlternateConfigFileName} {
+			path := filepath.Join(dir, name)
+			// Vérification si le fichier existe
+			if fileExists(path) {
+				// Retour du fichier trouvé
+				return path
+			}
 		}
 
 		// Move to parent directory
 		parent := filepath.Dir(dir)
 		// Vérification si on a atteint la racine du système
 		if parent == dir {
-			// Sortie de la boucle si racine atteinte
-			break
+			// Aucun fichier trouvé jusqu'à la racine
+			return ""
 		}
 		dir = parent
 	}
-
-	// No config file found, return default
-	// Retour de la configuration par défaut si aucun fichier trouvé
-	return DefaultConfig(), nil
 }
 
 // fileExists checks if a file exists.
@@ -155,7 +161,75 @@ func validateConfig(cfg *Config) error {
 	}
 
 	// Validate rules
-	for code, ruleCfg := range cfg.Rules {
+	if err := validateRules(cfg.Rules); err != nil {
+		// Retour d'erreur si règle invalide
+		return err
+	}
+
+	// Validate global exclusions
+	// Vérification si le pattern global est vide
+	if slices.Contains(cfg.Exclude, "") {
+		// Retour d'erreur si pattern vide
+		return fmt.Errorf("empty global exclusion pattern")
+	}
+
+	// Validate build matrix entries
+	for i, build := range cfg.BuildMatrix {
+		// Vérification qu'aucun tag n'est vide
+		if slices.Contains(build.Tags, "") {
+			// Retour d'erreur si tag vide
+			return fmt.Errorf("build_matrix[%d]: empty build tag", i)
+		}
+	}
+
+	// Validate analyzer deadline
+	if cfg.AnalyzerTimeout != "" {
+		deadline, err := time.ParseDuration(cfg.AnalyzerTimeout)
+		// Vérification que la durée est valide et positive
+		if err != nil || deadline < 0 {
+			// Retour d'erreur si durée invalide
+			return fmt.Errorf("analyzer_timeout: invalid duration %q", cfg.AnalyzerTimeout)
+		}
+	}
+
+	// Validate generated-code policy
+	if err := validateGenerated(cfg.Generated); err != nil {
+		// Retour d'erreur si politique inconnue
+		return err
+	}
+
+	// Validate hot path definition
+	if err := validateHotPaths(cfg.HotPaths); err != nil {
+		// Retour d'erreur si chemin chaud invalide
+		return err
+	}
+
+	// Validate update settings
+	if err := validateUpdate(cfg.Update); err != nil {
+		// Retour d'erreur si réglage de mise à jour invalide
+		return err
+	}
+
+	// Validate custom rule declarations
+	if err := validateCustomRules(cfg.CustomRules); err != nil {
+		// Retour d'erreur si règle personnalisée invalide
+		return err
+	}
+
+	// Retour sans erreur si toutes les validations passent
+	return nil
+}
+
+// validateRules validates the per-rule configurations.
+//
+// Params:
+//   - rules: per-rule configurations by code
+//
+// Returns:
+//   - error: Validation error if any
+func validateRules(rules map[string]*RuleConfig) error {
+	// Validate each rule configuration
+	for code, ruleCfg := range rules {
 		// Vérification si la configuration de règle est nulle
 		if ruleCfg == nil {
 			continue
@@ -174,16 +248,15 @@ func validateConfig(cfg *Config) error {
 			// Retour d'erreur si pattern vide
 			return fmt.Errorf("rule %s: empty exclusion pattern", code)
 		}
-	}
 
-	// Validate global exclusions
-	// Vérification si le pattern global est vide
-	if slices.Contains(cfg.Exclude, "") {
-		// Retour d'erreur si pattern vide
-		return fmt.Errorf("empty global exclusion pattern")
+		// Validate symbol and package exclusions
+		if err := validateSymbolPatterns(code, ruleCfg); err != nil {
+			// Retour d'erreur si pattern invalide
+			return err
+		}
 	}
 
-	// Retour sans erreur si toutes les validations passent
+	// Retour sans erreur si toutes les règles sont valides
 	return nil
 }
 
@@ -177,6 +177,81 @@ func TestValidateConfig_NegativeThreshold(t *testing.T) {
 	}
 }
 
+// Test_validateRules tests validateRules with per-rule configurations.
+func Test_validateRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   map[string]*RuleConfig
+		wantErr bool
+	}{
+		{name: "no rules", rules: nil, wantErr: false},
+		{name: "nil rule", rules: map[string]*RuleConfig{"TEST-RULE": nil}, wantErr: false},
+		{name: "negative threshold", rules: map[string]*RuleConfig{"TEST-RULE": {Threshold: Int(-1)}}, wantErr: true},
+		{name: "empty exclusion", rules: map[string]*RuleConfig{"TEST-RULE": {Exclude: []string{""}}}, wantErr: true},
+		{name: "symbol exclusions", rules: map[string]*RuleConfig{"TEST-RULE": {ExcludeSymbols: []string{"api.*.ServeHTTP"}, ExcludePackages: []string{"gen/..."}}}, wantErr: false},
+		{name: "malformed symbol exclusion", rules: map[string]*RuleConfig{"TEST-RULE": {ExcludeSymbols: []string{"api.[Handler"}}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRules(tt.rules)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateRules() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestValidateConfig_AnalyzerTimeout tests validateConfig with analyzer timeouts.
+func TestValidateConfig_AnalyzerTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout string
+		wantErr bool
+	}{
+		{name: "empty", timeout: "", wantErr: false},
+		{name: "valid duration", timeout: "30s", wantErr: false},
+		{name: "zero", timeout: "0", wantErr: false},
+		{name: "invalid duration", timeout: "thirty", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Version: 1, AnalyzerTimeout: tt.timeout}
+			err := validateConfig(cfg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestValidateConfig_Generated tests validateConfig with generated-code policies.
+func TestValidateConfig_Generated(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		wantErr bool
+	}{
+		{name: "default", policy: "", wantErr: false},
+		{name: "skip", policy: GeneratedSkip, wantErr: false},
+		{name: "unknown", policy: "never", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Version: 1, Generated: tt.policy}
+			err := validateConfig(cfg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 // TestValidateConfig_EmptyPattern tests validateConfig with empty patterns.
 func TestValidateConfig_EmptyPattern(t *testing.T) {
 	tests := []struct {
@@ -230,6 +305,22 @@ func TestValidateConfig_EmptyPattern(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "empty build tag",
+			cfg: &Config{
+				Version:     1,
+				BuildMatrix: []BuildConfig{{GOOS: "linux", Tags: []string{""}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid build matrix",
+			cfg: &Config{
+				Version:     1,
+				BuildMatrix: []BuildConfig{{GOOS: "windows"}, {Tags: []string{"integration"}}},
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,52 @@
+// Package config provides configuration management for KTN linter rules.
+package config
+
+import (
+	"reflect"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Analyzer carries the configuration of a run to the KTN analyzers.
+// Runners seed pass.ResultOf[Analyzer] with their own *Config, which lets
+// several runs with different configurations share the same analyzers.
+// When run as a regular requirement it yields the global configuration.
+var Analyzer *analysis.Analyzer = &analysis.Analyzer{
+	Name:       "ktnconfig",
+	Doc:        "Provides the KTN linter configuration of the current run",
+	Run:        runConfigAnalyzer,
+	ResultType: reflect.TypeFor[*Config](),
+}
+
+// runConfigAnalyzer returns the global configuration.
+//
+// Params:
+//   - _: analysis pass (unused)
+//
+// Returns:
+//   - any: global *Config
+//   - error: always nil
+func runConfigAnalyzer(_ *analysis.Pass) (any, error) {
+	// Return global configuration
+	return Get(), nil
+}
+
+// ForPass returns the configuration of the run a pass belongs to.
+//
+// Params:
+//   - pass: analysis pass
+//
+// Returns:
+//   - *Config: run configuration, or the global one when none was provided
+func ForPass(pass *analysis.Pass) *Config {
+	// Use the configuration seeded by the runner
+	if pass != nil {
+		// Check seeded result
+		if cfg, ok := pass.ResultOf[Analyzer].(*Config); ok && cfg != nil {
+			// Return run configuration
+			return cfg
+		}
+	}
+	// Fall back to global configuration
+	return Get()
+}
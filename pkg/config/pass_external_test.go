@@ -0,0 +1,56 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"golang.org/x/tools/go/analysis"
+)
+
+func TestForPass(t *testing.T) {
+	runCfg := config.DefaultConfig()
+	tests := []struct {
+		name    string
+		pass    *analysis.Pass
+		wantRun bool
+	}{
+		{"nil pass uses global config", nil, false},
+		{"pass without seeded config uses global config", &analysis.Pass{}, false},
+		{"seeded config wins", &analysis.Pass{ResultOf: map[*analysis.Analyzer]any{config.Analyzer: runCfg}}, true},
+		{"nil seeded config uses global config", &analysis.Pass{ResultOf: map[*analysis.Analyzer]any{config.Analyzer: (*config.Config)(nil)}}, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := config.ForPass(tt.pass)
+			if (got == runCfg) != tt.wantRun {
+				t.Errorf("ForPass() returned run config = %v, want %v", got == runCfg, tt.wantRun)
+			}
+			if got == nil {
+				t.Error("ForPass() returned nil")
+			}
+		})
+	}
+}
+
+func TestAnalyzer(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{"run yields the global config"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := config.Analyzer.Run(&analysis.Pass{})
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			if result != config.Get() {
+				t.Error("Run() did not return the global config")
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package config
+
+import "testing"
+
+func Test_runConfigAnalyzer(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{"returns global config"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runConfigAnalyzer(nil)
+			if err != nil || got != Get() {
+				t.Errorf("runConfigAnalyzer() = %v, %v, want global config", got, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+// Package config provides configuration management for KTN linter rules.
+package config
+
+import (
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// SkipMatcher decides which directories are skipped, using gitignore syntax.
+// Paths are matched relative to a base directory (usually the working directory).
+type SkipMatcher struct {
+	base  string
+	rules []skipRule
+}
+
+// NewSkipMatcher compiles gitignore-style patterns.
+// Supported syntax: "name" (any depth), "dir/" (directories only),
+// "/path" or "a/b" (anchored at base), "*", "?", "**" and "!" negation.
+// Blank lines and "#" comments are ignored.
+//
+// Params:
+//   - base: directory anchored patterns are relative to
+//   - patterns: skip list
+//
+// Returns:
+//   - *SkipMatcher: compiled matcher
+func NewSkipMatcher(base string, patterns []string) *SkipMatcher {
+	matcher := &SkipMatcher{base: base, rules: make([]skipRule, 0, len(patterns))}
+	// Compile each pattern
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		// Skip blank lines and comments
+		if pattern == "" || strings.HasPrefix(pattern, "#") {
+			continue
+		}
+		matcher.rules = append(matcher.rules, compileSkipRule(pattern))
+	}
+	// Return compiled matcher
+	return matcher
+}
+
+// compileSkipRule compiles one gitignore-style pattern.
+//
+// Params:
+//   - pattern: non-empty pattern
+//
+// Returns:
+//   - skipRule: compiled rule
+func compileSkipRule(pattern string) skipRule {
+	rule := skipRule{}
+	// Negated pattern
+	if rest, ok := strings.CutPrefix(pattern, "!"); ok {
+		rule.negate = true
+		pattern = rest
+	}
+	// Directory-only pattern
+	if rest, ok := strings.CutSuffix(pattern, "/"); ok {
+		rule.dirOnly = true
+		pattern = rest
+	}
+	// Anchored when a separator remains
+	rule.anchored = strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+	rule.pattern = regexp.MustCompile("^" + globToRegexp(pattern) + "$")
+	// Return compiled rule
+	return rule
+}
+
+// globToRegexp translates a gitignore glob to a regular expression.
+//
+// Params:
+//   - glob: glob without leading "!" or trailing "/"
+//
+// Returns:
+//   - string: equivalent regular expression body
+func globToRegexp(glob string) string {
+	var sb strings.Builder
+	// Translate glob tokens one by one
+	for i := 0; i < len(glob); i++ {
+		// Dispatch on the current character
+		switch {
+		// "**/" matches zero or more directories
+		case strings.HasPrefix(glob[i:], "**/"):
+			sb.WriteString("(?:.*/)?")
+			i += len("**/") - 1
+		// Trailing or inner "**" matches everything
+		case strings.HasPrefix(glob[i:], "**"):
+			sb.WriteString(".*")
+			i++
+		// "*" matches within one path element
+		case glob[i] == '*':
+			sb.WriteString("[^/]*")
+		// "?" matches one character within a path element
+		case glob[i] == '?':
+			sb.WriteString("[^/]")
+		// Literal character
+		default:
+			sb.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+	// Return regular expression body
+	return sb.String()
+}
+
+// Skips reports whether a path is skipped by the list.
+// A path is skipped when it, or one of its parent directories below the
+// base, is matched by the last applicable rule, as git does.
+//
+// Params:
+//   - target: absolute or base-relative path
+//   - isDir: whether target is a directory
+//
+// Returns:
+//   - bool: true if the path is skipped
+func (m *SkipMatcher) Skips(target string, isDir bool) bool {
+	// Check for an empty matcher
+	if m == nil || len(m.rules) == 0 {
+		// Return not skipped
+		return false
+	}
+
+	rel, inside := m.relative(target)
+	// Check for the base itself
+	if rel == "" {
+		// Return not skipped for the base itself
+		return false
+	}
+
+	parts := strings.Split(rel, "/")
+	name := parts[len(parts)-1]
+	// Paths outside the base are only matched on their name
+	if !inside {
+		// Return name match, anchored rules never applying
+		return m.match("../"+rel, name, isDir)
+	}
+
+	// Check parent directories first: an excluded parent cannot be re-included
+	for i := 1; i < len(parts); i++ {
+		// Check each ancestor directory
+		if m.match(path.Join(parts[:i]...), parts[i-1], true) {
+			// Return skipped by an ancestor
+			return true
+		}
+	}
+	// Check the path itself
+	return m.match(rel, name, isDir)
+}
+
+// match evaluates the rules on one path, the last matching rule winning.
+//
+// Params:
+//   - rel: slash-separated relative path
+//   - base: last path element
+//   - isDir: whether the path is a directory
+//
+// Returns:
+//   - bool: true if the path is skipped
+func (m *SkipMatcher) match(rel, base string, isDir bool) bool {
+	skipped := false
+	// Evaluate every rule in order
+	for i := range m.rules {
+		// Apply matching rules
+		if m.rules[i].matches(rel, base, isDir) {
+			skipped = !m.rules[i].negate
+		}
+	}
+	// Return final decision
+	return skipped
+}
+
+// relative returns target relative to the base, slash-separated.
+//
+// Params:
+//   - target: absolute or base-relative path
+//
+// Returns:
+//   - string: relative path, empty for the base itself
+//   - bool: false when target is an absolute path outside the base
+func (m *SkipMatcher) relative(target string) (string, bool) {
+	rel, inside := target, true
+	// Make absolute paths relative to the base
+	if filepath.IsAbs(target) {
+		r, err := filepath.Rel(m.base, target)
+		inside = m.base != "" && err == nil && r != ".." && !strings.HasPrefix(filepath.ToSlash(r), "../")
+		// Keep the absolute path when not under the base
+		if inside {
+			rel = r
+		}
+	}
+	rel = strings.Trim(filepath.ToSlash(rel), "/")
+	// Normalize the base itself
+	if rel == "." {
+		// Return empty path
+		return "", inside
+	}
+	// Return relative path
+	return rel, inside
+}
@@ -0,0 +1,72 @@
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+)
+
+func TestSkipMatcher_Skips(t *testing.T) {
+	base := filepath.Join(string(filepath.Separator), "repo")
+	tests := []struct {
+		name     string
+		patterns []string
+		target   string
+		isDir    bool
+		want     bool
+	}{
+		{"no patterns", []string{}, "gen", true, false},
+		{"comments and blanks ignored", []string{"# gen", "  "}, "gen", true, false},
+		{"name at any depth", []string{"gen"}, "a/b/gen", true, true},
+		{"name as absolute path", []string{"gen"}, filepath.Join(base, "a", "gen"), true, true},
+		{"base itself", []string{"*"}, base, true, false},
+		{"child of skipped dir", []string{"gen"}, "gen/sub", true, true},
+		{"directory only skips dirs", []string{"gen/"}, "gen", true, true},
+		{"directory only keeps files", []string{"gen/"}, "gen", false, false},
+		{"anchored matches from base", []string{"/gen"}, "gen", true, true},
+		{"anchored ignores deeper dirs", []string{"/gen"}, "a/gen", true, false},
+		{"inner slash anchors", []string{"tools/gen"}, "tools/gen", true, true},
+		{"star within element", []string{"mock_*"}, "x/mock_db", true, true},
+		{"star does not cross dirs", []string{"/a/*"}, "a/b/c", true, true},
+		{"question mark", []string{"v?"}, "v2", true, true},
+		{"double star prefix", []string{"**/proto"}, "api/v1/proto", true, true},
+		{"double star middle", []string{"/api/**/gen"}, "api/v1/x/gen", true, true},
+		{"negation re-includes", []string{"gen*", "!gen_keep"}, "gen_keep", true, false},
+		{"last rule wins", []string{"!gen", "gen"}, "gen", true, true},
+		{"negation cannot re-include below skipped parent", []string{"gen", "!gen/keep"}, "gen/keep", true, true},
+		{"outside base matched by name", []string{"gen"}, filepath.Join(string(filepath.Separator), "other", "gen"), true, true},
+		{"outside base ignores anchored", []string{"/gen"}, filepath.Join(string(filepath.Separator), "other", "gen"), true, false},
+		{"literal dot", []string{"a.b"}, "axb", true, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			m := config.NewSkipMatcher(base, tt.patterns)
+			if got := m.Skips(tt.target, tt.isDir); got != tt.want {
+				t.Errorf("Skips(%q) = %v, want %v", tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSkipMatcher_Skips_nil(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+	}{
+		{"relative path", "gen"},
+		{"absolute path", filepath.Join(string(filepath.Separator), "gen")},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var m *config.SkipMatcher
+			if m.Skips(tt.target, true) {
+				t.Errorf("nil matcher skips %q", tt.target)
+			}
+		})
+	}
+}
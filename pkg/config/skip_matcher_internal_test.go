@@ -0,0 +1,114 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func Test_globToRegexp(t *testing.T) {
+	tests := []struct {
+		name string
+		glob string
+		want string
+	}{
+		{"literal", "gen", "gen"},
+		{"quoted meta", "a.b", `a\.b`},
+		{"star", "*.pb", `[^/]*\.pb`},
+		{"question mark", "v?", "v[^/]"},
+		{"double star dir", "**/gen", "(?:.*/)?gen"},
+		{"trailing double star", "gen/**", "gen/.*"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := globToRegexp(tt.glob); got != tt.want {
+				t.Errorf("globToRegexp(%q) = %q, want %q", tt.glob, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_compileSkipRule(t *testing.T) {
+	tests := []struct {
+		name         string
+		pattern      string
+		wantNegate   bool
+		wantDirOnly  bool
+		wantAnchored bool
+		wantRegexp   string
+	}{
+		{"plain name", "gen", false, false, false, "^gen$"},
+		{"negated", "!gen", true, false, false, "^gen$"},
+		{"directory only", "gen/", false, true, false, "^gen$"},
+		{"leading slash", "/gen", false, false, true, "^gen$"},
+		{"inner slash", "a/gen", false, false, true, "^a/gen$"},
+		{"all markers", "!/a/", true, true, true, "^a$"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := compileSkipRule(tt.pattern)
+			if got.negate != tt.wantNegate || got.dirOnly != tt.wantDirOnly || got.anchored != tt.wantAnchored {
+				t.Errorf("compileSkipRule(%q) = %+v", tt.pattern, got)
+			}
+			if got.pattern.String() != tt.wantRegexp {
+				t.Errorf("compileSkipRule(%q) regexp = %q, want %q", tt.pattern, got.pattern, tt.wantRegexp)
+			}
+		})
+	}
+}
+
+func TestSkipMatcher_relative(t *testing.T) {
+	base := filepath.Join(string(filepath.Separator), "repo")
+	tests := []struct {
+		name       string
+		base       string
+		target     string
+		wantRel    string
+		wantInside bool
+	}{
+		{"relative path", base, "a/b", "a/b", true},
+		{"dot", base, ".", "", true},
+		{"base itself", base, base, "", true},
+		{"absolute inside", base, filepath.Join(base, "a", "b"), "a/b", true},
+		{"absolute outside", base, filepath.Join(string(filepath.Separator), "other"), "other", false},
+		{"sibling with base prefix", base, filepath.Join(string(filepath.Separator), "repo2"), "repo2", false},
+		{"no base", "", filepath.Join(string(filepath.Separator), "x"), "x", false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			m := &SkipMatcher{base: tt.base}
+			rel, inside := m.relative(tt.target)
+			if rel != tt.wantRel || inside != tt.wantInside {
+				t.Errorf("relative(%q) = (%q, %v), want (%q, %v)", tt.target, rel, inside, tt.wantRel, tt.wantInside)
+			}
+		})
+	}
+}
+
+func TestSkipMatcher_match(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		rel      string
+		want     bool
+	}{
+		{"no rule matches", []string{"gen"}, "src", false},
+		{"rule matches", []string{"gen"}, "gen", true},
+		{"negation after match", []string{"gen", "!gen"}, "gen", false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewSkipMatcher("", tt.patterns)
+			if got := m.match(tt.rel, tt.rel, true); got != tt.want {
+				t.Errorf("match(%q) = %v, want %v", tt.rel, got, tt.want)
+			}
+		})
+	}
+}
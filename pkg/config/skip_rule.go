@@ -0,0 +1,39 @@
+// Package config provides configuration management for KTN linter rules.
+package config
+
+import (
+	"regexp"
+)
+
+// skipRule is one compiled line of a gitignore-style skip list.
+// Rules are evaluated in order and the last matching rule wins.
+type skipRule struct {
+	pattern  *regexp.Regexp // Compiled glob
+	negate   bool           // "!pattern" re-includes a path
+	dirOnly  bool           // "pattern/" only matches directories
+	anchored bool           // Pattern contains a "/" and matches from the base
+}
+
+// matches reports whether the rule applies to a slash-separated relative path.
+//
+// Params:
+//   - rel: path relative to the matcher base
+//   - base: last path element
+//   - isDir: whether the path is a directory
+//
+// Returns:
+//   - bool: true if the rule matches
+func (r *skipRule) matches(rel, base string, isDir bool) bool {
+	// Directory-only rules never match files
+	if r.dirOnly && !isDir {
+		// Return no match
+		return false
+	}
+	// Anchored rules match the whole relative path
+	if r.anchored {
+		// Return full path match
+		return r.pattern.MatchString(rel)
+	}
+	// Other rules match the name at any depth
+	return r.pattern.MatchString(base)
+}
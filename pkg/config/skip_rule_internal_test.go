@@ -0,0 +1,32 @@
+package config
+
+import (
+	"regexp"
+	"testing"
+)
+
+func Test_skipRule_matches(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  skipRule
+		rel   string
+		isDir bool
+		want  bool
+	}{
+		{"name rule on base name", skipRule{pattern: regexp.MustCompile("^gen$")}, "a/gen", true, true},
+		{"name rule ignores path", skipRule{pattern: regexp.MustCompile("^a/gen$")}, "a/gen", true, false},
+		{"anchored rule on path", skipRule{pattern: regexp.MustCompile("^a/gen$"), anchored: true}, "a/gen", true, true},
+		{"directory rule on file", skipRule{pattern: regexp.MustCompile("^gen$"), dirOnly: true}, "gen", false, false},
+		{"directory rule on dir", skipRule{pattern: regexp.MustCompile("^gen$"), dirOnly: true}, "gen", true, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			base := tt.rel[len(tt.rel)-len("gen"):]
+			if got := tt.rule.matches(tt.rel, base, tt.isDir); got != tt.want {
+				t.Errorf("matches(%q) = %v, want %v", tt.rel, got, tt.want)
+			}
+		})
+	}
+}
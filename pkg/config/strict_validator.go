@@ -0,0 +1,197 @@
+// Package config provides configuration management for KTN linter rules.
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// strictValidator walks a YAML node tree against the Config type.
+type strictValidator struct {
+	catalog RuleCatalog
+	errs    []ValidationError
+}
+
+// addError records a validation error at a node position.
+//
+// Params:
+//   - node: node where the error occurred
+//   - format: message format
+//   - args: message arguments
+func (sv *strictValidator) addError(node *yaml.Node, format string, args ...any) {
+	sv.errs = append(sv.errs, NewValidationError(node.Line, node.Column, fmt.Sprintf(format, args...)))
+}
+
+// sorted returns the collected errors ordered by position.
+//
+// Returns:
+//   - []ValidationError: sorted errors
+func (sv *strictValidator) sorted() []ValidationError {
+	sort.SliceStable(sv.errs, func(i, j int) bool {
+		// Compare lines first
+		if sv.errs[i].Line != sv.errs[j].Line {
+			// Order by line
+			return sv.errs[i].Line < sv.errs[j].Line
+		}
+		// Order by column
+		return sv.errs[i].Column < sv.errs[j].Column
+	})
+	// Return sorted errors
+	return sv.errs
+}
+
+// walk validates a node against the expected Go type.
+//
+// Params:
+//   - node: YAML node to validate
+//   - typ: expected Go type
+//   - path: dotted yaml path used in messages
+func (sv *strictValidator) walk(node *yaml.Node, typ reflect.Type, path string) {
+	// Null values fall back to defaults
+	if node.Tag == "!!null" {
+		// Nothing to validate
+		return
+	}
+
+	// Dereference pointer types
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	// Dispatch on the expected kind
+	switch typ.Kind() {
+	// Struct fields map to yaml keys
+	case reflect.Struct:
+		sv.walkStruct(node, typ, path)
+	// Maps are keyed collections such as rules
+	case reflect.Map:
+		sv.walkMap(node, typ, path)
+	// Slices are yaml sequences
+	case reflect.Slice:
+		sv.walkSlice(node, typ, path)
+	// Scalars are decoded to check their type
+	default:
+		sv.walkScalar(node, typ, path)
+	}
+}
+
+// walkStruct validates a mapping node against struct fields.
+//
+// Params:
+//   - node: YAML node to validate
+//   - typ: struct type
+//   - path: dotted yaml path
+func (sv *strictValidator) walkStruct(node *yaml.Node, typ reflect.Type, path string) {
+	// Structs must be mappings
+	if node.Kind != yaml.MappingNode {
+		sv.addError(node, "%s: expected a mapping", displayPath(path))
+		// Stop walking this branch
+		return
+	}
+
+	fields := yamlFields(typ)
+	// Iterate over key/value pairs
+	for i := 0; i+1 < len(node.Content); i += mappingPairStep {
+		key, value := node.Content[i], node.Content[i+1]
+		field, ok := fields[key.Value]
+		// Report unknown keys with a suggestion
+		if !ok {
+			sv.addError(key, "unknown field %q in %s%s", key.Value, displayPath(path), didYouMean(key.Value, sortedKeys(fields)))
+			continue
+		}
+		childPath := joinPath(path, key.Value)
+		// Check threshold support for rule entries
+		if key.Value == thresholdFieldName && sv.catalog.Codes != nil && strings.HasPrefix(path, rulesFieldPath+".") {
+			sv.checkThresholdSupport(key, strings.TrimPrefix(path, rulesFieldPath+"."))
+		}
+		sv.walk(value, field.Type, childPath)
+	}
+}
+
+// checkThresholdSupport reports thresholds set on rules that ignore them.
+//
+// Params:
+//   - key: threshold key node
+//   - code: rule code owning the threshold
+func (sv *strictValidator) checkThresholdSupport(key *yaml.Node, code string) {
+	// Unknown codes are already reported
+	if !sv.catalog.Codes[code] {
+		// Nothing more to report
+		return
+	}
+	// Check if the rule declares a threshold
+	if _, ok := sv.catalog.Thresholds[code]; !ok {
+		sv.addError(key, "rule %s does not support a threshold", code)
+	}
+}
+
+// walkMap validates a mapping node against a map type.
+//
+// Params:
+//   - node: YAML node to validate
+//   - typ: map type
+//   - path: dotted yaml path
+func (sv *strictValidator) walkMap(node *yaml.Node, typ reflect.Type, path string) {
+	// Maps must be mappings
+	if node.Kind != yaml.MappingNode {
+		sv.addError(node, "%s: expected a mapping", displayPath(path))
+		// Stop walking this branch
+		return
+	}
+
+	// Iterate over key/value pairs
+	for i := 0; i+1 < len(node.Content); i += mappingPairStep {
+		key, value := node.Content[i], node.Content[i+1]
+		// Check rule codes against the catalog
+		if path == rulesFieldPath && sv.catalog.Codes != nil && !sv.catalog.Codes[key.Value] {
+			sv.addError(key, "unknown rule code %q%s", key.Value, didYouMean(key.Value, sortedCodes(sv.catalog.Codes)))
+			continue
+		}
+		sv.walk(value, typ.Elem(), joinPath(path, key.Value))
+	}
+}
+
+// walkSlice validates a sequence node against a slice type.
+//
+// Params:
+//   - node: YAML node to validate
+//   - typ: slice type
+//   - path: dotted yaml path
+func (sv *strictValidator) walkSlice(node *yaml.Node, typ reflect.Type, path string) {
+	// Slices must be sequences
+	if node.Kind != yaml.SequenceNode {
+		sv.addError(node, "%s: expected a list", displayPath(path))
+		// Stop walking this branch
+		return
+	}
+
+	// Validate each element
+	for i, item := range node.Content {
+		sv.walk(item, typ.Elem(), fmt.Sprintf("%s[%d]", path, i))
+	}
+}
+
+// walkScalar validates a scalar node by decoding it into the target type.
+//
+// Params:
+//   - node: YAML node to validate
+//   - typ: scalar type
+//   - path: dotted yaml path
+func (sv *strictValidator) walkScalar(node *yaml.Node, typ reflect.Type, path string) {
+	// Scalars must not be collections
+	if node.Kind != yaml.ScalarNode {
+		sv.addError(node, "%s: expected a %s value", displayPath(path), typ.Kind())
+		// Stop walking this branch
+		return
+	}
+
+	target := reflect.New(typ)
+	// Check decoding into the expected type
+	if err := node.Decode(target.Interface()); err != nil {
+		sv.addError(node, "%s: invalid value %q, expected %s", displayPath(path), node.Value, typ.Kind())
+	}
+}
@@ -0,0 +1,74 @@
+// Internal tests for the strict validator.
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Test_strictValidator_walk tests the walk method.
+func Test_strictValidator_walk(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantCount int
+	}{
+		{name: "valid document", data: "version: 1\nexclude:\n  - a\n", wantCount: 0},
+		{name: "list expected", data: "exclude: foo\n", wantCount: 1},
+		{name: "mapping expected", data: "rules: foo\n", wantCount: 1},
+		{name: "two unknown keys", data: "foo: 1\nbar: 2\n", wantCount: 2},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var root yaml.Node
+			// Parse fixture
+			if err := yaml.Unmarshal([]byte(tt.data), &root); err != nil {
+				t.Fatalf("parse fixture: %v", err)
+			}
+			sv := &strictValidator{}
+			sv.walk(root.Content[0], reflect.TypeFor[Config](), "")
+			// Verify error count
+			if len(sv.errs) != tt.wantCount {
+				t.Errorf("got %d errors, want %d: %v", len(sv.errs), tt.wantCount, sv.errs)
+			}
+		})
+	}
+}
+
+// Test_strictValidator_sorted tests the sorted method.
+func Test_strictValidator_sorted(t *testing.T) {
+	tests := []struct {
+		name string
+		errs []ValidationError
+		want []int
+	}{
+		{
+			name: "orders by line then column",
+			errs: []ValidationError{{Line: 3, Column: 1}, {Line: 1, Column: 5}, {Line: 1, Column: 2}},
+			want: []int{1, 1, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &strictValidator{errs: tt.errs}
+			got := sv.sorted()
+			// Verify line order
+			for i, line := range tt.want {
+				// Check each position
+				if got[i].Line != line {
+					t.Errorf("errs[%d].Line = %d, want %d", i, got[i].Line, line)
+				}
+			}
+			// Verify column order within the same line
+			if got[0].Column != 2 {
+				t.Errorf("errs[0].Column = %d, want 2", got[0].Column)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Package config provides configuration management for KTN linter rules.
+package config
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+// subPackagesSuffix extends a package pattern to its subpackages.
+const subPackagesSuffix string = "/..."
+
+// IsSymbolExcluded checks if a finding is excluded from a rule by its
+// package or enclosing declaration.
+// Symbols are qualified by import path ("pkg/api.UserHandler.ServeHTTP").
+// Patterns use path.Match syntax and match either the bare symbol
+// ("New*") or the end of the qualified name on a path boundary, so
+// "api.*Handler.ServeHTTP" applies to any module. Package patterns ending
+// with "/..." include subpackages.
+//
+// Params:
+//   - ruleCode: the rule code (e.g., "KTN-FUNC-006")
+//   - pkgPath: import path of the analyzed package
+//   - symbol: enclosing declaration ("Type.Method"), empty at file level
+//
+// Returns:
+//   - bool: true if the finding is excluded
+func (c *Config) IsSymbolExcluded(ruleCode, pkgPath, symbol string) bool {
+	// Check nil config
+	if c == nil || c.Rules == nil {
+		// Return not excluded
+		return false
+	}
+	ruleCfg := c.Rules[ruleCode]
+	// Check rule configuration
+	if ruleCfg == nil {
+		// Return not excluded
+		return false
+	}
+
+	// Check package exclusions
+	for _, pattern := range ruleCfg.ExcludePackages {
+		// Check package pattern
+		if matchesPackage(pkgPath, pattern) {
+			// Return excluded by package pattern
+			return true
+		}
+	}
+
+	// File-level findings have no symbol to match
+	if symbol == "" {
+		// Return not excluded
+		return false
+	}
+	// Check symbol exclusions
+	for _, pattern := range ruleCfg.ExcludeSymbols {
+		matched, _ := path.Match(pattern, symbol)
+		// Check bare and qualified symbol
+		if matched || matchesSuffix(pkgPath+"."+symbol, pattern) {
+			// Return excluded by symbol pattern
+			return true
+		}
+	}
+
+	// Return not excluded
+	return false
+}
+
+// matchesPackage checks an import path against a package pattern.
+//
+// Params:
+//   - pkgPath: import path
+//   - pattern: package pattern, "/..." suffix including subpackages
+//
+// Returns:
+//   - bool: true if the package matches
+func matchesPackage(pkgPath, pattern string) bool {
+	root, recursive := strings.CutSuffix(pattern, subPackagesSuffix)
+	// Check the package itself
+	if matchesSuffix(pkgPath, root) {
+		// Return matched
+		return true
+	}
+	// Check parent packages for recursive patterns
+	for dir := path.Dir(pkgPath); recursive && dir != "." && dir != "/"; dir = path.Dir(dir) {
+		// Check parent package
+		if matchesSuffix(dir, root) {
+			// Return matched on a parent package
+			return true
+		}
+	}
+	// Return no match
+	return false
+}
+
+// matchesSuffix checks if a pattern matches a name or one of its suffixes
+// starting after a "/".
+//
+// Params:
+//   - name: slash-separated qualified name
+//   - pattern: path.Match pattern
+//
+// Returns:
+//   - bool: true if the pattern matches
+func matchesSuffix(name, pattern string) bool {
+	// Try the full name, then each suffix after a separator
+	for {
+		// Check current suffix
+		if matched, _ := path.Match(pattern, name); matched {
+			// Return matched suffix
+			return true
+		}
+		_, rest, found := strings.Cut(name, "/")
+		// Stop after the last element
+		if !found {
+			// Return no match
+			return false
+		}
+		name = rest
+	}
+}
+
+// validateSymbolPatterns checks the symbol and package exclusions of a rule.
+//
+// Params:
+//   - code: rule code
+//   - ruleCfg: rule configuration
+//
+// Returns:
+//   - error: empty or malformed pattern
+func validateSymbolPatterns(code string, ruleCfg *RuleConfig) error {
+	// Check both pattern lists
+	for _, pattern := range slices.Concat(ruleCfg.ExcludeSymbols, ruleCfg.ExcludePackages) {
+		// Reject empty patterns
+		if pattern == "" {
+			// Return empty pattern error
+			return fmt.Errorf("rule %s: empty symbol or package exclusion pattern", code)
+		}
+		// Reject malformed patterns
+		if _, err := path.Match(strings.TrimSuffix(pattern, subPackagesSuffix), ""); err != nil {
+			// Return malformed pattern error
+			return fmt.Errorf("rule %s: invalid exclusion pattern %q: %w", code, pattern, err)
+		}
+	}
+	// Return valid
+	return nil
+}
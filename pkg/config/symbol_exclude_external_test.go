@@ -0,0 +1,64 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+)
+
+func TestConfig_IsSymbolExcluded(t *testing.T) {
+	rules := map[string]*config.RuleConfig{
+		"KTN-FUNC-006":   {ExcludeSymbols: []string{"pkg/api.*Handler.ServeHTTP", "New*"}},
+		"KTN-STRUCT-004": {ExcludePackages: []string{"internal/gen/...", "example.com/types"}},
+		"KTN-FUNC-001":   nil,
+	}
+	tests := []struct {
+		name    string
+		code    string
+		pkgPath string
+		symbol  string
+		want    bool
+	}{
+		{"qualified method", "KTN-FUNC-006", "example.com/pkg/api", "UserHandler.ServeHTTP", true},
+		{"other method", "KTN-FUNC-006", "example.com/pkg/api", "UserHandler.Close", false},
+		{"other package", "KTN-FUNC-006", "example.com/pkg/web", "UserHandler.ServeHTTP", false},
+		{"unqualified pattern", "KTN-FUNC-006", "example.com/pkg/web", "NewServer", true},
+		{"file level finding", "KTN-FUNC-006", "example.com/pkg/api", "", false},
+		{"package itself", "KTN-STRUCT-004", "example.com/internal/gen", "", true},
+		{"subpackage", "KTN-STRUCT-004", "example.com/internal/gen/models", "User", true},
+		{"exact package", "KTN-STRUCT-004", "example.com/types", "User", true},
+		{"unrelated package", "KTN-STRUCT-004", "example.com/internal/generic", "User", false},
+		{"nil rule", "KTN-FUNC-001", "example.com/types", "User", false},
+		{"unconfigured rule", "KTN-VAR-001", "example.com/types", "User", false},
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.Rules = rules
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cfg.IsSymbolExcluded(tt.code, tt.pkgPath, tt.symbol); got != tt.want {
+				t.Errorf("IsSymbolExcluded(%q, %q, %q) = %v, want %v", tt.code, tt.pkgPath, tt.symbol, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_IsSymbolExcluded_nil(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *config.Config
+	}{
+		{"nil config", nil},
+		{"no rules", &config.Config{}},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.cfg.IsSymbolExcluded("KTN-FUNC-006", "example.com/pkg", "Foo") {
+				t.Error("IsSymbolExcluded() = true, want false")
+			}
+		})
+	}
+}
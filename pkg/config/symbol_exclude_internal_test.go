@@ -0,0 +1,74 @@
+package config
+
+import "testing"
+
+func Test_matchesSuffix(t *testing.T) {
+	tests := []struct {
+		name    string
+		qname   string
+		pattern string
+		want    bool
+	}{
+		{"full name", "example.com/api.Handler", "example.com/api.Handler", true},
+		{"suffix on separator", "example.com/pkg/api.Handler", "api.Handler", true},
+		{"suffix inside element", "example.com/pkg/myapi.Handler", "api.Handler", false},
+		{"star stays in element", "example.com/pkg/api.Handler", "*.Handler", true},
+		{"no match", "example.com/pkg/api.Handler", "web.*", false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesSuffix(tt.qname, tt.pattern); got != tt.want {
+				t.Errorf("matchesSuffix(%q, %q) = %v, want %v", tt.qname, tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_matchesPackage(t *testing.T) {
+	tests := []struct {
+		name    string
+		pkgPath string
+		pattern string
+		want    bool
+	}{
+		{"exact", "example.com/gen", "example.com/gen", true},
+		{"suffix", "example.com/internal/gen", "gen", true},
+		{"recursive root", "example.com/gen", "gen/...", true},
+		{"recursive child", "example.com/gen/a/b", "gen/...", true},
+		{"non recursive child", "example.com/gen/a", "gen", false},
+		{"wildcard", "example.com/mocks_user", "mocks_*", true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesPackage(tt.pkgPath, tt.pattern); got != tt.want {
+				t.Errorf("matchesPackage(%q, %q) = %v, want %v", tt.pkgPath, tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_validateSymbolPatterns(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    *RuleConfig
+		wantErr bool
+	}{
+		{"valid", &RuleConfig{ExcludeSymbols: []string{"*.ServeHTTP"}, ExcludePackages: []string{"gen/..."}}, false},
+		{"empty symbol", &RuleConfig{ExcludeSymbols: []string{""}}, true},
+		{"empty package", &RuleConfig{ExcludePackages: []string{""}}, true},
+		{"malformed", &RuleConfig{ExcludePackages: []string{"gen[/..."}}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateSymbolPatterns("TEST-RULE", tt.rule); (err != nil) != tt.wantErr {
+				t.Errorf("validateSymbolPatterns() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Package config provides configuration management for KTN linter rules.
+package config
+
+import "fmt"
+
+const (
+	// UpdateChannelStable follows the latest stable release (default)
+	UpdateChannelStable string = "stable"
+	// UpdateChannelPrerelease also follows release candidates and betas
+	UpdateChannelPrerelease string = "prerelease"
+)
+
+// UpdateConfig configures where the upgrade command fetches releases.
+// Empty fields keep GitHub, the stable channel, the environment proxy and
+// the default download size cap.
+type UpdateConfig struct {
+	// Source is "github" (default), the base URL of a release mirror
+	// serving index.json, or a local directory or file:// URL
+	Source string `yaml:"source,omitempty"`
+
+	// Channel is "stable" (default) or "prerelease"
+	Channel string `yaml:"channel,omitempty"`
+
+	// Proxy is the HTTP proxy URL, the environment proxy when empty
+	Proxy string `yaml:"proxy,omitempty"`
+
+	// MaxSizeMB caps the size of a downloaded binary, 0 for the default
+	MaxSizeMB int `yaml:"max_size_mb,omitempty"`
+}
+
+// validateUpdate checks the update settings.
+//
+// Params:
+//   - update: configured update settings
+//
+// Returns:
+//   - error: unknown channel or negative size cap
+func validateUpdate(update UpdateConfig) error {
+	// Check known channels
+	switch update.Channel {
+	// Default and known channels
+	case "", UpdateChannelStable, UpdateChannelPrerelease:
+	// Unknown channel
+	default:
+		// Return invalid channel error
+		return fmt.Errorf("update.channel: invalid channel %q (want %s or %s)", update.Channel, UpdateChannelStable, UpdateChannelPrerelease)
+	}
+	// Check size cap
+	if update.MaxSizeMB < 0 {
+		// Return negative size error
+		return fmt.Errorf("update.max_size_mb: must be non-negative, got %d", update.MaxSizeMB)
+	}
+	// Return valid
+	return nil
+}
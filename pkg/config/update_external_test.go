@@ -0,0 +1,45 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+)
+
+func TestLoad_Update(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    config.UpdateConfig
+		wantErr bool
+	}{
+		{"default", "version: 1\n", config.UpdateConfig{}, false},
+		{
+			"mirror",
+			"update:\n  source: https://mirror.example.com/ktn\n  channel: prerelease\n  proxy: http://proxy:3128\n  max_size_mb: 64\n",
+			config.UpdateConfig{Source: "https://mirror.example.com/ktn", Channel: config.UpdateChannelPrerelease, Proxy: "http://proxy:3128", MaxSizeMB: 64},
+			false,
+		},
+		{"unknown channel", "update:\n  channel: nightly\n", config.UpdateConfig{}, true},
+		{"negative size", "update:\n  max_size_mb: -1\n", config.UpdateConfig{}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), config.DefaultConfigFileName)
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			cfg, err := config.Load(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Load() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && cfg.Update != tt.want {
+				t.Errorf("Load().Update = %+v, want %+v", cfg.Update, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package config
+
+import "testing"
+
+func Test_validateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  UpdateConfig
+		wantErr bool
+	}{
+		{"default", UpdateConfig{}, false},
+		{"stable mirror", UpdateConfig{Source: "https://mirror.example.com/ktn", Channel: UpdateChannelStable, MaxSizeMB: 64}, false},
+		{"prerelease", UpdateConfig{Channel: UpdateChannelPrerelease}, false},
+		{"unknown channel", UpdateConfig{Channel: "nightly"}, true},
+		{"negative size", UpdateConfig{MaxSizeMB: -1}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateUpdate(tt.update); (err != nil) != tt.wantErr {
+				t.Errorf("validateUpdate(%+v) error = %v, wantErr %v", tt.update, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,344 @@
+// Package config provides configuration management for KTN linter rules.
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// rulesFieldPath is the yaml path of the per-rule configuration map.
+	rulesFieldPath string = "rules"
+	// thresholdFieldName is the yaml name of the rule threshold option.
+	thresholdFieldName string = "threshold"
+	// minSuggestionDistance is the minimum edit distance accepted for suggestions.
+	minSuggestionDistance int = 2
+	// mappingPairStep is the stride between keys of a yaml mapping node.
+	mappingPairStep int = 2
+	// suggestionDistanceDivisor scales the accepted distance with the name length.
+	suggestionDistanceDivisor int = 3
+)
+
+// yamlLinePattern extracts the line number from yaml.v3 error messages.
+var yamlLinePattern *regexp.Regexp = regexp.MustCompile(`line (\d+)`)
+
+// RuleCatalog describes the rules known to the linter for strict validation.
+// A nil Codes map disables rule code checks.
+type RuleCatalog struct {
+	Codes      map[string]bool
+	Thresholds map[string]int
+}
+
+// ValidateStrict validates raw YAML configuration data strictly.
+// Unknown keys, unknown rule codes, mistyped values and semantic errors are
+// all reported, each with its line and column when available.
+//
+// Params:
+//   - data: raw YAML content
+//   - catalog: known rules and their configurable thresholds
+//
+// Returns:
+//   - []ValidationError: problems found, empty when the config is valid
+func ValidateStrict(data []byte, catalog RuleCatalog) []ValidationError {
+	var root yaml.Node
+	// Parse the document into a node tree to keep positions
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		// Return syntax error with its line when available
+		return []ValidationError{yamlErrorToValidation(err.Error())}
+	}
+
+	// Check for empty document
+	if len(root.Content) == 0 {
+		// Empty file is a valid default configuration
+		return []ValidationError{}
+	}
+
+	validator := &strictValidator{catalog: withCustomRuleCodes(data, catalog)}
+	validator.walk(root.Content[0], reflect.TypeFor[Config](), "")
+
+	// Stop before decoding when the structure itself is invalid
+	if len(validator.errs) > 0 {
+		// Return structural errors sorted by position
+		return validator.sorted()
+	}
+
+	// Decode with unknown field detection as a final safety net
+	cfg := DefaultConfig()
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	// Check decoding error
+	if err := decoder.Decode(cfg); err != nil {
+		// Return decoding errors
+		return decodeErrorsToValidation(err)
+	}
+
+	// Check semantic rules shared with the regular loader
+	if err := validateConfig(cfg); err != nil {
+		// Return semantic error without position
+		return []ValidationError{{Message: err.Error()}}
+	}
+
+	// Return no errors
+	return []ValidationError{}
+}
+
+// withCustomRuleCodes adds the codes of the custom rules declared in the
+// configuration to the catalog, so that their rules entries are known.
+//
+// Params:
+//   - data: raw YAML content
+//   - catalog: known rules
+//
+// Returns:
+//   - RuleCatalog: catalog including the declared custom rules
+func withCustomRuleCodes(data []byte, catalog RuleCatalog) RuleCatalog {
+	var declared Config
+	// Ignore malformed declarations, reported by the structural checks
+	if yaml.Unmarshal(data, &declared) != nil || len(declared.CustomRules) == 0 || catalog.Codes == nil {
+		// Return catalog unchanged
+		return catalog
+	}
+	codes := maps.Clone(catalog.Codes)
+	// Add declared codes
+	for _, rule := range declared.CustomRules {
+		codes[rule.Code] = true
+	}
+	// Return extended catalog
+	return RuleCatalog{Codes: codes, Thresholds: catalog.Thresholds}
+}
+
+// yamlFields maps yaml key names to struct fields.
+//
+// Params:
+//   - typ: struct type
+//
+// Returns:
+//   - map[string]reflect.StructField: fields indexed by yaml name
+func yamlFields(typ reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField, typ.NumField())
+	// Iterate over exported fields
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		name := YAMLFieldName(field)
+		// Skip unexported and ignored fields
+		if name == "" {
+			continue
+		}
+		fields[name] = field
+	}
+	// Return fields map
+	return fields
+}
+
+// YAMLFieldName returns the yaml key of a struct field.
+//
+// Params:
+//   - field: struct field
+//
+// Returns:
+//   - string: yaml key, empty when the field is not serialized
+func YAMLFieldName(field reflect.StructField) string {
+	// Skip unexported fields
+	if !field.IsExported() {
+		// Not serialized
+		return ""
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	// Check ignored fields
+	if name == "-" {
+		// Not serialized
+		return ""
+	}
+	// Check default name
+	if name == "" {
+		// yaml.v3 lowercases untagged field names
+		return strings.ToLower(field.Name)
+	}
+	// Return tagged name
+	return name
+}
+
+// sortedKeys returns the sorted keys of a field map.
+//
+// Params:
+//   - fields: fields indexed by name
+//
+// Returns:
+//   - []string: sorted names
+func sortedKeys(fields map[string]reflect.StructField) []string {
+	// Return sorted keys
+	return slices.Sorted(maps.Keys(fields))
+}
+
+// sortedCodes returns the sorted rule codes of a catalog.
+//
+// Params:
+//   - codes: known codes
+//
+// Returns:
+//   - []string: sorted codes
+func sortedCodes(codes map[string]bool) []string {
+	// Return sorted codes
+	return slices.Sorted(maps.Keys(codes))
+}
+
+// joinPath appends a key to a dotted yaml path.
+//
+// Params:
+//   - path: parent path
+//   - key: child key
+//
+// Returns:
+//   - string: joined path
+func joinPath(path, key string) string {
+	// Check root path
+	if path == "" {
+		// Return key alone
+		return key
+	}
+	// Return dotted path
+	return path + "." + key
+}
+
+// displayPath returns a readable name for a yaml path.
+//
+// Params:
+//   - path: dotted yaml path
+//
+// Returns:
+//   - string: path or "configuration" for the root
+func displayPath(path string) string {
+	// Check root path
+	if path == "" {
+		// Name the root document
+		return "configuration"
+	}
+	// Return path as-is
+	return path
+}
+
+// didYouMean formats a suggestion for a misspelled name.
+//
+// Params:
+//   - name: misspelled name
+//   - candidates: valid names
+//
+// Returns:
+//   - string: suggestion suffix or empty string
+func didYouMean(name string, candidates []string) string {
+	suggestion := Suggest(name, candidates)
+	// Check if a suggestion was found
+	if suggestion == "" {
+		// No close match
+		return ""
+	}
+	// Return formatted suggestion
+	return fmt.Sprintf("; did you mean %q?", suggestion)
+}
+
+// Suggest returns the closest candidate to name, if close enough.
+//
+// Params:
+//   - name: name to match
+//   - candidates: valid names
+//
+// Returns:
+//   - string: closest candidate or empty string
+func Suggest(name string, candidates []string) string {
+	maxDistance := max(minSuggestionDistance, len(name)/suggestionDistanceDivisor)
+	best, bestDistance := "", maxDistance+1
+	// Find the candidate with the smallest edit distance
+	for _, candidate := range candidates {
+		distance := levenshtein(strings.ToLower(name), strings.ToLower(candidate))
+		// Keep the closest candidate
+		if distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	// Return closest candidate
+	return best
+}
+
+// levenshtein computes the edit distance between two strings.
+//
+// Params:
+//   - a: first string
+//   - b: second string
+//
+// Returns:
+//   - int: number of single-character edits
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	// Initialize first row
+	for j := range prev {
+		prev[j] = j
+	}
+	// Fill the distance matrix row by row
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		// Compute each cell of the row
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			// Check matching characters
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	// Return final distance
+	return prev[len(b)]
+}
+
+// yamlErrorToValidation converts a yaml.v3 error message to a ValidationError.
+//
+// Params:
+//   - message: yaml error message
+//
+// Returns:
+//   - ValidationError: error with line when available
+func yamlErrorToValidation(message string) ValidationError {
+	message = strings.TrimPrefix(message, "yaml: ")
+	match := yamlLinePattern.FindStringSubmatch(message)
+	// Check if a line number was found
+	if match == nil {
+		// Return message without position
+		return ValidationError{Message: message}
+	}
+	line, _ := strconv.Atoi(match[1])
+	// Return message with line
+	return NewValidationError(line, 1, strings.TrimSpace(yamlLinePattern.ReplaceAllString(message, "")))
+}
+
+// decodeErrorsToValidation converts a decoding error to ValidationErrors.
+//
+// Params:
+//   - err: decoding error
+//
+// Returns:
+//   - []ValidationError: converted errors
+func decodeErrorsToValidation(err error) []ValidationError {
+	typeErr, ok := err.(*yaml.TypeError)
+	// Check for aggregated type errors
+	if !ok {
+		// Return single error
+		return []ValidationError{yamlErrorToValidation(err.Error())}
+	}
+	errs := make([]ValidationError, 0, len(typeErr.Errors))
+	// Convert each message
+	for _, msg := range typeErr.Errors {
+		errs = append(errs, yamlErrorToValidation(msg))
+	}
+	// Return converted errors
+	return errs
+}
@@ -0,0 +1,143 @@
+// External tests for strict configuration validation.
+package config_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+)
+
+// TestValidateStrict tests the ValidateStrict function.
+func TestValidateStrict(t *testing.T) {
+	catalog := config.RuleCatalog{
+		Codes:      map[string]bool{"KTN-FUNC-001": true, "KTN-FUNC-005": true},
+		Thresholds: map[string]int{"KTN-FUNC-005": 35},
+	}
+	tests := []struct {
+		name        string
+		data        string
+		wantLine    int
+		wantMessage string
+	}{
+		{
+			name:        "valid config",
+			data:        "version: 1\nrules:\n  KTN-FUNC-005:\n    threshold: 40\n",
+			wantMessage: "",
+		},
+		{
+			name:        "empty document",
+			data:        "",
+			wantMessage: "",
+		},
+		{
+			name:        "unknown top-level key",
+			data:        "version: 1\nexlude:\n  - foo\n",
+			wantLine:    2,
+			wantMessage: `unknown field "exlude" in configuration; did you mean "exclude"?`,
+		},
+		{
+			name:        "misspelled rule option",
+			data:        "rules:\n  KTN-FUNC-005:\n    treshold: 10\n",
+			wantLine:    3,
+			wantMessage: `did you mean "threshold"?`,
+		},
+		{
+			name:        "unknown rule code",
+			data:        "rules:\n  KTN-FUNC-05:\n    enabled: false\n",
+			wantLine:    2,
+			wantMessage: `unknown rule code "KTN-FUNC-05"; did you mean "KTN-FUNC-005"?`,
+		},
+		{
+			name:        "threshold on rule without threshold",
+			data:        "rules:\n  KTN-FUNC-001:\n    threshold: 3\n",
+			wantLine:    3,
+			wantMessage: "does not support a threshold",
+		},
+		{
+			name:        "mistyped value",
+			data:        "rules:\n  KTN-FUNC-005:\n    threshold: abc\n",
+			wantLine:    3,
+			wantMessage: "expected int",
+		},
+		{
+			name:        "semantic error",
+			data:        "rules:\n  KTN-FUNC-005:\n    threshold: -1\n",
+			wantMessage: "threshold must be non-negative",
+		},
+		{
+			name:        "syntax error",
+			data:        "rules:\n  - [\n",
+			wantMessage: "did not find expected",
+		},
+		{
+			name:        "custom rule code",
+			data:        "custom_rules:\n  - code: ACME-LOG-001\n    message: no println\n    pattern:\n      call: fmt.Println\nrules:\n  ACME-LOG-001:\n    exclude: [\"cmd/**\"]\n",
+			wantMessage: "",
+		},
+		{
+			name:        "misspelled custom pattern",
+			data:        "custom_rules:\n  - code: ACME-LOG-001\n    message: no println\n    pattern:\n      cal: fmt.Println\n",
+			wantLine:    5,
+			wantMessage: `did you mean "call"?`,
+		},
+		{
+			name:        "invalid custom rule",
+			data:        "custom_rules:\n  - code: ACME-LOG-001\n    message: no println\n",
+			wantMessage: "exactly one of call",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			errs := config.ValidateStrict([]byte(tt.data), catalog)
+
+			// Verify valid configs produce no error
+			if tt.wantMessage == "" {
+				// Check empty result
+				if len(errs) != 0 {
+					t.Errorf("expected no errors, got %v", errs)
+				}
+				return
+			}
+			// Verify an error was reported
+			if len(errs) == 0 {
+				t.Fatalf("expected error containing %q, got none", tt.wantMessage)
+			}
+			// Verify message
+			if !strings.Contains(errs[0].Message, tt.wantMessage) {
+				t.Errorf("message = %q, want substring %q", errs[0].Message, tt.wantMessage)
+			}
+			// Verify line when expected
+			if tt.wantLine != 0 && errs[0].Line != tt.wantLine {
+				t.Errorf("line = %d, want %d", errs[0].Line, tt.wantLine)
+			}
+		})
+	}
+}
+
+// TestSuggest tests the Suggest function.
+func TestSuggest(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		candidates []string
+		want       string
+	}{
+		{name: "close match", input: "treshold", candidates: []string{"enabled", "threshold", "exclude"}, want: "threshold"},
+		{name: "case insensitive", input: "ktn-func-001", candidates: []string{"KTN-FUNC-001"}, want: "KTN-FUNC-001"},
+		{name: "no match", input: "something", candidates: []string{"enabled"}, want: ""},
+		{name: "no candidates", input: "x", candidates: nil, want: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify suggestion
+			if got := config.Suggest(tt.input, tt.candidates); got != tt.want {
+				t.Errorf("Suggest(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
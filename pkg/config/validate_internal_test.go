@@ -0,0 +1,102 @@
+// Internal tests for strict configuration validation.
+package config
+
+import "testing"
+
+// Test_levenshtein tests the levenshtein function.
+func Test_levenshtein(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{name: "identical", a: "threshold", b: "threshold", want: 0},
+		{name: "one deletion", a: "treshold", b: "threshold", want: 1},
+		{name: "empty", a: "", b: "abc", want: 3},
+		{name: "substitution", a: "abc", b: "abd", want: 1},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify distance
+			if got := levenshtein(tt.a, tt.b); got != tt.want {
+				t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_yamlErrorToValidation tests the yamlErrorToValidation function.
+func Test_yamlErrorToValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  string
+		wantLine int
+	}{
+		{name: "with line", message: "yaml: line 4: did not find expected key", wantLine: 4},
+		{name: "without line", message: "yaml: unexpected end", wantLine: 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := yamlErrorToValidation(tt.message)
+			// Verify line
+			if got.Line != tt.wantLine {
+				t.Errorf("line = %d, want %d", got.Line, tt.wantLine)
+			}
+		})
+	}
+}
+
+// Test_withCustomRuleCodes tests the catalog extension with declared codes.
+func Test_withCustomRuleCodes(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  string
+		codes map[string]bool
+		want  map[string]bool
+	}{
+		{
+			name:  "declared code",
+			data:  "custom_rules:\n  - code: ACME-LOG-001\n",
+			codes: map[string]bool{"KTN-FUNC-001": true},
+			want:  map[string]bool{"KTN-FUNC-001": true, "ACME-LOG-001": true},
+		},
+		{
+			name:  "no custom rules",
+			data:  "version: 1\n",
+			codes: map[string]bool{"KTN-FUNC-001": true},
+			want:  map[string]bool{"KTN-FUNC-001": true},
+		},
+		{
+			name:  "code checks disabled",
+			data:  "custom_rules:\n  - code: ACME-LOG-001\n",
+			codes: nil,
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := withCustomRuleCodes([]byte(tt.data), RuleCatalog{Codes: tt.codes})
+			// Verify codes
+			if len(got.Codes) != len(tt.want) || (tt.want == nil) != (got.Codes == nil) {
+				t.Fatalf("Codes = %v, want %v", got.Codes, tt.want)
+			}
+			// Verify each code
+			for code := range tt.want {
+				if !got.Codes[code] {
+					t.Errorf("missing code %s", code)
+				}
+			}
+			// Verify the input catalog is untouched
+			if tt.codes != nil && len(tt.codes) != 1 {
+				t.Errorf("input catalog modified: %v", tt.codes)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Package config provides configuration management for KTN linter rules.
+package config
+
+import "fmt"
+
+// ValidationError describes a single problem found by strict validation.
+// Line and Column are 1-based and zero when the position is unknown.
+type ValidationError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+// NewValidationError creates a validation error at a position.
+//
+// Params:
+//   - line: 1-based line, zero when unknown
+//   - column: 1-based column, zero when unknown
+//   - message: error description
+//
+// Returns:
+//   - ValidationError: new validation error
+func NewValidationError(line, column int, message string) ValidationError {
+	// Return positioned error
+	return ValidationError{Line: line, Column: column, Message: message}
+}
+
+// Error returns the error message prefixed with its position.
+//
+// Returns:
+//   - string: formatted error message
+func (e ValidationError) Error() string {
+	// Check if position is known
+	if e.Line == 0 {
+		// Return message without position
+		return e.Message
+	}
+	// Return message with line and column
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Message)
+}
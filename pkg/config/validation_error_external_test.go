@@ -0,0 +1,54 @@
+// External tests for validation errors.
+package config_test
+
+import (
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+)
+
+// TestNewValidationError tests the NewValidationError function.
+func TestNewValidationError(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    int
+		column  int
+		message string
+	}{
+		{name: "positioned error", line: 2, column: 4, message: "bad key"},
+		{name: "unpositioned error", line: 0, column: 0, message: "bad value"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := config.NewValidationError(tt.line, tt.column, tt.message)
+			// Verify fields
+			if got.Line != tt.line || got.Column != tt.column || got.Message != tt.message {
+				t.Errorf("NewValidationError() = %+v", got)
+			}
+		})
+	}
+}
+
+// TestValidationError_Error tests the Error method.
+func TestValidationError_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		err  config.ValidationError
+		want string
+	}{
+		{name: "with position", err: config.ValidationError{Line: 3, Column: 5, Message: "bad"}, want: "3:5: bad"},
+		{name: "without position", err: config.ValidationError{Message: "bad"}, want: "bad"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify formatted message
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("Error() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
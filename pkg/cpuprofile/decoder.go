@@ -0,0 +1,177 @@
+// Package cpuprofile reads pprof CPU profiles to measure the CPU share of
+// functions.
+package cpuprofile
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Protobuf wire types used by the profile format.
+const (
+	// wireVarint encodes integers as base-128 varints
+	wireVarint int = 0
+	// wireFixed64 encodes 8-byte values
+	wireFixed64 int = 1
+	// wireBytes encodes length-delimited values
+	wireBytes int = 2
+	// wireFixed32 encodes 4-byte values
+	wireFixed32 int = 5
+	// wireTypeBits is the number of bits of the wire type in a field key
+	wireTypeBits uint = 3
+	// wireTypeMask extracts the wire type from a field key
+	wireTypeMask uint64 = 1<<wireTypeBits - 1
+	// fixed64Size is the size of a fixed64 value
+	fixed64Size int = 8
+	// fixed32Size is the size of a fixed32 value
+	fixed32Size int = 4
+)
+
+// errTruncated reports a message ending in the middle of a field.
+var errTruncated error = errors.New("truncated protobuf message")
+
+// decoder walks the fields of a protobuf message.
+type decoder struct {
+	data []byte
+}
+
+// done reports whether all fields were read.
+//
+// Returns:
+//   - bool: true at the end of the message
+func (d *decoder) done() bool {
+	// Check remaining bytes
+	return len(d.data) == 0
+}
+
+// field reads the key of the next field.
+//
+// Returns:
+//   - int: field number
+//   - int: wire type
+//   - error: malformed key
+func (d *decoder) field() (int, int, error) {
+	key, err := d.varint()
+	// Check key
+	if err != nil {
+		// Return malformed key
+		return 0, 0, err
+	}
+	// Return field number and wire type
+	return int(key >> wireTypeBits), int(key & wireTypeMask), nil
+}
+
+// varint reads a varint value.
+//
+// Returns:
+//   - uint64: decoded value
+//   - error: truncated or overflowing varint
+func (d *decoder) varint() (uint64, error) {
+	value, n := binary.Uvarint(d.data)
+	// Check decoding
+	if n <= 0 {
+		// Return truncated varint
+		return 0, errTruncated
+	}
+	d.data = d.data[n:]
+	// Return decoded value
+	return value, nil
+}
+
+// bytes reads a length-delimited value.
+//
+// Returns:
+//   - []byte: value bytes
+//   - error: truncated value
+func (d *decoder) bytes() ([]byte, error) {
+	size, err := d.varint()
+	// Check length and remaining bytes
+	if err != nil || size > uint64(len(d.data)) {
+		// Return truncated value
+		return []byte{}, errTruncated
+	}
+	value := d.data[:size]
+	d.data = d.data[size:]
+	// Return value bytes
+	return value, nil
+}
+
+// skip discards a field value.
+//
+// Params:
+//   - wireType: wire type of the value
+//
+// Returns:
+//   - error: truncated value or unknown wire type
+func (d *decoder) skip(wireType int) error {
+	// Dispatch on wire type
+	switch wireType {
+	// Varint value
+	case wireVarint:
+		_, err := d.varint()
+		// Return varint error
+		return err
+	// Length-delimited value
+	case wireBytes:
+		_, err := d.bytes()
+		// Return length error
+		return err
+	// Fixed-size values
+	case wireFixed64, wireFixed32:
+		size := fixed32Size
+		// Select value size
+		if wireType == wireFixed64 {
+			size = fixed64Size
+		}
+		// Check remaining bytes
+		if len(d.data) < size {
+			// Return truncated value
+			return errTruncated
+		}
+		d.data = d.data[size:]
+		// Return skipped
+		return nil
+	// Groups and unknown types
+	default:
+		// Return unsupported wire type
+		return fmt.Errorf("unsupported protobuf wire type %d", wireType)
+	}
+}
+
+// uints reads a repeated integer field, packed or not.
+//
+// Params:
+//   - wireType: wire type of the value
+//   - values: values read so far
+//
+// Returns:
+//   - []uint64: values with the new ones appended
+//   - error: malformed value
+func (d *decoder) uints(wireType int, values []uint64) ([]uint64, error) {
+	// Unpacked value
+	if wireType == wireVarint {
+		value, err := d.varint()
+		// Return appended value
+		return append(values, value), err
+	}
+	packed, err := d.bytes()
+	// Check packed bytes
+	if err != nil {
+		// Return malformed packed field
+		return values, err
+	}
+	inner := decoder{data: packed}
+	// Read every packed value
+	for !inner.done() {
+		value, err := inner.varint()
+		// Check value
+		if err != nil {
+			// Return malformed value
+			return values, err
+		}
+		values = append(values, value)
+	}
+	// Return values
+	return values, nil
+}
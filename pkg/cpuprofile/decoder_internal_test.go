@@ -0,0 +1,67 @@
+// Package cpuprofile reads pprof CPU profiles to measure the CPU share of
+// functions.
+package cpuprofile
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestDecoder_skip tests discarding values of each wire type.
+func TestDecoder_skip(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		wireType int
+		wantErr  bool
+	}{
+		{name: "varint", data: []byte{0x96, 0x01}, wireType: wireVarint, wantErr: false},
+		{name: "bytes", data: []byte{0x02, 0xaa, 0xbb}, wireType: wireBytes, wantErr: false},
+		{name: "fixed64", data: make([]byte, fixed64Size), wireType: wireFixed64, wantErr: false},
+		{name: "fixed32", data: make([]byte, fixed32Size), wireType: wireFixed32, wantErr: false},
+		{name: "truncated fixed64", data: make([]byte, fixed32Size), wireType: wireFixed64, wantErr: true},
+		{name: "truncated bytes", data: []byte{0x05, 0xaa}, wireType: wireBytes, wantErr: true},
+		{name: "group", data: []byte{}, wireType: 3, wantErr: true},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			msg := decoder{data: tt.data}
+			err := msg.skip(tt.wireType)
+			// Check error and consumption
+			if (err != nil) != tt.wantErr || (!tt.wantErr && !msg.done()) {
+				t.Errorf("skip() error = %v, remaining %d", err, len(msg.data))
+			}
+		})
+	}
+}
+
+// TestDecoder_uints tests packed and unpacked repeated integers.
+func TestDecoder_uints(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		wireType int
+		want     []uint64
+		wantErr  bool
+	}{
+		{name: "unpacked", data: []byte{0x07}, wireType: wireVarint, want: []uint64{1, 7}, wantErr: false},
+		{name: "packed", data: []byte{0x03, 0x02, 0x96, 0x01}, wireType: wireBytes, want: []uint64{1, 2, 150}, wantErr: false},
+		{name: "truncated packed value", data: []byte{0x01, 0x80}, wireType: wireBytes, want: []uint64{1}, wantErr: true},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			msg := decoder{data: tt.data}
+			got, err := msg.uints(tt.wireType, []uint64{1})
+			// Check values
+			if (err != nil) != tt.wantErr || !slices.Equal(got, tt.want) {
+				t.Errorf("uints() = %v, %v; want %v", got, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+// Package cpuprofile reads pprof CPU profiles to measure the CPU share of
+// functions.
+package cpuprofile
+
+import "fmt"
+
+const (
+	// labelPrefix starts the CPU share label of a finding
+	labelPrefix string = "[cpu: "
+	// labelSuffix ends the CPU share label of a finding
+	labelSuffix string = "%]"
+	// percentScale converts a share to a percentage
+	percentScale float64 = 100
+)
+
+// Label formats the CPU share label appended to performance findings,
+// e.g. "[cpu: 12.3%]".
+//
+// Params:
+//   - share: cumulative CPU share, between 0 and 1
+//
+// Returns:
+//   - string: share label
+func Label(share float64) string {
+	// Return percentage label
+	return fmt.Sprintf("%s%.1f%s", labelPrefix, share*percentScale, labelSuffix)
+}
@@ -0,0 +1,32 @@
+// Package cpuprofile_test provides black-box tests for the cpuprofile package.
+package cpuprofile_test
+
+import (
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/cpuprofile"
+)
+
+// TestLabel tests the formatting of CPU share labels.
+func TestLabel(t *testing.T) {
+	tests := []struct {
+		name  string
+		share float64
+		want  string
+	}{
+		{name: "no samples", share: 0, want: "[cpu: 0.0%]"},
+		{name: "partial share", share: 0.1234, want: "[cpu: 12.3%]"},
+		{name: "whole profile", share: 1, want: "[cpu: 100.0%]"},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Check the label
+			if got := cpuprofile.Label(tt.share); got != tt.want {
+				t.Errorf("Label() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
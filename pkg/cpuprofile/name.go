@@ -0,0 +1,125 @@
+// Package cpuprofile reads pprof CPU profiles to measure the CPU share of
+// functions.
+package cpuprofile
+
+import (
+	"strings"
+	"unicode"
+)
+
+// closurePrefixes start the names the compiler gives to closures and
+// go/defer wrappers ("F.func1", "F.gowrap2").
+var closurePrefixes []string = []string{"func", "gowrap", "deferwrap"}
+
+// functionKey converts a runtime function name to "importpath.Symbol",
+// the form used for findings: "example.com/api.(*Server).Handle.func1"
+// gives "example.com/api.Server.Handle".
+//
+// Params:
+//   - name: function name from the profile
+//
+// Returns:
+//   - string: normalized key, empty for unnamed functions
+func functionKey(name string) string {
+	name = stripTypeArguments(name)
+	slash := strings.LastIndex(name, "/")
+	qualifier, member, found := strings.Cut(name[slash+1:], ".")
+	// Check for a package qualifier
+	if !found {
+		// Return unqualified name
+		return name
+	}
+	pkg := name[:slash+1] + qualifier
+	rest := strings.NewReplacer("(*", "", "(", "", ")", "").Replace(member)
+	parts := strings.Split(strings.TrimSuffix(rest, "-fm"), ".")
+	kept := make([]string, 0, len(parts))
+	// Keep components up to the first closure
+	for _, part := range parts {
+		// Stop at closures and their nested numbering
+		if isClosurePart(part) {
+			break
+		}
+		kept = append(kept, part)
+	}
+	// Return normalized key
+	return pkg + "." + strings.Join(kept, ".")
+}
+
+// stripTypeArguments removes the "[...]" of generic instantiations.
+//
+// Params:
+//   - name: function name
+//
+// Returns:
+//   - string: name without type arguments
+func stripTypeArguments(name string) string {
+	var sb strings.Builder
+	sb.Grow(len(name))
+	depth := 0
+	// Copy characters outside brackets
+	for _, r := range name {
+		// Track bracket depth
+		switch r {
+		// Opening bracket
+		case '[':
+			depth++
+		// Closing bracket
+		case ']':
+			depth--
+		// Other characters
+		default:
+			// Keep characters outside brackets
+			if depth == 0 {
+				sb.WriteRune(r)
+			}
+		}
+	}
+	// Return stripped name
+	return sb.String()
+}
+
+// isClosurePart reports whether a name component designates a closure:
+// "func1", "gowrap2" or the bare number of a nested closure.
+//
+// Params:
+//   - part: name component
+//
+// Returns:
+//   - bool: true for closure components
+func isClosurePart(part string) bool {
+	// Try each closure prefix
+	for _, prefix := range closurePrefixes {
+		// Check prefix followed by a number
+		if number, found := strings.CutPrefix(part, prefix); found && isNumber(number) {
+			// Return closure component
+			return true
+		}
+	}
+	// Return nested closure number
+	return isNumber(part)
+}
+
+// isNumber reports whether s is a non-empty decimal number.
+//
+// Params:
+//   - s: string to check
+//
+// Returns:
+//   - bool: true for decimal numbers
+func isNumber(s string) bool {
+	// Reject empty strings
+	if s == "" {
+		// Return not a number
+		return false
+	}
+	// Check each character
+	for _, r := range s {
+		// Reject non-digits
+		if !unicode.IsDigit(r) {
+			// Return not a number
+			return false
+		}
+	}
+	// Return number
+	return true
+}
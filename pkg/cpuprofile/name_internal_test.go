@@ -0,0 +1,65 @@
+// Package cpuprofile reads pprof CPU profiles to measure the CPU share of
+// functions.
+package cpuprofile
+
+import (
+	"testing"
+)
+
+// TestFunctionKey tests normalizing runtime function names.
+func TestFunctionKey(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "function", in: "example.com/app/api.Handle", want: "example.com/app/api.Handle"},
+		{name: "pointer method", in: "example.com/app/api.(*Server).Handle", want: "example.com/app/api.Server.Handle"},
+		{name: "value method", in: "example.com/app/api.Server.Handle", want: "example.com/app/api.Server.Handle"},
+		{name: "nested closure", in: "example.com/app/api.Handle.func1.2", want: "example.com/app/api.Handle"},
+		{name: "go wrapper", in: "example.com/app/api.Handle.gowrap1", want: "example.com/app/api.Handle"},
+		{name: "method value", in: "example.com/app/api.(*Server).Handle-fm", want: "example.com/app/api.Server.Handle"},
+		{name: "generic method", in: "example.com/app/api.(*Cache[...]).Get", want: "example.com/app/api.Cache.Get"},
+		{name: "dotted package", in: "gopkg.in/yaml.v3.Marshal", want: "gopkg.in/yaml.v3.Marshal"},
+		{name: "main", in: "main.main", want: "main.main"},
+		{name: "unqualified", in: "unknown", want: "unknown"},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Check normalized key
+			if got := functionKey(tt.in); got != tt.want {
+				t.Errorf("functionKey(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestIsClosurePart tests recognizing closure name components.
+func TestIsClosurePart(t *testing.T) {
+	tests := []struct {
+		name string
+		part string
+		want bool
+	}{
+		{name: "closure", part: "func12", want: true},
+		{name: "defer wrapper", part: "deferwrap1", want: true},
+		{name: "nested closure number", part: "3", want: true},
+		{name: "function named func", part: "funcs", want: false},
+		{name: "empty", part: "", want: false},
+		{name: "method", part: "Handle", want: false},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Check classification
+			if got := isClosurePart(tt.part); got != tt.want {
+				t.Errorf("isClosurePart(%q) = %v, want %v", tt.part, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,380 @@
+// Package cpuprofile reads pprof CPU profiles to measure the CPU share of
+// functions.
+package cpuprofile
+
+// Field numbers of the pprof profile.proto messages.
+const (
+	// profileSampleType is Profile.sample_type
+	profileSampleType int = 1
+	// profileSample is Profile.sample
+	profileSample int = 2
+	// profileLocation is Profile.location
+	profileLocation int = 4
+	// profileFunction is Profile.function
+	profileFunction int = 5
+	// profileStringTable is Profile.string_table
+	profileStringTable int = 6
+	// profileDefaultSampleType is Profile.default_sample_type
+	profileDefaultSampleType int = 14
+	// valueTypeType is ValueType.type
+	valueTypeType int = 1
+	// sampleLocationID is Sample.location_id
+	sampleLocationID int = 1
+	// sampleValue is Sample.value
+	sampleValue int = 2
+	// locationID is Location.id
+	locationID int = 1
+	// locationLine is Location.line
+	locationLine int = 4
+	// lineFunctionID is Line.function_id
+	lineFunctionID int = 1
+	// functionID is Function.id
+	functionID int = 1
+	// functionName is Function.name
+	functionName int = 2
+	// cpuSampleType names the CPU time value of Go profiles
+	cpuSampleType string = "cpu"
+)
+
+// parser collects the tables of a profile before resolving its samples.
+type parser struct {
+	sampleTypes   []uint64
+	defaultType   uint64
+	samples       []sample
+	locations     map[uint64][]uint64
+	functionNames map[uint64]uint64
+	strings       []string
+}
+
+// newParser creates an empty parser.
+//
+// Returns:
+//   - *parser: parser ready to decode a profile
+func newParser() *parser {
+	// Return empty tables
+	return &parser{
+		sampleTypes:   []uint64{},
+		samples:       []sample{},
+		locations:     map[uint64][]uint64{},
+		functionNames: map[uint64]uint64{},
+		strings:       []string{},
+	}
+}
+
+// decode reads the fields of a Profile message.
+//
+// Params:
+//   - data: encoded Profile message
+//
+// Returns:
+//   - error: malformed message
+func (p *parser) decode(data []byte) error {
+	msg := decoder{data: data}
+	// Read every field
+	for !msg.done() {
+		field, wireType, err := msg.field()
+		// Check key
+		if err != nil {
+			// Return malformed key
+			return err
+		}
+		// Dispatch on field
+		if err := p.decodeField(&msg, field, wireType); err != nil {
+			// Return malformed field
+			return err
+		}
+	}
+	// Return decoded
+	return nil
+}
+
+// decodeField reads one field of a Profile message.
+//
+// Params:
+//   - msg: decoder positioned on the field value
+//   - field: field number
+//   - wireType: wire type of the value
+//
+// Returns:
+//   - error: malformed value
+func (p *parser) decodeField(msg *decoder, field, wireType int) error {
+	// Unknown and scalar fields
+	if wireType != wireBytes {
+		// Keep the default sample type
+		if field == profileDefaultSampleType {
+			value, err := msg.varint()
+			p.defaultType = value
+			// Return varint error
+			return err
+		}
+		// Return skip error
+		return msg.skip(wireType)
+	}
+	value, err := msg.bytes()
+	// Check value
+	if err != nil {
+		// Return truncated value
+		return err
+	}
+	// Dispatch on message field
+	switch field {
+	// Value type of each sample value
+	case profileSampleType:
+		// Return sample type error
+		return p.decodeSampleType(value)
+	// Stack sample
+	case profileSample:
+		// Return sample error
+		return p.decodeSample(value)
+	// Program location
+	case profileLocation:
+		// Return location error
+		return p.decodeLocation(value)
+	// Function
+	case profileFunction:
+		// Return function error
+		return p.decodeFunction(value)
+	// String table entry
+	case profileStringTable:
+		p.strings = append(p.strings, string(value))
+		// Return decoded string
+		return nil
+	// Mappings, comments and other messages
+	default:
+		// Return ignored field
+		return nil
+	}
+}
+
+// decodeSampleType reads a ValueType message.
+//
+// Params:
+//   - data: encoded ValueType
+//
+// Returns:
+//   - error: malformed message
+func (p *parser) decodeSampleType(data []byte) error {
+	msg := decoder{data: data}
+	var typ uint64
+	// Read every field
+	for !msg.done() {
+		field, wireType, err := msg.field()
+		// Dispatch on field
+		switch {
+		// Malformed key
+		case err != nil:
+		// Type name index
+		case field == valueTypeType && wireType == wireVarint:
+			typ, err = msg.varint()
+		// Unit and unknown fields
+		default:
+			err = msg.skip(wireType)
+		}
+		// Check field
+		if err != nil {
+			// Return malformed field
+			return err
+		}
+	}
+	p.sampleTypes = append(p.sampleTypes, typ)
+	// Return decoded
+	return nil
+}
+
+// decodeSample reads a Sample message.
+//
+// Params:
+//   - data: encoded Sample
+//
+// Returns:
+//   - error: malformed message
+func (p *parser) decodeSample(data []byte) error {
+	msg := decoder{data: data}
+	s := sample{locations: []uint64{}, values: []uint64{}}
+	// Read every field
+	for !msg.done() {
+		field, wireType, err := msg.field()
+		// Dispatch on field
+		switch {
+		// Malformed key
+		case err != nil:
+		// Stack locations, leaf first
+		case field == sampleLocationID:
+			s.locations, err = msg.uints(wireType, s.locations)
+		// Measured values
+		case field == sampleValue:
+			s.values, err = msg.uints(wireType, s.values)
+		// Labels and unknown fields
+		default:
+			err = msg.skip(wireType)
+		}
+		// Check field
+		if err != nil {
+			// Return malformed field
+			return err
+		}
+	}
+	p.samples = append(p.samples, s)
+	// Return decoded
+	return nil
+}
+
+// decodeLocation reads a Location message and the functions of its lines.
+//
+// Params:
+//   - data: encoded Location
+//
+// Returns:
+//   - error: malformed message
+func (p *parser) decodeLocation(data []byte) error {
+	msg := decoder{data: data}
+	var id uint64
+	functions := []uint64{}
+	// Read every field
+	for !msg.done() {
+		field, wireType, err := msg.field()
+		// Dispatch on field
+		switch {
+		// Malformed key
+		case err != nil:
+		// Location identifier
+		case field == locationID && wireType == wireVarint:
+			id, err = msg.varint()
+		// Source line, inlined callees first
+		case field == locationLine && wireType == wireBytes:
+			var line []byte
+			line, err = msg.bytes()
+			// Keep the function of the line
+			if err == nil {
+				functions, err = lineFunction(line, functions)
+			}
+		// Mapping, address and unknown fields
+		default:
+			err = msg.skip(wireType)
+		}
+		// Check field
+		if err != nil {
+			// Return malformed field
+			return err
+		}
+	}
+	p.locations[id] = functions
+	// Return decoded
+	return nil
+}
+
+// decodeFunction reads a Function message.
+//
+// Params:
+//   - data: encoded Function
+//
+// Returns:
+//   - error: malformed message
+func (p *parser) decodeFunction(data []byte) error {
+	msg := decoder{data: data}
+	var id, name uint64
+	// Read every field
+	for !msg.done() {
+		field, wireType, err := msg.field()
+		// Dispatch on field
+		switch {
+		// Malformed key
+		case err != nil:
+		// Function identifier
+		case field == functionID && wireType == wireVarint:
+			id, err = msg.varint()
+		// Function name index
+		case field == functionName && wireType == wireVarint:
+			name, err = msg.varint()
+		// System name, file name and start line
+		default:
+			err = msg.skip(wireType)
+		}
+		// Check field
+		if err != nil {
+			// Return malformed field
+			return err
+		}
+	}
+	p.functionNames[id] = name
+	// Return decoded
+	return nil
+}
+
+// lineFunction appends the function of a Line message.
+//
+// Params:
+//   - data: encoded Line
+//   - functions: functions read so far
+//
+// Returns:
+//   - []uint64: functions with the line function appended
+//   - error: malformed message
+func lineFunction(data []byte, functions []uint64) ([]uint64, error) {
+	msg := decoder{data: data}
+	// Read every field
+	for !msg.done() {
+		field, wireType, err := msg.field()
+		// Dispatch on field
+		switch {
+		// Malformed key
+		case err != nil:
+		// Function identifier
+		case field == lineFunctionID && wireType == wireVarint:
+			var id uint64
+			id, err = msg.varint()
+			functions = append(functions, id)
+		// Line, column and unknown fields
+		default:
+			err = msg.skip(wireType)
+		}
+		// Check field
+		if err != nil {
+			// Return malformed field
+			return functions, err
+		}
+	}
+	// Return functions
+	return functions, nil
+}
+
+// valueIndex returns the index of the CPU time among sample values: the
+// "cpu" sample type, else the default sample type, else the last one.
+//
+// Returns:
+//   - int: index into sample values
+func (p *parser) valueIndex() int {
+	index := len(p.sampleTypes) - 1
+	// Search the CPU or default sample type
+	for i, typ := range p.sampleTypes {
+		name := p.str(typ)
+		// Prefer the CPU time
+		if name == cpuSampleType {
+			// Return CPU index
+			return i
+		}
+		// Remember the default type
+		if p.defaultType != 0 && typ == p.defaultType {
+			index = i
+		}
+	}
+	// Return default or last index
+	return index
+}
+
+// str resolves a string table index.
+//
+// Params:
+//   - index: string table index
+//
+// Returns:
+//   - string: string, empty when out of range
+func (p *parser) str(index uint64) string {
+	// Check range
+	if index >= uint64(len(p.strings)) {
+		// Return empty string
+		return ""
+	}
+	// Return string
+	return p.strings[index]
+}
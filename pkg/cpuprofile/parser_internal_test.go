@@ -0,0 +1,61 @@
+// Package cpuprofile reads pprof CPU profiles to measure the CPU share of
+// functions.
+package cpuprofile
+
+import (
+	"testing"
+)
+
+// TestParser_valueIndex tests selecting the CPU time among sample values.
+func TestParser_valueIndex(t *testing.T) {
+	tests := []struct {
+		name        string
+		sampleTypes []uint64
+		defaultType uint64
+		want        int
+	}{
+		{name: "cpu type", sampleTypes: []uint64{1, 2}, want: 1},
+		{name: "default type", sampleTypes: []uint64{3, 4}, defaultType: 3, want: 0},
+		{name: "last type", sampleTypes: []uint64{3, 4}, want: 1},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			p := newParser()
+			p.strings = []string{"", "samples", cpuSampleType, "alloc_space", "inuse_space"}
+			p.sampleTypes = tt.sampleTypes
+			p.defaultType = tt.defaultType
+			// Check selected index
+			if got := p.valueIndex(); got != tt.want {
+				t.Errorf("valueIndex() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParser_str tests resolving string table indexes.
+func TestParser_str(t *testing.T) {
+	tests := []struct {
+		name  string
+		index uint64
+		want  string
+	}{
+		{name: "in range", index: 1, want: cpuSampleType},
+		{name: "out of range", index: 9, want: ""},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			p := newParser()
+			p.strings = []string{"", cpuSampleType}
+			// Check resolved string
+			if got := p.str(tt.index); got != tt.want {
+				t.Errorf("str(%d) = %q, want %q", tt.index, got, tt.want)
+			}
+		})
+	}
+}
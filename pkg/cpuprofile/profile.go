@@ -0,0 +1,151 @@
+// Package cpuprofile reads pprof CPU profiles to measure the CPU share of
+// functions.
+package cpuprofile
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	// gzipID1 is the first byte of gzip streams
+	gzipID1 byte = 0x1f
+	// gzipID2 is the second byte of gzip streams
+	gzipID2 byte = 0x8b
+)
+
+// gzipMagic starts gzip-compressed profiles, as written by runtime/pprof.
+var gzipMagic []byte = []byte{gzipID1, gzipID2}
+
+// Profile holds the cumulative CPU time of each function of a pprof CPU
+// profile. Functions are keyed like findings ("importpath.Type.Method") and
+// closures are merged into their enclosing function.
+type Profile struct {
+	total      uint64
+	cumulative map[string]uint64
+}
+
+// Load reads a pprof CPU profile, gzip-compressed or not.
+//
+// Params:
+//   - path: profile file (e.g. cpu.pprof or default.pgo)
+//
+// Returns:
+//   - *Profile: parsed profile
+//   - error: unreadable or malformed profile
+func Load(path string) (*Profile, error) {
+	data, err := os.ReadFile(path)
+	// Check read error
+	if err != nil {
+		// Return read error
+		return nil, err
+	}
+	prof, err := Parse(data)
+	// Check parse error
+	if err != nil {
+		// Return error with the file name
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	// Return parsed profile
+	return prof, nil
+}
+
+// Parse decodes a pprof CPU profile, gzip-compressed or not.
+//
+// Params:
+//   - data: profile bytes
+//
+// Returns:
+//   - *Profile: parsed profile
+//   - error: malformed profile
+func Parse(data []byte) (*Profile, error) {
+	// Decompress gzip profiles
+	if bytes.HasPrefix(data, gzipMagic) {
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		// Check gzip header
+		if err != nil {
+			// Return gzip error
+			return nil, err
+		}
+		data, err = io.ReadAll(reader)
+		// Check gzip stream
+		if err != nil {
+			// Return gzip error
+			return nil, err
+		}
+	}
+	tables := newParser()
+	// Decode profile tables
+	if err := tables.decode(data); err != nil {
+		// Return decoding error
+		return nil, fmt.Errorf("invalid profile: %w", err)
+	}
+	// Check sample values
+	if len(tables.sampleTypes) == 0 {
+		// Return empty profile error
+		return nil, errors.New("invalid profile: no sample type")
+	}
+	// Return aggregated profile
+	return aggregate(tables), nil
+}
+
+// aggregate sums the CPU time of every function present in each stack.
+//
+// Params:
+//   - p: decoded tables
+//
+// Returns:
+//   - *Profile: cumulative time by function
+func aggregate(p *parser) *Profile {
+	index := p.valueIndex()
+	prof := &Profile{cumulative: make(map[string]uint64, len(p.functionNames))}
+	seen := make(map[string]bool, len(p.functionNames))
+	// Attribute each sample to the functions of its stack
+	for _, s := range p.samples {
+		// Skip samples without the selected value
+		if index >= len(s.values) {
+			continue
+		}
+		value := s.values[index]
+		prof.total += value
+		clear(seen)
+		// Walk the stack
+		for _, location := range s.locations {
+			// Walk inlined frames
+			for _, function := range p.locations[location] {
+				key := functionKey(p.str(p.functionNames[function]))
+				// Count recursive and inlined frames once
+				if key == "" || seen[key] {
+					continue
+				}
+				seen[key] = true
+				prof.cumulative[key] += value
+			}
+		}
+	}
+	// Return aggregated profile
+	return prof
+}
+
+// Share returns the fraction of CPU time spent in a function and its
+// callees. Closures count for their enclosing function.
+//
+// Params:
+//   - pkgPath: import path of the package ("main" for commands)
+//   - symbol: function or "Type.Method"
+//
+// Returns:
+//   - float64: share between 0 and 1
+func (p *Profile) Share(pkgPath, symbol string) float64 {
+	// Check empty profile
+	if p == nil || p.total == 0 {
+		// Return no share
+		return 0
+	}
+	// Return cumulative share
+	return float64(p.cumulative[pkgPath+"."+symbol]) / float64(p.total)
+}
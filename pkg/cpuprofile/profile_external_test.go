@@ -0,0 +1,82 @@
+// Package cpuprofile_test provides black-box tests for the cpuprofile package.
+package cpuprofile_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"testing"
+	"time"
+
+	"github.com/kodflow/ktn-linter/pkg/cpuprofile"
+)
+
+// spinDuration keeps the CPU busy long enough to record samples.
+const spinDuration time.Duration = 50 * time.Millisecond
+
+// TestLoad tests loading profiles written by runtime/pprof.
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	recorded := filepath.Join(dir, "cpu.pprof")
+	file, err := os.Create(recorded)
+	// Check profile creation
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Record a real CPU profile
+	if err := pprof.StartCPUProfile(file); err != nil {
+		t.Fatal(err)
+	}
+	// Keep the CPU busy
+	for deadline := time.Now().Add(spinDuration); time.Now().Before(deadline); {
+		runtime.Gosched()
+	}
+	pprof.StopCPUProfile()
+	file.Close()
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{name: "runtime profile", path: recorded, wantErr: false},
+		{name: "missing file", path: filepath.Join(dir, "missing.pprof"), wantErr: true},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			prof, err := cpuprofile.Load(tt.path)
+			// Check error expectation
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Load() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// Check share bounds
+			if share := prof.Share("main", "main"); share < 0 || share > 1 {
+				t.Errorf("Share() = %v out of range", share)
+			}
+		})
+	}
+}
+
+// TestProfile_Share tests shares of a nil profile.
+func TestProfile_Share(t *testing.T) {
+	tests := []struct {
+		name string
+		prof *cpuprofile.Profile
+	}{
+		{name: "nil profile", prof: nil},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Check zero share
+			if got := tt.prof.Share("main", "main"); got != 0 {
+				t.Errorf("Share() = %v, want 0", got)
+			}
+		})
+	}
+}
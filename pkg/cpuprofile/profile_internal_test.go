@@ -0,0 +1,143 @@
+// Package cpuprofile reads pprof CPU profiles to measure the CPU share of
+// functions.
+package cpuprofile
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+// appendVarintField appends a varint field.
+func appendVarintField(b []byte, field int, value uint64) []byte {
+	b = binary.AppendUvarint(b, uint64(field)<<wireTypeBits|uint64(wireVarint))
+	// Return appended field
+	return binary.AppendUvarint(b, value)
+}
+
+// appendBytesField appends a length-delimited field.
+func appendBytesField(b []byte, field int, value []byte) []byte {
+	b = binary.AppendUvarint(b, uint64(field)<<wireTypeBits|uint64(wireBytes))
+	b = binary.AppendUvarint(b, uint64(len(value)))
+	// Return appended field
+	return append(b, value...)
+}
+
+// encodeProfile builds a CPU profile where each stack, leaf first, has the
+// CPU time of the same index.
+func encodeProfile(stacks [][]string, cpu []uint64) []byte {
+	strs := []string{"", "samples", "count", cpuSampleType, "nanoseconds"}
+	ids := map[string]uint64{}
+	var out []byte
+	// Declare sample types
+	for _, typ := range [][2]uint64{{1, 2}, {3, 4}} {
+		vt := appendVarintField(nil, valueTypeType, typ[0])
+		vt = appendVarintField(vt, 2, typ[1])
+		out = appendBytesField(out, profileSampleType, vt)
+	}
+	// Declare samples, functions and locations
+	for i, stack := range stacks {
+		var locs, values []byte
+		// Declare each frame
+		for _, name := range stack {
+			// Declare new functions with one location each
+			if _, ok := ids[name]; !ok {
+				id := uint64(len(ids) + 1)
+				ids[name] = id
+				strs = append(strs, name)
+				fn := appendVarintField(nil, functionID, id)
+				fn = appendVarintField(fn, functionName, uint64(len(strs)-1))
+				out = appendBytesField(out, profileFunction, fn)
+				line := appendVarintField(nil, lineFunctionID, id)
+				loc := appendVarintField(nil, locationID, id)
+				loc = appendBytesField(loc, locationLine, line)
+				out = appendBytesField(out, profileLocation, loc)
+			}
+			locs = binary.AppendUvarint(locs, ids[name])
+		}
+		values = binary.AppendUvarint(values, 1)
+		values = binary.AppendUvarint(values, cpu[i])
+		s := appendBytesField(nil, sampleLocationID, locs)
+		s = appendBytesField(s, sampleValue, values)
+		out = appendBytesField(out, profileSample, s)
+	}
+	// Write the string table
+	for _, s := range strs {
+		out = appendBytesField(out, profileStringTable, []byte(s))
+	}
+	// Return encoded profile
+	return out
+}
+
+// TestParse tests CPU shares of an encoded profile.
+func TestParse(t *testing.T) {
+	data := encodeProfile([][]string{
+		{"example.com/app/api.(*Server).encode", "example.com/app/api.(*Server).ServeHTTP.func1", "example.com/app/api.(*Server).ServeHTTP", "main.main"},
+		{"example.com/app/api.walk", "example.com/app/api.walk", "main.main"},
+		{"runtime.mallocgc", "example.com/app/api.Map[...]", "main.main"},
+		{"runtime.gcBgMarkWorker"},
+	}, []uint64{50, 20, 10, 20})
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	zw.Write(data)
+	zw.Close()
+	tests := []struct {
+		name   string
+		data   []byte
+		pkg    string
+		symbol string
+		want   float64
+	}{
+		{name: "method with its closure", data: data, pkg: "example.com/app/api", symbol: "Server.ServeHTTP", want: 0.5},
+		{name: "leaf method", data: data, pkg: "example.com/app/api", symbol: "Server.encode", want: 0.5},
+		{name: "recursion counted once", data: data, pkg: "example.com/app/api", symbol: "walk", want: 0.2},
+		{name: "generic function", data: data, pkg: "example.com/app/api", symbol: "Map", want: 0.1},
+		{name: "main package", data: data, pkg: "main", symbol: "main", want: 0.8},
+		{name: "gzip profile", data: gz.Bytes(), pkg: "main", symbol: "main", want: 0.8},
+		{name: "absent function", data: data, pkg: "example.com/app/api", symbol: "init", want: 0},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			prof, err := Parse(tt.data)
+			// Check parsing
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := prof.Share(tt.pkg, tt.symbol)
+			// Check share
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Share(%q, %q) = %v, want %v", tt.pkg, tt.symbol, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParse_malformed tests rejecting invalid profiles.
+func TestParse_malformed(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: []byte{}},
+		{name: "truncated varint", data: []byte{0x08, 0x80}},
+		{name: "truncated bytes", data: []byte{0x12, 0x05, 0x01}},
+		{name: "group wire type", data: []byte{0x0b}},
+		{name: "bad gzip", data: []byte{0x1f, 0x8b, 0x00}},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Check error
+			if _, err := Parse(tt.data); err == nil {
+				t.Errorf("Parse(%x) expected error", tt.data)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+// Package cpuprofile reads pprof CPU profiles to measure the CPU share of
+// functions.
+package cpuprofile
+
+// sample is one stack of a profile with its measured values.
+type sample struct {
+	locations []uint64
+	values    []uint64
+}
@@ -0,0 +1,8 @@
+// Package formatter provides output formatting for lint diagnostics.
+package formatter
+
+import "go/token"
+
+// CPUShareLookup returns the CPU share of the function enclosing a finding,
+// between 0 and 1, or 0 when no CPU profile ranks it.
+type CPUShareLookup func(pos token.Position) float64
@@ -12,12 +12,16 @@ type FormatterOptions struct {
 	NoColor     bool
 	SimpleMode  bool
 	VerboseMode bool
+	Symbols     SymbolLookup   // Enclosing symbols for JSON outputs (nil for none)
+	CPUShares   CPUShareLookup // CPU shares ranking findings (nil without profile)
+	HotPaths    HotPathLookup  // Hot path reasons of escalated findings (nil for none)
+	BaseDir     string         // Directory recorded in JSON reports (empty for none)
 }
 
 // NewFormatterByFormat creates a formatter based on output format.
 //
 // Params:
-//   - format: output format (text, json, sarif)
+//   - format: output format (text, json, sarif, jsonl)
 //   - w: writer for output
 //   - opts: formatter options
 //
@@ -29,14 +33,18 @@ func NewFormatterByFormat(format OutputFormat, w io.Writer, opts FormatterOption
 	// JSON format case
 	case FormatJSON:
 		// Return JSON formatter
-		return NewJSONFormatter(w, opts.VerboseMode)
+		return newJSONFormatter(w, opts)
 	// SARIF format case
 	case FormatSARIF:
 		// Return SARIF formatter
-		return NewSARIFFormatter(w, opts.VerboseMode)
+		return newSARIFFormatter(w, opts)
+	// JSON Lines format case
+	case FormatJSONL:
+		// Return JSON Lines formatter
+		return newJSONLFormatter(w, opts)
 	// Default case
 	default:
 		// Return default text formatter
-		return NewFormatter(w, opts.AIMode, opts.NoColor, opts.SimpleMode, opts.VerboseMode)
+		return newTextFormatter(w, opts)
 	}
 }
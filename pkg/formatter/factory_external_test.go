@@ -89,6 +89,25 @@ func TestNewFormatterByFormat(t *testing.T) {
 				}
 			},
 		},
+		{
+			// Test JSON Lines format
+			name:   "jsonl format returns jsonl formatter",
+			format: formatter.FormatJSONL,
+			opts: formatter.FormatterOptions{
+				VerboseMode: false,
+			},
+			expectNonNil: true,
+			validateOutput: func(t *testing.T, output string) {
+				lines := strings.Split(strings.TrimSpace(output), "\n")
+				// Parse the final summary line
+				var summary map[string]interface{}
+				err := json.Unmarshal([]byte(lines[len(lines)-1]), &summary)
+				// Verify the summary line
+				if err != nil || summary["type"] != "summary" {
+					t.Errorf("expected a JSON Lines summary, got %q (%v)", output, err)
+				}
+			},
+		},
 		{
 			// Test unknown format defaults to text
 			name:   "unknown format defaults to text formatter",
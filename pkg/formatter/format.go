@@ -12,6 +12,8 @@ const (
 	FormatJSON OutputFormat = "json"
 	// FormatSARIF represents SARIF output format.
 	FormatSARIF OutputFormat = "sarif"
+	// FormatJSONL represents JSON Lines output format, one finding per line.
+	FormatJSONL OutputFormat = "jsonl"
 )
 
 // ParseOutputFormat parses a string to an OutputFormat.
@@ -44,7 +46,7 @@ func (f OutputFormat) IsValid() bool {
 	// Check against all valid formats
 	switch f {
 	// Match any of the valid format constants
-	case FormatText, FormatJSON, FormatSARIF:
+	case FormatText, FormatJSON, FormatSARIF, FormatJSONL:
 		// Format is valid
 		return true
 	}
@@ -52,3 +54,13 @@ func (f OutputFormat) IsValid() bool {
 	// Format is invalid
 	return false
 }
+
+// CanStream reports whether the format can display findings package by
+// package, through a StreamFormatter.
+//
+// Returns:
+//   - bool: true if the format supports incremental output
+func (f OutputFormat) CanStream() bool {
+	// Only line-oriented formats stream
+	return f == FormatText || f == FormatJSONL
+}
@@ -35,6 +35,12 @@ func TestParseOutputFormat(t *testing.T) {
 			input:    "sarif",
 			expected: formatter.FormatSARIF,
 		},
+		{
+			// Test valid JSON Lines format
+			name:     "valid jsonl format",
+			input:    "jsonl",
+			expected: formatter.FormatJSONL,
+		},
 		{
 			// Test unknown format defaults to text
 			name:     "unknown format defaults to text",
@@ -101,6 +107,12 @@ func TestOutputFormatIsValid(t *testing.T) {
 			format:   formatter.FormatSARIF,
 			expected: true,
 		},
+		{
+			// Test FormatJSONL is valid
+			name:     "FormatJSONL is valid",
+			format:   formatter.FormatJSONL,
+			expected: true,
+		},
 		{
 			// Test unknown format is invalid
 			name:     "unknown format is invalid",
@@ -222,3 +234,34 @@ func TestParseOutputFormatRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+// TestOutputFormatCanStream tests the CanStream method on OutputFormat.
+//
+// Params:
+//   - t: testing object for running test cases
+func TestOutputFormatCanStream(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name     string
+		format   formatter.OutputFormat
+		expected bool
+	}{
+		{name: "text streams", format: formatter.FormatText, expected: true},
+		{name: "json is a single document", format: formatter.FormatJSON, expected: false},
+		{name: "sarif is a single document", format: formatter.FormatSARIF, expected: false},
+		{name: "jsonl streams", format: formatter.FormatJSONL, expected: true},
+	}
+
+	// Run all test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		// Run individual test case
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify the result matches expected
+			if result := tt.format.CanStream(); result != tt.expected {
+				// Report error with details
+				t.Errorf("OutputFormat(%q).CanStream() = %v, want %v", tt.format, result, tt.expected)
+			}
+		})
+	}
+}
@@ -2,8 +2,13 @@
 package formatter
 
 import (
+	"cmp"
+	"go/token"
+	"slices"
 	"strings"
 
+	"github.com/kodflow/ktn-linter/pkg/cpuprofile"
+	"github.com/kodflow/ktn-linter/pkg/rulecode"
 	"golang.org/x/tools/go/analysis"
 )
 
@@ -30,6 +35,11 @@ const (
 
 	// InitialFileMapCap définit la capacité estimée pour groupement par fichier
 	InitialFileMapCap int = 16
+
+	// hotPathLabelPrefix ouvre l'étiquette de chemin chaud d'un diagnostic
+	hotPathLabelPrefix string = "[hot path: "
+	// hotPathLabelSuffix ferme l'étiquette de chemin chaud d'un diagnostic
+	hotPathLabelSuffix string = "]"
 )
 
 // DiagnosticGroupData regroupe les diagnostics par fichier.
@@ -39,30 +49,19 @@ type DiagnosticGroupData struct {
 	Diagnostics []analysis.Diagnostic
 }
 
-// extractCode extrait le code d'erreur du message (ex: "KTN-VAR-001")
+// extractCode extrait le code d'erreur du message (ex: "KTN-VAR-001").
+// Les codes des règles tierces enregistrées (ex: "ACME-SEC-001") sont reconnus.
+//
 // Params:
-//   - pass: contexte d'analyse
+//   - message: message brut du diagnostic
 //
 // Returns:
-//   - string: message extrait
+//   - string: code extrait, "UNKNOWN" si absent
 func extractCode(message string) string {
-	// Cherche le pattern KTN-XXX-XXX avec ou sans crochets
-	// Format 1: [KTN-XXX-XXX]
-	if start := strings.Index(message, "[KTN-"); start != -1 {
-		// Vérification de la condition
-		if end := strings.Index(message[start:], "]"); end != -1 {
-			// Early return from function.
-			return message[start+1 : start+end]
-		}
-	}
-
-	// Format 2: KTN-XXX-XXX: (au début du message)
-	if strings.HasPrefix(message, "KTN-") {
-		// Vérification de la condition
-		if before, _, found := strings.Cut(message, ":"); found {
-			// Early return from function.
-			return before
-		}
+	// Cherche le code avec ou sans crochets
+	if code := rulecode.FromMessage(message); code != "" {
+		// Early return from function.
+		return code
 	}
 
 	// Early return from function.
@@ -76,28 +75,15 @@ func extractCode(message string) string {
 //   - truncate: true pour tronquer au premier \n
 //
 // Returns:
-//   - string: message nettoyé sans le code KTN
+//   - string: message nettoyé sans le code de règle
 func extractMessageWithOptions(message string, truncate bool) string {
-	var idx int
-	// Supprimer le code [KTN-XXX-XXX] ou KTN-XXX-XXX:
-	// Traitement Format 1: [KTN-XXX-XXX] ...
-	if strings.HasPrefix(message, "[KTN-") {
-		// Chercher le ] correspondant au code KTN
-		if idx = strings.Index(message, "]"); idx != -1 && idx < len(message)-1 {
-			message = strings.TrimSpace(message[idx+1:])
-		}
-		// Sinon traitement Format 2: KTN-XXX-XXX: ...
-	} else if strings.HasPrefix(message, "KTN-") {
-		// Chercher le : séparateur
-		if idx = strings.Index(message, ":"); idx != -1 && idx < len(message)-1 {
-			message = strings.TrimSpace(message[idx+1:])
-		}
-	}
+	// Supprimer le code [XXX-YYY-ZZZ] ou XXX-YYY-ZZZ:
+	message = rulecode.TrimCode(message)
 
 	// Tronquer au premier \n si demandé (mode normal)
 	if truncate {
 		// Chercher le premier \n
-		if idx = strings.Index(message, "\n"); idx != -1 {
+		if idx := strings.Index(message, "\n"); idx != -1 {
 			message = message[:idx]
 		}
 	}
@@ -105,3 +91,132 @@ func extractMessageWithOptions(message string, truncate bool) string {
 	// Retour du message nettoyé
 	return message
 }
+
+// cpuShare retourne la part CPU de la fonction englobante d'un diagnostic,
+// mesurée à partir d'un profil CPU (0 sans profil).
+//
+// Params:
+//   - shares: parts CPU des diagnostics (nil sans profil)
+//   - pos: position résolue du diagnostic
+//
+// Returns:
+//   - float64: part CPU entre 0 et 1
+func cpuShare(shares CPUShareLookup, pos token.Position) float64 {
+	// Vérification de la présence d'un profil
+	if shares == nil {
+		// Retour sans part CPU
+		return 0
+	}
+	// Retour de la part CPU
+	return shares(pos)
+}
+
+// hotPath retourne la raison pour laquelle la fonction englobante d'un
+// diagnostic est chaude (vide hors chemin chaud).
+//
+// Params:
+//   - hotPaths: raisons des diagnostics escaladés (nil sans chemin chaud)
+//   - pos: position résolue du diagnostic
+//
+// Returns:
+//   - string: raison du chemin chaud
+func hotPath(hotPaths HotPathLookup, pos token.Position) string {
+	// Vérification de la présence de chemins chauds
+	if hotPaths == nil {
+		// Retour sans raison
+		return ""
+	}
+	// Retour de la raison
+	return hotPaths(pos)
+}
+
+// HotPathLabel formate l'étiquette de chemin chaud d'un diagnostic, ex.
+// "[hot path: http.Handler]".
+//
+// Params:
+//   - reason: raison pour laquelle la fonction englobante est chaude
+//
+// Returns:
+//   - string: étiquette du chemin chaud
+func HotPathLabel(reason string) string {
+	// Retour de l'étiquette
+	return hotPathLabelPrefix + reason + hotPathLabelSuffix
+}
+
+// withCPULabel ajoute l'étiquette de part CPU (ex. "[cpu: 12.3%]") à la
+// première ligne d'un message, pour les sorties texte.
+//
+// Params:
+//   - message: message du diagnostic, éventuellement multi-ligne
+//   - share: part CPU de la fonction englobante (0 sans étiquette)
+//
+// Returns:
+//   - string: message étiqueté
+func withCPULabel(message string, share float64) string {
+	// Vérification de la part CPU
+	if share <= 0 {
+		// Retour du message inchangé
+		return message
+	}
+	// Retour du message étiqueté
+	return withLabel(message, cpuprofile.Label(share))
+}
+
+// withHotPathLabel ajoute l'étiquette de chemin chaud (ex. "[hot path:
+// //ktn:hot]") à la première ligne d'un message, pour les sorties texte.
+//
+// Params:
+//   - message: message du diagnostic, éventuellement multi-ligne
+//   - reason: raison du chemin chaud (vide sans étiquette)
+//
+// Returns:
+//   - string: message étiqueté
+func withHotPathLabel(message, reason string) string {
+	// Vérification de la raison
+	if reason == "" {
+		// Retour du message inchangé
+		return message
+	}
+	// Retour du message étiqueté
+	return withLabel(message, HotPathLabel(reason))
+}
+
+// withLabel ajoute une étiquette à la première ligne d'un message.
+//
+// Params:
+//   - message: message du diagnostic, éventuellement multi-ligne
+//   - label: étiquette à ajouter
+//
+// Returns:
+//   - string: message étiqueté
+func withLabel(message, label string) string {
+	first, rest, multiline := strings.Cut(message, "\n")
+	first += " " + label
+	// Conserver les détails après la première ligne
+	if multiline {
+		// Retour du message multi-ligne étiqueté
+		return first + "\n" + rest
+	}
+	// Retour du message étiqueté
+	return first
+}
+
+// rankByCPUShare trie une copie des diagnostics par part CPU décroissante.
+// L'ordre d'origine est conservé à égalité, donc sans profil CPU.
+//
+// Params:
+//   - fset: ensemble de fichiers
+//   - diagnostics: diagnostics à classer
+//   - shares: parts CPU des diagnostics (nil sans profil)
+//
+// Returns:
+//   - []analysis.Diagnostic: diagnostics classés
+func rankByCPUShare(fset *token.FileSet, diagnostics []analysis.Diagnostic, shares CPUShareLookup) []analysis.Diagnostic {
+	ranked := slices.Clone(diagnostics)
+	slices.SortStableFunc(ranked, func(a, b analysis.Diagnostic) int {
+		// Part CPU la plus élevée en premier
+		return cmp.Compare(cpuShare(shares, fset.Position(b.Pos)), cpuShare(shares, fset.Position(a.Pos)))
+	})
+	// Retour des diagnostics classés
+	return ranked
+}
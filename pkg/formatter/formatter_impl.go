@@ -22,6 +22,25 @@ type Formatter interface {
 	Format(fset *token.FileSet, diagnostics []analysis.Diagnostic)
 }
 
+// StreamFormatter est un Formatter capable d'afficher les diagnostics au fil
+// de l'analyse, paquet par paquet.
+type StreamFormatter interface {
+	Formatter
+
+	// FormatPackage affiche les diagnostics d'un paquet dès son analyse
+	//
+	// Params:
+	//   - fset: le FileSet contenant les informations de position
+	//   - diagnostics: les diagnostics du paquet
+	FormatPackage(fset *token.FileSet, diagnostics []analysis.Diagnostic)
+
+	// Finish termine la sortie une fois tous les paquets affichés
+	//
+	// Params:
+	//   - total: nombre total de diagnostics affichés
+	Finish(total int)
+}
+
 // formatterImpl implémente l'interface Formatter
 type formatterImpl struct {
 	writer      io.Writer
@@ -29,6 +48,8 @@ type formatterImpl struct {
 	noColor     bool
 	simpleMode  bool
 	verboseMode bool
+	shares      CPUShareLookup
+	hotPaths    HotPathLookup
 }
 
 // NewFormatter crée un nouveau formatter avec les options spécifiées.
@@ -43,13 +64,34 @@ type formatterImpl struct {
 // Returns:
 //   - Formatter: un formatter prêt à utiliser
 func NewFormatter(w io.Writer, aiMode, noColor, simpleMode, verboseMode bool) Formatter {
+	// Early return from function.
+	return newTextFormatter(w, FormatterOptions{
+		AIMode:      aiMode,
+		NoColor:     noColor,
+		SimpleMode:  simpleMode,
+		VerboseMode: verboseMode,
+	})
+}
+
+// newTextFormatter crée un formatter texte étiquetant les parts CPU et les
+// chemins chauds.
+//
+// Params:
+//   - w: le writer où écrire la sortie
+//   - opts: options du formatter
+//
+// Returns:
+//   - *formatterImpl: un formatter prêt à utiliser
+func newTextFormatter(w io.Writer, opts FormatterOptions) *formatterImpl {
 	// Early return from function.
 	return &formatterImpl{
 		writer:      w,
-		aiMode:      aiMode,
-		noColor:     noColor,
-		simpleMode:  simpleMode,
-		verboseMode: verboseMode,
+		aiMode:      opts.AIMode,
+		noColor:     opts.NoColor,
+		simpleMode:  opts.SimpleMode,
+		verboseMode: opts.VerboseMode,
+		shares:      opts.CPUShares,
+		hotPaths:    opts.HotPaths,
 	}
 }
 
@@ -103,7 +145,15 @@ func (f *formatterImpl) formatForHuman(fset *token.FileSet, diagnostics []analys
 	}
 
 	f.printHeader(totalCount)
+	f.printHumanGroups(fset, groups)
+	f.printSummary(totalCount)
+}
 
+// printHumanGroups affiche les groupes de diagnostics pour un humain
+// Params:
+//   - fset: ensemble de fichiers
+//   - groups: diagnostics regroupés par fichier
+func (f *formatterImpl) printHumanGroups(fset *token.FileSet, groups []DiagnosticGroupData) {
 	// Itération sur les éléments
 	for _, group := range groups {
 		f.printFileHeader(group.Filename, len(group.Diagnostics))
@@ -116,8 +166,6 @@ func (f *formatterImpl) formatForHuman(fset *token.FileSet, diagnostics []analys
 
 		fmt.Fprintln(f.writer)
 	}
-
-	f.printSummary(totalCount)
 }
 
 // formatForAI affiche un format optimisé pour l'IA
@@ -135,7 +183,14 @@ func (f *formatterImpl) formatForAI(fset *token.FileSet, diagnostics []analysis.
 
 	fmt.Fprintf(f.writer, "# KTN-Linter Report (AI Mode)\n\n")
 	fmt.Fprintf(f.writer, "Total issues found: %d\n\n", totalCount)
+	f.printAIGroups(fset, groups)
+}
 
+// printAIGroups affiche les groupes de diagnostics pour l'IA
+// Params:
+//   - fset: ensemble de fichiers
+//   - groups: diagnostics regroupés par fichier
+func (f *formatterImpl) printAIGroups(fset *token.FileSet, groups []DiagnosticGroupData) {
 	// Itération sur les éléments
 	for _, group := range groups {
 		fmt.Fprintf(f.writer, "## File: %s (%d issues)\n\n", group.Filename, len(group.Diagnostics))
@@ -147,13 +202,69 @@ func (f *formatterImpl) formatForAI(fset *token.FileSet, diagnostics []analysis.
 
 			fmt.Fprintf(f.writer, "### Issue at line %d, column %d\n", pos.Line, pos.Column)
 			fmt.Fprintf(f.writer, "- **Code**: %s\n", code)
-			fmt.Fprintf(f.writer, "- **Message**: %s\n", diag.Message)
+			fmt.Fprintf(f.writer, "- **Message**: %s\n", f.labelled(diag.Message, pos))
 			fmt.Fprintf(f.writer, "- **Category**: %s\n", diag.Category)
 			fmt.Fprintln(f.writer)
 		}
 	}
 }
 
+// FormatPackage affiche les diagnostics d'un paquet dès son analyse, sans
+// en-tête ni résumé
+// Params:
+//   - fset: ensemble de fichiers
+//   - diagnostics: diagnostics du paquet
+func (f *formatterImpl) FormatPackage(fset *token.FileSet, diagnostics []analysis.Diagnostic) {
+	// Vérification de la condition
+	if len(diagnostics) == 0 {
+		// Rien à afficher pour ce paquet
+		return
+	}
+
+	// Vérification de la condition
+	if f.simpleMode {
+		f.formatSimple(fset, diagnostics)
+		// Early return from function.
+		return
+	}
+
+	// Vérification de la condition
+	if f.aiMode {
+		f.printAIGroups(fset, f.groupByFile(fset, diagnostics))
+		// Early return from function.
+		return
+	}
+
+	f.printHumanGroups(fset, f.groupByFile(fset, diagnostics))
+}
+
+// Finish termine une sortie au fil de l'eau par le résumé
+// Params:
+//   - total: nombre total de diagnostics affichés
+func (f *formatterImpl) Finish(total int) {
+	// Vérification de la condition
+	if total == 0 {
+		f.printSuccess()
+		// Early return from function.
+		return
+	}
+
+	// Vérification de la condition
+	if f.simpleMode {
+		// Pas de résumé en format simple
+		return
+	}
+
+	// Vérification de la condition
+	if f.aiMode {
+		fmt.Fprintf(f.writer, "Total issues found: %d\n\n", total)
+		// Early return from function.
+		return
+	}
+
+	f.printSummary(total)
+}
+
 // formatSimple affiche un format simple une ligne par erreur (pour IDE)
 // Params:
 //   - fset: ensemble de fichiers
@@ -168,7 +279,7 @@ func (f *formatterImpl) formatSimple(fset *token.FileSet, diagnostics []analysis
 		pos := fset.Position(diag.Pos)
 		code := extractCode(diag.Message)
 		// Toujours afficher le message complet (jamais tronquer)
-		message := extractMessageWithOptions(diag.Message, false)
+		message := f.labelled(extractMessageWithOptions(diag.Message, false), pos)
 
 		// Format compatible avec golangci-lint et VSCode : code en premier
 		fmt.Fprintf(f.writer, "%s:%d:%d: [%s] %s\n",
@@ -176,7 +287,8 @@ func (f *formatterImpl) formatSimple(fset *token.FileSet, diagnostics []analysis
 	}
 }
 
-// groupByFile regroupe les diagnostics par fichier et les trie
+// groupByFile regroupe les diagnostics par fichier et les trie, les
+// fonctions à plus forte part CPU en premier
 // Params:
 //   - fset: ensemble de fichiers
 //   - diagnostics: liste des diagnostics
@@ -203,8 +315,14 @@ func (f *formatterImpl) groupByFile(fset *token.FileSet, diagnostics []analysis.
 	var groups []DiagnosticGroupData
 	// Itération sur les éléments
 	for filename, diags := range fileMap {
-		// Trier par ligne
+		// Trier par part CPU puis par ligne
 		sort.Slice(diags, func(i, j int) bool {
+			shareI, shareJ := cpuShare(f.shares, fset.Position(diags[i].Pos)), cpuShare(f.shares, fset.Position(diags[j].Pos))
+			// Les fonctions les plus coûteuses d'abord
+			if shareI != shareJ {
+				// Early return from function.
+				return shareI > shareJ
+			}
 			// Early return from function.
 			return fset.Position(diags[i].Pos).Line < fset.Position(diags[j].Pos).Line
 		})
@@ -214,8 +332,15 @@ func (f *formatterImpl) groupByFile(fset *token.FileSet, diagnostics []analysis.
 		})
 	}
 
-	// Trier par nom de fichier
+	// Trier par part CPU maximale (premier diagnostic) puis par nom de fichier
 	sort.Slice(groups, func(i, j int) bool {
+		shareI := cpuShare(f.shares, fset.Position(groups[i].Diagnostics[0].Pos))
+		shareJ := cpuShare(f.shares, fset.Position(groups[j].Diagnostics[0].Pos))
+		// Les fichiers les plus coûteux d'abord
+		if shareI != shareJ {
+			// Early return from function.
+			return shareI > shareJ
+		}
 		// Early return from function.
 		return groups[i].Filename < groups[j].Filename
 	})
@@ -224,7 +349,8 @@ func (f *formatterImpl) groupByFile(fset *token.FileSet, diagnostics []analysis.
 	return groups
 }
 
-// filterAndSortDiagnostics filtre et trie les diagnostics par position
+// filterAndSortDiagnostics filtre et trie les diagnostics par part CPU
+// puis par position
 // Params:
 //   - fset: ensemble de fichiers
 //   - diagnostics: liste des diagnostics
@@ -245,6 +371,12 @@ func (f *formatterImpl) filterAndSortDiagnostics(fset *token.FileSet, diagnostic
 	}
 
 	sort.Slice(filtered, func(i, j int) bool {
+		shareI, shareJ := cpuShare(f.shares, fset.Position(filtered[i].Pos)), cpuShare(f.shares, fset.Position(filtered[j].Pos))
+		// Les fonctions les plus coûteuses d'abord
+		if shareI != shareJ {
+			// Early return from function.
+			return shareI > shareJ
+		}
 		posI := fset.Position(filtered[i].Pos)
 		posJ := fset.Position(filtered[j].Pos)
 		// Vérification de la condition
@@ -299,6 +431,20 @@ func (f *formatterImpl) printFileHeader(filename string, count int) {
 	}
 }
 
+// labelled ajoute au message d'un diagnostic ses étiquettes de chemin chaud
+// et de part CPU.
+//
+// Params:
+//   - message: message du diagnostic
+//   - pos: position résolue du diagnostic
+//
+// Returns:
+//   - string: message étiqueté
+func (f *formatterImpl) labelled(message string, pos token.Position) string {
+	// Retour du message étiqueté
+	return withCPULabel(withHotPathLabel(message, hotPath(f.hotPaths, pos)), cpuShare(f.shares, pos))
+}
+
 // printDiagnostic affiche un diagnostic individuel
 // Params:
 //   - num: numéro du diagnostic
@@ -307,7 +453,7 @@ func (f *formatterImpl) printFileHeader(filename string, count int) {
 func (f *formatterImpl) printDiagnostic(num int, pos token.Position, diag analysis.Diagnostic) {
 	code := extractCode(diag.Message)
 	// Toujours afficher le message complet (jamais tronquer)
-	message := extractMessageWithOptions(diag.Message, false)
+	message := f.labelled(extractMessageWithOptions(diag.Message, false), pos)
 	location := fmt.Sprintf("%s:%d:%d", pos.Filename, pos.Line, pos.Column)
 
 	// Vérification de la condition
@@ -318,8 +464,8 @@ func (f *formatterImpl) printDiagnostic(num int, pos token.Position, diag analys
 		f.printMessage(message, false)
 		// Cas alternatif
 	} else {
-		codeColor := f.getCodeColor(code)
-		symbol := f.getSymbol(code)
+		codeColor := f.getCodeColor(code, diag.Category)
+		symbol := f.getSymbol(code, diag.Category)
 		fmt.Fprintf(f.writer, "\n%s[%d]%s %s%s%s\n",
 			Bold+Yellow, num, Reset,
 			Cyan, location, Reset)
@@ -392,18 +538,19 @@ func (f *formatterImpl) printSummary(count int) {
 // getCodeColor retourne la couleur ANSI appropriée pour un code d'erreur basée sur la sévérité
 // Params:
 //   - code: code d'erreur (ex: "KTN-VAR-001")
+//   - category: catégorie du diagnostic (chemin chaud ou froid)
 //
 // Returns:
 //   - string: couleur ANSI selon la sévérité (rouge/orange/bleu)
-func (f *formatterImpl) getCodeColor(code string) string {
+func (f *formatterImpl) getCodeColor(code, category string) string {
 	// Vérification de la condition
 	if f.noColor {
 		// Early return from function.
 		return ""
 	}
 
-	// Obtenir la sévérité du code
-	level := severity.GetSeverity(code)
+	// Obtenir la sévérité du finding
+	level := severity.ForDiagnostic(code, category)
 	// Retour de la couleur selon le niveau
 	return level.ColorCode()
 }
@@ -411,12 +558,13 @@ func (f *formatterImpl) getCodeColor(code string) string {
 // getSymbol retourne le symbole approprié pour un code d'erreur basé sur la sévérité
 // Params:
 //   - code: code d'erreur (ex: "KTN-VAR-001")
+//   - category: catégorie du diagnostic (chemin chaud ou froid)
 //
 // Returns:
 //   - string: symbole (✖/⚠/ℹ)
-func (f *formatterImpl) getSymbol(code string) string {
-	// Obtenir la sévérité du code
-	level := severity.GetSeverity(code)
+func (f *formatterImpl) getSymbol(code, category string) string {
+	// Obtenir la sévérité du finding
+	level := severity.ForDiagnostic(code, category)
 	// Retour du symbole selon le niveau
 	return level.Symbol()
 }
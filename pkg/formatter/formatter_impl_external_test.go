@@ -68,3 +68,57 @@ func TestFormatterImpl_Format(t *testing.T) {
 		})
 	}
 }
+
+// TestFormatterImpl_stream teste l'affichage paquet par paquet.
+func TestFormatterImpl_stream(t *testing.T) {
+	tests := []struct {
+		name       string
+		aiMode     bool
+		simpleMode bool
+		packages   int
+		want       []string
+		notWant    []string
+	}{
+		{name: "human output", packages: 2, want: []string{"a.go (1 issues)", "b.go (1 issues)", "Total: 2 issue(s)"}, notWant: []string{"KTN-LINTER REPORT"}},
+		{name: "AI output", aiMode: true, packages: 2, want: []string{"## File: a.go", "## File: b.go", "Total issues found: 2"}, notWant: []string{"# KTN-Linter Report"}},
+		{name: "simple output", simpleMode: true, packages: 2, want: []string{"a.go:1:1: [KTN-VAR-001]", "b.go:1:1: [KTN-VAR-001]"}, notWant: []string{"Total"}},
+		{name: "no issues", packages: 0, want: []string{"No issues found"}},
+	}
+
+	// Parcourir les cas de test
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			f, ok := formatter.NewFormatter(&buf, tt.aiMode, true, tt.simpleMode, false).(formatter.StreamFormatter)
+			// Vérification de l'interface
+			if !ok {
+				t.Fatal("text formatter does not implement StreamFormatter")
+			}
+			fset := token.NewFileSet()
+			// Afficher un paquet par fichier
+			for _, name := range []string{"a.go", "b.go"}[:tt.packages] {
+				file := fset.AddFile(name, -1, 10)
+				f.FormatPackage(fset, []analysis.Diagnostic{{Pos: file.Pos(0), Message: "KTN-VAR-001: finding"}})
+			}
+			f.FormatPackage(fset, nil)
+			f.Finish(tt.packages)
+			output := buf.String()
+
+			// Vérification des sorties attendues
+			for _, want := range tt.want {
+				// Vérification de la présence
+				if !strings.Contains(output, want) {
+					t.Errorf("output missing %q:\n%s", want, output)
+				}
+			}
+			// Vérification des sorties absentes
+			for _, notWant := range tt.notWant {
+				// Vérification de l'absence
+				if strings.Contains(output, notWant) {
+					t.Errorf("output contains %q:\n%s", notWant, output)
+				}
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"go/token"
 	"testing"
 
+	"github.com/kodflow/ktn-linter/pkg/rulecode"
 	"golang.org/x/tools/go/analysis"
 )
 
@@ -30,7 +31,13 @@ func Test_extractCode(t *testing.T) {
 			message: "some message without code",
 			want:    "UNKNOWN",
 		},
+		{
+			name:    "third-party code",
+			message: "ACME-SEC-001: hardcoded secret",
+			want:    "ACME-SEC-001",
+		},
 	}
+	rulecode.RegisterPrefix("ACME")
 
 	// Iteration over table-driven tests
 	for _, tt := range tests {
@@ -130,3 +137,131 @@ func Test_filterAndSortDiagnostics(t *testing.T) {
 		})
 	}
 }
+
+// sharesAt returns a CPU share lookup by file offset.
+//
+// Params:
+//   - shares: CPU shares by offset
+//
+// Returns:
+//   - CPUShareLookup: share lookup
+func sharesAt(shares map[int]float64) CPUShareLookup {
+	// Return lookup by offset
+	return func(pos token.Position) float64 {
+		// Return share of the offset
+		return shares[pos.Offset]
+	}
+}
+
+// reasonsAt returns a hot path lookup by file offset.
+//
+// Params:
+//   - reasons: hot path reasons by offset
+//
+// Returns:
+//   - HotPathLookup: reason lookup
+func reasonsAt(reasons map[int]string) HotPathLookup {
+	// Return lookup by offset
+	return func(pos token.Position) string {
+		// Return reason of the offset
+		return reasons[pos.Offset]
+	}
+}
+
+// Test_rankByCPUShare tests ranking diagnostics by CPU share.
+func Test_rankByCPUShare(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages []string
+		shares   CPUShareLookup
+		want     []string
+	}{
+		{
+			name:     "hottest first, ties keep order",
+			messages: []string{"A: a", "B: b", "C: c", "D: d"},
+			shares:   sharesAt(map[int]float64{1: 0.05, 3: 0.4}),
+			want:     []string{"D: d", "B: b", "A: a", "C: c"},
+		},
+		{
+			name:     "without profile keeps order",
+			messages: []string{"B: b", "A: a"},
+			want:     []string{"B: b", "A: a"},
+		},
+	}
+
+	// Iteration over table-driven tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file := fset.AddFile("test.go", -1, 100)
+			diags := make([]analysis.Diagnostic, 0, len(tt.messages))
+			// Build diagnostics in input order
+			for i, message := range tt.messages {
+				diags = append(diags, analysis.Diagnostic{Pos: file.Pos(i), Message: message})
+			}
+			ranked := rankByCPUShare(fset, diags, tt.shares)
+			// Check ranking and input preservation
+			for i, diag := range ranked {
+				// Check ranked message
+				if diag.Message != tt.want[i] {
+					t.Errorf("ranked[%d] = %q, want %q", i, diag.Message, tt.want[i])
+				}
+				// Check the input is not reordered
+				if diags[i].Message != tt.messages[i] {
+					t.Errorf("input[%d] = %q, want %q", i, diags[i].Message, tt.messages[i])
+				}
+			}
+		})
+	}
+}
+
+// Test_withCPULabel tests labelling text messages with CPU shares.
+func Test_withCPULabel(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		share   float64
+		want    string
+	}{
+		{name: "single line", message: "alloc", share: 0.42, want: "alloc [cpu: 42.0%]"},
+		{name: "multi line", message: "alloc\ndetails", share: 0.42, want: "alloc [cpu: 42.0%]\ndetails"},
+		{name: "not ranked", message: "alloc", share: 0, want: "alloc"},
+	}
+
+	// Iteration over table-driven tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Check labelled message
+			if got := withCPULabel(tt.message, tt.share); got != tt.want {
+				t.Errorf("withCPULabel() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_withHotPathLabel tests labelling text messages with hot path reasons.
+func Test_withHotPathLabel(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		reason  string
+		want    string
+	}{
+		{name: "single line", message: "alloc", reason: "//ktn:hot", want: "alloc [hot path: //ktn:hot]"},
+		{name: "multi line", message: "alloc\ndetails", reason: "http.Handler", want: "alloc [hot path: http.Handler]\ndetails"},
+		{name: "not escalated", message: "alloc", reason: "", want: "alloc"},
+	}
+
+	// Iteration over table-driven tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Check labelled message
+			if got := withHotPathLabel(tt.message, tt.reason); got != tt.want {
+				t.Errorf("withHotPathLabel() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
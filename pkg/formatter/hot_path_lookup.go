@@ -0,0 +1,8 @@
+// Package formatter provides output formatting for lint diagnostics.
+package formatter
+
+import "go/token"
+
+// HotPathLookup returns why the function enclosing a finding is hot (e.g.
+// "//ktn:hot"), empty when the finding is not escalated.
+type HotPathLookup func(pos token.Position) string
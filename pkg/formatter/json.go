@@ -13,8 +13,12 @@ import (
 // jsonFormatter implements JSON output formatting.
 // Provides structured output compatible with CI/CD tools.
 type jsonFormatter struct {
-	writer  io.Writer
-	verbose bool
+	writer   io.Writer
+	verbose  bool
+	symbols  SymbolLookup
+	shares   CPUShareLookup
+	hotPaths HotPathLookup
+	baseDir  string
 }
 
 // NewJSONFormatter creates a new JSON formatter.
@@ -26,10 +30,28 @@ type jsonFormatter struct {
 // Returns:
 //   - Formatter: JSON formatter instance
 func NewJSONFormatter(w io.Writer, verbose bool) Formatter {
+	// Return new JSON formatter
+	return newJSONFormatter(w, FormatterOptions{VerboseMode: verbose})
+}
+
+// newJSONFormatter creates a JSON formatter reporting enclosing symbols, CPU
+// shares, hot path reasons and the directory the report is written from.
+//
+// Params:
+//   - w: writer for output
+//   - opts: formatter options
+//
+// Returns:
+//   - *jsonFormatter: JSON formatter instance
+func newJSONFormatter(w io.Writer, opts FormatterOptions) *jsonFormatter {
 	// Return new JSON formatter
 	return &jsonFormatter{
-		writer:  w,
-		verbose: verbose,
+		writer:   w,
+		verbose:  opts.VerboseMode,
+		symbols:  opts.Symbols,
+		shares:   opts.CPUShares,
+		hotPaths: opts.HotPaths,
+		baseDir:  opts.BaseDir,
 	}
 }
 
@@ -69,8 +91,8 @@ func (f *jsonFormatter) buildReport(fset *token.FileSet, diagnostics []analysis.
 	// Build results and count by level
 	results := make([]JSONResult, 0, len(diagnostics))
 
-	// Iterate over diagnostics
-	for _, diag := range diagnostics {
+	// Iterate over diagnostics, hottest functions first
+	for _, diag := range rankByCPUShare(fset, diagnostics, f.shares) {
 		result := f.buildResult(fset, diag)
 		results = append(results, result)
 
@@ -86,6 +108,7 @@ func (f *jsonFormatter) buildReport(fset *token.FileSet, diagnostics []analysis.
 			Name:    "ktn-linter",
 			Version: "1.0.0",
 		},
+		BaseDir: f.baseDir,
 		Summary: JSONSummary{
 			TotalIssues: len(diagnostics),
 			ByLevel:     byLevel,
@@ -110,23 +133,31 @@ func (f *jsonFormatter) buildResult(fset *token.FileSet, diag analysis.Diagnosti
 	code := extractCode(diag.Message)
 
 	// Get severity level
-	level := severity.GetSeverity(code)
+	level := severity.ForDiagnostic(code, diag.Category)
 	levelStr := f.severityToLevel(level)
 
 	// Extract message without code prefix
 	message := extractMessageWithOptions(diag.Message, !f.verbose)
 
-	// Return constructed result
-	return JSONResult{
-		RuleID:  code,
-		Level:   levelStr,
-		Message: message,
+	result := JSONResult{
+		RuleID:   code,
+		Level:    levelStr,
+		Message:  message,
+		CPUShare: cpuShare(f.shares, pos),
+		HotPath:  hotPath(f.hotPaths, pos),
 		Location: JSONLocation{
 			File:   pos.Filename,
 			Line:   pos.Line,
 			Column: pos.Column,
 		},
 	}
+	// Report the enclosing declaration when known
+	if f.symbols != nil {
+		result.Symbol = f.symbols(pos)
+	}
+
+	// Return constructed result
+	return result
 }
 
 // severityToLevel converts severity level to JSON level string.
@@ -213,3 +213,118 @@ func Test_jsonFormatter_buildReport(t *testing.T) {
 		})
 	}
 }
+
+// Test_jsonFormatter_buildReport_BaseDir tests recording the report directory.
+//
+// Params:
+//   - t: testing object for running test cases
+func Test_jsonFormatter_buildReport_BaseDir(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name    string
+		opts    FormatterOptions
+		wantDir string
+	}{
+		{name: "directory recorded", opts: FormatterOptions{BaseDir: "/src/app"}, wantDir: "/src/app"},
+		{name: "no directory", opts: FormatterOptions{}, wantDir: ""},
+	}
+
+	// Run all test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		// Run individual test case
+		t.Run(tt.name, func(t *testing.T) {
+			report := newJSONFormatter(nil, tt.opts).buildReport(token.NewFileSet(), nil)
+			// Verify recorded directory
+			if report.BaseDir != tt.wantDir {
+				t.Errorf("BaseDir = %q, want %q", report.BaseDir, tt.wantDir)
+			}
+		})
+	}
+}
+
+// Test_jsonFormatter_buildReport_CPUShare tests ranking results by CPU share.
+//
+// Params:
+//   - t: testing object for running test cases
+func Test_jsonFormatter_buildReport_CPUShare(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name      string
+		messages  []string
+		wantRules []string
+		wantShare []float64
+	}{
+		{
+			// Test ranked results
+			name:      "hottest function first, full precision",
+			messages:  []string{"KTN-VAR-001: plain", "KTN-VAR-012: alloc"},
+			wantRules: []string{"KTN-VAR-012", "KTN-VAR-001"},
+			wantShare: []float64{0.2537, 0},
+		},
+	}
+
+	// Run all test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		// Run individual test case
+		t.Run(tt.name, func(t *testing.T) {
+			f := &jsonFormatter{shares: sharesAt(map[int]float64{11: 0.2537})}
+			fset := token.NewFileSet()
+			file := fset.AddFile("test.go", -1, 100)
+			diags := make([]analysis.Diagnostic, 0, len(tt.messages))
+			// Create test diagnostics
+			for i, message := range tt.messages {
+				diags = append(diags, analysis.Diagnostic{Pos: file.Pos(10 + i), Message: message})
+			}
+
+			report := f.buildReport(fset, diags)
+
+			// Verify ranking and shares
+			for i, result := range report.Results {
+				// Check rule order and share
+				if result.RuleID != tt.wantRules[i] || result.CPUShare != tt.wantShare[i] {
+					t.Errorf("Results[%d] = %s %v, want %s %v", i, result.RuleID, result.CPUShare, tt.wantRules[i], tt.wantShare[i])
+				}
+			}
+		})
+	}
+}
+
+// Test_jsonFormatter_buildResult_HotPath tests reporting hot path reasons.
+//
+// Params:
+//   - t: testing object for running test cases
+func Test_jsonFormatter_buildResult_HotPath(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name        string
+		offset      int
+		wantHotPath string
+	}{
+		{name: "escalated finding", offset: 11, wantHotPath: "pattern api.[Encode]"},
+		{name: "cold finding", offset: 12, wantHotPath: ""},
+	}
+
+	// Run all test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		// Run individual test case
+		t.Run(tt.name, func(t *testing.T) {
+			f := &jsonFormatter{hotPaths: reasonsAt(map[int]string{11: "pattern api.[Encode]"})}
+			fset := token.NewFileSet()
+			file := fset.AddFile("test.go", -1, 100)
+
+			result := f.buildResult(fset, analysis.Diagnostic{Pos: file.Pos(tt.offset), Message: "KTN-VAR-012: alloc"})
+
+			// Verify reason field
+			if result.HotPath != tt.wantHotPath {
+				t.Errorf("HotPath = %q, want %q", result.HotPath, tt.wantHotPath)
+			}
+			// Verify the message is not labelled
+			if result.Message != "alloc" {
+				t.Errorf("Message = %q, want %q", result.Message, "alloc")
+			}
+		})
+	}
+}
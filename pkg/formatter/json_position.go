@@ -0,0 +1,9 @@
+// Package formatter provides output formatting for lint diagnostics.
+package formatter
+
+// JSONPosition represents a line and column in the file of a finding.
+// Used for the end of findings reported on a range.
+type JSONPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
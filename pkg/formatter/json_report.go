@@ -7,6 +7,7 @@ type JSONReport struct {
 	Schema  string       `json:"$schema"`
 	Version string       `json:"version"`
 	Tool    JSONTool     `json:"tool"`
+	BaseDir string       `json:"baseDir,omitempty"` // Directory the report was written from
 	Summary JSONSummary  `json:"summary"`
 	Results []JSONResult `json:"results"`
 }
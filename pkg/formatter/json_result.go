@@ -2,10 +2,15 @@
 package formatter
 
 // JSONResult represents a single diagnostic result in JSON format.
-// Contains rule identification, severity, message, and location.
+// Contains rule identification, severity, message, and location, plus the
+// enclosing declaration, the CPU share of the enclosing function when a CPU
+// profile ranks findings and why it is hot when a finding is escalated.
 type JSONResult struct {
 	RuleID   string       `json:"ruleId"`
 	Level    string       `json:"level"`
 	Message  string       `json:"message"`
 	Location JSONLocation `json:"location"`
+	Symbol   string       `json:"symbol,omitempty"`
+	CPUShare float64      `json:"cpuShare,omitempty"`
+	HotPath  string       `json:"hotPath,omitempty"`
 }
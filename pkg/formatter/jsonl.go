@@ -0,0 +1,129 @@
+// Package formatter provides output formatting for lint diagnostics.
+package formatter
+
+import (
+	"encoding/json"
+	"go/token"
+	"io"
+
+	"github.com/kodflow/ktn-linter/pkg/rulecode"
+	"github.com/kodflow/ktn-linter/pkg/severity"
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	// jsonlFindingType tags the finding lines of JSON Lines output.
+	jsonlFindingType string = "finding"
+	// jsonlSummaryType tags the final line of JSON Lines output.
+	jsonlSummaryType string = "summary"
+)
+
+// jsonlFormatter implements JSON Lines output formatting.
+// Writes one JSON object per finding as soon as its package is analyzed,
+// then a summary line, for log pipelines and line tools (jq, grep).
+type jsonlFormatter struct {
+	writer  io.Writer
+	results *jsonFormatter
+	modules moduleResolver
+	byLevel map[string]int
+}
+
+// NewJSONLFormatter creates a new JSON Lines formatter.
+//
+// Params:
+//   - w: writer for output
+//   - verbose: enable verbose messages
+//
+// Returns:
+//   - Formatter: JSON Lines formatter instance, also a StreamFormatter
+func NewJSONLFormatter(w io.Writer, verbose bool) Formatter {
+	// Return new JSON Lines formatter
+	return newJSONLFormatter(w, FormatterOptions{VerboseMode: verbose})
+}
+
+// newJSONLFormatter creates a JSON Lines formatter reporting enclosing
+// symbols, CPU shares and hot path reasons.
+//
+// Params:
+//   - w: writer for output
+//   - opts: formatter options
+//
+// Returns:
+//   - *jsonlFormatter: JSON Lines formatter instance
+func newJSONLFormatter(w io.Writer, opts FormatterOptions) *jsonlFormatter {
+	// Return new JSON Lines formatter
+	return &jsonlFormatter{
+		writer:  w,
+		results: newJSONFormatter(w, opts),
+		byLevel: map[string]int{"error": 0, "warning": 0, "info": 0},
+	}
+}
+
+// Format outputs all diagnostics as JSON Lines, then the summary line.
+//
+// Params:
+//   - fset: fileset for position information
+//   - diagnostics: list of diagnostics to format
+func (f *jsonlFormatter) Format(fset *token.FileSet, diagnostics []analysis.Diagnostic) {
+	f.FormatPackage(fset, diagnostics)
+	f.Finish(len(diagnostics))
+}
+
+// FormatPackage outputs the diagnostics of one package, one line each.
+//
+// Params:
+//   - fset: fileset for position information
+//   - diagnostics: diagnostics of the package
+func (f *jsonlFormatter) FormatPackage(fset *token.FileSet, diagnostics []analysis.Diagnostic) {
+	encoder := json.NewEncoder(f.writer)
+	// Write each diagnostic, hottest functions first
+	for _, diag := range rankByCPUShare(fset, diagnostics, f.results.shares) {
+		finding := f.buildFinding(fset, diag)
+		f.byLevel[finding.Level]++
+		_ = encoder.Encode(finding)
+	}
+}
+
+// Finish outputs the summary line.
+//
+// Params:
+//   - total: number of findings written
+func (f *jsonlFormatter) Finish(total int) {
+	_ = json.NewEncoder(f.writer).Encode(JSONLSummary{
+		Type: jsonlSummaryType,
+		Tool: JSONTool{
+			Name:    "ktn-linter",
+			Version: "1.0.0",
+		},
+		JSONSummary: JSONSummary{
+			TotalIssues: total,
+			ByLevel:     f.byLevel,
+		},
+	})
+}
+
+// buildFinding builds one finding line from a diagnostic.
+//
+// Params:
+//   - fset: fileset for position information
+//   - diag: diagnostic to convert
+//
+// Returns:
+//   - JSONLFinding: converted finding
+func (f *jsonlFormatter) buildFinding(fset *token.FileSet, diag analysis.Diagnostic) JSONLFinding {
+	result := f.results.buildResult(fset, diag)
+	finding := JSONLFinding{
+		Type:       jsonlFindingType,
+		JSONResult: result,
+		Severity:   severity.ForDiagnostic(result.RuleID, diag.Category).String(),
+		Category:   rulecode.Category(result.RuleID),
+		Module:     f.modules.modulePath(result.Location.File),
+	}
+	// Add the end position when the analyzer reported a range
+	if diag.End.IsValid() {
+		end := fset.Position(diag.End)
+		finding.End = &JSONPosition{Line: end.Line, Column: end.Column}
+	}
+	// Return constructed finding
+	return finding
+}
@@ -0,0 +1,109 @@
+// Package formatter_test provides tests for the JSON Lines formatter.
+package formatter_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/formatter"
+	"golang.org/x/tools/go/analysis"
+)
+
+// TestJSONLFormatter tests the JSON Lines output, one finding per line then
+// a summary line.
+//
+// Params:
+//   - t: testing object for running test cases
+func TestJSONLFormatter(t *testing.T) {
+	dir := t.TempDir()
+	// Write the module of the analyzed file
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/app\n\ngo 1.25\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(dir, "api", "api.go")
+
+	tests := []struct {
+		name     string
+		stream   bool
+		packages int
+		wantEnd  bool
+	}{
+		{name: "batch output", packages: 2},
+		{name: "streamed output", stream: true, packages: 2},
+		{name: "range finding", packages: 1, wantEnd: true},
+		{name: "no findings", packages: 0},
+	}
+
+	// Run all test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		// Run individual test case
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			fset := token.NewFileSet()
+			file := fset.AddFile(filename, -1, 100)
+			file.SetLines([]int{0, 20, 40})
+			var diags []analysis.Diagnostic
+			// Build one finding per package
+			for i := range tt.packages {
+				diag := analysis.Diagnostic{Pos: file.Pos(20 * i), Message: "KTN-VAR-012: allocation dans une boucle"}
+				// Report a range on demand
+				if tt.wantEnd {
+					diag.End = file.Pos(45)
+				}
+				diags = append(diags, diag)
+			}
+			f := formatter.NewJSONLFormatter(&buf, false)
+			// Stream package by package or format at once
+			if tt.stream {
+				streamer := f.(formatter.StreamFormatter)
+				// Format each package
+				for _, diag := range diags {
+					streamer.FormatPackage(fset, []analysis.Diagnostic{diag})
+				}
+				streamer.Finish(len(diags))
+			} else {
+				f.Format(fset, diags)
+			}
+
+			scanner := bufio.NewScanner(&buf)
+			var findings []formatter.JSONLFinding
+			var summary formatter.JSONLSummary
+			// Decode each line
+			for scanner.Scan() {
+				var line formatter.JSONLFinding
+				// Check line is a JSON object
+				if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+					t.Fatalf("invalid line %q: %v", scanner.Text(), err)
+				}
+				// Split findings from the summary
+				if line.Type == "summary" {
+					_ = json.Unmarshal(scanner.Bytes(), &summary)
+				} else {
+					findings = append(findings, line)
+				}
+			}
+			// Verify one line per finding then the summary
+			if len(findings) != tt.packages || summary.TotalIssues != tt.packages || summary.ByLevel["warning"] != tt.packages {
+				t.Fatalf("findings = %d, summary = %+v; want %d findings", len(findings), summary, tt.packages)
+			}
+			// Verify finding fields
+			for _, finding := range findings {
+				// Check JSONResult and added fields
+				if finding.Type != "finding" || finding.RuleID != "KTN-VAR-012" || finding.Severity != "WARNING" ||
+					finding.Category != "var" || finding.Module != "example.com/app" || finding.Location.File != filename {
+					t.Errorf("finding = %+v", finding)
+				}
+				// Check the end position
+				if (finding.End != nil) != tt.wantEnd || (tt.wantEnd && (finding.End.Line != 3 || finding.End.Column != 6)) {
+					t.Errorf("end = %+v, wantEnd %v", finding.End, tt.wantEnd)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+// Package formatter provides output formatting for lint diagnostics.
+package formatter
+
+// JSONLFinding represents one finding line of JSON Lines output.
+// Extends JSONResult with the details needed to filter findings line by
+// line: severity, rule category, end position and module.
+type JSONLFinding struct {
+	Type string `json:"type"`
+	JSONResult
+	Severity string        `json:"severity"`
+	Category string        `json:"category"`
+	End      *JSONPosition `json:"end,omitempty"`
+	Module   string        `json:"module,omitempty"`
+}
@@ -0,0 +1,51 @@
+// Package formatter provides tests for internal JSON Lines formatter functions.
+package formatter
+
+import (
+	"bytes"
+	"go/token"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/severity"
+	"golang.org/x/tools/go/analysis"
+)
+
+// Test_jsonlFormatter_buildFinding tests the fields added to JSON results.
+//
+// Params:
+//   - t: testing object for running test cases
+func Test_jsonlFormatter_buildFinding(t *testing.T) {
+	tests := []struct {
+		name         string
+		message      string
+		category     string
+		wantSeverity string
+		wantCategory string
+	}{
+		{name: "rule severity", message: "KTN-VAR-012: allocation", wantSeverity: "WARNING", wantCategory: "var"},
+		{name: "hot path raises severity", message: "KTN-VAR-012: allocation", category: severity.CategoryHotPath, wantSeverity: "ERROR", wantCategory: "var"},
+		{name: "cold path lowers severity", message: "KTN-VAR-012: allocation", category: severity.CategoryColdPath, wantSeverity: "INFO", wantCategory: "var"},
+		{name: "message without rule code", message: "internal failure", wantSeverity: "WARNING", wantCategory: ""},
+	}
+
+	// Run all test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		// Run individual test case
+		t.Run(tt.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file := fset.AddFile("outside.go", -1, 10)
+			f := NewJSONLFormatter(&bytes.Buffer{}, false).(*jsonlFormatter)
+
+			finding := f.buildFinding(fset, analysis.Diagnostic{Pos: file.Pos(0), Message: tt.message, Category: tt.category})
+			// Verify the added fields
+			if finding.Type != jsonlFindingType || finding.Severity != tt.wantSeverity || finding.Category != tt.wantCategory || finding.End != nil {
+				t.Errorf("buildFinding() = %+v, want severity %q and category %q", finding, tt.wantSeverity, tt.wantCategory)
+			}
+			// Verify the severity matches the JSON level
+			if finding.Level != f.results.severityToLevel(severity.ForDiagnostic(finding.RuleID, tt.category)) {
+				t.Errorf("level = %q, severity = %q", finding.Level, finding.Severity)
+			}
+		})
+	}
+}
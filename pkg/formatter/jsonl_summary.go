@@ -0,0 +1,10 @@
+// Package formatter provides output formatting for lint diagnostics.
+package formatter
+
+// JSONLSummary represents the final line of JSON Lines output.
+// Provides the tool and the counts of the findings written before it.
+type JSONLSummary struct {
+	Type string   `json:"type"`
+	Tool JSONTool `json:"tool"`
+	JSONSummary
+}
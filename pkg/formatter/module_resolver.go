@@ -0,0 +1,59 @@
+// Package formatter provides output formatting for lint diagnostics.
+package formatter
+
+import (
+	"os"
+	"path/filepath"
+
+	"golang.org/x/mod/modfile"
+)
+
+// goModFile is the module definition file.
+const goModFile string = "go.mod"
+
+// moduleResolver finds the module of source files from their nearest
+// go.mod, caching the module path of each visited directory.
+type moduleResolver struct {
+	paths map[string]string
+}
+
+// modulePath returns the path of the module holding a file.
+//
+// Params:
+//   - filename: source file path
+//
+// Returns:
+//   - string: module path, empty outside modules
+func (r *moduleResolver) modulePath(filename string) string {
+	var visited []string
+	path := ""
+	// Walk up to the nearest go.mod
+	for dir := filepath.Dir(filename); ; dir = filepath.Dir(dir) {
+		// Reuse a directory resolved before
+		if cached, ok := r.paths[dir]; ok {
+			path = cached
+			break
+		}
+		visited = append(visited, dir)
+		// Stop at the first readable go.mod
+		if data, err := os.ReadFile(filepath.Join(dir, goModFile)); err == nil {
+			path = modfile.ModulePath(data)
+			break
+		}
+		// Stop at the filesystem root
+		if filepath.Dir(dir) == dir {
+			break
+		}
+	}
+
+	// Lazily create the cache
+	if r.paths == nil {
+		r.paths = make(map[string]string, len(visited))
+	}
+	// Remember the module of every visited directory
+	for _, dir := range visited {
+		r.paths[dir] = path
+	}
+	// Return module path
+	return path
+}
@@ -0,0 +1,62 @@
+// Package formatter provides tests for module resolution from go.mod files.
+package formatter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// Test_moduleResolver_modulePath tests module resolution from go.mod files.
+//
+// Params:
+//   - t: testing object for running test cases
+func Test_moduleResolver_modulePath(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":           "module example.com/root\n\ngo 1.25\n",
+		"nested/go.mod":    "module example.com/nested\n\ngo 1.25\n",
+		"broken/go.mod":    "go 1.25\n",
+		"pkg/deep/file.go": "",
+	}
+	// Write the test modules
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		// Check directory creation error
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		// Check write error
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		file string
+		want string
+	}{
+		{name: "module root", file: filepath.Join(dir, "main.go"), want: "example.com/root"},
+		{name: "package of the module", file: filepath.Join(dir, "pkg", "deep", "file.go"), want: "example.com/root"},
+		{name: "nested module", file: filepath.Join(dir, "nested", "sub", "x.go"), want: "example.com/nested"},
+		{name: "go.mod without module", file: filepath.Join(dir, "broken", "x.go"), want: ""},
+		{name: "outside modules", file: filepath.Join(t.TempDir(), "x.go"), want: ""},
+	}
+
+	resolver := moduleResolver{}
+	// Run all test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		// Run individual test case
+		t.Run(tt.name, func(t *testing.T) {
+			// Resolve twice to cover the cache
+			for range 2 {
+				// Verify the module path
+				if got := resolver.modulePath(tt.file); got != tt.want {
+					t.Errorf("modulePath(%q) = %q, want %q", tt.file, got, tt.want)
+				}
+			}
+		})
+	}
+}
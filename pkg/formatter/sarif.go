@@ -10,10 +10,21 @@ import (
 	"golang.org/x/tools/go/analysis"
 )
 
+const (
+	// sarifCPUShareProperty is the result property holding the CPU share of
+	// the enclosing function.
+	sarifCPUShareProperty string = "cpuShare"
+	// sarifHotPathProperty is the result property holding why the enclosing
+	// function is hot.
+	sarifHotPathProperty string = "hotPath"
+)
+
 // sarifFormatter implements SARIF output formatting.
 type sarifFormatter struct {
-	writer  io.Writer
-	verbose bool
+	writer   io.Writer
+	verbose  bool
+	shares   CPUShareLookup
+	hotPaths HotPathLookup
 }
 
 // NewSARIFFormatter creates a new SARIF formatter.
@@ -25,10 +36,26 @@ type sarifFormatter struct {
 // Returns:
 //   - Formatter: SARIF formatter instance
 func NewSARIFFormatter(w io.Writer, verbose bool) Formatter {
+	// Return new SARIF formatter
+	return newSARIFFormatter(w, FormatterOptions{VerboseMode: verbose})
+}
+
+// newSARIFFormatter creates a SARIF formatter reporting CPU shares and hot
+// path reasons.
+//
+// Params:
+//   - w: writer for output
+//   - opts: formatter options
+//
+// Returns:
+//   - *sarifFormatter: SARIF formatter instance
+func newSARIFFormatter(w io.Writer, opts FormatterOptions) *sarifFormatter {
 	// Return new SARIF formatter
 	return &sarifFormatter{
-		writer:  w,
-		verbose: verbose,
+		writer:   w,
+		verbose:  opts.VerboseMode,
+		shares:   opts.CPUShares,
+		hotPaths: opts.HotPaths,
 	}
 }
 
@@ -64,8 +91,8 @@ func (f *sarifFormatter) addResults(run *sarif.Run, fset *token.FileSet, diagnos
 	// Track seen rules for deduplication
 	seenRules := make(map[string]bool, len(diagnostics))
 
-	// Iterate over diagnostics
-	for _, diag := range diagnostics {
+	// Iterate over diagnostics, hottest functions first
+	for _, diag := range rankByCPUShare(fset, diagnostics, f.shares) {
 		// Get position
 		pos := fset.Position(diag.Pos)
 
@@ -79,7 +106,7 @@ func (f *sarifFormatter) addResults(run *sarif.Run, fset *token.FileSet, diagnos
 		}
 
 		// Get severity level
-		level := severity.GetSeverity(code)
+		level := severity.ForDiagnostic(code, diag.Category)
 		sarifLevel := f.severityToSARIF(level)
 
 		// Extract message
@@ -90,6 +117,20 @@ func (f *sarifFormatter) addResults(run *sarif.Run, fset *token.FileSet, diagnos
 		result.Level = sarifLevel
 		result.Message = sarif.NewTextMessage(message)
 
+		properties := sarif.NewPropertyBag()
+		// Expose the CPU share of the enclosing function
+		if share := cpuShare(f.shares, pos); share > 0 {
+			properties.Add(sarifCPUShareProperty, share)
+		}
+		// Expose why the enclosing function is hot
+		if reason := hotPath(f.hotPaths, pos); reason != "" {
+			properties.Add(sarifHotPathProperty, reason)
+		}
+		// Attach the properties of ranked or escalated findings
+		if len(properties.Properties) > 0 {
+			result.WithProperties(properties)
+		}
+
 		// Create location
 		location := sarif.NewLocation()
 		physicalLocation := sarif.NewPhysicalLocation()
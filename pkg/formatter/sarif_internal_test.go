@@ -168,6 +168,105 @@ func Test_sarifFormatter_addResults(t *testing.T) {
 	}
 }
 
+// Test_sarifFormatter_addResults_CPUShare tests ranking results by CPU share.
+//
+// Params:
+//   - t: testing object for running test cases
+func Test_sarifFormatter_addResults_CPUShare(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name      string
+		messages  []string
+		wantRules []string
+		wantShare []any
+	}{
+		{
+			// Test ranked results
+			name:      "hottest function first",
+			messages:  []string{"KTN-VAR-001: plain", "KTN-VAR-012: alloc"},
+			wantRules: []string{"KTN-VAR-012", "KTN-VAR-001"},
+			wantShare: []any{0.25, nil},
+		},
+	}
+
+	// Run all test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		// Run individual test case
+		t.Run(tt.name, func(t *testing.T) {
+			f := &sarifFormatter{shares: sharesAt(map[int]float64{11: 0.25})}
+			run := sarif.NewRunWithInformationURI("test", "http://test.com")
+			fset := token.NewFileSet()
+			file := fset.AddFile("test.go", -1, 100)
+			diags := make([]analysis.Diagnostic, 0, len(tt.messages))
+			// Create test diagnostics
+			for i, message := range tt.messages {
+				diags = append(diags, analysis.Diagnostic{Pos: file.Pos(10 + i), Message: message})
+			}
+
+			f.addResults(run, fset, diags)
+
+			// Verify ranking and shares
+			for i, result := range run.Results {
+				var share any
+				// Read the CPU share property
+				if result.Properties != nil {
+					share = result.Properties.Properties[sarifCPUShareProperty]
+				}
+				// Check rule order and share
+				if *result.RuleID != tt.wantRules[i] || share != tt.wantShare[i] {
+					t.Errorf("Results[%d] = %s %v, want %s %v", i, *result.RuleID, share, tt.wantRules[i], tt.wantShare[i])
+				}
+			}
+		})
+	}
+}
+
+// Test_sarifFormatter_addResults_HotPath tests reporting hot path reasons.
+//
+// Params:
+//   - t: testing object for running test cases
+func Test_sarifFormatter_addResults_HotPath(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name        string
+		offset      int
+		wantHotPath any
+	}{
+		{name: "escalated finding", offset: 11, wantHotPath: "http.Handler"},
+		{name: "cold finding", offset: 12, wantHotPath: nil},
+	}
+
+	// Run all test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		// Run individual test case
+		t.Run(tt.name, func(t *testing.T) {
+			f := &sarifFormatter{hotPaths: reasonsAt(map[int]string{11: "http.Handler"})}
+			run := sarif.NewRunWithInformationURI("test", "http://test.com")
+			fset := token.NewFileSet()
+			file := fset.AddFile("test.go", -1, 100)
+
+			f.addResults(run, fset, []analysis.Diagnostic{{Pos: file.Pos(tt.offset), Message: "KTN-VAR-012: alloc"}})
+
+			result := run.Results[0]
+			var reason any
+			// Read the hot path property
+			if result.Properties != nil {
+				reason = result.Properties.Properties[sarifHotPathProperty]
+			}
+			// Check reason property
+			if reason != tt.wantHotPath {
+				t.Errorf("hotPath = %v, want %v", reason, tt.wantHotPath)
+			}
+			// Check the message is not labelled
+			if *result.Message.Text != "alloc" {
+				t.Errorf("Message = %q, want %q", *result.Message.Text, "alloc")
+			}
+		})
+	}
+}
+
 // Test_sarifFormatter_Format tests the Format method.
 //
 // Params:
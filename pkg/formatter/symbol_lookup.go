@@ -0,0 +1,8 @@
+// Package formatter provides output formatting for lint diagnostics.
+package formatter
+
+import "go/token"
+
+// SymbolLookup returns the declaration enclosing a finding (e.g.
+// "Type.Method"), empty at file level.
+type SymbolLookup func(pos token.Position) string
@@ -0,0 +1,17 @@
+// Package ktnlint runs the KTN rules in-process from other Go tools.
+package ktnlint
+
+// Finding is one rule violation reported by Run.
+// Positions are resolved, so findings outlive the analyzed packages.
+type Finding struct {
+	Code     string `json:"code"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Package  string `json:"package"`
+	Symbol   string `json:"symbol,omitempty"`
+	Analyzer string `json:"analyzer"`
+	Build    string `json:"build,omitempty"` // Build configurations, empty if found in all
+}
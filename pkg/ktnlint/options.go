@@ -0,0 +1,19 @@
+// Package ktnlint runs the KTN rules in-process from other Go tools.
+package ktnlint
+
+import "github.com/kodflow/ktn-linter/pkg/config"
+
+// Options selects what Run analyzes and how.
+// The zero value analyzes "./..." in the current directory with the default configuration.
+// Custom rules declared in Config.CustomRules only run once registered with
+// ktnpattern.Register, which is not safe to call concurrently with Run.
+type Options struct {
+	Patterns []string       // Package patterns (default: "./...")
+	Dir      string         // Directory patterns are resolved from (default: current)
+	Config   *config.Config // Run configuration (default: config.DefaultConfig())
+	Rules    []string       // Rule codes or categories to run (default: all rules)
+
+	// StrictLoad makes Run fail on the first package load or type error.
+	// By default broken packages are skipped and reported as KTN-LOAD findings.
+	StrictLoad bool
+}
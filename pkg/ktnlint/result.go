@@ -0,0 +1,11 @@
+// Package ktnlint runs the KTN rules in-process from other Go tools.
+package ktnlint
+
+// Result is the outcome of a Run.
+// Findings are deduplicated and sorted by file, line and column.
+type Result struct {
+	Findings []Finding `json:"findings"`
+	Packages int       `json:"packages"`
+	Files    int       `json:"files"`
+	Lines    int       `json:"lines"`
+}
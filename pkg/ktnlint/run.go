@@ -0,0 +1,177 @@
+// Package ktnlint runs the KTN rules in-process from other Go tools.
+//
+// Unlike the ktn-linter command, Run never exits the process, never reads or
+// writes the global configuration and writes nothing to stdout or stderr.
+// Each call uses its own configuration, so concurrent calls with different
+// configurations are safe:
+//
+//	result, err := ktnlint.Run(ctx, ktnlint.Options{
+//		Patterns: []string{"./..."},
+//		Dir:      "/path/to/module",
+//		Rules:    []string{"KTN-FUNC-001", "var"},
+//	})
+package ktnlint
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn"
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+	"github.com/kodflow/ktn-linter/pkg/rulecode"
+	"github.com/kodflow/ktn-linter/pkg/severity"
+	"github.com/kodflow/ktn-linter/pkg/stats"
+	"golang.org/x/tools/go/analysis"
+)
+
+// defaultPattern is the package pattern used when none is given.
+const defaultPattern string = "./..."
+
+// Run loads the packages matched by opts and runs the selected rules on them.
+//
+// Params:
+//   - ctx: cancellation context; loading and analysis stop when it is done
+//   - opts: packages, configuration and rules to run
+//
+// Returns:
+//   - Result: findings and analyzed source size
+//   - error: selection, loading or cancellation error
+func Run(ctx context.Context, opts Options) (Result, error) {
+	// Stop early when already cancelled
+	if err := ctx.Err(); err != nil {
+		// Return cancellation error
+		return Result{}, err
+	}
+
+	analyzers, err := selectAnalyzers(opts.Rules)
+	// Check selection error
+	if err != nil {
+		// Return selection error
+		return Result{}, err
+	}
+
+	orch := orchestrator.NewOrchestrator(io.Discard, false)
+	cfg := cmp.Or(opts.Config, config.DefaultConfig())
+	orch.SetConfig(cfg)
+	orch.SetBuildMatrix(cfg.BuildConfigurations())
+	orch.SetStrictLoad(opts.StrictLoad)
+
+	patterns := opts.Patterns
+	// Default to every package of the directory
+	if len(patterns) == 0 {
+		patterns = []string{defaultPattern}
+	}
+
+	pkgs, err := orch.LoadPackagesContext(ctx, opts.Dir, patterns)
+	// Check loading error, reporting cancellation as such
+	if err != nil {
+		// Return loading error
+		return Result{}, cmp.Or(ctx.Err(), err)
+	}
+
+	diags, err := orch.RunAnalyzersContext(ctx, pkgs, analyzers)
+	// Check cancellation
+	if err != nil {
+		// Return cancellation error
+		return Result{}, err
+	}
+
+	files, lines := orch.SourceLines()
+	// Return converted findings
+	return Result{
+		Findings: toFindings(orch.NormalizeDiagnostics(orch.FilterDiagnostics(diags))),
+		Packages: len(pkgs),
+		Files:    files,
+		Lines:    lines,
+	}, nil
+}
+
+// selectAnalyzers resolves rule codes and categories to analyzers.
+//
+// Params:
+//   - selectors: rule codes (e.g. "KTN-FUNC-001") or categories (e.g. "func")
+//
+// Returns:
+//   - []*analysis.Analyzer: selected analyzers without duplicates
+//   - error: unknown code or category
+func selectAnalyzers(selectors []string) ([]*analysis.Analyzer, error) {
+	// Run every rule by default
+	if len(selectors) == 0 {
+		// Return all rules
+		return ktn.GetAllRules(), nil
+	}
+
+	selected := make([]*analysis.Analyzer, 0, len(selectors))
+	// Resolve each selector
+	for _, selector := range selectors {
+		var analyzers []*analysis.Analyzer
+		// Rule codes start with a registered prefix
+		if rulecode.IsCode(strings.ToUpper(selector)) {
+			// Check rule exists
+			if analyzer := ktn.GetRuleByCode(strings.ToUpper(selector)); analyzer != nil {
+				analyzers = []*analysis.Analyzer{analyzer}
+			}
+		} else {
+			// Otherwise treat as a category
+			analyzers = ktn.GetRulesByCategory(strings.ToLower(selector))
+		}
+		// Reject unknown selectors
+		if len(analyzers) == 0 {
+			// Return selection error
+			return []*analysis.Analyzer{}, fmt.Errorf("unknown rule or category: %s", selector)
+		}
+		// Add analyzers not selected yet
+		for _, analyzer := range analyzers {
+			// Skip duplicates
+			if !slices.Contains(selected, analyzer) {
+				selected = append(selected, analyzer)
+			}
+		}
+	}
+	// Return selection
+	return selected, nil
+}
+
+// toFindings converts normalized diagnostics to sorted findings.
+//
+// Params:
+//   - results: normalized diagnostics
+//
+// Returns:
+//   - []Finding: findings sorted by position
+func toFindings(results []orchestrator.DiagnosticResult) []Finding {
+	findings := make([]Finding, 0, len(results))
+	// Convert each diagnostic
+	for i := range results {
+		pos := results[i].Position()
+		code := stats.RuleCode(results[i].Diag.Message)
+		findings = append(findings, Finding{
+			Code:     code,
+			Severity: severity.ForDiagnostic(code, results[i].Diag.Category).String(),
+			Message:  strings.TrimPrefix(results[i].Diag.Message, code+": "),
+			File:     pos.Filename,
+			Line:     pos.Line,
+			Column:   pos.Column,
+			Package:  results[i].Package,
+			Symbol:   results[i].Symbol,
+			Build:    results[i].Build,
+			Analyzer: results[i].AnalyzerName,
+		})
+	}
+	slices.SortFunc(findings, func(a, b Finding) int {
+		// Order by file, line, column then code
+		return cmp.Or(
+			cmp.Compare(a.File, b.File),
+			cmp.Compare(a.Line, b.Line),
+			cmp.Compare(a.Column, b.Column),
+			cmp.Compare(a.Code, b.Code),
+		)
+	})
+	// Return sorted findings
+	return findings
+}
@@ -0,0 +1,192 @@
+// External tests for the embeddable API.
+package ktnlint_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"github.com/kodflow/ktn-linter/pkg/ktnlint"
+)
+
+// writeModule creates a module with one untyped package-level variable.
+//
+// Params:
+//   - t: test context
+//
+// Returns:
+//   - string: module directory
+func writeModule(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod": "module example.com/api\n\ngo 1.25\n",
+		"p.go":   "// Package p is a fixture.\npackage p\n\n// Value is untyped.\nvar Value = 1\n",
+	}
+	// Write fixture files
+	for name, content := range files {
+		// Check write error
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Return module directory
+	return dir
+}
+
+// disabledConfig returns a configuration disabling one rule.
+//
+// Params:
+//   - code: rule to disable
+//
+// Returns:
+//   - *config.Config: configuration with the rule disabled
+func disabledConfig(code string) *config.Config {
+	cfg := config.DefaultConfig()
+	disabled := false
+	cfg.Rules[code] = &config.RuleConfig{Enabled: &disabled}
+	// Return configuration
+	return cfg
+}
+
+// countCode counts findings of a rule.
+//
+// Params:
+//   - result: run result
+//   - code: rule code
+//
+// Returns:
+//   - int: number of findings with that code
+func countCode(result ktnlint.Result, code string) int {
+	count := 0
+	// Count matching findings
+	for _, finding := range result.Findings {
+		// Check code
+		if finding.Code == code {
+			count++
+		}
+	}
+	// Return count
+	return count
+}
+
+// TestRun tests running rules with per-call configurations.
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       *config.Config
+		wantCount int
+	}{
+		{name: "default configuration", cfg: nil, wantCount: 1},
+		{name: "rule disabled", cfg: disabledConfig("KTN-VAR-001"), wantCount: 0},
+	}
+
+	dir := writeModule(t)
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ktnlint.Run(context.Background(), ktnlint.Options{
+				Dir:    dir,
+				Config: tt.cfg,
+				Rules:  []string{"KTN-VAR-001"},
+			})
+			// Check run error
+			if err != nil {
+				t.Skipf("cannot analyze fixture module: %v", err)
+			}
+			// Verify findings
+			if got := countCode(result, "KTN-VAR-001"); got != tt.wantCount {
+				t.Errorf("KTN-VAR-001 findings = %d, want %d: %+v", got, tt.wantCount, result.Findings)
+			}
+			// Verify source size
+			if result.Packages == 0 || result.Lines == 0 {
+				t.Errorf("Packages=%d Lines=%d, want both > 0", result.Packages, result.Lines)
+			}
+		})
+	}
+}
+
+// TestRun_concurrent tests concurrent runs with different configurations.
+func TestRun_concurrent(t *testing.T) {
+	tests := []struct {
+		name   string
+		rounds int
+	}{
+		{name: "alternating configurations", rounds: 4},
+	}
+
+	dir := writeModule(t)
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var wg sync.WaitGroup
+			counts := make([]int, tt.rounds)
+			errs := make([]error, tt.rounds)
+			// Start runs with alternating configurations
+			for i := range tt.rounds {
+				var cfg *config.Config
+				// Disable the rule on odd runs
+				if i%2 == 1 {
+					cfg = disabledConfig("KTN-VAR-001")
+				}
+				wg.Go(func() {
+					result, err := ktnlint.Run(context.Background(), ktnlint.Options{Dir: dir, Config: cfg, Rules: []string{"var"}})
+					counts[i], errs[i] = countCode(result, "KTN-VAR-001"), err
+				})
+			}
+			wg.Wait()
+
+			// Verify each run used its own configuration
+			for i := range tt.rounds {
+				// Check run error
+				if errs[i] != nil {
+					t.Skipf("cannot analyze fixture module: %v", errs[i])
+				}
+				want := 1 - i%2
+				// Check findings
+				if counts[i] != want {
+					t.Errorf("run %d: KTN-VAR-001 findings = %d, want %d", i, counts[i], want)
+				}
+			}
+		})
+	}
+}
+
+// TestRun_errors tests selection and cancellation errors.
+func TestRun_errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		cancelled bool
+		rules     []string
+		wantCtx   bool
+	}{
+		{name: "cancelled context", cancelled: true, rules: nil, wantCtx: true},
+		{name: "unknown rule", cancelled: false, rules: []string{"KTN-NOPE-001"}, wantCtx: false},
+		{name: "unknown category", cancelled: false, rules: []string{"nope"}, wantCtx: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			// Cancel before running when requested
+			if tt.cancelled {
+				cancel()
+			}
+			_, err := ktnlint.Run(ctx, ktnlint.Options{Dir: t.TempDir(), Rules: tt.rules})
+			// Verify an error is returned
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			// Verify error kind
+			if errors.Is(err, context.Canceled) != tt.wantCtx {
+				t.Errorf("error = %v, want context error %v", err, tt.wantCtx)
+			}
+		})
+	}
+}
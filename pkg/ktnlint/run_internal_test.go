@@ -0,0 +1,89 @@
+// Internal tests for the embeddable API.
+package ktnlint
+
+import (
+	"go/token"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn"
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+	"golang.org/x/tools/go/analysis"
+)
+
+// Test_selectAnalyzers tests the selectAnalyzers function.
+func Test_selectAnalyzers(t *testing.T) {
+	tests := []struct {
+		name      string
+		selectors []string
+		wantLen   int
+		wantErr   bool
+	}{
+		{name: "all rules", selectors: nil, wantLen: len(ktn.GetAllRules()), wantErr: false},
+		{name: "lower case code", selectors: []string{"ktn-func-001"}, wantLen: 1, wantErr: false},
+		{name: "category", selectors: []string{"comment"}, wantLen: len(ktn.GetRulesByCategory("comment")), wantErr: false},
+		{name: "duplicates are merged", selectors: []string{"KTN-COMMENT-001", "comment"}, wantLen: len(ktn.GetRulesByCategory("comment")), wantErr: false},
+		{name: "unknown", selectors: []string{"unknown"}, wantLen: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectAnalyzers(tt.selectors)
+			// Verify error
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("selectAnalyzers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// Verify count
+			if len(got) != tt.wantLen {
+				t.Errorf("selectAnalyzers() len = %d, want %d", len(got), tt.wantLen)
+			}
+		})
+	}
+}
+
+// Test_toFindings tests the toFindings function.
+func Test_toFindings(t *testing.T) {
+	tests := []struct {
+		name      string
+		messages  []string
+		wantCodes []string
+		wantMsg   string
+	}{
+		{
+			name:      "sorted by position with code stripped",
+			messages:  []string{"KTN-VAR-001: second", "KTN-FUNC-001: first"},
+			wantCodes: []string{"KTN-FUNC-001", "KTN-VAR-001"},
+			wantMsg:   "first",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file := fset.AddFile("a.go", -1, 100)
+			file.SetLines([]int{0, 10, 20})
+			results := make([]orchestrator.DiagnosticResult, 0, len(tt.messages))
+			// Place messages on decreasing lines
+			for i, message := range tt.messages {
+				results = append(results, orchestrator.DiagnosticResult{
+					Diag: analysis.Diagnostic{Pos: file.Pos(20 - 10*i), Message: message},
+					Fset: fset,
+				})
+			}
+
+			got := toFindings(results)
+			// Verify order
+			for i, code := range tt.wantCodes {
+				// Check code
+				if got[i].Code != code {
+					t.Errorf("finding %d code = %s, want %s", i, got[i].Code, code)
+				}
+			}
+			// Verify message
+			if got[0].Message != tt.wantMsg {
+				t.Errorf("message = %q, want %q", got[0].Message, tt.wantMsg)
+			}
+		})
+	}
+}
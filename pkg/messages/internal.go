@@ -0,0 +1,34 @@
+// Package messages provides structured error messages for KTN rules.
+// This file contains INTERNAL messages for analyzers that failed.
+package messages
+
+// registerInternalMessages enregistre les messages INTERNAL.
+func registerInternalMessages() {
+	Register(Message{
+		Code:  "KTN-INTERNAL-001",
+		Short: "l'analyseur '%s' a paniqué sur le package '%s': %v",
+		Verbose: `PROBLÈME: L'analyseur '%s' a paniqué sur le package '%s': %v
+
+POURQUOI: Erreur interne du linter, pas du code analysé:
+  - Les règles de cet analyseur ne s'appliquent pas à ce package
+  - Les autres analyseurs et packages sont analysés normalement
+
+ACTIONS:
+  - Signaler le problème avec la pile d'appels ci-dessous
+  - Désactiver la règle dans .ktn-linter.yaml en attendant un correctif`,
+	})
+
+	Register(Message{
+		Code:  "KTN-INTERNAL-002",
+		Short: "l'analyseur '%s' a dépassé %s sur le package '%s'",
+		Verbose: `PROBLÈME: L'analyseur '%s' a dépassé %s sur le package '%s'
+
+POURQUOI: L'analyseur a été abandonné:
+  - Ses findings sur ce package sont ignorés
+  - Les autres analyseurs et packages sont analysés normalement
+
+ACTIONS:
+  - Augmenter analyzer_timeout dans .ktn-linter.yaml
+  - Signaler le problème si le package est de taille raisonnable`,
+	})
+}
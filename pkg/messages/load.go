@@ -0,0 +1,50 @@
+// Package messages provides structured error messages for KTN rules.
+// This file contains LOAD messages for packages that cannot be analyzed.
+package messages
+
+// registerLoadMessages enregistre les messages LOAD.
+func registerLoadMessages() {
+	Register(Message{
+		Code:  "KTN-LOAD-001",
+		Short: "package '%s' impossible à charger: %s",
+		Verbose: `PROBLÈME: Le package '%s' n'a pas pu être chargé: %s
+
+POURQUOI: Le package n'a pas été analysé:
+  - Aucune règle KTN ne s'applique à ses fichiers
+  - Les autres packages sont analysés normalement
+
+ACTIONS:
+  - Vérifier go.mod, les imports et les contraintes de build
+  - Lancer 'go list' sur le package pour le détail
+  - --strict-load pour interrompre l'analyse à la première erreur`,
+	})
+
+	Register(Message{
+		Code:  "KTN-LOAD-002",
+		Short: "erreur de syntaxe dans le package '%s': %s",
+		Verbose: `PROBLÈME: Erreur de syntaxe dans le package '%s': %s
+
+POURQUOI: Le package n'a pas été analysé:
+  - Aucune règle KTN ne s'applique à ses fichiers
+  - Les autres packages sont analysés normalement
+
+ACTIONS:
+  - Corriger l'erreur signalée ('gofmt -l' la reproduit)
+  - --strict-load pour interrompre l'analyse à la première erreur`,
+	})
+
+	Register(Message{
+		Code:  "KTN-LOAD-003",
+		Short: "erreur de typage dans le package '%s': %s",
+		Verbose: `PROBLÈME: Erreur de typage dans le package '%s': %s
+
+POURQUOI: Le package n'a pas été analysé:
+  - Aucune règle KTN ne s'applique à ses fichiers
+  - Les autres packages sont analysés normalement
+
+ACTIONS:
+  - Corriger l'erreur signalée ('go vet' la reproduit)
+  - Vérifier les packages importés (une erreur s'y propage)
+  - --strict-load pour interrompre l'analyse à la première erreur`,
+	})
+}
@@ -141,4 +141,6 @@ func init() {
 	registerTestMessages()
 	registerVarMessages()
 	registerInterfaceMessages()
+	registerLoadMessages()
+	registerInternalMessages()
 }
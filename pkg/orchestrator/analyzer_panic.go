@@ -0,0 +1,22 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+import (
+	"fmt"
+)
+
+// analyzerPanic is the error returned when an analyzer panics.
+// It keeps the stack of the panicking goroutine for the report.
+type analyzerPanic struct {
+	value any    // Value passed to panic
+	stack []byte // Stack trace captured in the deferred recover
+}
+
+// Error returns the panic value.
+//
+// Returns:
+//   - string: panic description
+func (p *analyzerPanic) Error() string {
+	// Return the panic value
+	return fmt.Sprintf("panic: %v", p.value)
+}
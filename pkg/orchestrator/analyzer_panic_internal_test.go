@@ -0,0 +1,29 @@
+// Internal tests for analyzer panic errors.
+package orchestrator
+
+import (
+	"testing"
+)
+
+// Test_analyzerPanic_Error tests the panic error message.
+func Test_analyzerPanic_Error(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{name: "string value", value: "boom", want: "panic: boom"},
+		{name: "integer value", value: 42, want: "panic: 42"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			p := &analyzerPanic{value: tt.value}
+			// Verify message
+			if got := p.Error(); got != tt.want {
+				t.Errorf("Error() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+import (
+	"go/token"
+)
+
+// buildRange maps the file positions added by one load to its build label.
+// Loads of a build matrix share a FileSet, so their positions never overlap.
+type buildRange struct {
+	start token.Pos // First position base of the load
+	end   token.Pos // Position base after the load
+	label string    // Build configuration label
+}
+
+// contains reports whether a position was produced by this load.
+//
+// Params:
+//   - pos: diagnostic position
+//
+// Returns:
+//   - bool: true if pos belongs to the range
+func (r buildRange) contains(pos token.Pos) bool {
+	// Check half-open interval
+	return pos >= r.start && pos < r.end
+}
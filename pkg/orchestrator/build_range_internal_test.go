@@ -0,0 +1,32 @@
+// Internal tests for build matrix position ranges.
+package orchestrator
+
+import (
+	"go/token"
+	"testing"
+)
+
+// Test_buildRange_contains tests the contains method.
+func Test_buildRange_contains(t *testing.T) {
+	r := buildRange{start: 10, end: 20, label: "windows"}
+	tests := []struct {
+		name string
+		pos  int
+		want bool
+	}{
+		{name: "start is included", pos: 10, want: true},
+		{name: "inside", pos: 15, want: true},
+		{name: "end is excluded", pos: 20, want: false},
+		{name: "before", pos: 1, want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify membership
+			if got := r.contains(token.Pos(tt.pos)); got != tt.want {
+				t.Errorf("contains(%d) = %v, want %v", tt.pos, got, tt.want)
+			}
+		})
+	}
+}
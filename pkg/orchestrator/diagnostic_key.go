@@ -0,0 +1,24 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+// diagnosticKey identifies a finding across build configurations.
+// Comparable, so it can key a map without formatting a string.
+type diagnosticKey struct {
+	filename string
+	line     int
+	column   int
+	message  string
+}
+
+// keyOf returns the identity of a diagnostic.
+//
+// Params:
+//   - d: diagnostic, its position is cached
+//
+// Returns:
+//   - diagnosticKey: file, line, column and message of the finding
+func keyOf(d *DiagnosticResult) diagnosticKey {
+	pos := d.Position()
+	// Return comparable key
+	return diagnosticKey{filename: pos.Filename, line: pos.Line, column: pos.Column, message: d.Diag.Message}
+}
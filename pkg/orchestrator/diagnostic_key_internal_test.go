@@ -0,0 +1,41 @@
+// Internal tests for diagnostic keys.
+package orchestrator
+
+import (
+	"go/token"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Test_keyOf tests diagnostic identity.
+func Test_keyOf(t *testing.T) {
+	fset := token.NewFileSet()
+	file := fset.AddFile("a.go", -1, 100)
+	file.SetLines([]int{0, 10, 20})
+	tests := []struct {
+		name  string
+		other DiagnosticResult
+		same  bool
+	}{
+		{name: "same position and message", other: DiagnosticResult{Diag: analysis.Diagnostic{Pos: file.Pos(12), Message: "m"}, Fset: fset}, same: true},
+		{name: "other column", other: DiagnosticResult{Diag: analysis.Diagnostic{Pos: file.Pos(13), Message: "m"}, Fset: fset}, same: false},
+		{name: "other message", other: DiagnosticResult{Diag: analysis.Diagnostic{Pos: file.Pos(12), Message: "n"}, Fset: fset}, same: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			base := DiagnosticResult{Diag: analysis.Diagnostic{Pos: file.Pos(12), Message: "m"}, Fset: fset}
+			want := diagnosticKey{filename: "a.go", line: 2, column: 3, message: "m"}
+			// Verify the key fields
+			if got := keyOf(&base); got != want {
+				t.Errorf("keyOf() = %+v, want %+v", got, want)
+			}
+			// Verify equality
+			if (keyOf(&base) == keyOf(&tt.other)) != tt.same {
+				t.Errorf("keys equal = %v, want %v", !tt.same, tt.same)
+			}
+		})
+	}
+}
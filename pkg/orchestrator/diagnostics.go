@@ -2,15 +2,22 @@
 package orchestrator
 
 import (
-	"fmt"
+	"go/token"
+	"slices"
 	"strings"
 
+	"github.com/kodflow/ktn-linter/pkg/rulecode"
 	"golang.org/x/tools/go/analysis"
 )
 
 // DiagnosticsProcessor handles filtering and processing diagnostics.
 // Provides deduplication, cache file filtering, and modernize prefix addition.
-type DiagnosticsProcessor struct{}
+type DiagnosticsProcessor struct {
+	builds   int                        // Number of build configurations merged (0 or 1 = no labels)
+	symbols  map[token.Position]string  // Enclosing symbols of the last extracted diagnostics
+	shares   map[token.Position]float64 // CPU shares of the last extracted diagnostics
+	hotPaths map[token.Position]string  // Hot path reasons of the last extracted diagnostics
+}
 
 // NewDiagnosticsProcessor creates a new DiagnosticsProcessor.
 //
@@ -21,6 +28,16 @@ func NewDiagnosticsProcessor() *DiagnosticsProcessor {
 	return &DiagnosticsProcessor{}
 }
 
+// SetBuildCount declares how many build configurations are merged.
+// With more than one, findings missing from some configurations are
+// labelled with the configurations they were found in.
+//
+// Params:
+//   - count: number of analyzed build configurations
+func (p *DiagnosticsProcessor) SetBuildCount(count int) {
+	p.builds = count
+}
+
 // Filter filters out diagnostics from cache/tmp files.
 //
 // Params:
@@ -44,7 +61,9 @@ func (p *DiagnosticsProcessor) Filter(diagnostics []DiagnosticResult) []Diagnost
 	return filtered
 }
 
-// Extract extracts and deduplicates diagnostics.
+// Extract extracts and deduplicates diagnostics. The enclosing symbols, CPU
+// shares and hot path reasons of the extracted diagnostics stay available
+// through Symbol, CPUShare and HotPath until the next call.
 //
 // Params:
 //   - diagnostics: raw diagnostics with fset
@@ -52,36 +71,177 @@ func (p *DiagnosticsProcessor) Filter(diagnostics []DiagnosticResult) []Diagnost
 // Returns:
 //   - []analysis.Diagnostic: deduplicated diagnostics
 func (p *DiagnosticsProcessor) Extract(diagnostics []DiagnosticResult) []analysis.Diagnostic {
+	normalized := p.Normalize(diagnostics)
+
+	// Build result slice
+	diags := make([]analysis.Diagnostic, 0, len(normalized))
+	p.symbols = make(map[token.Position]string, len(normalized))
+	p.shares = make(map[token.Position]float64, len(normalized))
+	p.hotPaths = make(map[token.Position]string, len(normalized))
+	// Iterate over normalized results
+	for i := range normalized {
+		diags = append(diags, normalized[i].Diag)
+		// Remember the declaration holding the finding
+		if normalized[i].Symbol != "" {
+			p.symbols[normalized[i].Position()] = normalized[i].Symbol
+		}
+		// Remember the CPU share of ranked findings
+		if normalized[i].CPUShare > 0 {
+			p.shares[normalized[i].Position()] = normalized[i].CPUShare
+		}
+		// Remember why escalated findings are hot
+		if normalized[i].HotPath != "" {
+			p.hotPaths[normalized[i].Position()] = normalized[i].HotPath
+		}
+	}
+
+	// Return processed diagnostics
+	return diags
+}
+
+// Symbol returns the enclosing symbol of a diagnostic returned by the last
+// Extract call.
+//
+// Params:
+//   - pos: resolved position of the diagnostic
+//
+// Returns:
+//   - string: declaration name (e.g. "Type.Method"), empty at file level
+func (p *DiagnosticsProcessor) Symbol(pos token.Position) string {
+	// Return recorded symbol
+	return p.symbols[pos]
+}
+
+// CPUShare returns the CPU share of the function enclosing a diagnostic
+// returned by the last Extract call.
+//
+// Params:
+//   - pos: resolved position of the diagnostic
+//
+// Returns:
+//   - float64: CPU share between 0 and 1, 0 when not ranked
+func (p *DiagnosticsProcessor) CPUShare(pos token.Position) float64 {
+	// Return recorded share
+	return p.shares[pos]
+}
+
+// HotPath returns why the function enclosing a diagnostic returned by the
+// last Extract call is hot.
+//
+// Params:
+//   - pos: resolved position of the diagnostic
+//
+// Returns:
+//   - string: hot path reason, empty when not escalated
+func (p *DiagnosticsProcessor) HotPath(pos token.Position) string {
+	// Return recorded reason
+	return p.hotPaths[pos]
+}
+
+// Normalize merges findings reported by several build configurations and
+// prefixes modernize messages. The runner analyzes each file once per build,
+// so identical findings only come from distinct configurations.
+// Unlike Extract, results keep their FileSet and analyzer name.
+//
+// Params:
+//   - diagnostics: raw diagnostics with fset
+//
+// Returns:
+//   - []DiagnosticResult: deduplicated diagnostics
+func (p *DiagnosticsProcessor) Normalize(diagnostics []DiagnosticResult) []DiagnosticResult {
 	// Deduplicate diagnostics
-	seen := make(map[string]bool, len(diagnostics))
-	var deduped []DiagnosticResult
+	seen := make(map[diagnosticKey]int, len(diagnostics))
+	deduped := make([]DiagnosticResult, 0, len(diagnostics))
+	builds := make([][]string, 0, len(diagnostics))
 
 	// Iterate over diagnostics
 	for i := range diagnostics {
-		pos := diagnostics[i].Position()
-		key := fmt.Sprintf("%s:%d:%d:%s", pos.Filename, pos.Line, pos.Column, diagnostics[i].Diag.Message)
-		// Skip duplicates
-		if !seen[key] {
-			seen[key] = true
-			deduped = append(deduped, diagnostics[i])
+		key := keyOf(&diagnostics[i])
+		// Skip duplicates, remembering the configuration they came from
+		if index, ok := seen[key]; ok {
+			builds[index] = appendBuild(builds[index], diagnostics[i].Build)
+			continue
 		}
-	}
+		seen[key] = len(deduped)
+		builds = append(builds, appendBuild(nil, diagnostics[i].Build))
 
-	// Build result slice
-	diags := make([]analysis.Diagnostic, 0, len(deduped))
-	// Iterate over deduplicated
-	for _, d := range deduped {
-		diag := d.Diag
+		result := diagnostics[i]
 		// Prefix modernize messages
-		if p.isModernize(d.AnalyzerName) && !strings.HasPrefix(diag.Message, "KTN-") {
-			code := p.formatModernizeCode(d.AnalyzerName)
-			diag.Message = code + ": " + diag.Message
+		if p.isModernize(result.AnalyzerName) && !strings.HasPrefix(result.Diag.Message, "KTN-") {
+			code := p.formatModernizeCode(result.AnalyzerName)
+			result.Diag.Message = code + ": " + result.Diag.Message
 		}
-		diags = append(diags, diag)
+		deduped = append(deduped, result)
 	}
 
+	// Label findings specific to some build configurations
+	p.labelBuilds(deduped, builds)
+
 	// Return processed diagnostics
-	return diags
+	return deduped
+}
+
+// labelBuilds records on each finding the build configurations it was found
+// in, when it was not found in all of them. Shared findings get no label.
+//
+// Params:
+//   - deduped: deduplicated diagnostics, updated in place
+//   - builds: build labels of each deduplicated diagnostic
+func (p *DiagnosticsProcessor) labelBuilds(deduped []DiagnosticResult, builds [][]string) {
+	// Nothing to label without a build matrix
+	if p.builds <= 1 {
+		// Keep findings unlabelled
+		return
+	}
+
+	// Iterate over deduplicated findings
+	for i := range deduped {
+		// Skip findings common to every configuration
+		if len(builds[i]) == 0 || len(builds[i]) >= p.builds {
+			deduped[i].Build = ""
+			continue
+		}
+		deduped[i].Build = strings.Join(builds[i], ", ")
+		deduped[i].Diag.Message = appendBuildLabel(deduped[i].Diag.Message, deduped[i].Build)
+	}
+}
+
+// appendBuild adds a build label to a list if not already present.
+//
+// Params:
+//   - labels: current labels
+//   - label: label to add (empty is ignored)
+//
+// Returns:
+//   - []string: updated labels
+func appendBuild(labels []string, label string) []string {
+	// Ignore unlabelled or already recorded configurations
+	if label == "" || slices.Contains(labels, label) {
+		// Return labels unchanged
+		return labels
+	}
+	// Return labels with the new configuration
+	return append(labels, label)
+}
+
+// appendBuildLabel appends the build label to the first line of a message.
+//
+// Params:
+//   - message: diagnostic message, possibly multi-line
+//   - label: build configurations the finding was found in
+//
+// Returns:
+//   - string: annotated message
+func appendBuildLabel(message, label string) string {
+	first, rest, multiline := strings.Cut(message, "\n")
+	first += " [build: " + label + "]"
+	// Keep the verbose details after the first line
+	if multiline {
+		// Return annotated multi-line message
+		return first + "\n" + rest
+	}
+	// Return annotated message
+	return first
 }
 
 // isModernize checks if an analyzer is a modernize analyzer.
@@ -92,6 +252,30 @@ func (p *DiagnosticsProcessor) Extract(diagnostics []DiagnosticResult) []analysi
 // Returns:
 //   - bool: true if modernize analyzer
 func (p *DiagnosticsProcessor) isModernize(name string) bool {
+	// Return lookup result
+	return isModernizeAnalyzer(name)
+}
+
+// formatModernizeCode formats an analyzer name as a KTN-MDRNZ code.
+//
+// Params:
+//   - name: analyzer name
+//
+// Returns:
+//   - string: formatted code
+func (p *DiagnosticsProcessor) formatModernizeCode(name string) string {
+	// Return formatted code
+	return modernizeCode(name)
+}
+
+// isModernizeAnalyzer checks if an analyzer is a modernize analyzer.
+//
+// Params:
+//   - name: analyzer name
+//
+// Returns:
+//   - bool: true if modernize analyzer
+func isModernizeAnalyzer(name string) bool {
 	modernizeAnalyzers := map[string]bool{
 		"any":              true,
 		"bloop":            true,
@@ -116,14 +300,33 @@ func (p *DiagnosticsProcessor) isModernize(name string) bool {
 	return modernizeAnalyzers[name]
 }
 
-// formatModernizeCode formats an analyzer name as a KTN-MDRNZ code.
+// modernizeCode formats an analyzer name as a KTN-MDRNZ code.
 //
 // Params:
 //   - name: analyzer name
 //
 // Returns:
 //   - string: formatted code
-func (p *DiagnosticsProcessor) formatModernizeCode(name string) string {
+func modernizeCode(name string) string {
 	// Return formatted code
 	return "KTN-MDRNZ-" + strings.ToUpper(name)
 }
+
+// findingCode returns the rule code of a raw finding. Modernize messages
+// are only prefixed by Normalize, so their code comes from the analyzer.
+//
+// Params:
+//   - analyzerName: name of the reporting analyzer
+//   - message: diagnostic message
+//
+// Returns:
+//   - string: rule code, empty when unknown
+func findingCode(analyzerName, message string) string {
+	// Derive modernize codes from the analyzer name
+	if isModernizeAnalyzer(analyzerName) && !strings.HasPrefix(message, "KTN-") {
+		// Return modernize code
+		return modernizeCode(analyzerName)
+	}
+	// Return code carried by the message
+	return rulecode.FromMessage(message)
+}
@@ -213,3 +213,201 @@ func TestDiagnosticsProcessor_Extract(t *testing.T) {
 		})
 	}
 }
+
+// TestDiagnosticsProcessor_Normalize tests the Normalize method.
+func TestDiagnosticsProcessor_Normalize(t *testing.T) {
+	fset := token.NewFileSet()
+	file := fset.AddFile("/src/a.go", -1, 100)
+	tests := []struct {
+		name        string
+		diagnostics []orchestrator.DiagnosticResult
+		wantLen     int
+		wantPrefix  string
+	}{
+		{
+			name: "duplicates removed",
+			diagnostics: []orchestrator.DiagnosticResult{
+				{Diag: analysis.Diagnostic{Pos: file.Pos(1), Message: "KTN-A: x"}, Fset: fset},
+				{Diag: analysis.Diagnostic{Pos: file.Pos(1), Message: "KTN-A: x"}, Fset: fset},
+			},
+			wantLen:    1,
+			wantPrefix: "KTN-A",
+		},
+		{
+			name: "modernize prefix added",
+			diagnostics: []orchestrator.DiagnosticResult{
+				{Diag: analysis.Diagnostic{Pos: file.Pos(1), Message: "use any"}, Fset: fset, AnalyzerName: "any"},
+			},
+			wantLen:    1,
+			wantPrefix: "KTN-MDRNZ-ANY",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := orchestrator.NewDiagnosticsProcessor().Normalize(tt.diagnostics)
+			// Verify length
+			if len(got) != tt.wantLen {
+				t.Fatalf("Normalize() len = %d, want %d", len(got), tt.wantLen)
+			}
+			// Verify message prefix
+			if !strings.HasPrefix(got[0].Diag.Message, tt.wantPrefix) {
+				t.Errorf("message = %q, want prefix %q", got[0].Diag.Message, tt.wantPrefix)
+			}
+			// Verify fileset is kept
+			if got[0].Fset != fset {
+				t.Error("expected FileSet to be preserved")
+			}
+		})
+	}
+}
+
+// TestDiagnosticsProcessor_Symbol tests the symbols of extracted findings.
+func TestDiagnosticsProcessor_Symbol(t *testing.T) {
+	fset := token.NewFileSet()
+	file := fset.AddFile("/src/a.go", -1, 100)
+	processor := orchestrator.NewDiagnosticsProcessor()
+	processor.Extract([]orchestrator.DiagnosticResult{
+		{Diag: analysis.Diagnostic{Pos: file.Pos(1), Message: "KTN-A: x"}, Fset: fset, Symbol: "Server.Handle"},
+		{Diag: analysis.Diagnostic{Pos: file.Pos(5), Message: "KTN-B: y"}, Fset: fset},
+	})
+	tests := []struct {
+		name   string
+		offset int
+		want   string
+	}{
+		{name: "finding inside a declaration", offset: 1, want: "Server.Handle"},
+		{name: "file level finding", offset: 5, want: ""},
+		{name: "unknown position", offset: 9, want: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify recorded symbol
+			if got := processor.Symbol(fset.Position(file.Pos(tt.offset))); got != tt.want {
+				t.Errorf("Symbol() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestDiagnosticsProcessor_CPUShare tests the CPU shares of extracted findings.
+func TestDiagnosticsProcessor_CPUShare(t *testing.T) {
+	fset := token.NewFileSet()
+	file := fset.AddFile("/src/a.go", -1, 100)
+	processor := orchestrator.NewDiagnosticsProcessor()
+	processor.Extract([]orchestrator.DiagnosticResult{
+		{Diag: analysis.Diagnostic{Pos: file.Pos(1), Message: "KTN-VAR-012: x"}, Fset: fset, CPUShare: 0.4213},
+		{Diag: analysis.Diagnostic{Pos: file.Pos(5), Message: "KTN-B: y"}, Fset: fset},
+	})
+	tests := []struct {
+		name   string
+		offset int
+		want   float64
+	}{
+		{name: "ranked finding", offset: 1, want: 0.4213},
+		{name: "unranked finding", offset: 5, want: 0},
+		{name: "unknown position", offset: 9, want: 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify recorded share
+			if got := processor.CPUShare(fset.Position(file.Pos(tt.offset))); got != tt.want {
+				t.Errorf("CPUShare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestDiagnosticsProcessor_HotPath tests the hot path reasons of extracted findings.
+func TestDiagnosticsProcessor_HotPath(t *testing.T) {
+	fset := token.NewFileSet()
+	file := fset.AddFile("/src/a.go", -1, 100)
+	processor := orchestrator.NewDiagnosticsProcessor()
+	processor.Extract([]orchestrator.DiagnosticResult{
+		{Diag: analysis.Diagnostic{Pos: file.Pos(1), Message: "KTN-VAR-012: x"}, Fset: fset, HotPath: "pattern api.[Encode]"},
+		{Diag: analysis.Diagnostic{Pos: file.Pos(5), Message: "KTN-VAR-012: y"}, Fset: fset},
+	})
+	tests := []struct {
+		name   string
+		offset int
+		want   string
+	}{
+		{name: "escalated finding", offset: 1, want: "pattern api.[Encode]"},
+		{name: "cold finding", offset: 5, want: ""},
+		{name: "unknown position", offset: 9, want: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify recorded reason
+			if got := processor.HotPath(fset.Position(file.Pos(tt.offset))); got != tt.want {
+				t.Errorf("HotPath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestDiagnosticsProcessor_SetBuildCount tests build labels of merged findings.
+func TestDiagnosticsProcessor_SetBuildCount(t *testing.T) {
+	fset := token.NewFileSet()
+	file := fset.AddFile("/src/a.go", -1, 100)
+	shared := analysis.Diagnostic{Pos: file.Pos(1), Message: "KTN-A: shared"}
+	unique := analysis.Diagnostic{Pos: file.Pos(2), Message: "KTN-A: unique\ndetails"}
+	tests := []struct {
+		name        string
+		builds      int
+		wantShared  string
+		wantUnique  string
+		wantBuildOf string
+	}{
+		{
+			name:        "findings of one configuration are labelled",
+			builds:      2,
+			wantShared:  "KTN-A: shared",
+			wantUnique:  "KTN-A: unique [build: windows]\ndetails",
+			wantBuildOf: "windows",
+		},
+		{
+			name:        "single configuration is never labelled",
+			builds:      1,
+			wantShared:  "KTN-A: shared",
+			wantUnique:  "KTN-A: unique\ndetails",
+			wantBuildOf: "windows",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			processor := orchestrator.NewDiagnosticsProcessor()
+			processor.SetBuildCount(tt.builds)
+			got := processor.Normalize([]orchestrator.DiagnosticResult{
+				{Diag: shared, Fset: fset, Build: "host"},
+				{Diag: shared, Fset: fset, Build: "windows"},
+				{Diag: unique, Fset: fset, Build: "windows"},
+			})
+			// Verify shared findings are merged
+			if len(got) != 2 {
+				t.Fatalf("Normalize() len = %d, want 2", len(got))
+			}
+			// Verify messages
+			if got[0].Diag.Message != tt.wantShared || got[1].Diag.Message != tt.wantUnique {
+				t.Errorf("messages = %q, %q, want %q, %q", got[0].Diag.Message, got[1].Diag.Message, tt.wantShared, tt.wantUnique)
+			}
+			// Verify shared findings carry no build label
+			if got[0].Build != "" && tt.builds > 1 {
+				t.Errorf("shared Build = %q, want empty", got[0].Build)
+			}
+			// Verify unique finding keeps its configuration
+			if got[1].Build != tt.wantBuildOf {
+				t.Errorf("unique Build = %q, want %q", got[1].Build, tt.wantBuildOf)
+			}
+		})
+	}
+}
@@ -90,3 +90,100 @@ func TestDiagnosticsProcessor_formatModernizeCode(t *testing.T) {
 		})
 	}
 }
+
+// Test_appendBuild tests the appendBuild function.
+func Test_appendBuild(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels []string
+		label  string
+		want   int
+	}{
+		{name: "adds new label", labels: []string{"host"}, label: "windows", want: 2},
+		{name: "ignores duplicate", labels: []string{"host"}, label: "host", want: 1},
+		{name: "ignores empty label", labels: nil, label: "", want: 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify resulting length
+			if got := appendBuild(tt.labels, tt.label); len(got) != tt.want {
+				t.Errorf("appendBuild() = %v, want %d labels", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_appendBuildLabel tests the appendBuildLabel function.
+func Test_appendBuildLabel(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{name: "single line", message: "KTN-A: x", want: "KTN-A: x [build: linux]"},
+		{name: "multi line", message: "KTN-A: x\nmore", want: "KTN-A: x [build: linux]\nmore"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify label placement
+			if got := appendBuildLabel(tt.message, "linux"); got != tt.want {
+				t.Errorf("appendBuildLabel() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestDiagnosticsProcessor_labelBuilds tests the labelBuilds method.
+func TestDiagnosticsProcessor_labelBuilds(t *testing.T) {
+	tests := []struct {
+		name   string
+		builds int
+		want   string
+	}{
+		{name: "labels partial findings", builds: 3, want: "host, linux"},
+		{name: "skips findings of every configuration", builds: 2, want: ""},
+		{name: "skips without matrix", builds: 0, want: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			p := &DiagnosticsProcessor{builds: tt.builds}
+			deduped := []DiagnosticResult{{}}
+			p.labelBuilds(deduped, [][]string{{"host", "linux"}})
+			// Verify label
+			if deduped[0].Build != tt.want {
+				t.Errorf("Build = %q, want %q", deduped[0].Build, tt.want)
+			}
+		})
+	}
+}
+
+// Test_findingCode tests the rule code of raw findings.
+func Test_findingCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		analyzer string
+		message  string
+		want     string
+	}{
+		{name: "KTN rule", analyzer: "ktnfunc006", message: "KTN-FUNC-006: too many params", want: "KTN-FUNC-006"},
+		{name: "unprefixed modernize", analyzer: "minmax", message: "if statement can be modernized using max", want: "KTN-MDRNZ-MINMAX"},
+		{name: "prefixed modernize", analyzer: "minmax", message: "KTN-MDRNZ-MINMAX: use max", want: "KTN-MDRNZ-MINMAX"},
+		{name: "unknown analyzer", analyzer: "other", message: "plain message", want: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify code
+			if got := findingCode(tt.analyzer, tt.message); got != tt.want {
+				t.Errorf("findingCode(%q, %q) = %q, want %q", tt.analyzer, tt.message, got, tt.want)
+			}
+		})
+	}
+}
@@ -2,14 +2,29 @@
 package orchestrator
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"golang.org/x/mod/modfile"
+)
+
+const (
+	// goWorkFile is the workspace file name.
+	goWorkFile string = "go.work"
+	// goWorkEnv is the environment variable overriding workspace lookup.
+	goWorkEnv string = "GOWORK"
+	// goWorkOff disables workspace mode in GOWORK.
+	goWorkOff string = "off"
 )
 
 // ModuleDiscovery handles finding Go modules recursively.
 // Searches directories for go.mod files and returns module root paths.
-type ModuleDiscovery struct{}
+type ModuleDiscovery struct {
+	skip *config.SkipMatcher // Configured skip list (nil = built-in skips only)
+}
 
 // NewModuleDiscovery creates a new ModuleDiscovery.
 //
@@ -20,6 +35,14 @@ func NewModuleDiscovery() *ModuleDiscovery {
 	return &ModuleDiscovery{}
 }
 
+// SetSkipDirs sets the gitignore-style skip list applied during discovery.
+//
+// Params:
+//   - skip: compiled skip list (nil keeps the built-in skips only)
+func (d *ModuleDiscovery) SetSkipDirs(skip *config.SkipMatcher) {
+	d.skip = skip
+}
+
 // FindModules finds all go.mod files in paths.
 //
 // Params:
@@ -67,7 +90,7 @@ func (d *ModuleDiscovery) FindModules(paths []string) ([]string, error) {
 //   - error: search error if any
 func (d *ModuleDiscovery) findInPath(path string) ([]string, error) {
 	// Get absolute path
-	absPath, err := filepath.Abs(path)
+	absPath, err := filepath.Abs(patternDir(path))
 	// Check for error
 	if err != nil {
 		// Return error with empty slice
@@ -136,21 +159,9 @@ func (d *ModuleDiscovery) searchDirectory(dir string) ([]string, error) {
 			return nil
 		}
 
-		// Skip hidden directories
-		if entry.IsDir() && strings.HasPrefix(entry.Name(), ".") {
-			// Skip hidden directory
-			return filepath.SkipDir
-		}
-
-		// Skip vendor directories
-		if entry.IsDir() && entry.Name() == "vendor" {
-			// Skip vendor directory
-			return filepath.SkipDir
-		}
-
-		// Skip testdata directories (not part of Go package hierarchy)
-		if entry.IsDir() && entry.Name() == "testdata" {
-			// Skip testdata directory
+		// Skip hidden, vendor, testdata and configured directories
+		if entry.IsDir() && path != dir && (isSkippedDir(entry.Name()) || d.skip.Skips(path, true)) {
+			// Skip directory
 			return filepath.SkipDir
 		}
 
@@ -173,6 +184,150 @@ func (d *ModuleDiscovery) searchDirectory(dir string) ([]string, error) {
 	return modules, nil
 }
 
+// patternDir returns the directory searched by a "dir/..." pattern.
+//
+// Params:
+//   - pattern: path or recursive pattern
+//
+// Returns:
+//   - string: directory part of the pattern
+func patternDir(pattern string) string {
+	dir := strings.TrimSuffix(strings.TrimSuffix(pattern, "..."), "/")
+	// Check for the bare "..." pattern
+	if dir == "" {
+		// Return current directory
+		return "."
+	}
+	// Return directory
+	return dir
+}
+
+// isSkippedDir reports whether a directory is outside the Go package hierarchy.
+// Hidden, vendor and testdata directories are never searched.
+//
+// Params:
+//   - name: directory base name
+//
+// Returns:
+//   - bool: true if the directory should be skipped
+func isSkippedDir(name string) bool {
+	// Skip hidden directories
+	if strings.HasPrefix(name, ".") && name != "." && name != ".." {
+		// Hidden directory
+		return true
+	}
+	// Skip vendor and testdata directories
+	return name == "vendor" || name == "testdata"
+}
+
+// FindWorkspace returns the go.work workspace governing every path, as the
+// go command would find it: GOWORK when set, otherwise the nearest go.work
+// in a parent directory. Used modules matched by the skip list are dropped.
+//
+// Params:
+//   - paths: paths to analyze
+//
+// Returns:
+//   - *Workspace: workspace, nil when none applies to all paths
+//   - error: go.work read or parse error
+func (d *ModuleDiscovery) FindWorkspace(paths []string) (*Workspace, error) {
+	var workFile string
+	// Each path must resolve to the same go.work
+	for _, p := range paths {
+		found := findWorkFile(patternDir(p))
+		// Check for a path outside any workspace or in another one
+		if found == "" || (workFile != "" && found != workFile) {
+			// Return no workspace
+			return nil, nil
+		}
+		workFile = found
+	}
+	// Check for empty paths
+	if workFile == "" {
+		// Return no workspace
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(workFile)
+	// Check read error
+	if err != nil {
+		// Return read error
+		return nil, fmt.Errorf("reading %s: %w", workFile, err)
+	}
+	work, err := modfile.ParseWork(workFile, data, nil)
+	// Check parse error
+	if err != nil {
+		// Return parse error
+		return nil, fmt.Errorf("parsing %s: %w", workFile, err)
+	}
+
+	ws := &Workspace{Dir: filepath.Dir(workFile), Modules: make([]string, 0, len(work.Use))}
+	// Resolve used module directories
+	for _, use := range work.Use {
+		module := use.Path
+		// Resolve relative directories from the workspace
+		if !filepath.IsAbs(module) {
+			module = filepath.Join(ws.Dir, module)
+		}
+		// Drop skipped modules
+		if d.skip.Skips(module, true) {
+			continue
+		}
+		ws.Modules = append(ws.Modules, filepath.Clean(module))
+	}
+	// Return workspace
+	return ws, nil
+}
+
+// findWorkFile returns the go.work file governing a path.
+//
+// Params:
+//   - path: file or directory
+//
+// Returns:
+//   - string: absolute go.work path, empty when workspace mode is off
+func findWorkFile(path string) string {
+	// Honor the GOWORK override
+	if env := os.Getenv(goWorkEnv); env != "" {
+		// Check for disabled workspace mode
+		if env == goWorkOff {
+			// Return no workspace
+			return ""
+		}
+		abs, _ := filepath.Abs(env)
+		// Return configured workspace
+		return abs
+	}
+
+	dir, err := filepath.Abs(path)
+	// Check for invalid path
+	if err != nil {
+		// Return no workspace
+		return ""
+	}
+	// Start from the directory of files
+	if info, statErr := os.Stat(dir); statErr == nil && !info.IsDir() {
+		dir = filepath.Dir(dir)
+	}
+
+	// Walk up directory tree
+	for {
+		candidate := filepath.Join(dir, goWorkFile)
+		// Check if go.work exists
+		if info, statErr := os.Stat(candidate); statErr == nil && !info.IsDir() {
+			// Return workspace file
+			return candidate
+		}
+		parent := filepath.Dir(dir)
+		// Stop at the filesystem root
+		if parent == dir {
+			// Return no workspace
+			return ""
+		}
+		dir = parent
+	}
+}
+
 // ResolvePatterns resolves patterns for a module.
 //
 // Params:
@@ -194,3 +349,41 @@ func (d *ModuleDiscovery) ResolvePatterns(_moduleRoot string, originalPatterns [
 	// Default to recursive
 	return []string{"./..."}
 }
+
+// NeedsModuleDiscovery checks if CLI arguments require module discovery.
+// Directory arguments may hold several modules; standard patterns need it too
+// at the root of a go.work workspace, whose modules are only visible through
+// their go.work.
+//
+// Params:
+//   - args: command line arguments
+//
+// Returns:
+//   - bool: true if discovery needed
+func NeedsModuleDiscovery(args []string) bool {
+	// Check each arg
+	for _, arg := range args {
+		// Skip standard Go patterns outside a workspace root
+		if arg == "./..." || arg == "." {
+			// Check for a go.work in the working directory
+			if _, err := os.Stat(goWorkFile); err == nil {
+				// Workspace found, discovery needed
+				return true
+			}
+			continue
+		}
+		// Check if path exists as directory
+		info, err := os.Stat(arg)
+		// Skip if not accessible
+		if err != nil {
+			continue
+		}
+		// Check if directory
+		if info.IsDir() {
+			// Directory found, discovery needed
+			return true
+		}
+	}
+	// No directory found, no discovery needed
+	return false
+}
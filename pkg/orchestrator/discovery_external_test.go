@@ -3,9 +3,11 @@ package orchestrator_test
 import (
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"testing"
 
+	"github.com/kodflow/ktn-linter/pkg/config"
 	"github.com/kodflow/ktn-linter/pkg/orchestrator"
 )
 
@@ -295,3 +297,208 @@ func TestFindModulesMultiple(t *testing.T) {
 		})
 	}
 }
+
+// TestModuleDiscovery_SetSkipDirs tests skipping configured directories.
+func TestModuleDiscovery_SetSkipDirs(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		want     int
+	}{
+		{name: "no skip list", patterns: []string{}, want: 3},
+		{name: "skip by name", patterns: []string{"legacy"}, want: 2},
+		{name: "skip anchored path", patterns: []string{"/tools/gen"}, want: 2},
+		{name: "negation keeps module", patterns: []string{"*", "!tools", "!gen"}, want: 2},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			// Create root and nested modules
+			for _, dir := range []string{".", "legacy", "tools/gen"} {
+				modDir := filepath.Join(tmpDir, dir)
+				// Check directory creation error
+				if err := os.MkdirAll(modDir, 0o755); err != nil {
+					t.Fatal(err)
+				}
+				// Check write error
+				if err := os.WriteFile(filepath.Join(modDir, "go.mod"), []byte("module m\n"), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			d := orchestrator.NewModuleDiscovery()
+			d.SetSkipDirs(config.NewSkipMatcher(tmpDir, tt.patterns))
+			modules, err := d.FindModules([]string{tmpDir})
+			// Check no error
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// Check module count
+			if len(modules) != tt.want {
+				t.Errorf("FindModules() = %v, want %d modules", modules, tt.want)
+			}
+		})
+	}
+}
+
+// TestModuleDiscovery_FindWorkspace tests go.work detection.
+func TestModuleDiscovery_FindWorkspace(t *testing.T) {
+	tests := []struct {
+		name      string
+		work      string
+		gowork    string
+		path      string
+		skip      []string
+		want      []string
+		wantNil   bool
+		wantError bool
+	}{
+		{name: "no go.work", path: "a", wantNil: true},
+		{name: "workspace from root", work: "go 1.25\n\nuse (\n\t./a\n\t./b\n)\n", path: ".", want: []string{"a", "b"}},
+		{name: "workspace from nested path", work: "go 1.25\n\nuse ./a\n", path: "a/sub", want: []string{"a"}},
+		{name: "skipped use directive", work: "go 1.25\n\nuse (\n\t./a\n\t./b\n)\n", path: ".", skip: []string{"b"}, want: []string{"a"}},
+		{name: "workspace mode off", work: "go 1.25\n\nuse ./a\n", gowork: "off", path: ".", wantNil: true},
+		{name: "invalid go.work", work: "use (\n", path: ".", wantError: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GOWORK", tt.gowork)
+			tmpDir := t.TempDir()
+			// Create module directories
+			for _, dir := range []string{"a/sub", "b"} {
+				// Check directory creation error
+				if err := os.MkdirAll(filepath.Join(tmpDir, dir), 0o755); err != nil {
+					t.Fatal(err)
+				}
+			}
+			// Write go.work when required
+			if tt.work != "" {
+				// Check write error
+				if err := os.WriteFile(filepath.Join(tmpDir, "go.work"), []byte(tt.work), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			d := orchestrator.NewModuleDiscovery()
+			d.SetSkipDirs(config.NewSkipMatcher(tmpDir, tt.skip))
+			ws, err := d.FindWorkspace([]string{filepath.Join(tmpDir, tt.path)})
+			// Verify error expectation
+			if (err != nil) != tt.wantError {
+				t.Fatalf("FindWorkspace() error = %v, wantError %v", err, tt.wantError)
+			}
+			// Stop after expected errors or missing workspace
+			if tt.wantError || tt.wantNil {
+				// Verify no workspace
+				if ws != nil {
+					t.Errorf("FindWorkspace() = %+v, want nil", ws)
+				}
+				return
+			}
+			// Check workspace found
+			if ws == nil || ws.Dir != tmpDir {
+				t.Fatalf("FindWorkspace() = %+v, want workspace in %s", ws, tmpDir)
+			}
+			want := make([]string, 0, len(tt.want))
+			// Build expected module directories
+			for _, dir := range tt.want {
+				want = append(want, filepath.Join(tmpDir, dir))
+			}
+			// Verify used modules
+			if !slices.Equal(ws.Modules, want) {
+				t.Errorf("Modules = %v, want %v", ws.Modules, want)
+			}
+		})
+	}
+}
+
+// TestNeedsModuleDiscovery tests the NeedsModuleDiscovery function.
+func TestNeedsModuleDiscovery(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected bool
+	}{
+		{
+			name:     "standard pattern ./...",
+			args:     []string{"./..."},
+			expected: false,
+		},
+		{
+			name:     "single dot pattern",
+			args:     []string{"."},
+			expected: false,
+		},
+		{
+			name:     "package path pattern",
+			args:     []string{"github.com/example/pkg"},
+			expected: false,
+		},
+		{
+			name:     "nonexistent directory",
+			args:     []string{"/nonexistent/path/to/dir"},
+			expected: false,
+		},
+		{
+			name:     "multiple standard patterns",
+			args:     []string{"./...", "."},
+			expected: false,
+		},
+		{
+			name:     "empty args",
+			args:     []string{},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			result := orchestrator.NeedsModuleDiscovery(tt.args)
+			// Verify result
+			if result != tt.expected {
+				t.Errorf("NeedsModuleDiscovery(%v) = %v, want %v", tt.args, result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestNeedsModuleDiscovery_workspace tests standard patterns at a go.work root.
+func TestNeedsModuleDiscovery_workspace(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected bool
+	}{
+		{
+			name:     "recursive pattern at workspace root",
+			args:     []string{"./..."},
+			expected: true,
+		},
+		{
+			name:     "single dot at workspace root",
+			args:     []string{"."},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			// Check write error
+			if err := os.WriteFile(filepath.Join(dir, "go.work"), []byte("go 1.25\n"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			t.Chdir(dir)
+			result := orchestrator.NeedsModuleDiscovery(tt.args)
+			// Verify result
+			if result != tt.expected {
+				t.Errorf("NeedsModuleDiscovery(%v) = %v, want %v", tt.args, result, tt.expected)
+			}
+		})
+	}
+}
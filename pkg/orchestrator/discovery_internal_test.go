@@ -0,0 +1,81 @@
+// Internal tests for module discovery.
+package orchestrator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// Test_patternDir tests extracting the directory of a pattern.
+func Test_patternDir(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		want    string
+	}{
+		{name: "recursive current directory", pattern: "./...", want: "."},
+		{name: "bare recursive pattern", pattern: "...", want: "."},
+		{name: "recursive subdirectory", pattern: "./pkg/...", want: "./pkg"},
+		{name: "plain directory", pattern: "pkg", want: "pkg"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify directory
+			if got := patternDir(tt.pattern); got != tt.want {
+				t.Errorf("patternDir(%q) = %q, want %q", tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_findWorkFile tests locating the governing go.work file.
+func Test_findWorkFile(t *testing.T) {
+	tests := []struct {
+		name   string
+		gowork string
+		path   string
+		want   string
+	}{
+		{name: "found in parent directory", path: "mod/sub", want: "go.work"},
+		{name: "found from a file", path: "mod/file.go", want: "go.work"},
+		{name: "explicit GOWORK", gowork: "custom.work", path: "mod", want: "custom.work"},
+		{name: "workspace mode off", gowork: "off", path: "mod", want: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			// Create module directory
+			if err := os.MkdirAll(filepath.Join(dir, "mod", "sub"), 0o755); err != nil {
+				t.Fatal(err)
+			}
+			// Create workspace and source files
+			for _, name := range []string{"go.work", "mod/file.go"} {
+				// Check write error
+				if err := os.WriteFile(filepath.Join(dir, name), []byte("go 1.25\n"), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			gowork := tt.gowork
+			// Make explicit workspace files absolute
+			if gowork != "" && gowork != goWorkOff {
+				gowork = filepath.Join(dir, gowork)
+			}
+			t.Setenv(goWorkEnv, gowork)
+
+			want := ""
+			// Build expected absolute path
+			if tt.want != "" {
+				want = filepath.Join(dir, tt.want)
+			}
+			// Verify go.work location
+			if got := findWorkFile(filepath.Join(dir, tt.path)); got != want {
+				t.Errorf("findWorkFile() = %q, want %q", got, want)
+			}
+		})
+	}
+}
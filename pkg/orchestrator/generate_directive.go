@@ -0,0 +1,23 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+import (
+	"fmt"
+	"go/token"
+	"path/filepath"
+)
+
+// generateDirective is a //go:generate line of a package.
+type generateDirective struct {
+	position token.Position // Position of the directive
+	command  string         // Command run by go generate
+}
+
+// label returns the attribution shown next to findings in generated files.
+//
+// Returns:
+//   - string: "file.go:line: command"
+func (d generateDirective) label() string {
+	// Return short position and command
+	return fmt.Sprintf("%s:%d: %s", filepath.Base(d.position.Filename), d.position.Line, d.command)
+}
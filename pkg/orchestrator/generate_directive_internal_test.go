@@ -0,0 +1,32 @@
+// Internal tests for go generate directives.
+package orchestrator
+
+import (
+	"go/token"
+	"testing"
+)
+
+// Test_generateDirective_label tests the attribution label.
+func Test_generateDirective_label(t *testing.T) {
+	tests := []struct {
+		name      string
+		directive generateDirective
+		expected  string
+	}{
+		{
+			name:      "base name and line",
+			directive: generateDirective{position: token.Position{Filename: "/src/kind/kind.go", Line: 4}, command: "stringer -type=Kind"},
+			expected:  "kind.go:4: stringer -type=Kind",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify label
+			if got := tt.directive.label(); got != tt.expected {
+				t.Errorf("label() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+import (
+	"go/ast"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	// goGeneratePrefix introduces a go generate directive
+	goGeneratePrefix string = "//go:generate "
+	// commentAnalyzerPrefix is shared by KTN-COMMENT analyzers
+	commentAnalyzerPrefix string = "ktncomment"
+)
+
+// generatedHeader matches the official header and captures its text.
+var generatedHeader *regexp.Regexp = regexp.MustCompile(`^// Code generated (.*)DO NOT EDIT\.$`)
+
+// filterGeneratedFiles applies the generated-code policy to the files of
+// an analyzer.
+//
+// Params:
+//   - a: analyzer
+//   - files: candidate files
+//
+// Returns:
+//   - []*ast.File: files the analyzer may lint
+func (r *AnalysisRunner) filterGeneratedFiles(a *analysis.Analyzer, files []*ast.File) []*ast.File {
+	policy := r.configuration().GeneratedPolicy()
+	// Check whether generated files are kept for this analyzer
+	if policy == config.GeneratedReport || (policy == config.GeneratedOnlyCommentRules && isCommentAnalyzer(a)) {
+		// Return all files
+		return files
+	}
+	kept := make([]*ast.File, 0, len(files))
+	// Drop generated files
+	for _, file := range files {
+		// Keep hand-written files
+		if !ast.IsGenerated(file) {
+			kept = append(kept, file)
+		}
+	}
+	// Return hand-written files
+	return kept
+}
+
+// isCommentAnalyzer reports whether an analyzer is a KTN-COMMENT rule.
+//
+// Params:
+//   - a: analyzer
+//
+// Returns:
+//   - bool: true for comment analyzers
+func isCommentAnalyzer(a *analysis.Analyzer) bool {
+	// Comment analyzers share the ktncomment prefix
+	return strings.HasPrefix(a.Name, commentAnalyzerPrefix)
+}
+
+// generatedGroups splits analyzers by the files they see. Requirements such
+// as the inspector are computed once per group, so analyzers of a group
+// must share their file set.
+//
+// Params:
+//   - analyzers: analyzers of one run
+//
+// Returns:
+//   - [][]*analysis.Analyzer: groups to run with separate results
+func (r *AnalysisRunner) generatedGroups(analyzers []*analysis.Analyzer) [][]*analysis.Analyzer {
+	// Only this policy gives analyzers different file sets
+	if r.configuration().GeneratedPolicy() != config.GeneratedOnlyCommentRules {
+		// Return a single group
+		return [][]*analysis.Analyzer{analyzers}
+	}
+	var comment, others []*analysis.Analyzer
+	// Separate comment analyzers
+	for _, a := range analyzers {
+		// Dispatch on analyzer kind
+		switch {
+		// Comment analyzer
+		case isCommentAnalyzer(a):
+			comment = append(comment, a)
+		// Other analyzer
+		default:
+			others = append(others, a)
+		}
+	}
+	// Return both groups
+	return [][]*analysis.Analyzer{others, comment}
+}
+
+// recordGenerators maps the generated files of a package to the
+// //go:generate directive producing them, when attribution is enabled.
+//
+// Params:
+//   - pkg: analyzed package
+func (r *AnalysisRunner) recordGenerators(pkg *packages.Package) {
+	// Check attribution
+	if !r.configuration().GeneratedSource {
+		// Attribution disabled
+		return
+	}
+	directives := packageDirectives(pkg)
+	// Nothing to attribute without directives
+	if len(directives) == 0 {
+		// No generator declared
+		return
+	}
+	r.generatorsMu.Lock()
+	defer r.generatorsMu.Unlock()
+	// Attribute each generated file
+	for _, file := range pkg.Syntax {
+		// Skip hand-written files
+		if !ast.IsGenerated(file) {
+			continue
+		}
+		filename := pkg.Fset.Position(file.Pos()).Filename
+		// Keep attributable files
+		if directive, ok := generatorFor(filename, generatorTool(file), directives); ok {
+			r.generators[filename] = directive.label()
+		}
+	}
+}
+
+// generatorOf returns the generator label of a file.
+//
+// Params:
+//   - filename: analyzed file
+//
+// Returns:
+//   - string: directive label, empty for unattributed files
+func (r *AnalysisRunner) generatorOf(filename string) string {
+	r.generatorsMu.Lock()
+	defer r.generatorsMu.Unlock()
+	// Return recorded label
+	return r.generators[filename]
+}
+
+// packageDirectives collects the //go:generate lines of the hand-written
+// files of a package.
+//
+// Params:
+//   - pkg: analyzed package
+//
+// Returns:
+//   - []generateDirective: directives in source order
+func packageDirectives(pkg *packages.Package) []generateDirective {
+	directives := []generateDirective{}
+	// Scan hand-written files
+	for _, file := range pkg.Syntax {
+		// Generated files do not declare their own generator
+		if ast.IsGenerated(file) {
+			continue
+		}
+		// Scan every comment
+		for _, group := range file.Comments {
+			// Keep go generate directives
+			for _, comment := range group.List {
+				// Check the directive prefix
+				if command, found := strings.CutPrefix(comment.Text, goGeneratePrefix); found {
+					directives = append(directives, generateDirective{position: pkg.Fset.Position(comment.Pos()), command: strings.TrimSpace(command)})
+				}
+			}
+		}
+	}
+	// Return directives
+	return directives
+}
+
+// generatorTool returns the tool named by the header of a generated file
+// (`Code generated by "stringer -type=Kind"; DO NOT EDIT.` gives "stringer").
+//
+// Params:
+//   - file: generated file
+//
+// Returns:
+//   - string: tool name, empty when the header names none
+func generatorTool(file *ast.File) string {
+	// Scan comments before the package clause
+	for _, group := range file.Comments {
+		// Stop at the package clause
+		if group.Pos() > file.Package {
+			break
+		}
+		// Search the header line
+		for _, comment := range group.List {
+			match := generatedHeader.FindStringSubmatch(comment.Text)
+			// Check header and "by" clause
+			if match == nil {
+				continue
+			}
+			fields := strings.Fields(strings.TrimPrefix(match[1], "by "))
+			// Check for a named tool
+			if !strings.HasPrefix(match[1], "by ") || len(fields) == 0 {
+				// Return unnamed tool
+				return ""
+			}
+			// Return the tool base name without quotes or punctuation
+			return path.Base(strings.Trim(fields[0], "\".;,"))
+		}
+	}
+	// Return unnamed tool
+	return ""
+}
+
+// generatorFor picks the directive producing a generated file: the one
+// naming the file, else the one running the tool of its header, else the
+// only directive of the package.
+//
+// Params:
+//   - filename: generated file
+//   - tool: tool named by its header, may be empty
+//   - directives: directives of the package
+//
+// Returns:
+//   - generateDirective: producing directive
+//   - bool: false when it cannot be determined
+func generatorFor(filename, tool string, directives []generateDirective) (generateDirective, bool) {
+	base := filepath.Base(filename)
+	// Prefer directives naming the output file
+	for _, directive := range directives {
+		// Check output name
+		if strings.Contains(directive.command, base) {
+			// Return naming directive
+			return directive, true
+		}
+	}
+	// Then directives running the header tool
+	for _, directive := range directives {
+		// Check tool name
+		if tool != "" && strings.Contains(directive.command, tool) {
+			// Return tool directive
+			return directive, true
+		}
+	}
+	// Fall back to a single directive
+	if len(directives) == 1 {
+		// Return only directive
+		return directives[0], true
+	}
+	// Return undetermined
+	return generateDirective{}, false
+}
+
+// appendGeneratorLabel appends the generator attribution to the first line
+// of a message.
+//
+// Params:
+//   - message: diagnostic message
+//   - label: directive label
+//
+// Returns:
+//   - string: annotated message
+func appendGeneratorLabel(message, label string) string {
+	first, rest, multiline := strings.Cut(message, "\n")
+	first += " [generated by " + label + "]"
+	// Keep the verbose details after the first line
+	if multiline {
+		// Return annotated multi-line message
+		return first + "\n" + rest
+	}
+	// Return annotated message
+	return first
+}
@@ -0,0 +1,239 @@
+// Internal tests for the generated-code policy.
+package orchestrator
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// generatedSource is a stringer output with the official header.
+const generatedSource string = "// Code generated by \"stringer -type=Kind\"; DO NOT EDIT.\n\npackage kind\n\nfunc (k Kind) String() string { return \"\" }\n"
+
+// handwrittenSource declares the generator of generatedSource.
+const handwrittenSource string = "// Package kind.\npackage kind\n\n//go:generate stringer -type=Kind\n\n// Kind is an enum.\ntype Kind int\n"
+
+// parseGeneratedPackage parses a hand-written and a generated file.
+//
+// Params:
+//   - t: testing context
+//
+// Returns:
+//   - *packages.Package: package with both files
+func parseGeneratedPackage(t *testing.T) *packages.Package {
+	t.Helper()
+	fset := token.NewFileSet()
+	handwritten, err := parser.ParseFile(fset, "/src/kind/kind.go", handwrittenSource, parser.ParseComments)
+	// Verify parsing
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	generated, err := parser.ParseFile(fset, "/src/kind/kind_string.go", generatedSource, parser.ParseComments)
+	// Verify parsing
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	// Return package
+	return &packages.Package{PkgPath: "example.com/kind", Fset: fset, Syntax: []*ast.File{handwritten, generated}}
+}
+
+// TestAnalysisRunner_filterGeneratedFiles tests the policy per analyzer.
+func TestAnalysisRunner_filterGeneratedFiles(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   string
+		analyzer string
+		expected int
+	}{
+		{name: "report keeps generated files", policy: config.GeneratedReport, analyzer: "ktnfunc001", expected: 2},
+		{name: "skip drops generated files", policy: config.GeneratedSkip, analyzer: "ktncomment001", expected: 1},
+		{name: "comment rules keep generated files", policy: config.GeneratedOnlyCommentRules, analyzer: "ktncomment001", expected: 2},
+		{name: "other rules drop generated files", policy: config.GeneratedOnlyCommentRules, analyzer: "ktnfunc001", expected: 1},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			pkg := parseGeneratedPackage(t)
+			runner := NewAnalysisRunner(&bytes.Buffer{}, false)
+			runner.SetConfig(&config.Config{Generated: tt.policy})
+			files := runner.selectFiles(&analysis.Analyzer{Name: tt.analyzer}, pkg, pkg.Fset)
+			// Verify kept files
+			if len(files) != tt.expected {
+				t.Errorf("selectFiles() = %d files, want %d", len(files), tt.expected)
+			}
+		})
+	}
+}
+
+// TestAnalysisRunner_generatedGroups tests the split of analyzers by file set.
+func TestAnalysisRunner_generatedGroups(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   string
+		expected []int
+	}{
+		{name: "single group", policy: config.GeneratedSkip, expected: []int{3}},
+		{name: "comment group", policy: config.GeneratedOnlyCommentRules, expected: []int{2, 1}},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			runner := NewAnalysisRunner(&bytes.Buffer{}, false)
+			runner.SetConfig(&config.Config{Generated: tt.policy})
+			analyzers := []*analysis.Analyzer{{Name: "ktnfunc001"}, {Name: "ktncomment001"}, {Name: "ktnvar001"}}
+			groups := runner.generatedGroups(analyzers)
+			// Verify group count
+			if len(groups) != len(tt.expected) {
+				t.Fatalf("generatedGroups() = %d groups, want %d", len(groups), len(tt.expected))
+			}
+			// Verify group sizes
+			for index, group := range groups {
+				// Compare sizes
+				if len(group) != tt.expected[index] {
+					t.Errorf("group %d has %d analyzers, want %d", index, len(group), tt.expected[index])
+				}
+			}
+		})
+	}
+}
+
+// TestAnalysisRunner_recordGenerators tests generator attribution.
+func TestAnalysisRunner_recordGenerators(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		expected string
+	}{
+		{name: "attribution enabled", enabled: true, expected: "kind.go:4: stringer -type=Kind"},
+		{name: "attribution disabled", enabled: false, expected: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			pkg := parseGeneratedPackage(t)
+			runner := NewAnalysisRunner(&bytes.Buffer{}, false)
+			runner.SetConfig(&config.Config{GeneratedSource: tt.enabled})
+			runner.recordGenerators(pkg)
+			// Verify generated file label
+			if got := runner.generatorOf("/src/kind/kind_string.go"); got != tt.expected {
+				t.Errorf("generatorOf() = %q, want %q", got, tt.expected)
+			}
+			// Verify hand-written files are not attributed
+			if got := runner.generatorOf("/src/kind/kind.go"); got != "" {
+				t.Errorf("generatorOf(kind.go) = %q, want empty", got)
+			}
+		})
+	}
+}
+
+// Test_generatorTool tests tool extraction from generated headers.
+func Test_generatorTool(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{name: "quoted command", header: "// Code generated by \"stringer -type=Kind\"; DO NOT EDIT.", expected: "stringer"},
+		{name: "tool path", header: "// Code generated by github.com/golang/mock/mockgen. DO NOT EDIT.", expected: "mockgen"},
+		{name: "no tool", header: "// Code generated DO NOT EDIT.", expected: ""},
+		{name: "not a header", header: "// Generated code.", expected: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.ParseFile(token.NewFileSet(), "gen.go", tt.header+"\n\npackage gen\n", parser.ParseComments)
+			// Verify parsing
+			if err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			// Verify tool
+			if got := generatorTool(file); got != tt.expected {
+				t.Errorf("generatorTool() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+// Test_generatorFor tests the choice of the producing directive.
+func Test_generatorFor(t *testing.T) {
+	mockgen := generateDirective{command: "mockgen -destination=mock_store.go . Store"}
+	stringer := generateDirective{command: "stringer -type=Kind"}
+	tests := []struct {
+		name       string
+		filename   string
+		tool       string
+		directives []generateDirective
+		expected   string
+		found      bool
+	}{
+		{name: "output file named", filename: "/src/mock_store.go", directives: []generateDirective{stringer, mockgen}, expected: mockgen.command, found: true},
+		{name: "header tool", filename: "/src/kind_string.go", tool: "stringer", directives: []generateDirective{mockgen, stringer}, expected: stringer.command, found: true},
+		{name: "single directive", filename: "/src/other.go", directives: []generateDirective{stringer}, expected: stringer.command, found: true},
+		{name: "ambiguous", filename: "/src/other.go", directives: []generateDirective{stringer, mockgen}, found: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := generatorFor(tt.filename, tt.tool, tt.directives)
+			// Verify result
+			if found != tt.found || got.command != tt.expected {
+				t.Errorf("generatorFor() = %q, %v, want %q, %v", got.command, found, tt.expected, tt.found)
+			}
+		})
+	}
+}
+
+// Test_appendGeneratorLabel tests message annotation.
+func Test_appendGeneratorLabel(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  string
+		expected string
+	}{
+		{name: "single line", message: "KTN-VAR-001: msg", expected: "KTN-VAR-001: msg [generated by kind.go:4: stringer]"},
+		{name: "multi line", message: "KTN-VAR-001: msg\ndetails", expected: "KTN-VAR-001: msg [generated by kind.go:4: stringer]\ndetails"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify annotation
+			if got := appendGeneratorLabel(tt.message, "kind.go:4: stringer"); got != tt.expected {
+				t.Errorf("appendGeneratorLabel() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+// Test_isCommentAnalyzer tests comment analyzer detection.
+func Test_isCommentAnalyzer(t *testing.T) {
+	tests := []struct {
+		name     string
+		analyzer string
+		expected bool
+	}{
+		{name: "comment analyzer", analyzer: "ktncomment001", expected: true},
+		{name: "other analyzer", analyzer: "ktnfunc001", expected: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify detection
+			if got := isCommentAnalyzer(&analysis.Analyzer{Name: tt.analyzer}); got != tt.expected {
+				t.Errorf("isCommentAnalyzer(%s) = %v, want %v", tt.analyzer, got, tt.expected)
+			}
+		})
+	}
+}
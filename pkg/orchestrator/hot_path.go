@@ -0,0 +1,280 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"strings"
+
+	"github.com/kodflow/ktn-linter/pkg/cpuprofile"
+	"github.com/kodflow/ktn-linter/pkg/rulecode"
+	"github.com/kodflow/ktn-linter/pkg/severity"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	// hotAnnotation marks a function as hot in its doc comment
+	hotAnnotation string = "//ktn:hot"
+	// httpPackage is the import path of net/http
+	httpPackage string = "net/http"
+	// serveHTTP is the method of http.Handler
+	serveHTTP string = "ServeHTTP"
+	// mainPackage is the package name of commands in CPU profiles
+	mainPackage string = "main"
+	// handlerParams is the parameter count of an HTTP handler
+	handlerParams int = 2
+	// percentScale converts a share to a percentage
+	percentScale float64 = 100
+)
+
+// perfRules are the performance rules whose severity depends on hot paths.
+var perfRules map[string]bool = map[string]bool{
+	"KTN-VAR-010": true, // bytes.Buffer/strings.Builder sans Grow
+	"KTN-VAR-011": true, // Concaténations répétées
+	"KTN-VAR-012": true, // Allocations dans les boucles
+	"KTN-VAR-014": true, // Buffers répétés sans sync.Pool
+}
+
+// classifyHotPath escalates a performance finding inside a hot function and
+// demotes it elsewhere, when a hot path definition is configured.
+//
+// Params:
+//   - diag: diagnostic to classify
+//   - pkg: analyzed package
+//   - files: files of the pass
+//   - symbol: enclosing declaration
+//
+// Returns:
+//   - string: why the enclosing function is hot, empty when not escalated
+func (r *AnalysisRunner) classifyHotPath(diag *analysis.Diagnostic, pkg *packages.Package, files []*ast.File, symbol string) string {
+	// Only performance rules are classified, and only on demand
+	if !perfRules[rulecode.FromMessage(diag.Message)] || !r.configuration().HotPathsEnabled() {
+		// Keep the rule severity
+		return ""
+	}
+	reason := r.hotReason(pkg, enclosingFunc(files, diag.Pos), symbol)
+	// Demote findings outside hot paths
+	if reason == "" {
+		diag.Category = severity.CategoryColdPath
+		// Cold finding
+		return ""
+	}
+	diag.Category = severity.CategoryHotPath
+	// Return the escalation reason
+	return reason
+}
+
+// hotReason tells why a function is hot: its //ktn:hot annotation, an HTTP
+// handler signature, a hot_paths.functions pattern or its CPU share.
+//
+// Params:
+//   - pkg: analyzed package
+//   - fn: enclosing function, nil at file level
+//   - symbol: enclosing declaration
+//
+// Returns:
+//   - string: reason, empty for cold code
+func (r *AnalysisRunner) hotReason(pkg *packages.Package, fn *ast.FuncDecl, symbol string) string {
+	// File-level code runs once
+	if fn == nil {
+		// Return cold
+		return ""
+	}
+	// Check the annotation
+	if hasHotAnnotation(fn) {
+		// Return annotated
+		return hotAnnotation
+	}
+	// Check HTTP handlers
+	if isHTTPHandler(pkg.TypesInfo, fn) {
+		// Return handler
+		return "http.Handler"
+	}
+	cfg := r.configuration()
+	// Check configured patterns
+	if pattern := cfg.HotFunctionPattern(pkg.PkgPath, symbol); pattern != "" {
+		// Return matching pattern
+		return "pattern " + pattern
+	}
+	share := r.hotProfile().Share(profilePackage(pkg), symbol)
+	// Check the CPU profile
+	if share > 0 && share >= cfg.HotProfileThreshold() {
+		// Return CPU share
+		return fmt.Sprintf("%.1f%% CPU", share*percentScale)
+	}
+	// Return cold
+	return ""
+}
+
+// hotProfile returns the hot_paths.profile CPU profile.
+//
+// Returns:
+//   - *cpuprofile.Profile: profile, nil when none is usable
+func (r *AnalysisRunner) hotProfile() *cpuprofile.Profile {
+	path := r.configuration().HotPaths.Profile
+	// No profile configured
+	if path == "" {
+		// Return no profile
+		return nil
+	}
+	// Return the profile loaded once
+	return r.loadProfile(path, "hot_paths.profile")
+}
+
+// loadProfile loads a CPU profile once per path. An unreadable profile is
+// reported on stderr once and ignored, except a missing default.pgo.
+//
+// Params:
+//   - path: profile file
+//   - origin: setting naming the profile in error messages
+//
+// Returns:
+//   - *cpuprofile.Profile: profile, nil when unusable
+func (r *AnalysisRunner) loadProfile(path, origin string) *cpuprofile.Profile {
+	r.profilesMu.Lock()
+	defer r.profilesMu.Unlock()
+	// Reuse the profile of this path
+	if prof, loaded := r.profiles[path]; loaded {
+		// Return cached profile
+		return prof
+	}
+	prof, err := cpuprofile.Load(path)
+	// Report unusable profiles, a module without default.pgo is not an error
+	if err != nil && (origin != defaultPGOFile || !errors.Is(err, fs.ErrNotExist)) {
+		fmt.Fprintf(r.stderr, "%s: %v\n", origin, err)
+	}
+	r.profiles[path] = prof
+	// Return loaded profile, nil on error
+	return prof
+}
+
+// enclosingFunc returns the top-level function containing pos.
+//
+// Params:
+//   - files: files of the analyzed package
+//   - pos: position to locate
+//
+// Returns:
+//   - *ast.FuncDecl: enclosing function, nil outside functions
+func enclosingFunc(files []*ast.File, pos token.Pos) *ast.FuncDecl {
+	// Find the file containing the position
+	for _, file := range files {
+		// Skip files not containing pos
+		if pos < file.FileStart || pos > file.FileEnd {
+			continue
+		}
+		// Search function declarations
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			// Check declaration range
+			if ok && pos >= fn.Pos() && pos <= fn.End() {
+				// Return enclosing function
+				return fn
+			}
+		}
+	}
+	// Return no function
+	return nil
+}
+
+// hasHotAnnotation reports whether the doc comment of a function contains
+// a //ktn:hot line.
+//
+// Params:
+//   - fn: function declaration
+//
+// Returns:
+//   - bool: true for annotated functions
+func hasHotAnnotation(fn *ast.FuncDecl) bool {
+	// Check for a doc comment
+	if fn.Doc == nil {
+		// Return not annotated
+		return false
+	}
+	// Search the annotation line
+	for _, comment := range fn.Doc.List {
+		// Accept the annotation followed by an optional note
+		if comment.Text == hotAnnotation || strings.HasPrefix(comment.Text, hotAnnotation+" ") {
+			// Return annotated
+			return true
+		}
+	}
+	// Return not annotated
+	return false
+}
+
+// isHTTPHandler reports whether a function serves HTTP requests: a
+// ServeHTTP method implementing http.Handler, or a function with the
+// http.HandlerFunc signature.
+//
+// Params:
+//   - info: type information of the package
+//   - fn: function declaration
+//
+// Returns:
+//   - bool: true for HTTP handlers
+func isHTTPHandler(info *types.Info, fn *ast.FuncDecl) bool {
+	// Methods must be named after http.Handler
+	if info == nil || (fn.Recv != nil && fn.Name.Name != serveHTTP) {
+		// Return not a handler
+		return false
+	}
+	obj, ok := info.Defs[fn.Name].(*types.Func)
+	// Check resolved function
+	if !ok {
+		// Return not a handler
+		return false
+	}
+	sig := obj.Signature()
+	params := sig.Params()
+	// Check the handler shape
+	if params.Len() != handlerParams || sig.Results().Len() != 0 {
+		// Return not a handler
+		return false
+	}
+	request, ok := params.At(1).Type().(*types.Pointer)
+	// Return whether parameters are the writer and the request
+	return ok && isHTTPType(params.At(0).Type(), "ResponseWriter") && isHTTPType(request.Elem(), "Request")
+}
+
+// isHTTPType reports whether a type is a named type of net/http.
+//
+// Params:
+//   - typ: type to check
+//   - name: type name in net/http
+//
+// Returns:
+//   - bool: true for net/http.<name>
+func isHTTPType(typ types.Type, name string) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+	// Check named type
+	if !ok || named.Obj().Pkg() == nil {
+		// Return not a net/http type
+		return false
+	}
+	// Return whether package and name match
+	return named.Obj().Pkg().Path() == httpPackage && named.Obj().Name() == name
+}
+
+// profilePackage returns the package qualifier of a package in CPU
+// profiles, where commands are named "main".
+//
+// Params:
+//   - pkg: analyzed package
+//
+// Returns:
+//   - string: profile package qualifier
+func profilePackage(pkg *packages.Package) string {
+	// Commands are profiled as package main
+	if pkg.Name == mainPackage {
+		// Return main
+		return mainPackage
+	}
+	// Return import path
+	return pkg.PkgPath
+}
@@ -0,0 +1,267 @@
+// Internal tests for hot path classification.
+package orchestrator
+
+import (
+	"bytes"
+	"encoding/binary"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"github.com/kodflow/ktn-linter/pkg/severity"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// httpStubSource declares the net/http types used by handlers.
+const httpStubSource string = "package http\n\ntype ResponseWriter interface{ Write([]byte) (int, error) }\n\ntype Request struct{}\n"
+
+// hotSource declares hot and cold functions, each allocating once.
+const hotSource string = `package api
+
+import "net/http"
+
+// Server serves the API.
+type Server struct{}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) { _ = []int{} }
+
+// Serve is not a handler method.
+func (s *Server) Serve(w http.ResponseWriter, r *http.Request) { _ = []int{} }
+
+// List has the http.HandlerFunc signature.
+func List(w http.ResponseWriter, r *http.Request) { _ = []int{} }
+
+// Encode is annotated.
+//
+//ktn:hot called per frame
+func Encode() { _ = []int{} }
+
+// EncodeFrame matches a configured pattern.
+func EncodeFrame() { _ = []int{} }
+
+// Profiled is hot in the CPU profile.
+func Profiled() { _ = []int{} }
+
+// Rare is below the profile threshold.
+func Rare() { _ = []int{} }
+
+// Startup is cold.
+func Startup() { _ = []int{} }
+
+var table = []int{}
+`
+
+// parseHotPackage parses and type-checks hotSource.
+//
+// Params:
+//   - t: testing context
+//
+// Returns:
+//   - *packages.Package: typed package
+func parseHotPackage(t *testing.T) *packages.Package {
+	t.Helper()
+	fset := token.NewFileSet()
+	stub, err := parser.ParseFile(fset, "/src/net/http/http.go", httpStubSource, 0)
+	// Verify parsing
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpPkg, err := new(types.Config).Check(httpPackage, fset, []*ast.File{stub}, nil)
+	// Verify type-checking
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := parser.ParseFile(fset, "/src/api/api.go", hotSource, parser.ParseComments)
+	// Verify parsing
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := &types.Info{Defs: map[*ast.Ident]types.Object{}}
+	conf := types.Config{Importer: importerFunc(func(string) (*types.Package, error) { return httpPkg, nil })}
+	typed, err := conf.Check("example.com/app/api", fset, []*ast.File{file}, info)
+	// Verify type-checking
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Return package
+	return &packages.Package{Name: "api", PkgPath: "example.com/app/api", Fset: fset, Syntax: []*ast.File{file}, Types: typed, TypesInfo: info}
+}
+
+// importerFunc adapts a function to types.Importer.
+type importerFunc func(path string) (*types.Package, error)
+
+// Import returns the package of a path.
+func (f importerFunc) Import(path string) (*types.Package, error) {
+	// Return imported package
+	return f(path)
+}
+
+// writeHotProfile writes a CPU profile where each function has its own
+// sample with the given CPU time.
+//
+// Params:
+//   - t: testing context
+//   - cpu: CPU time by runtime function name
+//
+// Returns:
+//   - string: profile path
+func writeHotProfile(t *testing.T, cpu map[string]uint64) string {
+	t.Helper()
+	field := func(b []byte, num int, wire uint64) []byte {
+		// Return appended key
+		return binary.AppendUvarint(b, uint64(num)<<3|wire)
+	}
+	message := func(b []byte, num int, value []byte) []byte {
+		b = binary.AppendUvarint(field(b, num, 2), uint64(len(value)))
+		// Return appended message
+		return append(b, value...)
+	}
+	varint := func(b []byte, num int, value uint64) []byte {
+		// Return appended varint
+		return binary.AppendUvarint(field(b, num, 0), value)
+	}
+	out := message(nil, 1, varint(varint(nil, 1, 1), 2, 2))
+	strs := []string{"", "cpu", "nanoseconds"}
+	id := uint64(0)
+	// Declare one function, location and sample per entry
+	for name, value := range cpu {
+		id++
+		strs = append(strs, name)
+		out = message(out, 5, varint(varint(nil, 1, id), 2, uint64(len(strs)-1)))
+		out = message(out, 4, message(varint(nil, 1, id), 4, varint(nil, 1, id)))
+		out = message(out, 2, varint(varint(nil, 1, id), 2, value))
+	}
+	// Write the string table
+	for _, s := range strs {
+		out = message(out, 6, []byte(s))
+	}
+	path := filepath.Join(t.TempDir(), "cpu.pprof")
+	// Write the profile
+	if err := os.WriteFile(path, out, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// Return profile path
+	return path
+}
+
+// TestAnalysisRunner_classifyHotPath tests escalation and demotion.
+func TestAnalysisRunner_classifyHotPath(t *testing.T) {
+	pkg := parseHotPackage(t)
+	profile := writeHotProfile(t, map[string]uint64{
+		"example.com/app/api.Profiled": 60,
+		"example.com/app/api.Rare":     1,
+		"runtime.mallocgc":             39,
+	})
+	hot := config.HotPathConfig{Functions: []string{"api.EncodeFrame"}, Profile: profile, ProfileThreshold: 5}
+	tests := []struct {
+		name       string
+		cfg        config.HotPathConfig
+		code       string
+		anchor     string
+		category   string
+		wantReason string
+	}{
+		{name: "handler method", cfg: hot, code: "KTN-VAR-012", anchor: "ServeHTTP(", category: severity.CategoryHotPath, wantReason: "http.Handler"},
+		{name: "other method", cfg: hot, code: "KTN-VAR-012", anchor: "Serve(", category: severity.CategoryColdPath},
+		{name: "handler function", cfg: hot, code: "KTN-VAR-010", anchor: "List(", category: severity.CategoryHotPath, wantReason: "http.Handler"},
+		{name: "annotation", cfg: hot, code: "KTN-VAR-011", anchor: "Encode(", category: severity.CategoryHotPath, wantReason: "//ktn:hot"},
+		{name: "pattern", cfg: hot, code: "KTN-VAR-014", anchor: "EncodeFrame(", category: severity.CategoryHotPath, wantReason: "pattern api.EncodeFrame"},
+		{name: "profile", cfg: hot, code: "KTN-VAR-012", anchor: "Profiled(", category: severity.CategoryHotPath, wantReason: "60.0% CPU"},
+		{name: "below threshold", cfg: hot, code: "KTN-VAR-012", anchor: "Rare(", category: severity.CategoryColdPath},
+		{name: "cold function", cfg: hot, code: "KTN-VAR-012", anchor: "Startup(", category: severity.CategoryColdPath},
+		{name: "package level", cfg: hot, code: "KTN-VAR-012", anchor: "table", category: severity.CategoryColdPath},
+		{name: "other rule", cfg: hot, code: "KTN-FUNC-001", anchor: "ServeHTTP(", category: ""},
+		{name: "no definition", cfg: config.HotPathConfig{}, code: "KTN-VAR-012", anchor: "ServeHTTP(", category: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			runner := NewAnalysisRunner(&bytes.Buffer{}, false)
+			runner.SetConfig(&config.Config{HotPaths: tt.cfg})
+			file := pkg.Syntax[0]
+			pos := file.FileStart + token.Pos(strings.Index(hotSource, tt.anchor))
+			diag := analysis.Diagnostic{Pos: pos, Message: tt.code + ": finding\ndetails"}
+			reason := runner.classifyHotPath(&diag, pkg, pkg.Syntax, EnclosingSymbol(pkg.Syntax, pos))
+			// Verify category
+			if diag.Category != tt.category {
+				t.Errorf("Category = %q, want %q", diag.Category, tt.category)
+			}
+			// Verify reason
+			if reason != tt.wantReason {
+				t.Errorf("classifyHotPath() = %q, want %q", reason, tt.wantReason)
+			}
+			// Verify the message is left untouched
+			if diag.Message != tt.code+": finding\ndetails" {
+				t.Errorf("Message = %q, want it unchanged", diag.Message)
+			}
+		})
+	}
+}
+
+// TestAnalysisRunner_hotProfile tests loading and caching the profile.
+func TestAnalysisRunner_hotProfile(t *testing.T) {
+	valid := writeHotProfile(t, map[string]uint64{"main.main": 1})
+	tests := []struct {
+		name       string
+		path       string
+		wantLoaded bool
+		wantStderr bool
+	}{
+		{name: "no profile", path: "", wantLoaded: false, wantStderr: false},
+		{name: "valid profile", path: valid, wantLoaded: true, wantStderr: false},
+		{name: "missing profile", path: filepath.Join(t.TempDir(), "missing.pprof"), wantLoaded: false, wantStderr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var stderr bytes.Buffer
+			runner := NewAnalysisRunner(&stderr, false)
+			runner.SetConfig(&config.Config{HotPaths: config.HotPathConfig{Profile: tt.path}})
+			first := runner.hotProfile()
+			// Verify cached result
+			if second := runner.hotProfile(); first != second {
+				t.Error("hotProfile() not cached")
+			}
+			// Verify loading
+			if (first != nil) != tt.wantLoaded {
+				t.Errorf("hotProfile() = %v, wantLoaded %v", first, tt.wantLoaded)
+			}
+			// Verify single report
+			if (stderr.Len() > 0) != tt.wantStderr || strings.Count(stderr.String(), "\n") > 1 {
+				t.Errorf("stderr = %q, wantStderr %v", stderr.String(), tt.wantStderr)
+			}
+		})
+	}
+}
+
+// Test_profilePackage tests profile qualifiers of commands.
+func Test_profilePackage(t *testing.T) {
+	tests := []struct {
+		name     string
+		pkg      *packages.Package
+		expected string
+	}{
+		{name: "library", pkg: &packages.Package{Name: "api", PkgPath: "example.com/app/api"}, expected: "example.com/app/api"},
+		{name: "command", pkg: &packages.Package{Name: "main", PkgPath: "example.com/app/cmd/server"}, expected: "main"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify qualifier
+			if got := profilePackage(tt.pkg); got != tt.expected {
+				t.Errorf("profilePackage() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
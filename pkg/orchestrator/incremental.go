@@ -0,0 +1,438 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	// testVariantSuffix marks the external test package of a package.
+	testVariantSuffix string = "_test"
+	// testMainSuffix marks the generated test main package.
+	testMainSuffix string = ".test"
+)
+
+// IncrementalSession keeps analysis results between runs so that only the
+// packages touched by a change, plus their reverse dependencies, are reloaded.
+type IncrementalSession struct {
+	orch      *Orchestrator
+	dir       string
+	patterns  []string
+	opts      Options
+	analyzers []*analysis.Analyzer
+	pkgs      map[string]*packages.Package  // by package ID
+	results   map[string][]DiagnosticResult // by base package path
+}
+
+// NewIncrementalSession creates a session for packages of a module.
+//
+// Params:
+//   - dir: module directory (empty for current)
+//   - patterns: package patterns to analyze
+//   - opts: analyzer selection options
+//
+// Returns:
+//   - *IncrementalSession: session ready for a first Full run
+func (o *Orchestrator) NewIncrementalSession(dir string, patterns []string, opts Options) *IncrementalSession {
+	// Return empty session
+	return &IncrementalSession{
+		orch:     o,
+		dir:      dir,
+		patterns: patterns,
+		opts:     opts,
+		pkgs:     map[string]*packages.Package{},
+		results:  map[string][]DiagnosticResult{},
+	}
+}
+
+// Full reselects analyzers and re-analyzes every package of the session.
+//
+// Returns:
+//   - error: selection or loading error if any
+func (s *IncrementalSession) Full() error {
+	analyzers, err := s.orch.SelectAnalyzers(s.opts)
+	// Check for error
+	if err != nil {
+		// Return selection error
+		return err
+	}
+
+	pkgs, err := s.orch.LoadPackagesFromDir(s.dir, s.patterns)
+	// Check for error
+	if err != nil {
+		// Keep previous state on load error
+		return err
+	}
+
+	s.analyzers = analyzers
+	s.pkgs = make(map[string]*packages.Package, len(pkgs))
+	s.results = make(map[string][]DiagnosticResult, len(pkgs))
+	s.analyze(pkgs)
+	// Full run succeeded
+	return nil
+}
+
+// Update re-analyzes the packages affected by changed files.
+//
+// Params:
+//   - changed: absolute paths of added, modified or removed files
+//
+// Returns:
+//   - int: number of packages re-analyzed
+//   - error: loading error if any
+func (s *IncrementalSession) Update(changed []string) (int, error) {
+	affected := s.reverseDependents(s.affectedPackages(changed))
+	patterns := s.reloadPatterns(affected)
+	patterns = append(patterns, s.newPackageDirs(changed)...)
+
+	var pkgs []*packages.Package
+	// Load affected packages when some still exist
+	if len(patterns) > 0 {
+		loaded, err := s.orch.LoadPackagesFromDir(s.dir, patterns)
+		// Check for error
+		if err != nil {
+			// Keep previous state on load error
+			return 0, err
+		}
+		pkgs = loaded
+	}
+
+	// Forget previous results of reloaded or removed packages
+	for id, pkg := range s.pkgs {
+		// Check if package belongs to the affected set
+		if affected[basePkgPath(pkg)] {
+			delete(s.pkgs, id)
+			delete(s.results, basePkgPath(pkg))
+		}
+	}
+	s.analyze(pkgs)
+
+	// Return reloaded package count
+	return len(pkgs), nil
+}
+
+// PackageCount returns the number of packages tracked by the session.
+//
+// Returns:
+//   - int: number of loaded packages, test variants included
+func (s *IncrementalSession) PackageCount() int {
+	// Return tracked package count
+	return len(s.pkgs)
+}
+
+// Owns reports whether a file belongs to the session module.
+//
+// Params:
+//   - path: absolute file path
+//
+// Returns:
+//   - bool: true if the file is under the session directory
+func (s *IncrementalSession) Owns(path string) bool {
+	root := absPath(s.dir)
+	// Check path prefix
+	return path == root || strings.HasPrefix(path, root+string(filepath.Separator))
+}
+
+// Diagnostics returns the current findings of the session, sorted by position.
+//
+// Returns:
+//   - []DiagnosticResult: filtered and deduplicated diagnostics
+func (s *IncrementalSession) Diagnostics() []DiagnosticResult {
+	var all []DiagnosticResult
+	// Collect results of every package
+	for _, diags := range s.results {
+		all = append(all, diags...)
+	}
+	result := s.orch.processor.Normalize(s.orch.FilterDiagnostics(all))
+	sortResults(result)
+	// Return sorted findings
+	return result
+}
+
+// analyze runs the session analyzers and stores results per package.
+//
+// Params:
+//   - pkgs: packages to analyze
+func (s *IncrementalSession) analyze(pkgs []*packages.Package) {
+	// Register packages and reset their results
+	for _, pkg := range pkgs {
+		s.pkgs[pkg.ID] = pkg
+		s.results[basePkgPath(pkg)] = nil
+	}
+
+	diags := s.orch.RunAnalyzers(pkgs, s.analyzers)
+	// Attribute each finding to its analyzed package, whatever its file:
+	// load errors of packages without files point to the package path
+	for i := range diags {
+		base := baseImportPath(diags[i].Package)
+		s.results[base] = append(s.results[base], diags[i])
+	}
+}
+
+// affectedPackages returns the base paths of packages containing changed files.
+// A Go file unknown to every package, such as a file just added, affects the
+// packages of its directory.
+//
+// Params:
+//   - changed: absolute file paths
+//
+// Returns:
+//   - map[string]bool: affected base package paths
+func (s *IncrementalSession) affectedPackages(changed []string) map[string]bool {
+	owners := make(map[string]string, len(s.pkgs))
+	dirs := make(map[string][]string, len(s.pkgs))
+	// Index files and directories of known packages
+	for _, pkg := range s.pkgs {
+		base := basePkgPath(pkg)
+		// Record owner and directory of each source file
+		for _, file := range pkg.GoFiles {
+			owners[file] = base
+			dirs[filepath.Dir(file)] = append(dirs[filepath.Dir(file)], base)
+		}
+	}
+
+	affected := make(map[string]bool, len(changed))
+	// Find packages owning each changed file
+	for _, path := range changed {
+		// Known file: its package is affected
+		if base, ok := owners[path]; ok {
+			affected[base] = true
+			continue
+		}
+		// Skip unknown non-Go files
+		if !strings.HasSuffix(path, goFileExt) {
+			continue
+		}
+		// New file: packages of its directory are affected
+		for _, base := range dirs[filepath.Dir(path)] {
+			affected[base] = true
+		}
+	}
+	// Return affected packages
+	return affected
+}
+
+// reverseDependents extends a package set with every package importing it.
+//
+// Params:
+//   - seeds: base package paths to start from
+//
+// Returns:
+//   - map[string]bool: seeds and their transitive reverse dependencies
+func (s *IncrementalSession) reverseDependents(seeds map[string]bool) map[string]bool {
+	importers := make(map[string][]string, len(s.pkgs))
+	// Build the reverse import graph of known packages
+	for _, pkg := range s.pkgs {
+		base := basePkgPath(pkg)
+		// Record each import edge
+		for importPath := range pkg.Imports {
+			importers[importPath] = append(importers[importPath], base)
+		}
+	}
+
+	// Start from seed packages
+	queue := slices.Collect(maps.Keys(seeds))
+	// Walk the reverse graph breadth first
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		// Visit each importer once
+		for _, importer := range importers[current] {
+			// Skip already visited packages
+			if seeds[importer] {
+				continue
+			}
+			seeds[importer] = true
+			queue = append(queue, importer)
+		}
+	}
+
+	// Return extended set
+	return seeds
+}
+
+// reloadPatterns returns load patterns for affected packages still on disk.
+//
+// Params:
+//   - affected: base package paths to reload
+//
+// Returns:
+//   - []string: package paths whose directory still exists
+func (s *IncrementalSession) reloadPatterns(affected map[string]bool) []string {
+	patterns := make([]string, 0, len(affected))
+	seen := make(map[string]bool, len(affected))
+	// Keep packages whose directory still exists
+	for _, pkg := range s.pkgs {
+		base := basePkgPath(pkg)
+		// Skip unaffected, already added or removed packages
+		if !affected[base] || seen[base] || len(pkg.GoFiles) == 0 || !dirExists(filepath.Dir(pkg.GoFiles[0])) {
+			continue
+		}
+		seen[base] = true
+		patterns = append(patterns, base)
+	}
+	sort.Strings(patterns)
+	// Return reload patterns
+	return patterns
+}
+
+// newPackageDirs returns directories of changed files not owned by any known package.
+//
+// Params:
+//   - changed: absolute file paths
+//
+// Returns:
+//   - []string: directory patterns to load
+func (s *IncrementalSession) newPackageDirs(changed []string) []string {
+	known := make(map[string]bool, len(s.pkgs))
+	// Index directories of known packages
+	for _, pkg := range s.pkgs {
+		// Record directory of each source file
+		for _, file := range pkg.GoFiles {
+			known[filepath.Dir(file)] = true
+		}
+	}
+
+	seen := make(map[string]bool, len(changed))
+	dirs := make([]string, 0, len(changed))
+	// Collect unknown directories of existing Go files
+	for _, path := range changed {
+		dir := filepath.Dir(path)
+		// Skip non-Go, removed or already known files
+		if !strings.HasSuffix(path, goFileExt) || known[dir] || seen[dir] || !dirExists(dir) {
+			continue
+		}
+		seen[dir] = true
+		dirs = append(dirs, dir)
+	}
+	// Return new package directories
+	return dirs
+}
+
+// DiffFindings compares two sets of findings.
+// Findings are matched by file and message summary so that line shifts caused by
+// unrelated edits are not reported as changes.
+//
+// Params:
+//   - before: previous findings
+//   - after: current findings
+//
+// Returns:
+//   - []DiagnosticResult: findings only present in after
+//   - []DiagnosticResult: findings only present in before
+func DiffFindings(before, after []DiagnosticResult) ([]DiagnosticResult, []DiagnosticResult) {
+	remaining := make(map[string]int, len(before))
+	// Count previous findings
+	for i := range before {
+		remaining[findingKey(&before[i])]++
+	}
+
+	var added []DiagnosticResult
+	// Match current findings against previous ones
+	for i := range after {
+		key := findingKey(&after[i])
+		// Check if a previous finding is still available
+		if remaining[key] > 0 {
+			remaining[key]--
+			continue
+		}
+		added = append(added, after[i])
+	}
+
+	var resolved []DiagnosticResult
+	// Previous findings left unmatched are resolved
+	for i := range before {
+		key := findingKey(&before[i])
+		// Check if the finding was consumed
+		if remaining[key] > 0 {
+			remaining[key]--
+			resolved = append(resolved, before[i])
+		}
+	}
+
+	// Return both sides of the diff
+	return added, resolved
+}
+
+// findingKey builds the matching key of a finding.
+// Only the summary line of the message is used, so that changes in the
+// explanatory text do not turn a finding into a new one.
+//
+// Params:
+//   - d: diagnostic result
+//
+// Returns:
+//   - string: file and message key
+func findingKey(d *DiagnosticResult) string {
+	summary, _, _ := strings.Cut(d.Diag.Message, "\n")
+	// Combine file and summary
+	return d.Position().Filename + "\x00" + summary
+}
+
+// sortResults sorts diagnostics by file, line and column.
+//
+// Params:
+//   - results: diagnostics to sort in place
+func sortResults(results []DiagnosticResult) {
+	sort.SliceStable(results, func(i, j int) bool {
+		pi, pj := results[i].Position(), results[j].Position()
+		// Compare file names first
+		if pi.Filename != pj.Filename {
+			// Order by file
+			return pi.Filename < pj.Filename
+		}
+		// Compare lines
+		if pi.Line != pj.Line {
+			// Order by line
+			return pi.Line < pj.Line
+		}
+		// Order by column
+		return pi.Column < pj.Column
+	})
+}
+
+// basePkgPath returns the package path without test variant suffixes.
+//
+// Params:
+//   - pkg: loaded package
+//
+// Returns:
+//   - string: import path of the package under test
+func basePkgPath(pkg *packages.Package) string {
+	// Return base path of the package
+	return baseImportPath(pkg.PkgPath)
+}
+
+// baseImportPath returns an import path without test variant suffixes.
+//
+// Params:
+//   - pkgPath: import path of a package or of its test variants
+//
+// Returns:
+//   - string: import path of the package under test
+func baseImportPath(pkgPath string) string {
+	path := strings.TrimSuffix(pkgPath, testMainSuffix)
+	// Return path without external test suffix
+	return strings.TrimSuffix(path, testVariantSuffix)
+}
+
+// dirExists checks if a directory exists.
+//
+// Params:
+//   - path: directory path
+//
+// Returns:
+//   - bool: true if path is an existing directory
+func dirExists(path string) bool {
+	info, err := os.Stat(path)
+	// Return true for existing directories
+	return err == nil && info.IsDir()
+}
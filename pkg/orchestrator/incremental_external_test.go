@@ -0,0 +1,285 @@
+// External tests for incremental analysis.
+package orchestrator_test
+
+import (
+	"bytes"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+	"golang.org/x/tools/go/analysis"
+)
+
+// TestDiffFindings tests the DiffFindings function.
+func TestDiffFindings(t *testing.T) {
+	fset := token.NewFileSet()
+	file := fset.AddFile("/src/a.go", -1, 100)
+	result := func(offset int, message string) orchestrator.DiagnosticResult {
+		return orchestrator.DiagnosticResult{
+			Diag: analysis.Diagnostic{Pos: file.Pos(offset), Message: message},
+			Fset: fset,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		before       []orchestrator.DiagnosticResult
+		after        []orchestrator.DiagnosticResult
+		wantAdded    int
+		wantResolved int
+	}{
+		{name: "no findings", wantAdded: 0, wantResolved: 0},
+		{
+			name:      "new finding",
+			after:     []orchestrator.DiagnosticResult{result(1, "KTN-A: x")},
+			wantAdded: 1,
+		},
+		{
+			name:         "resolved finding",
+			before:       []orchestrator.DiagnosticResult{result(1, "KTN-A: x")},
+			wantResolved: 1,
+		},
+		{
+			name:   "moved finding is unchanged",
+			before: []orchestrator.DiagnosticResult{result(1, "KTN-A: x")},
+			after:  []orchestrator.DiagnosticResult{result(50, "KTN-A: x")},
+		},
+		{
+			name:      "duplicate messages are counted",
+			before:    []orchestrator.DiagnosticResult{result(1, "KTN-A: x")},
+			after:     []orchestrator.DiagnosticResult{result(1, "KTN-A: x"), result(9, "KTN-A: x")},
+			wantAdded: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			added, resolved := orchestrator.DiffFindings(tt.before, tt.after)
+			// Verify added findings
+			if len(added) != tt.wantAdded {
+				t.Errorf("added = %d, want %d", len(added), tt.wantAdded)
+			}
+			// Verify resolved findings
+			if len(resolved) != tt.wantResolved {
+				t.Errorf("resolved = %d, want %d", len(resolved), tt.wantResolved)
+			}
+		})
+	}
+}
+
+// TestIncrementalSession_Update tests incremental re-analysis of a module.
+func TestIncrementalSession_Update(t *testing.T) {
+	tests := []struct {
+		name         string
+		file         string
+		wantPackages int
+	}{
+		{name: "dependency change reloads importers", file: "a/a.go", wantPackages: 2},
+		{name: "leaf change reloads only itself", file: "b/b.go", wantPackages: 1},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writeWatchModule(t)
+			orch := orchestrator.NewOrchestrator(&bytes.Buffer{}, false)
+			session := orch.NewIncrementalSession(dir, []string{"./..."}, orchestrator.Options{OnlyRule: "KTN-VAR-001"})
+
+			// Run initial analysis
+			if err := session.Full(); err != nil {
+				t.Fatalf("Full() error: %v", err)
+			}
+			// Verify initial state is clean
+			if got := len(session.Diagnostics()); got != 0 {
+				t.Fatalf("initial findings = %d, want 0", got)
+			}
+
+			path := filepath.Join(dir, tt.file)
+			content, err := os.ReadFile(path)
+			// Check read error
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Introduce an untyped package variable
+			if err := os.WriteFile(path, append(content, []byte("\nvar untyped = 1\n")...), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			count, err := session.Update([]string{path})
+			// Verify update succeeded
+			if err != nil {
+				t.Fatalf("Update() error: %v", err)
+			}
+			// Verify reloaded package count
+			if count != tt.wantPackages {
+				t.Errorf("Update() reloaded %d packages, want %d", count, tt.wantPackages)
+			}
+			// Verify new finding is reported
+			if got := len(session.Diagnostics()); got != 1 {
+				t.Errorf("findings after update = %d, want 1", got)
+			}
+			// Verify ownership
+			if !session.Owns(path) {
+				t.Errorf("Owns(%q) = false, want true", path)
+			}
+		})
+	}
+}
+
+// TestIncrementalSession_UpdateNewFile tests a file added to a known package.
+func TestIncrementalSession_UpdateNewFile(t *testing.T) {
+	tests := []struct {
+		name         string
+		file         string
+		wantPackages int
+	}{
+		{name: "new file in imported package", file: "a/b.go", wantPackages: 2},
+		{name: "new file in leaf package", file: "b/c.go", wantPackages: 1},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writeWatchModule(t)
+			orch := orchestrator.NewOrchestrator(&bytes.Buffer{}, false)
+			session := orch.NewIncrementalSession(dir, []string{"./..."}, orchestrator.Options{OnlyRule: "KTN-VAR-001"})
+
+			// Run initial analysis
+			if err := session.Full(); err != nil {
+				t.Fatalf("Full() error: %v", err)
+			}
+
+			path := filepath.Join(dir, tt.file)
+			pkgName := filepath.Base(filepath.Dir(path))
+			// Add a file with an untyped package variable
+			if err := os.WriteFile(path, []byte("package "+pkgName+"\n\nvar untyped = 1\n"), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			count, err := session.Update([]string{path})
+			// Verify update succeeded
+			if err != nil {
+				t.Fatalf("Update() error: %v", err)
+			}
+			// Verify reloaded package count
+			if count != tt.wantPackages {
+				t.Errorf("Update() reloaded %d packages, want %d", count, tt.wantPackages)
+			}
+			diags := session.Diagnostics()
+			// Verify the new file finding is reported
+			if len(diags) != 1 || diags[0].Position().Filename != path {
+				t.Errorf("findings after update = %v, want one in %s", diags, path)
+			}
+		})
+	}
+}
+
+// TestIncrementalSession_UpdateFixedPackage tests that load findings of a
+// broken package are cleared once it is fixed.
+func TestIncrementalSession_UpdateFixedPackage(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		file     string
+		broken   string
+		fixed    string
+	}{
+		{
+			name:     "type error",
+			patterns: []string{"./..."},
+			file:     "b/b.go",
+			broken:   "package b\n\n// Use returns a string.\nfunc Use() int { return \"x\" }\n",
+			fixed:    "package b\n\n// Use returns one.\nfunc Use() int { return 1 }\n",
+		},
+		{
+			name:     "mixed package clauses",
+			patterns: []string{"./..."},
+			file:     "b/c.go",
+			broken:   "package c\n",
+			fixed:    "package b\n",
+		},
+		{
+			name:     "every file excluded by build constraints",
+			patterns: []string{"./a", "./d"},
+			file:     "d/d.go",
+			broken:   "//go:build ignore\n\npackage d\n",
+			fixed:    "package d\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writeWatchModule(t)
+			path := filepath.Join(dir, tt.file)
+			// Create package directory
+			if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+				t.Fatal(err)
+			}
+			// Break the package
+			if err := os.WriteFile(path, []byte(tt.broken), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			orch := orchestrator.NewOrchestrator(&bytes.Buffer{}, false)
+			session := orch.NewIncrementalSession(dir, tt.patterns, orchestrator.Options{OnlyRule: "KTN-VAR-001"})
+
+			// Run initial analysis
+			if err := session.Full(); err != nil {
+				t.Fatalf("Full() error: %v", err)
+			}
+			// Verify the broken package is reported
+			if got := len(session.Diagnostics()); got == 0 {
+				t.Fatal("initial findings = 0, want load findings")
+			}
+
+			// Fix the package
+			if err := os.WriteFile(path, []byte(tt.fixed), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			// Verify update succeeded
+			if _, err := session.Update([]string{path}); err != nil {
+				t.Fatalf("Update() error: %v", err)
+			}
+			// Verify load findings are cleared
+			if diags := session.Diagnostics(); len(diags) != 0 {
+				t.Errorf("findings after fix = %v, want none", diags)
+			}
+		})
+	}
+}
+
+// writeWatchModule creates a module where package b imports package a.
+//
+// Params:
+//   - t: test context
+//
+// Returns:
+//   - string: module directory
+func writeWatchModule(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod": "module example.com/wm\n\ngo 1.25\n",
+		"a/a.go": "package a\n\n// Value returns one.\nfunc Value() int { return 1 }\n",
+		"b/b.go": "package b\n\nimport \"example.com/wm/a\"\n\n// Use calls a.\nfunc Use() int { return a.Value() }\n",
+	}
+	// Write each fixture file
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		// Create parent directory
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		// Write file content
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Ensure later writes get a distinct modification time
+	time.Sleep(time.Millisecond)
+	return dir
+}
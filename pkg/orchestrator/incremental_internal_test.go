@@ -0,0 +1,120 @@
+// Internal tests for incremental analysis.
+package orchestrator
+
+import (
+	"go/token"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// Test_basePkgPath tests the basePkgPath function.
+func Test_basePkgPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		pkgPath string
+		want    string
+	}{
+		{name: "regular package", pkgPath: "example.com/a", want: "example.com/a"},
+		{name: "external test package", pkgPath: "example.com/a_test", want: "example.com/a"},
+		{name: "test main package", pkgPath: "example.com/a.test", want: "example.com/a"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify base path
+			if got := basePkgPath(&packages.Package{PkgPath: tt.pkgPath}); got != tt.want {
+				t.Errorf("basePkgPath(%q) = %q, want %q", tt.pkgPath, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_baseImportPath tests the baseImportPath function.
+func Test_baseImportPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		pkgPath string
+		want    string
+	}{
+		{name: "regular package", pkgPath: "example.com/a", want: "example.com/a"},
+		{name: "external test package", pkgPath: "example.com/a_test", want: "example.com/a"},
+		{name: "test main package", pkgPath: "example.com/a.test", want: "example.com/a"},
+		{name: "no package", pkgPath: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify base path
+			if got := baseImportPath(tt.pkgPath); got != tt.want {
+				t.Errorf("baseImportPath(%q) = %q, want %q", tt.pkgPath, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_findingKey tests the findingKey function.
+func Test_findingKey(t *testing.T) {
+	fset := token.NewFileSet()
+	file := fset.AddFile("/src/a.go", -1, 100)
+	tests := []struct {
+		name  string
+		left  string
+		right string
+		same  bool
+	}{
+		{name: "same summary different details", left: "KTN-A: x\ndetail one", right: "KTN-A: x\ndetail two", same: true},
+		{name: "different summary", left: "KTN-A: x", right: "KTN-A: y", same: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			left := &DiagnosticResult{Diag: analysis.Diagnostic{Pos: file.Pos(1), Message: tt.left}, Fset: fset}
+			right := &DiagnosticResult{Diag: analysis.Diagnostic{Pos: file.Pos(2), Message: tt.right}, Fset: fset}
+			// Verify key equality
+			if got := findingKey(left) == findingKey(right); got != tt.same {
+				t.Errorf("keys equal = %v, want %v", got, tt.same)
+			}
+		})
+	}
+}
+
+// Test_sortResults tests the sortResults function.
+func Test_sortResults(t *testing.T) {
+	fset := token.NewFileSet()
+	fileA := fset.AddFile("/src/a.go", -1, 100)
+	fileB := fset.AddFile("/src/b.go", -1, 100)
+	tests := []struct {
+		name    string
+		results []DiagnosticResult
+		want    []string
+	}{
+		{
+			name: "orders by file",
+			results: []DiagnosticResult{
+				{Diag: analysis.Diagnostic{Pos: fileB.Pos(1), Message: "b"}, Fset: fset},
+				{Diag: analysis.Diagnostic{Pos: fileA.Pos(5), Message: "a2"}, Fset: fset},
+				{Diag: analysis.Diagnostic{Pos: fileA.Pos(1), Message: "a1"}, Fset: fset},
+			},
+			want: []string{"a1", "a2", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			sortResults(tt.results)
+			// Verify order
+			for i, message := range tt.want {
+				// Check each message
+				if tt.results[i].Diag.Message != message {
+					t.Errorf("results[%d] = %q, want %q", i, tt.results[i].Diag.Message, message)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"runtime/debug"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/kodflow/ktn-linter/pkg/messages"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	// internalAnalyzerName is the analyzer name attached to internal findings.
+	internalAnalyzerName string = "ktninternal"
+	// ruleCodeInternalPanic reports analyzers that panicked.
+	ruleCodeInternalPanic string = "KTN-INTERNAL-001"
+	// ruleCodeInternalTimeout reports analyzers that exceeded their deadline.
+	ruleCodeInternalTimeout string = "KTN-INTERNAL-002"
+)
+
+var (
+	// errAnalyzerTimeout is returned when an analyzer exceeds its deadline.
+	errAnalyzerTimeout error = errors.New("analyzer deadline exceeded")
+	// errRequirementFailed is returned for analyzers whose requirement already failed.
+	errRequirementFailed error = errors.New("required analyzer failed")
+)
+
+// invoke runs an analyzer pass, turning panics into *analyzerPanic errors.
+// With a deadline or a cancellable context, the pass runs in its own
+// goroutine and is abandoned when it takes too long: its late reports are
+// dropped, so it cannot write to the diagnostics channel afterwards.
+//
+// Params:
+//   - ctx: run context, abandoning the pass when cancelled
+//   - pass: pass to run, with a ResultOf map owned by this pass
+//   - deadline: maximum run time (0 = unbounded)
+//
+// Returns:
+//   - any: analyzer result
+//   - error: analyzer error, *analyzerPanic, errAnalyzerTimeout or ctx error
+func invoke(ctx context.Context, pass *analysis.Pass, deadline time.Duration) (any, error) {
+	// Run inline when nothing can interrupt the pass
+	if deadline <= 0 && ctx.Done() == nil {
+		res := protectedRun(pass)
+		// Return analyzer outcome
+		return res.result, res.err
+	}
+
+	var abandoned atomic.Bool
+	report := pass.Report
+	pass.Report = func(diag analysis.Diagnostic) {
+		// Drop reports of abandoned passes
+		if !abandoned.Load() {
+			report(diag)
+		}
+	}
+
+	done := make(chan outcome, 1)
+	go func() {
+		done <- protectedRun(pass)
+	}()
+
+	var timeout <-chan time.Time
+	// Arm the deadline when configured
+	if deadline > 0 {
+		timer := time.NewTimer(deadline)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	// Wait for the first event
+	select {
+	// Analyzer finished
+	case res := <-done:
+		// Return analyzer outcome
+		return res.result, res.err
+	// Deadline exceeded
+	case <-timeout:
+		abandoned.Store(true)
+		// Return timeout
+		return nil, errAnalyzerTimeout
+	// Run cancelled
+	case <-ctx.Done():
+		abandoned.Store(true)
+		// Return cancellation
+		return nil, ctx.Err()
+	}
+}
+
+// isFailure reports whether a value is a panic or timeout failure.
+//
+// Params:
+//   - value: error or analyzer result
+//
+// Returns:
+//   - bool: true for *analyzerPanic and errAnalyzerTimeout
+func isFailure(value any) bool {
+	err, ok := value.(error)
+	// Check for failure errors
+	if !ok {
+		// Return false for results
+		return false
+	}
+	var panicked *analyzerPanic
+	// Return failure kind
+	return errors.As(err, &panicked) || errors.Is(err, errAnalyzerTimeout)
+}
+
+// protectedRun runs an analyzer pass and recovers its panics.
+//
+// Params:
+//   - pass: pass to run
+//
+// Returns:
+//   - outcome: analyzer result, or the recovered panic
+func protectedRun(pass *analysis.Pass) (res outcome) {
+	defer func() {
+		// Convert panics into errors
+		if value := recover(); value != nil {
+			res = outcome{err: &analyzerPanic{value: value, stack: debug.Stack()}}
+		}
+	}()
+	result, err := pass.Analyzer.Run(pass)
+	// Return analyzer outcome
+	return outcome{result: result, err: err}
+}
+
+// internalResult converts an analyzer failure into a KTN-INTERNAL finding,
+// reported on the first file of the package.
+//
+// Params:
+//   - pkg: analyzed package
+//   - a: failing analyzer
+//   - err: *analyzerPanic or errAnalyzerTimeout
+//   - deadline: configured deadline, for timeout messages
+//   - verbose: use verbose messages
+//
+// Returns:
+//   - DiagnosticResult: internal finding
+//   - bool: false when err is not an internal failure
+func internalResult(pkg *packages.Package, a *analysis.Analyzer, err error, deadline time.Duration, verbose bool) (DiagnosticResult, bool) {
+	var text string
+	var panicked *analyzerPanic
+	// Dispatch on the failure kind
+	switch {
+	// Panic: message followed by the stack trace
+	case errors.As(err, &panicked):
+		msg, _ := messages.Get(ruleCodeInternalPanic)
+		text = ruleCodeInternalPanic + ": " + msg.Format(verbose, a.Name, pkg.PkgPath, panicked.value) +
+			"\n" + strings.TrimRight(string(panicked.stack), "\n")
+	// Deadline exceeded
+	case errors.Is(err, errAnalyzerTimeout):
+		msg, _ := messages.Get(ruleCodeInternalTimeout)
+		text = ruleCodeInternalTimeout + ": " + msg.Format(verbose, a.Name, deadline, pkg.PkgPath)
+	// Regular analyzer errors are not findings
+	default:
+		// Return no finding
+		return DiagnosticResult{}, false
+	}
+
+	fset := pkg.Fset
+	// Return internal finding
+	return DiagnosticResult{
+		Diag:         analysis.Diagnostic{Pos: loadErrorPos(fset, pkg, ""), Message: text},
+		Fset:         fset,
+		AnalyzerName: internalAnalyzerName,
+		Package:      pkg.PkgPath,
+	}, true
+}
@@ -0,0 +1,230 @@
+// Internal tests for analyzer panic and timeout isolation.
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/token"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// newTestPass returns a pass running fn and counting its reports.
+//
+// Params:
+//   - fn: analyzer run function
+//   - reports: incremented on each report
+//
+// Returns:
+//   - *analysis.Pass: pass to invoke
+func newTestPass(fn func(*analysis.Pass) (any, error), reports *int) *analysis.Pass {
+	// Return pass with counting reporter
+	return &analysis.Pass{
+		Analyzer: &analysis.Analyzer{Name: "test", Run: fn},
+		Report:   func(analysis.Diagnostic) { *reports++ },
+	}
+}
+
+// Test_invoke tests analyzer invocation with panics and deadlines.
+func Test_invoke(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	block := func(*analysis.Pass) (any, error) {
+		<-release
+		return nil, nil
+	}
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		deadline time.Duration
+		run      func(*analysis.Pass) (any, error)
+		want     any
+		check    func(error) bool
+	}{
+		{
+			name: "inline result",
+			ctx:  context.Background(),
+			run:  func(*analysis.Pass) (any, error) { return "ok", nil },
+			want: "ok",
+			check: func(err error) bool {
+				return err == nil
+			},
+		},
+		{
+			name: "inline panic",
+			ctx:  context.Background(),
+			run:  func(*analysis.Pass) (any, error) { panic("boom") },
+			check: func(err error) bool {
+				var p *analyzerPanic
+				return errors.As(err, &p) && p.value == "boom"
+			},
+		},
+		{
+			name:     "panic under deadline",
+			ctx:      context.Background(),
+			deadline: time.Minute,
+			run:      func(*analysis.Pass) (any, error) { panic("boom") },
+			check: func(err error) bool {
+				var p *analyzerPanic
+				return errors.As(err, &p)
+			},
+		},
+		{
+			name:     "result under deadline",
+			ctx:      context.Background(),
+			deadline: time.Minute,
+			run:      func(*analysis.Pass) (any, error) { return "ok", nil },
+			want:     "ok",
+			check: func(err error) bool {
+				return err == nil
+			},
+		},
+		{
+			name:     "deadline exceeded",
+			ctx:      context.Background(),
+			deadline: time.Millisecond,
+			run:      block,
+			check: func(err error) bool {
+				return errors.Is(err, errAnalyzerTimeout)
+			},
+		},
+		{
+			name: "context cancelled",
+			ctx:  cancelled,
+			run:  block,
+			check: func(err error) bool {
+				return errors.Is(err, context.Canceled)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var reports int
+			got, err := invoke(tt.ctx, newTestPass(tt.run, &reports), tt.deadline)
+			// Verify error
+			if !tt.check(err) {
+				t.Errorf("invoke() error = %v", err)
+			}
+			// Verify result
+			if got != tt.want {
+				t.Errorf("invoke() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_invoke_dropsLateReports tests that abandoned passes cannot report.
+func Test_invoke_dropsLateReports(t *testing.T) {
+	release := make(chan struct{})
+	finished := make(chan struct{})
+	var reports int
+	pass := newTestPass(func(pass *analysis.Pass) (any, error) {
+		<-release
+		pass.Report(analysis.Diagnostic{Message: "late"})
+		close(finished)
+		return nil, nil
+	}, &reports)
+
+	_, err := invoke(context.Background(), pass, time.Millisecond)
+	close(release)
+	<-finished
+
+	// Verify timeout
+	if !errors.Is(err, errAnalyzerTimeout) {
+		t.Fatalf("expected timeout, got %v", err)
+	}
+	// Verify late report was dropped
+	if reports != 0 {
+		t.Errorf("reports = %d, want 0", reports)
+	}
+}
+
+// Test_isFailure tests failure detection.
+func Test_isFailure(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  bool
+	}{
+		{name: "result", value: "result", want: false},
+		{name: "nil", value: nil, want: false},
+		{name: "regular error", value: errors.New("bad"), want: false},
+		{name: "panic", value: &analyzerPanic{value: "boom"}, want: true},
+		{name: "timeout", value: errAnalyzerTimeout, want: true},
+		{name: "wrapped timeout", value: fmt.Errorf("run: %w", errAnalyzerTimeout), want: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify detection
+			if got := isFailure(tt.value); got != tt.want {
+				t.Errorf("isFailure(%v) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_internalResult tests KTN-INTERNAL finding creation.
+func Test_internalResult(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantOK   bool
+		contains []string
+	}{
+		{
+			name:     "panic",
+			err:      &analyzerPanic{value: "boom", stack: []byte("goroutine 1\n")},
+			wantOK:   true,
+			contains: []string{ruleCodeInternalPanic, "boom", "example.com/p", "goroutine 1"},
+		},
+		{
+			name:     "timeout",
+			err:      errAnalyzerTimeout,
+			wantOK:   true,
+			contains: []string{ruleCodeInternalTimeout, "2s", "example.com/p"},
+		},
+		{
+			name:   "regular error",
+			err:    errors.New("bad"),
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			pkg := &packages.Package{PkgPath: "example.com/p", Fset: fset, GoFiles: []string{"/tmp/p/p.go"}}
+			a := &analysis.Analyzer{Name: "slow"}
+
+			got, ok := internalResult(pkg, a, tt.err, 2*time.Second, false)
+			// Verify finding creation
+			if ok != tt.wantOK {
+				t.Fatalf("internalResult() ok = %v, want %v", ok, tt.wantOK)
+			}
+			// Verify message content
+			for _, want := range tt.contains {
+				// Check each expected fragment
+				if !strings.Contains(got.Diag.Message, want) {
+					t.Errorf("message %q does not contain %q", got.Diag.Message, want)
+				}
+			}
+			// Verify finding metadata
+			if ok && (got.AnalyzerName != internalAnalyzerName || got.Package != pkg.PkgPath) {
+				t.Errorf("unexpected metadata: %+v", got)
+			}
+		})
+	}
+}
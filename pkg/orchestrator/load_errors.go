@@ -0,0 +1,286 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+import (
+	"go/token"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kodflow/ktn-linter/pkg/messages"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	// loadAnalyzerName is the analyzer name attached to load findings.
+	loadAnalyzerName string = "ktnload"
+	// ruleCodeLoadList reports packages the go command cannot load.
+	ruleCodeLoadList string = "KTN-LOAD-001"
+	// ruleCodeLoadParse reports syntax errors.
+	ruleCodeLoadParse string = "KTN-LOAD-002"
+	// ruleCodeLoadType reports type-checking errors.
+	ruleCodeLoadType string = "KTN-LOAD-003"
+	// posSeparatorCount is the number of ':' in a "file:line:col" position.
+	posSeparatorCount int = 2
+)
+
+// loadErrors returns the errors that prevent a package from being analyzed.
+// VCS status errors are harmless and ignored. Compiler output reported by
+// go list repeats the parse and type errors, so it is dropped when those
+// positioned errors are present.
+//
+// Params:
+//   - pkg: loaded package
+//
+// Returns:
+//   - []packages.Error: blocking errors, empty if the package is sound
+func loadErrors(pkg *packages.Package) []packages.Error {
+	checked := slices.ContainsFunc(pkg.Errors, func(err packages.Error) bool {
+		// Report parse and type errors
+		return err.Kind == packages.ParseError || err.Kind == packages.TypeError
+	})
+
+	errs := make([]packages.Error, 0, len(pkg.Errors))
+	// Keep every blocking error
+	for _, err := range pkg.Errors {
+		// Skip VCS errors and duplicated compiler output
+		if strings.Contains(err.Msg, "VCS status") || (checked && isCompilerOutput(err)) {
+			continue
+		}
+		errs = append(errs, err)
+	}
+	// Return blocking errors
+	return errs
+}
+
+// isCompilerOutput reports whether an error is raw compiler output from go list.
+//
+// Params:
+//   - err: package error
+//
+// Returns:
+//   - bool: true for "# pkg" compiler output
+func isCompilerOutput(err packages.Error) bool {
+	// Compiler output starts with the "# importpath" header
+	return err.Kind == packages.ListError && strings.HasPrefix(err.Msg, "# ")
+}
+
+// loadErrorResults converts package errors into KTN-LOAD findings.
+//
+// Params:
+//   - pkg: package with load errors
+//   - errs: blocking errors of the package
+//   - verbose: use verbose messages
+//
+// Returns:
+//   - []DiagnosticResult: one finding per error
+func loadErrorResults(pkg *packages.Package, errs []packages.Error, verbose bool) []DiagnosticResult {
+	fset := pkg.Fset
+	// Packages that failed early may have no FileSet
+	if fset == nil {
+		fset = token.NewFileSet()
+	}
+
+	results := make([]DiagnosticResult, 0, len(errs))
+	// Convert each error
+	for _, err := range errs {
+		code := loadErrorCode(err.Kind)
+		msg, _ := messages.Get(code)
+		results = append(results, DiagnosticResult{
+			Diag: analysis.Diagnostic{
+				Pos:     loadErrorPos(fset, pkg, err.Pos),
+				Message: code + ": " + msg.Format(verbose, pkg.PkgPath, err.Msg),
+			},
+			Fset:         fset,
+			AnalyzerName: loadAnalyzerName,
+			Package:      pkg.PkgPath,
+		})
+	}
+	// Return load findings
+	return results
+}
+
+// loadErrorCode maps a packages error kind to its KTN-LOAD rule code.
+//
+// Params:
+//   - kind: error kind reported by go/packages
+//
+// Returns:
+//   - string: rule code
+func loadErrorCode(kind packages.ErrorKind) string {
+	// Dispatch on error kind
+	switch kind {
+	// Syntax errors
+	case packages.ParseError:
+		// Return parse code
+		return ruleCodeLoadParse
+	// Type-checking errors
+	case packages.TypeError:
+		// Return type code
+		return ruleCodeLoadType
+	// go list and unknown errors
+	default:
+		// Return list code
+		return ruleCodeLoadList
+	}
+}
+
+// loadErrorPos resolves a "file:line:col" error position in a FileSet.
+// Files missing from the FileSet are added, so formatters can print the
+// position; errors without position point to the first file of the package.
+//
+// Params:
+//   - fset: FileSet of the package
+//   - pkg: package with the error
+//   - position: position reported by go/packages
+//
+// Returns:
+//   - token.Pos: resolved position
+func loadErrorPos(fset *token.FileSet, pkg *packages.Package, position string) token.Pos {
+	filename, line, col := splitPosition(position)
+	// Fall back to the first file of the package
+	if filename == "" {
+		filename = firstPackageFile(pkg)
+		line, col = 1, 1
+	}
+
+	file := lookupFile(fset, filename)
+	// Register unparsed files so the position can be printed
+	if file == nil {
+		file = addFile(fset, filename)
+	}
+
+	// Check the line exists in the file
+	if line < 1 || line > file.LineCount() {
+		// Return start of file
+		return file.Pos(0)
+	}
+	pos := file.LineStart(line) + token.Pos(max(col-1, 0))
+	// Keep the position inside the file
+	if int(pos) > file.Base()+file.Size() {
+		// Return start of the line
+		return file.LineStart(line)
+	}
+	// Return exact position
+	return pos
+}
+
+// splitPosition parses a "file:line:col" or "file:line" position.
+//
+// Params:
+//   - position: position string, possibly empty or "-"
+//
+// Returns:
+//   - string: file name, empty when unknown
+//   - int: line (0 when unknown)
+//   - int: column (0 when unknown)
+func splitPosition(position string) (string, int, int) {
+	// Check for missing position
+	if position == "" || position == "-" {
+		// Return unknown position
+		return "", 0, 0
+	}
+
+	rest := position
+	numbers := make([]int, 0, posSeparatorCount)
+	// Peel up to two trailing numbers
+	for range posSeparatorCount {
+		idx := strings.LastIndexByte(rest, ':')
+		// Stop when no separator is left
+		if idx < 0 {
+			break
+		}
+		n, err := strconv.Atoi(rest[idx+1:])
+		// Stop at the first non-numeric component
+		if err != nil {
+			break
+		}
+		numbers = append(numbers, n)
+		rest = rest[:idx]
+	}
+
+	// Dispatch on the number of components found
+	switch len(numbers) {
+	// file:line:col
+	case posSeparatorCount:
+		// Return full position
+		return rest, numbers[1], numbers[0]
+	// file:line
+	case 1:
+		// Return line position
+		return rest, numbers[0], 1
+	// file only
+	default:
+		// Return file position
+		return rest, 1, 1
+	}
+}
+
+// firstPackageFile returns the first known file of a package.
+//
+// Params:
+//   - pkg: package
+//
+// Returns:
+//   - string: file name, or the package path when no file is known
+func firstPackageFile(pkg *packages.Package) string {
+	// Try compiled, regular and ignored files in order
+	for _, files := range [][]string{pkg.CompiledGoFiles, pkg.GoFiles, pkg.OtherFiles} {
+		// Check for files
+		if len(files) > 0 {
+			// Return first file
+			return files[0]
+		}
+	}
+	// Return package path as pseudo file name
+	return pkg.PkgPath
+}
+
+// lookupFile finds a file of a FileSet by name.
+//
+// Params:
+//   - fset: FileSet to search
+//   - filename: file name
+//
+// Returns:
+//   - *token.File: file, nil when absent
+func lookupFile(fset *token.FileSet, filename string) *token.File {
+	var found *token.File
+	// Iterate over files until found
+	fset.Iterate(func(file *token.File) bool {
+		// Check name
+		if file.Name() == filename {
+			found = file
+			// Stop iteration
+			return false
+		}
+		// Continue iteration
+		return true
+	})
+	// Return found file
+	return found
+}
+
+// addFile registers a file in a FileSet with its line table.
+// Unreadable files are registered as a single empty line.
+//
+// Params:
+//   - fset: FileSet to update
+//   - filename: file name
+//
+// Returns:
+//   - *token.File: registered file
+func addFile(fset *token.FileSet, filename string) *token.File {
+	content, err := os.ReadFile(filename)
+	// Check for unreadable file
+	if err != nil || len(content) == 0 {
+		// Register a one-byte placeholder
+		return fset.AddFile(filename, -1, 1)
+	}
+	file := fset.AddFile(filename, -1, len(content))
+	file.SetLinesForContent(content)
+	// Return registered file
+	return file
+}
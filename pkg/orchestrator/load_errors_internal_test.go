@@ -0,0 +1,254 @@
+// Internal tests for load error findings.
+package orchestrator
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// Test_loadErrors tests the loadErrors function.
+func Test_loadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		errs []packages.Error
+		want int
+	}{
+		{name: "no errors", errs: nil, want: 0},
+		{name: "VCS errors ignored", errs: []packages.Error{{Msg: "error obtaining VCS status"}}, want: 0},
+		{name: "type error kept", errs: []packages.Error{{Msg: "undefined: x", Kind: packages.TypeError}}, want: 1},
+		{name: "compiler output dropped with type error", errs: []packages.Error{{Msg: "# p\na.go:1:1: undefined: x", Kind: packages.ListError}, {Msg: "undefined: x", Kind: packages.TypeError}}, want: 1},
+		{name: "compiler output kept alone", errs: []packages.Error{{Msg: "# p\nlink error", Kind: packages.ListError}}, want: 1},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify blocking errors
+			if got := loadErrors(&packages.Package{Errors: tt.errs}); len(got) != tt.want {
+				t.Errorf("loadErrors() = %v, want %d errors", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_isCompilerOutput tests the isCompilerOutput function.
+func Test_isCompilerOutput(t *testing.T) {
+	tests := []struct {
+		name string
+		err  packages.Error
+		want bool
+	}{
+		{name: "compiler output", err: packages.Error{Msg: "# p\nerror", Kind: packages.ListError}, want: true},
+		{name: "other list error", err: packages.Error{Msg: "no Go files", Kind: packages.ListError}, want: false},
+		{name: "type error", err: packages.Error{Msg: "# weird", Kind: packages.TypeError}, want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify detection
+			if got := isCompilerOutput(tt.err); got != tt.want {
+				t.Errorf("isCompilerOutput() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_loadErrorCode tests the loadErrorCode function.
+func Test_loadErrorCode(t *testing.T) {
+	tests := []struct {
+		name string
+		kind packages.ErrorKind
+		want string
+	}{
+		{name: "list error", kind: packages.ListError, want: ruleCodeLoadList},
+		{name: "unknown error", kind: packages.UnknownError, want: ruleCodeLoadList},
+		{name: "parse error", kind: packages.ParseError, want: ruleCodeLoadParse},
+		{name: "type error", kind: packages.TypeError, want: ruleCodeLoadType},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify code
+			if got := loadErrorCode(tt.kind); got != tt.want {
+				t.Errorf("loadErrorCode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_splitPosition tests the splitPosition function.
+func Test_splitPosition(t *testing.T) {
+	tests := []struct {
+		name     string
+		position string
+		wantFile string
+		wantLine int
+		wantCol  int
+	}{
+		{name: "empty", position: "", wantFile: "", wantLine: 0, wantCol: 0},
+		{name: "dash", position: "-", wantFile: "", wantLine: 0, wantCol: 0},
+		{name: "full", position: "/src/a.go:3:7", wantFile: "/src/a.go", wantLine: 3, wantCol: 7},
+		{name: "line only", position: "/src/a.go:3", wantFile: "/src/a.go", wantLine: 3, wantCol: 1},
+		{name: "file only", position: "/src/a.go", wantFile: "/src/a.go", wantLine: 1, wantCol: 1},
+		{name: "windows drive", position: `C:\src\a.go:3:7`, wantFile: `C:\src\a.go`, wantLine: 3, wantCol: 7},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			file, line, col := splitPosition(tt.position)
+			// Verify components
+			if file != tt.wantFile || line != tt.wantLine || col != tt.wantCol {
+				t.Errorf("splitPosition(%q) = %q, %d, %d, want %q, %d, %d", tt.position, file, line, col, tt.wantFile, tt.wantLine, tt.wantCol)
+			}
+		})
+	}
+}
+
+// Test_firstPackageFile tests the firstPackageFile function.
+func Test_firstPackageFile(t *testing.T) {
+	tests := []struct {
+		name string
+		pkg  *packages.Package
+		want string
+	}{
+		{name: "compiled file", pkg: &packages.Package{CompiledGoFiles: []string{"a.go"}, GoFiles: []string{"b.go"}}, want: "a.go"},
+		{name: "other file", pkg: &packages.Package{OtherFiles: []string{"c.s"}}, want: "c.s"},
+		{name: "no file", pkg: &packages.Package{PkgPath: "example.com/p"}, want: "example.com/p"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify selected file
+			if got := firstPackageFile(tt.pkg); got != tt.want {
+				t.Errorf("firstPackageFile() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_lookupFile tests the lookupFile function.
+func Test_lookupFile(t *testing.T) {
+	fset := token.NewFileSet()
+	fset.AddFile("/src/a.go", -1, 10)
+	tests := []struct {
+		name      string
+		filename  string
+		wantFound bool
+	}{
+		{name: "known file", filename: "/src/a.go", wantFound: true},
+		{name: "unknown file", filename: "/src/b.go", wantFound: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify lookup
+			if got := lookupFile(fset, tt.filename); (got != nil) != tt.wantFound {
+				t.Errorf("lookupFile(%q) = %v, want found %v", tt.filename, got, tt.wantFound)
+			}
+		})
+	}
+}
+
+// Test_addFile tests the addFile function.
+func Test_addFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "a.go")
+	// Check write error
+	if err := os.WriteFile(path, []byte("package a\n\nvar x = y\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		filename  string
+		wantLines int
+	}{
+		{name: "readable file has line table", filename: path, wantLines: 3},
+		{name: "missing file is a placeholder", filename: path + ".missing", wantLines: 1},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			file := addFile(token.NewFileSet(), tt.filename)
+			// Verify line table
+			if file.LineCount() != tt.wantLines {
+				t.Errorf("LineCount() = %d, want %d", file.LineCount(), tt.wantLines)
+			}
+		})
+	}
+}
+
+// Test_loadErrorPos tests the loadErrorPos function.
+func Test_loadErrorPos(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "a.go")
+	// Check write error
+	if err := os.WriteFile(path, []byte("package a\n\nvar x = y\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	pkg := &packages.Package{GoFiles: []string{path}}
+	tests := []struct {
+		name     string
+		position string
+		wantLine int
+		wantCol  int
+	}{
+		{name: "exact position", position: path + ":3:9", wantLine: 3, wantCol: 9},
+		{name: "missing position uses first file", position: "", wantLine: 1, wantCol: 1},
+		{name: "line out of range", position: path + ":42:1", wantLine: 1, wantCol: 1},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			got := fset.Position(loadErrorPos(fset, pkg, tt.position))
+			// Verify resolved position
+			if got.Filename != path || got.Line != tt.wantLine || got.Column != tt.wantCol {
+				t.Errorf("loadErrorPos() = %v, want %s:%d:%d", got, path, tt.wantLine, tt.wantCol)
+			}
+		})
+	}
+}
+
+// Test_loadErrorResults tests the loadErrorResults function.
+func Test_loadErrorResults(t *testing.T) {
+	tests := []struct {
+		name     string
+		pkg      *packages.Package
+		wantCode string
+	}{
+		{
+			name:     "type error without FileSet",
+			pkg:      &packages.Package{PkgPath: "example.com/p", Errors: []packages.Error{{Msg: "undefined: y", Kind: packages.TypeError}}},
+			wantCode: ruleCodeLoadType,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := loadErrorResults(tt.pkg, tt.pkg.Errors, false)
+			// Verify one finding per error
+			if len(got) != 1 {
+				t.Fatalf("loadErrorResults() len = %d, want 1", len(got))
+			}
+			// Verify code, message and metadata
+			if !strings.HasPrefix(got[0].Diag.Message, tt.wantCode+": ") || !strings.Contains(got[0].Diag.Message, "undefined: y") {
+				t.Errorf("message = %q, want %s with the error", got[0].Diag.Message, tt.wantCode)
+			}
+			// Verify FileSet and package
+			if got[0].Fset == nil || got[0].Package != tt.pkg.PkgPath || got[0].AnalyzerName != loadAnalyzerName {
+				t.Errorf("result = %+v, want FileSet, package and analyzer name", got[0])
+			}
+		})
+	}
+}
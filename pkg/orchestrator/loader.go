@@ -2,9 +2,16 @@
 package orchestrator
 
 import (
+	"context"
 	"fmt"
+	"go/token"
 	"io"
+	"os"
+	"slices"
 	"strings"
+	"time"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
 
 	"golang.org/x/tools/go/packages"
 )
@@ -12,7 +19,10 @@ import (
 // PackageLoader handles loading Go packages for analysis.
 // Configures the packages.Config and checks for loading errors.
 type PackageLoader struct {
-	stderr io.Writer
+	stderr   io.Writer
+	profiler *Profiler      // Optional timing recorder (nil = disabled)
+	fset     *token.FileSet // Shared FileSet (nil = one per load)
+	strict   bool           // Fail on the first package error
 }
 
 // NewPackageLoader creates a new PackageLoader.
@@ -27,6 +37,32 @@ func NewPackageLoader(stderr io.Writer) *PackageLoader {
 	return &PackageLoader{stderr: stderr}
 }
 
+// SetProfiler enables timing of package loads.
+//
+// Params:
+//   - profiler: timing recorder (nil disables profiling)
+func (l *PackageLoader) SetProfiler(profiler *Profiler) {
+	l.profiler = profiler
+}
+
+// SetFileSet makes every load parse files into the same FileSet, so that
+// positions of packages from different loads can be resolved together.
+//
+// Params:
+//   - fset: shared FileSet (nil creates one per load)
+func (l *PackageLoader) SetFileSet(fset *token.FileSet) {
+	l.fset = fset
+}
+
+// SetStrict makes loads fail on the first package error.
+// Otherwise packages with errors are returned and reported by the runner.
+//
+// Params:
+//   - strict: fail on load or type errors
+func (l *PackageLoader) SetStrict(strict bool) {
+	l.strict = strict
+}
+
 // Load loads Go packages from the given patterns.
 //
 // Params:
@@ -50,21 +86,72 @@ func (l *PackageLoader) Load(patterns []string) ([]*packages.Package, error) {
 //   - []*packages.Package: loaded packages
 //   - error: loading error if any
 func (l *PackageLoader) LoadFromDir(dir string, patterns []string) ([]*packages.Package, error) {
+	// Load without cancellation
+	return l.LoadContext(context.Background(), dir, patterns)
+}
+
+// LoadContext loads Go packages from a directory, stopping early if ctx is cancelled.
+//
+// Params:
+//   - ctx: cancellation context passed to the go command
+//   - dir: directory containing go.mod (empty for current)
+//   - patterns: package patterns to load
+//
+// Returns:
+//   - []*packages.Package: loaded packages
+//   - error: loading or cancellation error if any
+func (l *PackageLoader) LoadContext(ctx context.Context, dir string, patterns []string) ([]*packages.Package, error) {
+	// Load with the host build configuration
+	return l.LoadBuildContext(ctx, dir, patterns, nil)
+}
+
+// LoadBuildContext loads Go packages for a given build configuration.
+// Packages are only type-checked, so no cross-compiler is required.
+//
+// Params:
+//   - ctx: cancellation context passed to the go command
+//   - dir: directory containing go.mod (empty for current)
+//   - patterns: package patterns to load
+//   - build: GOOS/GOARCH/tags to load (nil for the host configuration)
+//
+// Returns:
+//   - []*packages.Package: loaded packages
+//   - error: loading or cancellation error if any
+func (l *PackageLoader) LoadBuildContext(ctx context.Context, dir string, patterns []string, build *config.BuildConfig) ([]*packages.Package, error) {
 	cfg := &packages.Config{
-		Mode:       packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo,
+		Context:    ctx,
+		Mode:       packages.NeedName | packages.NeedFiles | packages.NeedImports | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedModule,
 		Tests:      true,
-		BuildFlags: []string{"-buildvcs=false"},
+		BuildFlags: buildFlags(build),
+		Env:        buildEnv(build),
 		Dir:        dir,
+		Fset:       l.fset,
 	}
 
+	start := time.Now()
 	pkgs, err := packages.Load(cfg, patterns...)
+	l.profiler.RecordLoad(dir, time.Since(start))
 	// Check for load error
 	if err != nil {
+		// Report interruptions as such, go list errors lose the context error
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			err = ctxErr
+		}
 		// Return error
 		return []*packages.Package{}, fmt.Errorf("loading packages: %w", err)
 	}
 
-	// Check for package errors
+	// Packages fully excluded from a non-host build are simply absent from it
+	if build != nil {
+		pkgs = dropExcluded(pkgs)
+	}
+
+	// Check for package errors in strict mode
+	if !l.strict {
+		// Return packages, broken ones included
+		return pkgs, nil
+	}
+	// Fail on the first package error
 	if err := l.checkErrors(pkgs); err != nil {
 		// Return error
 		return []*packages.Package{}, err
@@ -74,6 +161,68 @@ func (l *PackageLoader) LoadFromDir(dir string, patterns []string) ([]*packages.
 	return pkgs, nil
 }
 
+// buildFlags returns the go command flags for a build configuration.
+//
+// Params:
+//   - build: build configuration (nil for the host configuration)
+//
+// Returns:
+//   - []string: build flags
+func buildFlags(build *config.BuildConfig) []string {
+	flags := []string{"-buildvcs=false"}
+	// Add build tags when requested
+	if build != nil && len(build.Tags) > 0 {
+		flags = append(flags, "-tags="+strings.Join(build.Tags, ","))
+	}
+	// Return flags
+	return flags
+}
+
+// buildEnv returns the go command environment for a build configuration.
+//
+// Params:
+//   - build: build configuration (nil for the host configuration)
+//
+// Returns:
+//   - []string: environment of the go command
+func buildEnv(build *config.BuildConfig) []string {
+	// Inherit the environment when no platform is overridden
+	if build == nil || (build.GOOS == "" && build.GOARCH == "") {
+		// Return current environment
+		return os.Environ()
+	}
+
+	env := os.Environ()
+	// Override target operating system
+	if build.GOOS != "" {
+		env = append(env, "GOOS="+build.GOOS)
+	}
+	// Override target architecture
+	if build.GOARCH != "" {
+		env = append(env, "GOARCH="+build.GOARCH)
+	}
+	// Type-checking only: never require a cross C toolchain
+	return append(env, "CGO_ENABLED=0")
+}
+
+// dropExcluded removes packages whose files are all excluded by build constraints.
+//
+// Params:
+//   - pkgs: loaded packages
+//
+// Returns:
+//   - []*packages.Package: packages with at least one file in the build
+func dropExcluded(pkgs []*packages.Package) []*packages.Package {
+	// Keep packages that are not fully excluded
+	return slices.DeleteFunc(pkgs, func(pkg *packages.Package) bool {
+		// Check every error is a build constraint exclusion
+		return len(pkg.Errors) > 0 && !slices.ContainsFunc(pkg.Errors, func(err packages.Error) bool {
+			// Report errors other than build constraint exclusions
+			return !strings.Contains(err.Msg, "build constraints exclude all Go files")
+		})
+	})
+}
+
 // checkErrors checks for package loading errors.
 //
 // Params:
@@ -84,14 +233,10 @@ func (l *PackageLoader) LoadFromDir(dir string, patterns []string) ([]*packages.
 func (l *PackageLoader) checkErrors(pkgs []*packages.Package) error {
 	// Iterate over packages
 	for _, pkg := range pkgs {
-		// Iterate over errors
-		for _, err := range pkg.Errors {
-			// Skip VCS errors
-			if strings.Contains(err.Error(), "VCS status") {
-				continue
-			}
+		// Return first real error
+		if errs := loadErrors(pkg); len(errs) > 0 {
 			// Return first real error
-			return fmt.Errorf("package %s: %v", pkg.PkgPath, err)
+			return fmt.Errorf("package %s: %v", pkg.PkgPath, errs[0])
 		}
 	}
 	// No errors found
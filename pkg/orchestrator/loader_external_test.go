@@ -37,6 +37,7 @@ func TestPackageLoader_Load(t *testing.T) {
 	tests := []struct {
 		name        string
 		patterns    []string
+		strict      bool
 		expectError bool
 		minPackages int
 	}{
@@ -47,8 +48,15 @@ func TestPackageLoader_Load(t *testing.T) {
 			minPackages: 1,
 		},
 		{
-			name:        "load invalid pattern returns error",
+			name:        "tolerant load keeps broken package",
 			patterns:    []string{"./nonexistent/package"},
+			expectError: false,
+			minPackages: 1,
+		},
+		{
+			name:        "strict load invalid pattern returns error",
+			patterns:    []string{"./nonexistent/package"},
+			strict:      true,
 			expectError: true,
 			minPackages: 0,
 		},
@@ -59,6 +67,7 @@ func TestPackageLoader_Load(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var buf bytes.Buffer
 			loader := orchestrator.NewPackageLoader(&buf)
+			loader.SetStrict(tt.strict)
 
 			pkgs, err := loader.Load(tt.patterns)
 
@@ -3,8 +3,11 @@ package orchestrator
 
 import (
 	"bytes"
+	"os"
+	"slices"
 	"testing"
 
+	"github.com/kodflow/ktn-linter/pkg/config"
 	"golang.org/x/tools/go/packages"
 )
 
@@ -72,3 +75,83 @@ func TestPackageLoader_checkErrors(t *testing.T) {
 		})
 	}
 }
+
+// Test_buildFlags tests the buildFlags function.
+func Test_buildFlags(t *testing.T) {
+	tests := []struct {
+		name  string
+		build *config.BuildConfig
+		want  []string
+	}{
+		{name: "host", build: nil, want: []string{"-buildvcs=false"}},
+		{name: "platform only", build: &config.BuildConfig{GOOS: "windows"}, want: []string{"-buildvcs=false"}},
+		{name: "tags", build: &config.BuildConfig{Tags: []string{"integration", "e2e"}}, want: []string{"-buildvcs=false", "-tags=integration,e2e"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify flags
+			if got := buildFlags(tt.build); !slices.Equal(got, tt.want) {
+				t.Errorf("buildFlags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_buildEnv tests the buildEnv function.
+func Test_buildEnv(t *testing.T) {
+	tests := []struct {
+		name        string
+		build       *config.BuildConfig
+		wantInherit bool
+		want        []string
+	}{
+		{name: "host inherits environment", build: nil, wantInherit: true},
+		{name: "tags only inherit environment", build: &config.BuildConfig{Tags: []string{"x"}}, wantInherit: true},
+		{name: "platform overrides", build: &config.BuildConfig{GOOS: "windows", GOARCH: "arm64"}, want: []string{"GOOS=windows", "GOARCH=arm64", "CGO_ENABLED=0"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildEnv(tt.build)
+			// Verify inherited environment
+			if tt.wantInherit {
+				// Check unchanged environment
+				if !slices.Equal(got, os.Environ()) {
+					t.Errorf("buildEnv() = %v, want os.Environ()", got)
+				}
+				return
+			}
+			// Verify overrides come last
+			if !slices.Equal(got[len(got)-len(tt.want):], tt.want) {
+				t.Errorf("buildEnv() tail = %v, want %v", got[len(got)-len(tt.want):], tt.want)
+			}
+		})
+	}
+}
+
+// Test_dropExcluded tests the dropExcluded function.
+func Test_dropExcluded(t *testing.T) {
+	excluded := packages.Error{Msg: "build constraints exclude all Go files in /x"}
+	tests := []struct {
+		name string
+		pkgs []*packages.Package
+		want int
+	}{
+		{name: "keeps valid package", pkgs: []*packages.Package{{ID: "a"}}, want: 1},
+		{name: "drops excluded package", pkgs: []*packages.Package{{ID: "a", Errors: []packages.Error{excluded}}}, want: 0},
+		{name: "keeps other errors", pkgs: []*packages.Package{{ID: "a", Errors: []packages.Error{excluded, {Msg: "syntax error"}}}}, want: 1},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify remaining packages
+			if got := dropExcluded(tt.pkgs); len(got) != tt.want {
+				t.Errorf("dropExcluded() len = %d, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
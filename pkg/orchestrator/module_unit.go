@@ -0,0 +1,10 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+// moduleUnit is one load of a multi-module run: a standalone module or the
+// modules of a go.work workspace loaded together.
+type moduleUnit struct {
+	dir      string   // Directory to load from
+	patterns []string // Package patterns
+	label    string   // Description for verbose logs
+}
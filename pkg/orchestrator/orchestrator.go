@@ -2,8 +2,15 @@
 package orchestrator
 
 import (
+	"context"
 	"fmt"
+	"go/token"
 	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/packages"
@@ -19,6 +26,11 @@ type Orchestrator struct {
 	discovery *ModuleDiscovery
 	stderr    io.Writer
 	verbose   bool
+	builds    []config.BuildConfig // Build matrix (empty = host only)
+	fset      *token.FileSet       // FileSet shared by build matrix loads
+	ranges    []buildRange         // Positions produced by each matrix load
+	mu        sync.Mutex           // Protects ranges
+	jobs      int                  // Modules loaded concurrently (0 = GOMAXPROCS)
 }
 
 // NewOrchestrator creates a new Orchestrator.
@@ -51,8 +63,8 @@ func NewOrchestrator(stderr io.Writer, verbose bool) *Orchestrator {
 //   - []*packages.Package: loaded packages
 //   - error: loading error if any
 func (o *Orchestrator) LoadPackages(patterns []string) ([]*packages.Package, error) {
-	// Delegate to loader
-	return o.loader.Load(patterns)
+	// Load from current directory
+	return o.LoadPackagesContext(context.Background(), "", patterns)
 }
 
 // SelectAnalyzers selects analyzers based on options.
@@ -77,8 +89,8 @@ func (o *Orchestrator) SelectAnalyzers(opts Options) ([]*analysis.Analyzer, erro
 // Returns:
 //   - []DiagnosticResult: collected diagnostics
 func (o *Orchestrator) RunAnalyzers(pkgs []*packages.Package, analyzers []*analysis.Analyzer) []DiagnosticResult {
-	// Delegate to runner
-	return o.runner.Run(pkgs, analyzers)
+	// Delegate to runner and tag build configurations
+	return o.labelResults(o.runner.Run(pkgs, analyzers))
 }
 
 // FilterDiagnostics filters out cache/tmp diagnostics.
@@ -144,6 +156,260 @@ func (o *Orchestrator) Run(patterns []string, opts Options) ([]analysis.Diagnost
 	return diags, nil
 }
 
+// Symbol returns the enclosing symbol of a diagnostic returned by the last
+// ExtractDiagnostics call, for output formats reporting it.
+//
+// Params:
+//   - pos: resolved position of the diagnostic
+//
+// Returns:
+//   - string: declaration name (e.g. "Type.Method"), empty at file level
+func (o *Orchestrator) Symbol(pos token.Position) string {
+	// Delegate to processor
+	return o.processor.Symbol(pos)
+}
+
+// CPUShare returns the CPU share of the function enclosing a diagnostic
+// returned by the last ExtractDiagnostics call, for output formats ranking
+// findings by profile.
+//
+// Params:
+//   - pos: resolved position of the diagnostic
+//
+// Returns:
+//   - float64: CPU share between 0 and 1, 0 when not ranked
+func (o *Orchestrator) CPUShare(pos token.Position) float64 {
+	// Delegate to processor
+	return o.processor.CPUShare(pos)
+}
+
+// HotPath returns why the function enclosing a diagnostic returned by the
+// last ExtractDiagnostics call is hot, for output formats reporting it.
+//
+// Params:
+//   - pos: resolved position of the diagnostic
+//
+// Returns:
+//   - string: hot path reason, empty when not escalated
+func (o *Orchestrator) HotPath(pos token.Position) string {
+	// Delegate to processor
+	return o.processor.HotPath(pos)
+}
+
+// NormalizeDiagnostics deduplicates diagnostics and keeps their metadata.
+//
+// Params:
+//   - diagnostics: filtered diagnostics
+//
+// Returns:
+//   - []DiagnosticResult: deduplicated diagnostics
+func (o *Orchestrator) NormalizeDiagnostics(diagnostics []DiagnosticResult) []DiagnosticResult {
+	// Delegate to processor
+	return o.processor.Normalize(diagnostics)
+}
+
+// SourceLines returns the number of files and lines analyzed so far.
+//
+// Returns:
+//   - int: number of distinct files
+//   - int: total number of lines
+func (o *Orchestrator) SourceLines() (int, int) {
+	// Delegate to runner
+	return o.runner.SourceLines()
+}
+
+// SetConfig makes the orchestrator use its own configuration instead of
+// the global one, so that several orchestrators can run concurrently.
+//
+// Params:
+//   - cfg: run configuration (nil falls back to the global configuration)
+func (o *Orchestrator) SetConfig(cfg *config.Config) {
+	o.runner.SetConfig(cfg)
+}
+
+// LoadPackagesContext loads packages from a directory with cancellation.
+//
+// Params:
+//   - ctx: cancellation context
+//   - dir: module directory (empty for current)
+//   - patterns: package patterns
+//
+// Returns:
+//   - []*packages.Package: loaded packages
+//   - error: loading or cancellation error if any
+func (o *Orchestrator) LoadPackagesContext(ctx context.Context, dir string, patterns []string) ([]*packages.Package, error) {
+	// Load the host configuration only without a build matrix
+	if len(o.builds) == 0 {
+		pkgs, err := o.loader.LoadContext(ctx, dir, patterns)
+		// Return packages outside skipped directories
+		return o.dropSkipped(pkgs), err
+	}
+
+	var all []*packages.Package
+	// Load every build configuration, each with its own FileSet
+	for i := range o.builds {
+		build := &o.builds[i]
+		// Log if verbose
+		if o.verbose {
+			fmt.Fprintf(o.stderr, "Loading build configuration: %s\n", build.Label())
+		}
+		pkgs, err := o.loadBuild(ctx, dir, patterns, build)
+		// Check for error
+		if err != nil {
+			// Return error with the failing configuration
+			return []*packages.Package{}, fmt.Errorf("build %s: %w", build.Label(), err)
+		}
+		all = append(all, pkgs...)
+	}
+
+	// Return packages of all configurations
+	return o.dropSkipped(all), nil
+}
+
+// dropSkipped removes packages located in directories matched by skip_dirs.
+//
+// Params:
+//   - pkgs: loaded packages
+//
+// Returns:
+//   - []*packages.Package: packages to analyze
+func (o *Orchestrator) dropSkipped(pkgs []*packages.Package) []*packages.Package {
+	skip := o.skipMatcher()
+	// Keep everything without a skip list
+	if skip == nil {
+		// Return packages unchanged
+		return pkgs
+	}
+
+	kept := pkgs[:0]
+	// Filter packages by directory
+	for _, pkg := range pkgs {
+		// Skip packages whose directory is excluded
+		if len(pkg.GoFiles) > 0 && skip.Skips(filepath.Dir(pkg.GoFiles[0]), true) {
+			continue
+		}
+		kept = append(kept, pkg)
+	}
+	// Return kept packages
+	return kept
+}
+
+// SetStrictLoad makes loading fail on the first package error, instead of
+// reporting broken packages as KTN-LOAD findings and analyzing the others.
+//
+// Params:
+//   - strict: fail on load or type errors
+func (o *Orchestrator) SetStrictLoad(strict bool) {
+	o.loader.SetStrict(strict)
+}
+
+// SetPGOProfile sets the CPU profile ranking performance findings by the
+// CPU share of their enclosing function. Without it, each module's
+// default.pgo is used when present.
+//
+// Params:
+//   - path: pprof CPU profile (empty for default.pgo)
+func (o *Orchestrator) SetPGOProfile(path string) {
+	o.runner.SetPGOProfile(path)
+}
+
+// SetBuildMatrix sets the build configurations loaded and analyzed.
+// Findings of shared files are merged, and findings only reported by some
+// configurations are labelled with them.
+//
+// Params:
+//   - builds: build configurations (empty for the host configuration only)
+func (o *Orchestrator) SetBuildMatrix(builds []config.BuildConfig) {
+	o.builds = builds
+	o.ranges = nil
+	o.processor.SetBuildCount(len(builds))
+	o.fset = nil
+	// Share one FileSet so positions of every configuration resolve together
+	if len(builds) > 0 {
+		o.fset = token.NewFileSet()
+	}
+	o.loader.SetFileSet(o.fset)
+}
+
+// loadBuild loads one build configuration and records its position range.
+//
+// Params:
+//   - ctx: cancellation context
+//   - dir: module directory (empty for current)
+//   - patterns: package patterns
+//   - build: build configuration to load
+//
+// Returns:
+//   - []*packages.Package: loaded packages
+//   - error: loading error if any
+func (o *Orchestrator) loadBuild(ctx context.Context, dir string, patterns []string, build *config.BuildConfig) ([]*packages.Package, error) {
+	start := token.Pos(o.fset.Base())
+	pkgs, err := o.loader.LoadBuildContext(ctx, dir, patterns, build)
+	o.mu.Lock()
+	o.ranges = append(o.ranges, buildRange{start: start, end: token.Pos(o.fset.Base()), label: build.Label()})
+	o.mu.Unlock()
+	// Return loaded packages
+	return pkgs, err
+}
+
+// labelResults tags each diagnostic with the build configuration it comes from.
+//
+// Params:
+//   - diags: diagnostics to tag in place
+//
+// Returns:
+//   - []DiagnosticResult: tagged diagnostics
+func (o *Orchestrator) labelResults(diags []DiagnosticResult) []DiagnosticResult {
+	// Nothing to tag without a build matrix
+	if len(o.builds) == 0 {
+		// Return unchanged diagnostics
+		return diags
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	// Tag each diagnostic from the load that produced its position
+	for i := range diags {
+		// Find the matching load range
+		for _, r := range o.ranges {
+			// Check if the position belongs to this load
+			if r.contains(diags[i].Diag.Pos) {
+				diags[i].Build = r.label
+				break
+			}
+		}
+	}
+	// Return tagged diagnostics
+	return diags
+}
+
+// RunAnalyzersContext runs analyzers on packages until done or ctx is cancelled.
+//
+// Params:
+//   - ctx: cancellation context
+//   - pkgs: packages to analyze
+//   - analyzers: analyzers to run
+//
+// Returns:
+//   - []DiagnosticResult: collected diagnostics
+//   - error: ctx error when cancelled
+func (o *Orchestrator) RunAnalyzersContext(ctx context.Context, pkgs []*packages.Package, analyzers []*analysis.Analyzer) ([]DiagnosticResult, error) {
+	diags := o.labelResults(o.runner.RunContext(ctx, pkgs, analyzers))
+	// Return partial results with cancellation error
+	return diags, ctx.Err()
+}
+
+// EnableProfiling starts recording package load and analyzer timings.
+//
+// Returns:
+//   - *Profiler: profiler receiving the timings
+func (o *Orchestrator) EnableProfiling() *Profiler {
+	profiler := NewProfiler()
+	o.loader.SetProfiler(profiler)
+	o.runner.SetProfiler(profiler)
+	// Return active profiler
+	return profiler
+}
+
 // GetFirstFset returns the FileSet from the first diagnostic.
 //
 // Params:
@@ -184,8 +450,8 @@ func (o *Orchestrator) DiscoverModules(paths []string) ([]string, error) {
 //   - []*packages.Package: loaded packages
 //   - error: loading error if any
 func (o *Orchestrator) LoadPackagesFromDir(dir string, patterns []string) ([]*packages.Package, error) {
-	// Delegate to loader
-	return o.loader.LoadFromDir(dir, patterns)
+	// Load without cancellation
+	return o.LoadPackagesContext(context.Background(), dir, patterns)
 }
 
 // RunMultiModule runs analysis across multiple modules.
@@ -198,93 +464,83 @@ func (o *Orchestrator) LoadPackagesFromDir(dir string, patterns []string) ([]*pa
 //   - []DiagnosticResult: aggregated diagnostics
 //   - error: pipeline error if any
 func (o *Orchestrator) RunMultiModule(paths []string, opts Options) ([]DiagnosticResult, error) {
-	// Discover modules
-	modules, err := o.DiscoverModules(paths)
-	// Check for error
-	if err != nil {
-		// Return empty slice on error
-		return []DiagnosticResult{}, fmt.Errorf("discovering modules: %w", err)
-	}
-
-	// Check if no modules found
-	if len(modules) == 0 {
-		// Fall back to standard load from current directory
-		return o.runSingleModule("", paths, opts)
-	}
-
-	// Log if verbose
-	if o.verbose {
-		fmt.Fprintf(o.stderr, "Found %d Go module(s)\n", len(modules))
-	}
+	// Run without cancellation
+	return o.RunMultiModuleContext(context.Background(), paths, opts)
+}
 
-	// Select analyzers once
-	analyzers, err := o.SelectAnalyzers(opts)
-	// Check for error
-	if err != nil {
+// RunMultiModuleContext runs analysis across multiple modules until done or
+// ctx is cancelled. Modules used by a go.work workspace are loaded together
+// in a single pass sharing one FileSet; other modules are loaded on their
+// own, up to the SetJobs limit at a time.
+// On cancellation, the findings collected so far are returned with ctx error.
+//
+// Params:
+//   - ctx: cancellation context
+//   - paths: paths to analyze (may contain multiple modules)
+//   - opts: linting options
+//
+// Returns:
+//   - []DiagnosticResult: aggregated diagnostics, partial when cancelled
+//   - error: pipeline or cancellation error if any
+func (o *Orchestrator) RunMultiModuleContext(ctx context.Context, paths []string, opts Options) ([]DiagnosticResult, error) {
+	var allDiags []DiagnosticResult
+	err := o.StreamMultiModuleContext(ctx, paths, opts, func(diags []DiagnosticResult) {
+		allDiags = append(allDiags, diags...)
+	})
+	// Check for error before any analysis
+	if err != nil && allDiags == nil {
 		// Return empty slice on error
 		return []DiagnosticResult{}, err
 	}
+	// Return aggregated diagnostics
+	return allDiags, err
+}
 
-	// Aggregate results
-	var allDiags []DiagnosticResult
-
-	// Process each module
-	for _, moduleRoot := range modules {
-		// Log if verbose
-		if o.verbose {
-			fmt.Fprintf(o.stderr, "Analyzing module: %s\n", moduleRoot)
-		}
-
-		// Get patterns for this module
-		patterns := o.discovery.ResolvePatterns(moduleRoot, paths)
-
-		// Load packages from module directory
-		pkgs, err := o.LoadPackagesFromDir(moduleRoot, patterns)
-		// Check for error
-		if err != nil {
-			// Log warning and continue
-			if o.verbose {
-				fmt.Fprintf(o.stderr, "Warning: %v\n", err)
-			}
-			continue
-		}
-
-		// Run analyzers
-		diags := o.RunAnalyzers(pkgs, analyzers)
-		allDiags = append(allDiags, diags...)
+// skipMatcher compiles the skip_dirs of the run configuration against the
+// working directory.
+//
+// Returns:
+//   - *config.SkipMatcher: compiled skip list, nil when none is configured
+func (o *Orchestrator) skipMatcher() *config.SkipMatcher {
+	patterns := o.runner.configuration().SkipDirs
+	// Check for empty skip list
+	if len(patterns) == 0 {
+		// Return no matcher
+		return nil
 	}
-
-	// Return aggregated diagnostics
-	return allDiags, nil
+	wd, _ := os.Getwd()
+	// Return matcher relative to the working directory
+	return config.NewSkipMatcher(wd, patterns)
 }
 
-// runSingleModule runs analysis for a single module.
+// splitWorkspace separates discovered modules used by a workspace from the
+// others.
 //
 // Params:
-//   - dir: module directory (empty for current)
-//   - patterns: package patterns
-//   - opts: linting options
+//   - ws: workspace, nil when none applies
+//   - modules: discovered module directories
 //
 // Returns:
-//   - []DiagnosticResult: collected diagnostics
-//   - error: pipeline error if any
-func (o *Orchestrator) runSingleModule(dir string, patterns []string, opts Options) ([]DiagnosticResult, error) {
-	// Load packages
-	pkgs, err := o.LoadPackagesFromDir(dir, patterns)
-	// Check for error
-	if err != nil {
-		// Return empty slice on error
-		return []DiagnosticResult{}, err
+//   - []string: modules listed in go.work
+//   - []string: other modules
+func splitWorkspace(ws *Workspace, modules []string) ([]string, []string) {
+	// Without workspace, every module is loaded on its own
+	if ws == nil {
+		// Return all modules as standalone
+		return []string{}, modules
 	}
 
-	// Select analyzers
-	analyzers, err := o.SelectAnalyzers(opts)
-	// Check for error
-	if err != nil {
-		// Return empty slice on error
-		return []DiagnosticResult{}, err
+	members := make([]string, 0, len(modules))
+	others := make([]string, 0, len(modules))
+	// Dispatch modules
+	for _, module := range modules {
+		// Check workspace membership
+		if ws.Contains(module) {
+			members = append(members, module)
+			continue
+		}
+		others = append(others, module)
 	}
-
-	// Run analyzers and return results
-	return o.RunAnalyzers(pkgs, analyzers), nil
+	// Return both groups
+	return members, others
 }
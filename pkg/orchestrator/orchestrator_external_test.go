@@ -3,10 +3,20 @@ package orchestrator_test
 
 import (
 	"bytes"
+	"context"
+	"errors"
+	"fmt"
 	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 
+	"github.com/kodflow/ktn-linter/pkg/config"
 	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
 )
 
 // TestNewOrchestrator tests the NewOrchestrator function.
@@ -44,6 +54,7 @@ func TestOrchestrator_LoadPackages(t *testing.T) {
 	tests := []struct {
 		name        string
 		patterns    []string
+		strict      bool
 		expectError bool
 		minPackages int
 	}{
@@ -54,8 +65,15 @@ func TestOrchestrator_LoadPackages(t *testing.T) {
 			minPackages: 1,
 		},
 		{
-			name:        "load invalid pattern",
+			name:        "tolerant load keeps broken package",
 			patterns:    []string{"./nonexistent/package"},
+			expectError: false,
+			minPackages: 1,
+		},
+		{
+			name:        "strict load invalid pattern",
+			patterns:    []string{"./nonexistent/package"},
+			strict:      true,
 			expectError: true,
 			minPackages: 0,
 		},
@@ -66,6 +84,7 @@ func TestOrchestrator_LoadPackages(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var buf bytes.Buffer
 			orch := orchestrator.NewOrchestrator(&buf, false)
+			orch.SetStrictLoad(tt.strict)
 
 			pkgs, err := orch.LoadPackages(tt.patterns)
 
@@ -406,3 +425,259 @@ func TestOptionsDefaults(t *testing.T) {
 		})
 	}
 }
+
+// TestOrchestrator_contextCancellation tests cancelled loads and analyses.
+func TestOrchestrator_contextCancellation(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{name: "cancelled context stops loading and analysis"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			orch := orchestrator.NewOrchestrator(&bytes.Buffer{}, false)
+			orch.SetConfig(config.DefaultConfig())
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			// Verify load is cancelled
+			if _, err := orch.LoadPackagesContext(ctx, "", []string{"."}); !errors.Is(err, context.Canceled) {
+				t.Errorf("LoadPackagesContext() error = %v, want context.Canceled", err)
+			}
+			diags, err := orch.RunAnalyzersContext(ctx, []*packages.Package{{ID: "p", PkgPath: "p"}}, []*analysis.Analyzer{})
+			// Verify analysis is cancelled
+			if !errors.Is(err, context.Canceled) || len(diags) != 0 {
+				t.Errorf("RunAnalyzersContext() = %v, %v, want no results and context.Canceled", diags, err)
+			}
+			diags, err = orch.RunMultiModuleContext(ctx, []string{t.TempDir()}, orchestrator.Options{})
+			// Verify multi-module analysis is cancelled
+			if !errors.Is(err, context.Canceled) || len(diags) != 0 {
+				t.Errorf("RunMultiModuleContext() = %v, %v, want no results and context.Canceled", diags, err)
+			}
+		})
+	}
+}
+
+// TestOrchestrator_SetBuildMatrix tests linting several build configurations.
+func TestOrchestrator_SetBuildMatrix(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{name: "windows-only file is analyzed and labelled"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			files := map[string]string{
+				"go.mod":    "module matrix\n\ngo 1.25\n",
+				"shared.go": "package matrix\n\nvar shared int = 1\n",
+				"win.go":    "//go:build windows\n\npackage matrix\n\nvar windowsOnly int = 1\n",
+			}
+			// Write the test module
+			for name, content := range files {
+				// Check write error
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			orch := orchestrator.NewOrchestrator(&bytes.Buffer{}, false)
+			orch.SetConfig(config.DefaultConfig())
+			orch.SetBuildMatrix([]config.BuildConfig{{GOOS: "linux"}, {GOOS: "windows"}})
+			pkgs, err := orch.LoadPackagesFromDir(dir, []string{"./..."})
+			// Check load error
+			if err != nil {
+				t.Fatalf("LoadPackagesFromDir() error = %v", err)
+			}
+			reporter := &analysis.Analyzer{
+				Name: "vars",
+				Doc:  "reports package variables",
+				Run: func(pass *analysis.Pass) (any, error) {
+					// Report every package-level variable
+					for _, name := range pass.Pkg.Scope().Names() {
+						pass.Reportf(pass.Pkg.Scope().Lookup(name).Pos(), "KTN-T: %s", name)
+					}
+					return nil, nil
+				},
+			}
+			got := orch.NormalizeDiagnostics(orch.FilterDiagnostics(orch.RunAnalyzers(pkgs, []*analysis.Analyzer{reporter})))
+			builds := make(map[string]string, len(got))
+			// Index build labels by message
+			for _, d := range got {
+				builds[d.Diag.Message] = d.Build
+			}
+			// Verify shared finding is merged without label
+			if build, ok := builds["KTN-T: shared"]; !ok || build != "" || len(got) != 2 {
+				t.Errorf("findings = %v, want unlabelled shared finding and one windows finding", builds)
+			}
+			// Verify windows-only finding is labelled
+			if builds["KTN-T: windowsOnly [build: windows]"] != "windows" {
+				t.Errorf("findings = %v, want windowsOnly labelled windows", builds)
+			}
+		})
+	}
+}
+
+// TestOrchestrator_SetStrictLoad tests linting a module with a broken package.
+func TestOrchestrator_SetStrictLoad(t *testing.T) {
+	tests := []struct {
+		name      string
+		strict    bool
+		wantError bool
+	}{
+		{name: "tolerant load reports broken package", strict: false, wantError: false},
+		{name: "strict load fails", strict: true, wantError: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			files := map[string]string{
+				"go.mod":        "module tolerant\n\ngo 1.25\n",
+				"good/good.go":  "package good\n\nvar good int = 1\n",
+				"broken/bad.go": "package broken\n\nvar bad int = undefined\n",
+			}
+			// Write the test module
+			for name, content := range files {
+				path := filepath.Join(dir, name)
+				// Check directory creation error
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				// Check write error
+				if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			orch := orchestrator.NewOrchestrator(&bytes.Buffer{}, false)
+			orch.SetConfig(config.DefaultConfig())
+			orch.SetStrictLoad(tt.strict)
+			pkgs, err := orch.LoadPackagesFromDir(dir, []string{"./..."})
+			// Verify error expectation
+			if (err != nil) != tt.wantError {
+				t.Fatalf("LoadPackagesFromDir() error = %v, wantError %v", err, tt.wantError)
+			}
+			// Stop after the expected strict failure
+			if tt.wantError {
+				return
+			}
+
+			reporter := &analysis.Analyzer{
+				Name: "vars",
+				Doc:  "reports package variables",
+				Run: func(pass *analysis.Pass) (any, error) {
+					// Report every package-level variable
+					for _, name := range pass.Pkg.Scope().Names() {
+						pass.Reportf(pass.Pkg.Scope().Lookup(name).Pos(), "KTN-T: %s", name)
+					}
+					return nil, nil
+				},
+			}
+			got := orch.NormalizeDiagnostics(orch.FilterDiagnostics(orch.RunAnalyzers(pkgs, []*analysis.Analyzer{reporter})))
+			var analyzed, load []string
+			// Split regular and load findings
+			for i := range got {
+				pos := got[i].Position()
+				// Check for load findings
+				if strings.HasPrefix(got[i].Diag.Message, "KTN-LOAD-003: ") {
+					load = append(load, fmt.Sprintf("%s:%d:%d", filepath.Base(pos.Filename), pos.Line, pos.Column))
+					continue
+				}
+				analyzed = append(analyzed, got[i].Diag.Message)
+			}
+			// Verify the sound package is analyzed
+			if !slices.Equal(analyzed, []string{"KTN-T: good"}) {
+				t.Errorf("analyzed findings = %v, want only the good package", analyzed)
+			}
+			// Verify the broken package is reported at the error position
+			if !slices.Equal(load, []string{"bad.go:3:15"}) {
+				t.Errorf("load findings = %v, want bad.go:3:15", load)
+			}
+		})
+	}
+}
+
+// TestOrchestrator_workspace tests loading go.work modules in one pass.
+func TestOrchestrator_workspace(t *testing.T) {
+	tests := []struct {
+		name string
+		skip []string
+		want []string
+	}{
+		{name: "modules share one load", skip: []string{}, want: []string{"example.com/a", "example.com/a/gen", "example.com/b"}},
+		{name: "skipped packages are dropped", skip: []string{"gen"}, want: []string{"example.com/a", "example.com/b"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Workspace mode rejects -mod=mod set by the environment
+			t.Setenv("GOFLAGS", "")
+			t.Setenv("GOWORK", "")
+			dir := t.TempDir()
+			files := map[string]string{
+				"go.work":           "go 1.25\n\nuse (\n\t./a\n\t./b\n)\n",
+				"a/go.mod":          "module example.com/a\n\ngo 1.25\n",
+				"a/a.go":            "package a\n\n// Value is shared.\nconst Value int = 1\n",
+				"a/gen/gen.go":      "package gen\n",
+				"b/go.mod":          "module example.com/b\n\ngo 1.25\n\nrequire example.com/a v0.0.0\n",
+				"b/b.go":            "package b\n\nimport \"example.com/a\"\n\n// Value reuses a.\nconst Value int = a.Value\n",
+				"ignored/go.mod":    "module example.com/ignored\n\ngo 1.25\n",
+				"ignored/ignore.go": "package ignored\n",
+			}
+			// Write the test workspace
+			for name, content := range files {
+				path := filepath.Join(dir, name)
+				// Check directory creation error
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				// Check write error
+				if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			discovery := orchestrator.NewModuleDiscovery()
+			ws, err := discovery.FindWorkspace([]string{dir})
+			// Check workspace detection
+			if err != nil || ws == nil {
+				t.Fatalf("FindWorkspace() = %v, %v", ws, err)
+			}
+
+			cfg := config.DefaultConfig()
+			cfg.SkipDirs = tt.skip
+			orch := orchestrator.NewOrchestrator(&bytes.Buffer{}, false)
+			orch.SetConfig(cfg)
+			pkgs, err := orch.LoadPackagesFromDir(ws.Dir, ws.Patterns(ws.Modules))
+			// Check load error
+			if err != nil {
+				t.Fatalf("LoadPackagesFromDir() error = %v", err)
+			}
+			got := make([]string, 0, len(pkgs))
+			// Collect package paths and check the shared FileSet
+			for _, pkg := range pkgs {
+				got = append(got, pkg.PkgPath)
+				// Verify cross-module imports resolve
+				if len(pkg.Errors) > 0 {
+					t.Errorf("package %s errors: %v", pkg.PkgPath, pkg.Errors)
+				}
+				// Verify one FileSet for the whole workspace
+				if pkg.Fset != pkgs[0].Fset {
+					t.Errorf("package %s has its own FileSet", pkg.PkgPath)
+				}
+			}
+			slices.Sort(got)
+			// Verify loaded packages
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("packages = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Internal tests for the orchestrator.
+package orchestrator
+
+import (
+	"bytes"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"golang.org/x/tools/go/packages"
+)
+
+// Test_splitWorkspace tests separating workspace modules from the others.
+func Test_splitWorkspace(t *testing.T) {
+	root := filepath.Join(string(filepath.Separator), "ws")
+	a, b, c := filepath.Join(root, "a"), filepath.Join(root, "b"), filepath.Join(root, "c")
+	tests := []struct {
+		name        string
+		ws          *Workspace
+		wantMembers []string
+		wantOthers  []string
+	}{
+		{name: "no workspace", ws: nil, wantMembers: []string{}, wantOthers: []string{a, b, c}},
+		{name: "partial workspace", ws: &Workspace{Dir: root, Modules: []string{a, c}}, wantMembers: []string{a, c}, wantOthers: []string{b}},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			members, others := splitWorkspace(tt.ws, []string{a, b, c})
+			// Verify both groups
+			if !slices.Equal(members, tt.wantMembers) || !slices.Equal(others, tt.wantOthers) {
+				t.Errorf("splitWorkspace() = %v, %v, want %v, %v", members, others, tt.wantMembers, tt.wantOthers)
+			}
+		})
+	}
+}
+
+// TestOrchestrator_dropSkipped tests dropping packages of skipped directories.
+func TestOrchestrator_dropSkipped(t *testing.T) {
+	root := filepath.Join(string(filepath.Separator), "repo")
+	tests := []struct {
+		name string
+		skip []string
+		want []string
+	}{
+		{name: "no skip list", skip: []string{}, want: []string{"app", "gen", "empty"}},
+		{name: "skipped directory", skip: []string{"gen"}, want: []string{"app", "empty"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			cfg.SkipDirs = tt.skip
+			o := NewOrchestrator(&bytes.Buffer{}, false)
+			o.SetConfig(cfg)
+			pkgs := []*packages.Package{
+				{PkgPath: "app", GoFiles: []string{filepath.Join(root, "app", "app.go")}},
+				{PkgPath: "gen", GoFiles: []string{filepath.Join(root, "gen", "gen.go")}},
+				{PkgPath: "empty"},
+			}
+			got := make([]string, 0, len(pkgs))
+			// Collect kept package paths
+			for _, pkg := range o.dropSkipped(pkgs) {
+				got = append(got, pkg.PkgPath)
+			}
+			// Verify kept packages
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("dropSkipped() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
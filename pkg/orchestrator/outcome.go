@@ -0,0 +1,9 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+// outcome is the result of one analyzer run.
+// Sent back by the goroutine running a pass under a deadline.
+type outcome struct {
+	result any   // Value returned by the analyzer
+	err    error // Analyzer error or recovered panic
+}
@@ -0,0 +1,11 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+import "golang.org/x/tools/go/packages"
+
+// packageResult holds the findings of one analyzed package.
+// Sent back by workers as soon as the package is analyzed.
+type packageResult struct {
+	pkg   *packages.Package  // Analyzed package
+	diags []DiagnosticResult // Findings of the package
+}
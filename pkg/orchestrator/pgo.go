@@ -0,0 +1,89 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+import (
+	"go/ast"
+	"path/filepath"
+
+	"github.com/kodflow/ktn-linter/pkg/cpuprofile"
+	"github.com/kodflow/ktn-linter/pkg/rulecode"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// defaultPGOFile is the CPU profile used by the go command for
+// profile-guided optimization.
+const defaultPGOFile string = "default.pgo"
+
+// pgoRules are the performance rules ranked by the CPU share of their
+// enclosing function.
+var pgoRules map[string]bool = map[string]bool{
+	"KTN-VAR-008": true, // Slices sans capacité
+	"KTN-VAR-009": true, // make([]T, n) suivi d'append
+	"KTN-VAR-010": true, // bytes.Buffer/strings.Builder sans Grow
+	"KTN-VAR-011": true, // Concaténations répétées
+	"KTN-VAR-012": true, // Allocations dans les boucles
+	"KTN-VAR-013": true, // Grandes structs passées par valeur
+	"KTN-VAR-014": true, // Buffers répétés sans sync.Pool
+	"KTN-VAR-015": true, // Conversions string() répétées
+	"KTN-VAR-017": true, // Maps sans capacité
+}
+
+// SetPGOProfile sets the CPU profile ranking performance findings. Without
+// it, the default.pgo file at the root of each module is used when present.
+//
+// Params:
+//   - path: pprof CPU profile (empty for default.pgo)
+func (r *AnalysisRunner) SetPGOProfile(path string) {
+	r.pgoPath = path
+}
+
+// cpuShare returns the cumulative CPU share of the function enclosing a
+// performance finding, when a CPU profile is available.
+//
+// Params:
+//   - diag: diagnostic to rank
+//   - pkg: analyzed package
+//   - files: files of the pass
+//   - symbol: enclosing declaration
+//
+// Returns:
+//   - float64: CPU share between 0 and 1 (0 when not ranked)
+func (r *AnalysisRunner) cpuShare(diag *analysis.Diagnostic, pkg *packages.Package, files []*ast.File, symbol string) float64 {
+	// Only findings of performance rules inside functions are ranked
+	if !pgoRules[rulecode.FromMessage(diag.Message)] || enclosingFunc(files, diag.Pos) == nil {
+		// Return not ranked
+		return 0
+	}
+	prof := r.pgoProfile(pkg)
+	// Without profile, findings keep their position order
+	if prof == nil {
+		// Return not ranked
+		return 0
+	}
+	// Return the share of the enclosing function
+	return prof.Share(profilePackage(pkg), symbol)
+}
+
+// pgoProfile returns the CPU profile of a package: the --pgo profile, or
+// the default.pgo file of its module.
+//
+// Params:
+//   - pkg: analyzed package
+//
+// Returns:
+//   - *cpuprofile.Profile: profile, nil when none is usable
+func (r *AnalysisRunner) pgoProfile(pkg *packages.Package) *cpuprofile.Profile {
+	// Prefer the explicit profile
+	if r.pgoPath != "" {
+		// Return the --pgo profile
+		return r.loadProfile(r.pgoPath, "--pgo")
+	}
+	// Packages outside modules have no default profile
+	if pkg.Module == nil || pkg.Module.Dir == "" {
+		// Return no profile
+		return nil
+	}
+	// Return the module profile
+	return r.loadProfile(filepath.Join(pkg.Module.Dir, defaultPGOFile), defaultPGOFile)
+}
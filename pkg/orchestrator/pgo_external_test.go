@@ -0,0 +1,105 @@
+// External tests for profile-guided ranking.
+package orchestrator_test
+
+import (
+	"bytes"
+	"go/ast"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"strings"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+	"golang.org/x/tools/go/analysis"
+)
+
+// TestOrchestrator_SetPGOProfile tests ranking findings with the module
+// default.pgo or an explicit profile.
+func TestOrchestrator_SetPGOProfile(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":     "module pgo\n\ngo 1.25\n",
+		"hot/hot.go": "package hot\n\nfunc Encode() {\n\t_ = []int{}\n}\n",
+	}
+	// Write the test module
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		// Check directory creation error
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		// Check write error
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var profile bytes.Buffer
+	// Record a valid CPU profile as the module default.pgo
+	if err := pprof.StartCPUProfile(&profile); err != nil {
+		t.Fatal(err)
+	}
+	pprof.StopCPUProfile()
+	// Check write error
+	if err := os.WriteFile(filepath.Join(dir, "default.pgo"), profile.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name       string
+		pgo        string
+		wantStderr bool
+	}{
+		{name: "module default.pgo", pgo: "", wantStderr: false},
+		{name: "missing explicit profile", pgo: filepath.Join(dir, "missing.pprof"), wantStderr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var stderr bytes.Buffer
+			orch := orchestrator.NewOrchestrator(&stderr, false)
+			orch.SetConfig(config.DefaultConfig())
+			orch.SetPGOProfile(tt.pgo)
+			pkgs, err := orch.LoadPackagesFromDir(dir, []string{"./..."})
+			// Check load error
+			if err != nil {
+				t.Fatal(err)
+			}
+			reporter := &analysis.Analyzer{
+				Name: "alloc",
+				Doc:  "reports composite literals",
+				Run: func(pass *analysis.Pass) (any, error) {
+					// Report each composite literal
+					for _, file := range pass.Files {
+						ast.Inspect(file, func(n ast.Node) bool {
+							// Check composite literals
+							if lit, ok := n.(*ast.CompositeLit); ok {
+								pass.Reportf(lit.Pos(), "KTN-VAR-012: allocation")
+							}
+							return true
+						})
+					}
+					return nil, nil
+				},
+			}
+			got := orch.FilterDiagnostics(orch.RunAnalyzers(pkgs, []*analysis.Analyzer{reporter}))
+			// Verify a single finding
+			if len(got) != 1 {
+				t.Fatalf("findings = %d, want 1", len(got))
+			}
+			// Verify the message is left untouched
+			if got[0].Diag.Message != "KTN-VAR-012: allocation" {
+				t.Errorf("Message = %q, want no label", got[0].Diag.Message)
+			}
+			// Verify functions missing from the profile are not ranked
+			if got[0].CPUShare != 0 {
+				t.Errorf("CPUShare = %v, want 0", got[0].CPUShare)
+			}
+			// Verify unusable profiles are reported
+			if strings.Contains(stderr.String(), "--pgo") != tt.wantStderr {
+				t.Errorf("stderr = %q, wantStderr %v", stderr.String(), tt.wantStderr)
+			}
+		})
+	}
+}
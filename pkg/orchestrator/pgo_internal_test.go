@@ -0,0 +1,73 @@
+// Internal tests for profile-guided ranking.
+package orchestrator
+
+import (
+	"bytes"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// TestAnalysisRunner_cpuShare tests CPU shares of performance findings.
+func TestAnalysisRunner_cpuShare(t *testing.T) {
+	profile := writeHotProfile(t, map[string]uint64{
+		"example.com/app/api.Profiled": 60,
+		"example.com/app/api.Rare":     1,
+		"runtime.mallocgc":             39,
+	})
+	moduleDir := filepath.Dir(profile)
+	// Use the profile as the module default.pgo
+	if err := os.Rename(profile, filepath.Join(moduleDir, defaultPGOFile)); err != nil {
+		t.Fatal(err)
+	}
+	explicit := filepath.Join(moduleDir, defaultPGOFile)
+	tests := []struct {
+		name       string
+		pgo        string
+		module     *packages.Module
+		code       string
+		anchor     string
+		wantShare  float64
+		wantStderr bool
+	}{
+		{name: "explicit profile", pgo: explicit, code: "KTN-VAR-012", anchor: "Profiled(", wantShare: 0.6},
+		{name: "module default.pgo", module: &packages.Module{Dir: moduleDir}, code: "KTN-VAR-008", anchor: "Rare(", wantShare: 0.01},
+		{name: "function absent from profile", pgo: explicit, code: "KTN-VAR-017", anchor: "Startup("},
+		{name: "package level", pgo: explicit, code: "KTN-VAR-012", anchor: "table"},
+		{name: "other rule", pgo: explicit, code: "KTN-FUNC-001", anchor: "Profiled("},
+		{name: "no module", code: "KTN-VAR-012", anchor: "Profiled("},
+		{name: "module without default.pgo", module: &packages.Module{Dir: t.TempDir()}, code: "KTN-VAR-012", anchor: "Profiled("},
+		{name: "missing explicit profile", pgo: filepath.Join(t.TempDir(), "missing.pprof"), code: "KTN-VAR-012", anchor: "Profiled(", wantStderr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var stderr bytes.Buffer
+			pkg := parseHotPackage(t)
+			pkg.Module = tt.module
+			runner := NewAnalysisRunner(&stderr, false)
+			runner.SetPGOProfile(tt.pgo)
+			pos := pkg.Syntax[0].FileStart + token.Pos(strings.Index(hotSource, tt.anchor))
+			message := tt.code + ": finding\ndetails"
+			diag := analysis.Diagnostic{Pos: pos, Message: message}
+			// Verify share of the enclosing function
+			if got := runner.cpuShare(&diag, pkg, pkg.Syntax, EnclosingSymbol(pkg.Syntax, pos)); got != tt.wantShare {
+				t.Errorf("cpuShare() = %v, want %v", got, tt.wantShare)
+			}
+			// Verify the message is left untouched
+			if diag.Message != message {
+				t.Errorf("Message = %q, want %q", diag.Message, message)
+			}
+			// Verify unusable profiles are reported
+			if (stderr.Len() > 0) != tt.wantStderr {
+				t.Errorf("stderr = %q, wantStderr %v", stderr.String(), tt.wantStderr)
+			}
+		})
+	}
+}
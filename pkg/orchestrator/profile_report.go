@@ -0,0 +1,78 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+// profileTabPadding is the column padding of profile tables.
+const profileTabPadding int = 2
+
+// ProfileReport is a snapshot of the timings recorded by a Profiler.
+// Every list is sorted by decreasing total time.
+type ProfileReport struct {
+	Load      time.Duration `json:"load"`
+	Analysis  time.Duration `json:"analysis"`
+	Loads     []TimingStat  `json:"loads"`
+	Analyzers []TimingStat  `json:"analyzers"`
+	Packages  []TimingStat  `json:"packages"`
+}
+
+// WriteText prints the slowest analyzers and packages.
+//
+// Params:
+//   - w: destination writer
+//   - top: number of entries per table (0 = all)
+//
+// Returns:
+//   - error: write error if any
+func (p *ProfileReport) WriteText(w io.Writer, top int) error {
+	tw := tabwriter.NewWriter(w, 0, 0, profileTabPadding, ' ', 0)
+	fmt.Fprintf(tw, "Package loading:\t%s\t(%d load(s))\n", p.Load.Round(time.Millisecond), len(p.Loads))
+	fmt.Fprintf(tw, "Analysis (cumulated):\t%s\t\n", p.Analysis.Round(time.Millisecond))
+	writeTimingTable(tw, "ANALYZER", limitStats(p.Analyzers, top))
+	writeTimingTable(tw, "PACKAGE", limitStats(p.Packages, top))
+	// Flush table
+	return tw.Flush()
+}
+
+// writeTimingTable prints one timing table.
+//
+// Params:
+//   - w: destination writer
+//   - title: header of the name column
+//   - stats: rows to print
+func writeTimingTable(w io.Writer, title string, stats []TimingStat) {
+	// Skip empty tables
+	if len(stats) == 0 {
+		// Nothing to print
+		return
+	}
+	fmt.Fprintf(w, "\n%s\tTOTAL\tCALLS\tMAX\t\n", title)
+	// Print each row
+	for _, stat := range stats {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t\n", stat.Name,
+			stat.Total.Round(time.Microsecond), stat.Calls, stat.Max.Round(time.Microsecond))
+	}
+}
+
+// limitStats keeps the first entries of a sorted list.
+//
+// Params:
+//   - stats: sorted stats
+//   - top: maximum number of entries (0 = all)
+//
+// Returns:
+//   - []TimingStat: truncated list
+func limitStats(stats []TimingStat, top int) []TimingStat {
+	// Keep everything when no limit applies
+	if top <= 0 || len(stats) <= top {
+		// Return full list
+		return stats
+	}
+	// Return truncated list
+	return stats[:top]
+}
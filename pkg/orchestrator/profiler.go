@@ -0,0 +1,146 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Profiler records wall time per analyzer, per package and per package load.
+// All methods are safe for concurrent use, and a nil *Profiler records nothing
+// so that callers do not need to check whether profiling is enabled.
+type Profiler struct {
+	mu        sync.Mutex
+	loads     map[string]*TimingStat
+	analyzers map[string]*TimingStat
+	packages  map[string]*TimingStat
+}
+
+// NewProfiler creates an empty Profiler.
+//
+// Returns:
+//   - *Profiler: profiler ready to record
+func NewProfiler() *Profiler {
+	// Return empty profiler
+	return &Profiler{
+		loads:     map[string]*TimingStat{},
+		analyzers: map[string]*TimingStat{},
+		packages:  map[string]*TimingStat{},
+	}
+}
+
+// RecordLoad records the duration of a package load.
+//
+// Params:
+//   - dir: module directory the packages were loaded from
+//   - elapsed: load duration
+func (p *Profiler) RecordLoad(dir string, elapsed time.Duration) {
+	// Ignore measurements when profiling is disabled
+	if p == nil {
+		// Nothing to record
+		return
+	}
+	p.record(p.loads, cmp.Or(dir, "."), elapsed)
+}
+
+// RecordAnalyzer records the duration of one analyzer run on one package.
+//
+// Params:
+//   - name: analyzer name
+//   - elapsed: run duration
+func (p *Profiler) RecordAnalyzer(name string, elapsed time.Duration) {
+	// Ignore measurements when profiling is disabled
+	if p == nil {
+		// Nothing to record
+		return
+	}
+	p.record(p.analyzers, name, elapsed)
+}
+
+// RecordPackage records the duration of all analyzers on one package.
+//
+// Params:
+//   - path: package path (test variants are recorded separately)
+//   - elapsed: analysis duration
+func (p *Profiler) RecordPackage(path string, elapsed time.Duration) {
+	// Ignore measurements when profiling is disabled
+	if p == nil {
+		// Nothing to record
+		return
+	}
+	p.record(p.packages, path, elapsed)
+}
+
+// Report returns the recorded timings sorted by decreasing total time.
+//
+// Returns:
+//   - *ProfileReport: timings snapshot
+func (p *Profiler) Report() *ProfileReport {
+	report := &ProfileReport{
+		Loads:     []TimingStat{},
+		Analyzers: []TimingStat{},
+		Packages:  []TimingStat{},
+	}
+	// Nothing recorded without profiler
+	if p == nil {
+		// Return empty report
+		return report
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	report.Loads = sortedTimings(p.loads)
+	report.Analyzers = sortedTimings(p.analyzers)
+	report.Packages = sortedTimings(p.packages)
+	// Sum load time
+	for _, stat := range report.Loads {
+		report.Load += stat.Total
+	}
+	// Sum package analysis time
+	for _, stat := range report.Packages {
+		report.Analysis += stat.Total
+	}
+	// Return report
+	return report
+}
+
+// record merges a measurement into one of the profiler tables.
+//
+// Params:
+//   - entries: table to update
+//   - name: entry name
+//   - elapsed: measured duration
+func (p *Profiler) record(entries map[string]*TimingStat, name string, elapsed time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	stat, ok := entries[name]
+	// Create entry on first measurement
+	if !ok {
+		stat = &TimingStat{Name: name}
+		entries[name] = stat
+	}
+	stat.add(elapsed)
+}
+
+// sortedTimings copies a timing table sorted by decreasing total time.
+//
+// Params:
+//   - entries: timing table
+//
+// Returns:
+//   - []TimingStat: sorted copy
+func sortedTimings(entries map[string]*TimingStat) []TimingStat {
+	stats := make([]TimingStat, 0, len(entries))
+	// Copy entries
+	for _, stat := range entries {
+		stats = append(stats, *stat)
+	}
+	slices.SortFunc(stats, func(a, b TimingStat) int {
+		// Slowest first, then by name
+		return cmp.Or(cmp.Compare(b.Total, a.Total), cmp.Compare(a.Name, b.Name))
+	})
+	// Return sorted stats
+	return stats
+}
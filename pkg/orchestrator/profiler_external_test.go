@@ -0,0 +1,173 @@
+// External tests for the profiler.
+package orchestrator_test
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+)
+
+// TestProfiler_Report tests recording and reporting timings.
+func TestProfiler_Report(t *testing.T) {
+	tests := []struct {
+		name          string
+		profiler      *orchestrator.Profiler
+		wantAnalyzers []string
+		wantLoad      time.Duration
+		wantAnalysis  time.Duration
+	}{
+		{
+			name:          "nil profiler records nothing",
+			profiler:      nil,
+			wantAnalyzers: []string{},
+			wantLoad:      0,
+			wantAnalysis:  0,
+		},
+		{
+			name:          "slowest analyzer first",
+			profiler:      orchestrator.NewProfiler(),
+			wantAnalyzers: []string{"slow", "fast"},
+			wantLoad:      3 * time.Second,
+			wantAnalysis:  5 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			tt.profiler.RecordLoad("", time.Second)
+			tt.profiler.RecordLoad("mod", 2*time.Second)
+			tt.profiler.RecordAnalyzer("fast", time.Millisecond)
+			tt.profiler.RecordAnalyzer("slow", time.Second)
+			tt.profiler.RecordAnalyzer("fast", time.Millisecond)
+			tt.profiler.RecordPackage("p", 5*time.Second)
+
+			report := tt.profiler.Report()
+			// Verify totals
+			if report.Load != tt.wantLoad || report.Analysis != tt.wantAnalysis {
+				t.Errorf("Load=%v Analysis=%v, want %v and %v", report.Load, report.Analysis, tt.wantLoad, tt.wantAnalysis)
+			}
+			// Verify order
+			if len(report.Analyzers) != len(tt.wantAnalyzers) {
+				t.Fatalf("Analyzers = %v, want %v", report.Analyzers, tt.wantAnalyzers)
+			}
+			// Check each analyzer
+			for i, name := range tt.wantAnalyzers {
+				// Check analyzer name
+				if report.Analyzers[i].Name != name {
+					t.Errorf("Analyzers[%d] = %s, want %s", i, report.Analyzers[i].Name, name)
+				}
+			}
+		})
+	}
+}
+
+// TestProfiler_concurrent tests concurrent recording.
+func TestProfiler_concurrent(t *testing.T) {
+	tests := []struct {
+		name    string
+		workers int
+	}{
+		{name: "parallel recording", workers: 8},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			profiler := orchestrator.NewProfiler()
+			var wg sync.WaitGroup
+			// Record from several goroutines
+			for range tt.workers {
+				wg.Go(func() {
+					profiler.RecordAnalyzer("a", time.Millisecond)
+				})
+			}
+			wg.Wait()
+			// Verify every call was recorded
+			if got := profiler.Report().Analyzers[0].Calls; got != tt.workers {
+				t.Errorf("Calls = %d, want %d", got, tt.workers)
+			}
+		})
+	}
+}
+
+// TestProfileReport_WriteText tests the WriteText method.
+func TestProfileReport_WriteText(t *testing.T) {
+	tests := []struct {
+		name    string
+		top     int
+		want    []string
+		notWant []string
+	}{
+		{name: "all entries", top: 0, want: []string{"ANALYZER", "slow", "fast", "PACKAGE"}, notWant: []string{}},
+		{name: "top one", top: 1, want: []string{"slow"}, notWant: []string{"fast"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			profiler := orchestrator.NewProfiler()
+			profiler.RecordAnalyzer("slow", time.Second)
+			profiler.RecordAnalyzer("fast", time.Millisecond)
+			profiler.RecordPackage("p", time.Second)
+
+			var buf bytes.Buffer
+			// Check write error
+			if err := profiler.Report().WriteText(&buf, tt.top); err != nil {
+				t.Fatal(err)
+			}
+			// Verify expected content
+			for _, want := range tt.want {
+				// Check substring
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("output missing %q:\n%s", want, buf.String())
+				}
+			}
+			// Verify absent content
+			for _, notWant := range tt.notWant {
+				// Check substring
+				if strings.Contains(buf.String(), notWant) {
+					t.Errorf("output contains %q:\n%s", notWant, buf.String())
+				}
+			}
+		})
+	}
+}
+
+// TestOrchestrator_EnableProfiling tests that loads and analyses are timed.
+func TestOrchestrator_EnableProfiling(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{name: "load and analysis are recorded"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			orch := orchestrator.NewOrchestrator(&bytes.Buffer{}, false)
+			profiler := orch.EnableProfiling()
+			pkgs, err := orch.LoadPackages([]string{"."})
+			// Check load error
+			if err != nil {
+				t.Skipf("cannot load packages: %v", err)
+			}
+			analyzers, err := orch.SelectAnalyzers(orchestrator.Options{OnlyRule: "KTN-VAR-001"})
+			// Check selection error
+			if err != nil {
+				t.Fatal(err)
+			}
+			orch.RunAnalyzers(pkgs, analyzers)
+
+			report := profiler.Report()
+			// Verify recorded tables
+			if len(report.Loads) != 1 || len(report.Packages) == 0 || len(report.Analyzers) == 0 {
+				t.Errorf("report = %+v, want one load, packages and analyzers", report)
+			}
+		})
+	}
+}
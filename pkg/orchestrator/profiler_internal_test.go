@@ -0,0 +1,124 @@
+// Internal tests for the profiler.
+package orchestrator
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// Test_sortedTimings tests the sortedTimings function.
+func Test_sortedTimings(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries map[string]*TimingStat
+		want    []string
+	}{
+		{
+			name:    "empty",
+			entries: map[string]*TimingStat{},
+			want:    []string{},
+		},
+		{
+			name: "by total then name",
+			entries: map[string]*TimingStat{
+				"b": {Name: "b", Total: time.Second},
+				"a": {Name: "a", Total: time.Second},
+				"c": {Name: "c", Total: time.Minute},
+			},
+			want: []string{"c", "a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := sortedTimings(tt.entries)
+			// Verify length
+			if len(got) != len(tt.want) {
+				t.Fatalf("sortedTimings() = %v, want %v", got, tt.want)
+			}
+			// Verify order
+			for i, name := range tt.want {
+				// Check entry name
+				if got[i].Name != name {
+					t.Errorf("entry %d = %s, want %s", i, got[i].Name, name)
+				}
+			}
+		})
+	}
+}
+
+// TestTimingStat_add tests the add method.
+func TestTimingStat_add(t *testing.T) {
+	tests := []struct {
+		name      string
+		durations []time.Duration
+		wantTotal time.Duration
+		wantMax   time.Duration
+	}{
+		{name: "accumulates", durations: []time.Duration{time.Second, 3 * time.Second, time.Second}, wantTotal: 5 * time.Second, wantMax: 3 * time.Second},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			stat := &TimingStat{}
+			// Add each measurement
+			for _, d := range tt.durations {
+				stat.add(d)
+			}
+			// Verify aggregates
+			if stat.Calls != len(tt.durations) || stat.Total != tt.wantTotal || stat.Max != tt.wantMax {
+				t.Errorf("stat = %+v", stat)
+			}
+		})
+	}
+}
+
+// Test_limitStats tests the limitStats function.
+func Test_limitStats(t *testing.T) {
+	tests := []struct {
+		name    string
+		top     int
+		wantLen int
+	}{
+		{name: "no limit", top: 0, wantLen: 3},
+		{name: "limit above length", top: 5, wantLen: 3},
+		{name: "limit below length", top: 2, wantLen: 2},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			stats := []TimingStat{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+			// Verify length
+			if got := limitStats(stats, tt.top); len(got) != tt.wantLen {
+				t.Errorf("limitStats() len = %d, want %d", len(got), tt.wantLen)
+			}
+		})
+	}
+}
+
+// Test_packageLabel tests the packageLabel function.
+func Test_packageLabel(t *testing.T) {
+	tests := []struct {
+		name string
+		pkg  *packages.Package
+		want string
+	}{
+		{name: "plain package", pkg: &packages.Package{ID: "a/b", PkgPath: "a/b"}, want: "a/b"},
+		{name: "test variant", pkg: &packages.Package{ID: "a/b [a/b.test]", PkgPath: "a/b"}, want: "a/b [test]"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify label
+			if got := packageLabel(tt.pkg); got != tt.want {
+				t.Errorf("packageLabel() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@
 package orchestrator
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/token"
@@ -9,10 +11,14 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn"
 	"github.com/kodflow/ktn-linter/pkg/config"
+	"github.com/kodflow/ktn-linter/pkg/cpuprofile"
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/packages"
 )
@@ -28,8 +34,19 @@ type waitGroup interface {
 // AnalysisRunner handles running analyzers on packages.
 // Manages file selection, required analyzer execution, and pass creation.
 type AnalysisRunner struct {
-	stderr  io.Writer
-	verbose bool
+	stderr   io.Writer
+	verbose  bool
+	linesMu  sync.Mutex
+	lines    map[string]int // Line count by analyzed file name
+	profiler *Profiler      // Optional timing recorder (nil = disabled)
+	cfg      *config.Config // Run configuration (nil = global configuration)
+
+	generatorsMu sync.Mutex
+	generators   map[string]string // go:generate label by generated file name
+
+	profilesMu sync.Mutex
+	profiles   map[string]*cpuprofile.Profile // CPU profiles by path (nil = unusable)
+	pgoPath    string                         // CPU profile ranking performance findings (empty = default.pgo)
 }
 
 // NewAnalysisRunner creates a new AnalysisRunner.
@@ -43,8 +60,11 @@ type AnalysisRunner struct {
 func NewAnalysisRunner(stderr io.Writer, verbose bool) *AnalysisRunner {
 	// Return new runner instance
 	return &AnalysisRunner{
-		stderr:  stderr,
-		verbose: verbose,
+		stderr:     stderr,
+		verbose:    verbose,
+		lines:      map[string]int{},
+		generators: map[string]string{},
+		profiles:   map[string]*cpuprofile.Profile{},
 	}
 }
 
@@ -58,21 +78,67 @@ func NewAnalysisRunner(stderr io.Writer, verbose bool) *AnalysisRunner {
 // Returns:
 //   - []DiagnosticResult: collected diagnostics
 func (r *AnalysisRunner) Run(pkgs []*packages.Package, analyzers []*analysis.Analyzer) []DiagnosticResult {
-	// Use channel for concurrent-safe diagnostic collection
-	diagChan := make(chan DiagnosticResult, len(pkgs)*diagChannelBufferMultiplier)
+	// Run without cancellation
+	return r.RunContext(context.Background(), pkgs, analyzers)
+}
+
+// RunContext runs analyzers on packages until done or ctx is cancelled.
+// Packages not yet started when ctx is cancelled are skipped.
+//
+// Params:
+//   - ctx: cancellation context
+//   - pkgs: packages to analyze
+//   - analyzers: analyzers to run
+//
+// Returns:
+//   - []DiagnosticResult: collected diagnostics
+func (r *AnalysisRunner) RunContext(ctx context.Context, pkgs []*packages.Package, analyzers []*analysis.Analyzer) []DiagnosticResult {
+	var allDiagnostics []DiagnosticResult
+	r.run(ctx, pkgs, analyzers, false, func(diags []DiagnosticResult) {
+		allDiagnostics = append(allDiagnostics, diags...)
+	})
+	// Return collected diagnostics
+	return allDiagnostics
+}
+
+// RunStream runs analyzers on packages like RunContext, but hands the
+// findings of each package to emit as soon as the package is analyzed.
+// The syntax trees and type information of analyzed packages are released
+// to keep memory low, so pkgs must not be analyzed again.
+//
+// Params:
+//   - ctx: cancellation context
+//   - pkgs: packages to analyze
+//   - analyzers: analyzers to run
+//   - emit: receives the findings of each package, never concurrently
+func (r *AnalysisRunner) RunStream(ctx context.Context, pkgs []*packages.Package, analyzers []*analysis.Analyzer, emit func([]DiagnosticResult)) {
+	r.run(ctx, pkgs, analyzers, true, emit)
+}
+
+// run analyzes packages on a worker pool limited by GOMAXPROCS and hands
+// the findings of each package to emit from the calling goroutine.
+//
+// Params:
+//   - ctx: cancellation context
+//   - pkgs: packages to analyze
+//   - analyzers: analyzers to run
+//   - release: drop syntax trees and type information after analysis
+//   - emit: receives the findings of each package with findings
+func (r *AnalysisRunner) run(ctx context.Context, pkgs []*packages.Package, analyzers []*analysis.Analyzer, release bool, emit func([]DiagnosticResult)) {
+	resultChan := make(chan packageResult, len(pkgs))
 	var wg sync.WaitGroup
 
 	// Limit concurrent workers to GOMAXPROCS
 	workerCount := runtime.GOMAXPROCS(0)
 	pkgChan := make(chan *packages.Package, len(pkgs))
 
-	// Pre-allocate results map size for workers
-	resultsMapSize := len(analyzers)
+	// Files already covered by a test variant of their package
+	tested := testedFiles(pkgs)
 
 	// Start workers (one goroutine per available CPU)
 	for range workerCount {
 		wg.Add(1)
-		go r.worker(analyzers, pkgChan, diagChan, &wg, resultsMapSize)
+		go r.worker(ctx, analyzers, tested, pkgChan, resultChan, &wg)
 	}
 
 	// Send packages to workers
@@ -84,65 +150,139 @@ func (r *AnalysisRunner) Run(pkgs []*packages.Package, analyzers []*analysis.Ana
 	// Wait for workers and close results channel
 	go func() {
 		wg.Wait()
-		close(diagChan)
+		close(resultChan)
 	}()
 
-	// Collect all diagnostics in result slice
-	var allDiagnostics []DiagnosticResult
 	// Range over channel until closed by background goroutine
-	for diag := range diagChan {
-		allDiagnostics = append(allDiagnostics, diag)
+	for result := range resultChan {
+		// Free the package once analyzed, its findings only need the FileSet
+		if release {
+			result.pkg.Syntax = nil
+			result.pkg.TypesInfo = nil
+		}
+		// Skip packages without findings
+		if len(result.diags) > 0 {
+			emit(result.diags)
+		}
 	}
-
-	// Return collected diagnostics
-	return allDiagnostics
 }
 
-// worker processes packages from pkgChan and sends diagnostics to diagChan.
+// worker processes packages from pkgChan and sends their findings to
+// resultChan.
 //
 // Params:
+//   - ctx: cancellation context
 //   - analyzers: analyzers to run
+//   - tested: files analyzed with a test variant of their package
 //   - pkgChan: channel receiving packages to analyze
-//   - diagChan: channel for sending diagnostics
+//   - resultChan: channel for sending the findings of each package
 //   - wg: wait group to signal completion
-//   - resultsMapSize: pre-computed size for results map
 func (r *AnalysisRunner) worker(
+	ctx context.Context,
 	analyzers []*analysis.Analyzer,
+	tested map[*ast.File]bool,
 	pkgChan <-chan *packages.Package,
-	diagChan chan<- DiagnosticResult,
+	resultChan chan<- packageResult,
 	wg waitGroup,
-	resultsMapSize int,
 ) {
 	defer wg.Done()
 
 	// Process packages from channel
 	for pkg := range pkgChan {
-		// Create fresh results map for each package to avoid cache corruption
-		// between packages (inspect.Analyzer caches AST data that is package-specific)
-		results := make(map[*analysis.Analyzer]any, resultsMapSize)
-		r.analyzePackageParallel(pkg, analyzers, results, diagChan)
+		// Drain remaining packages once cancelled
+		if ctx.Err() != nil {
+			continue
+		}
+		resultChan <- packageResult{pkg: pkg, diags: r.analyzePackage(ctx, pkg, analyzers, tested)}
 	}
 }
 
+// analyzePackage analyzes a package and collects its findings.
+//
+// Params:
+//   - ctx: cancellation context
+//   - pkg: package to analyze
+//   - analyzers: analyzers to run
+//   - tested: files analyzed with a test variant of their package
+//
+// Returns:
+//   - []DiagnosticResult: findings of the package
+func (r *AnalysisRunner) analyzePackage(
+	ctx context.Context,
+	pkg *packages.Package,
+	analyzers []*analysis.Analyzer,
+	tested map[*ast.File]bool,
+) []DiagnosticResult {
+	// Create fresh results map for each package to avoid cache corruption
+	// between packages (inspect.Analyzer caches AST data that is package-specific)
+	results := make(map[*analysis.Analyzer]any, len(analyzers)+1)
+	results[config.Analyzer] = r.configuration()
+
+	diagChan := make(chan DiagnosticResult, diagChannelBufferMultiplier)
+	collected := make(chan []DiagnosticResult, 1)
+	// Collect findings while analyzers report them
+	go func() {
+		var diags []DiagnosticResult
+		// Range over channel until the package is analyzed
+		for diag := range diagChan {
+			diags = append(diags, diag)
+		}
+		collected <- diags
+	}()
+	r.analyzePackageParallel(ctx, pkg, analyzers, tested, results, diagChan)
+	close(diagChan)
+	// Return the findings of the package
+	return <-collected
+}
+
 // analyzePackageParallel analyzes a package and sends diagnostics to a channel.
+// With Tests enabled, go/packages returns each package twice (p and its test
+// variant "p [p.test]") plus the generated test main. Every file is analyzed
+// once: production analyzers run on the base package, test analyzers on the
+// test variant, or on the base package when it has no internal test variant.
 // Uses separate results maps for test vs non-test analyzers to avoid inspect cache issues.
+// Packages with load or type errors are not analyzed and yield KTN-LOAD findings.
 //
 // Params:
+//   - ctx: run context
 //   - pkg: package to analyze
 //   - analyzers: analyzers to run
+//   - tested: files analyzed with a test variant of their package
 //   - results: analyzer results map (modified in-place)
 //   - diagChan: channel for sending diagnostics
 func (r *AnalysisRunner) analyzePackageParallel(
+	ctx context.Context,
 	pkg *packages.Package,
 	analyzers []*analysis.Analyzer,
+	tested map[*ast.File]bool,
 	results map[*analysis.Analyzer]any,
 	diagChan chan<- DiagnosticResult,
 ) {
+	// Generated test mains only contain build cache files
+	if isTestMain(pkg) {
+		// Skip the package
+		return
+	}
+
+	// Report packages with load errors instead of analyzing them
+	if errs := loadErrors(pkg); len(errs) > 0 {
+		// Send one finding per error
+		for _, result := range loadErrorResults(pkg, errs, r.configuration().Verbose) {
+			diagChan <- result
+		}
+		// Skip analysis of the broken package
+		return
+	}
+
 	pkgFset := pkg.Fset
+	r.recordLines(pkg)
+	r.recordGenerators(pkg)
+	start := time.Now()
+	defer func() { r.profiler.RecordPackage(packageLabel(pkg), time.Since(start)) }()
 
 	// Log if verbose
 	if r.verbose {
-		fmt.Fprintf(r.stderr, "Analyzing package: %s\n", pkg.PkgPath)
+		fmt.Fprintf(r.stderr, "Analyzing package: %s\n", packageLabel(pkg))
 	}
 
 	// Separate analyzers into test and non-test groups
@@ -151,7 +291,7 @@ func (r *AnalysisRunner) analyzePackageParallel(
 	// Iterate over analyzers
 	for _, a := range analyzers {
 		// Check if test analyzer
-		if strings.HasPrefix(a.Name, "ktntest") {
+		if isTestAnalyzer(a) {
 			// Add to test analyzers
 			testAnalyzers = append(testAnalyzers, a)
 		} else {
@@ -160,41 +300,261 @@ func (r *AnalysisRunner) analyzePackageParallel(
 		}
 	}
 
-	// Run non-test analyzers first (filtered files)
-	r.runAnalyzerGroup(pkg, pkgFset, nonTestAnalyzers, results, diagChan)
+	variant := isTestVariant(pkg)
+	// Production files are analyzed with the base package; test variants only
+	// add their test files, when rules are forced on tests
+	if !variant || r.configuration().ForceAllRulesOnTests {
+		r.runProductionGroups(ctx, pkg, nonTestAnalyzers, results, diagChan)
+	}
 
-	// Clear results before running test analyzers (different file set)
-	for k := range results {
-		delete(results, k)
+	// Base packages covered by a test variant leave test analyzers to it
+	if !variant && len(pkg.Syntax) > 0 && tested[pkg.Syntax[0]] {
+		// Skip test analyzers
+		return
 	}
 
+	// Clear results before running test analyzers (different file set)
+	clear(results)
+	results[config.Analyzer] = r.configuration()
+
 	// Run test analyzers (all files including *_test.go)
-	r.runAnalyzerGroup(pkg, pkgFset, testAnalyzers, results, diagChan)
+	r.runAnalyzerGroup(ctx, pkg, pkgFset, testAnalyzers, results, diagChan)
+}
+
+// runProductionGroups runs the non-test analyzers of a package, one group
+// per file set of the generated-code policy and of custom rule exclusions.
+//
+// Params:
+//   - ctx: run context
+//   - pkg: package to analyze
+//   - analyzers: non-test analyzers
+//   - results: results map (modified in-place)
+//   - diagChan: diagnostics channel
+func (r *AnalysisRunner) runProductionGroups(
+	ctx context.Context,
+	pkg *packages.Package,
+	analyzers []*analysis.Analyzer,
+	results map[*analysis.Analyzer]any,
+	diagChan chan<- DiagnosticResult,
+) {
+	var groups [][]*analysis.Analyzer
+	// Split off custom rules excluding files of the package
+	for _, group := range r.generatedGroups(analyzers) {
+		groups = append(groups, r.customRuleGroups(pkg, group)...)
+	}
+	// Run each group on its own file set
+	for index, group := range groups {
+		// Requirements of the previous group saw other files
+		if index > 0 {
+			clear(results)
+			results[config.Analyzer] = r.configuration()
+		}
+		r.runAnalyzerGroup(ctx, pkg, pkg.Fset, group, results, diagChan)
+	}
+}
+
+// testedFiles returns the files of internal test variants. go/packages parses
+// each file once, so the base package shares these *ast.File values.
+//
+// Params:
+//   - pkgs: loaded packages
+//
+// Returns:
+//   - map[*ast.File]bool: files belonging to a test variant
+func testedFiles(pkgs []*packages.Package) map[*ast.File]bool {
+	tested := make(map[*ast.File]bool, len(pkgs))
+	// Collect files of test variants
+	for _, pkg := range pkgs {
+		// Skip base packages
+		if !isTestVariant(pkg) {
+			continue
+		}
+		// Mark every file of the variant
+		for _, file := range pkg.Syntax {
+			tested[file] = true
+		}
+	}
+	// Return covered files
+	return tested
+}
+
+// isTestVariant reports whether a package is a test variant ("p [p.test]"
+// or "p_test [p.test]") rather than the base package.
+//
+// Params:
+//   - pkg: loaded package
+//
+// Returns:
+//   - bool: true for test variants
+func isTestVariant(pkg *packages.Package) bool {
+	// Test variants have an ID distinct from their package path
+	return pkg.ID != "" && pkg.ID != pkg.PkgPath
+}
+
+// isTestMain reports whether a package is the test main generated by go test.
+//
+// Params:
+//   - pkg: loaded package
+//
+// Returns:
+//   - bool: true for "p.test" packages
+func isTestMain(pkg *packages.Package) bool {
+	// Generated test mains are named main with a ".test" path
+	return pkg.Name == "main" && pkg.ID == pkg.PkgPath && strings.HasSuffix(pkg.PkgPath, ".test")
+}
+
+// isTestAnalyzer reports whether an analyzer checks test files (KTN-TEST).
+//
+// Params:
+//   - a: analyzer
+//
+// Returns:
+//   - bool: true for test analyzers
+func isTestAnalyzer(a *analysis.Analyzer) bool {
+	// Test analyzers share the ktntest prefix
+	return strings.HasPrefix(a.Name, "ktntest")
+}
+
+// SetConfig sets the configuration used by this runner and its analyzers.
+//
+// Params:
+//   - cfg: run configuration (nil falls back to the global configuration)
+func (r *AnalysisRunner) SetConfig(cfg *config.Config) {
+	r.cfg = cfg
+}
+
+// configuration returns the configuration of the run.
+//
+// Returns:
+//   - *config.Config: run configuration, or the global one when unset
+func (r *AnalysisRunner) configuration() *config.Config {
+	// Prefer the run configuration
+	if r.cfg != nil {
+		// Return run configuration
+		return r.cfg
+	}
+	// Fall back to global configuration
+	return config.Get()
+}
+
+// SetProfiler enables timing of analyzers and packages.
+//
+// Params:
+//   - profiler: timing recorder (nil disables profiling)
+func (r *AnalysisRunner) SetProfiler(profiler *Profiler) {
+	r.profiler = profiler
+}
+
+// packageLabel returns a short label distinguishing test variants of a package.
+//
+// Params:
+//   - pkg: loaded package
+//
+// Returns:
+//   - string: package path, suffixed with " [test]" for test variants
+func packageLabel(pkg *packages.Package) string {
+	// Plain packages have their path as ID
+	if pkg.ID == pkg.PkgPath {
+		// Return package path
+		return pkg.PkgPath
+	}
+	// Return labeled test variant
+	return pkg.PkgPath + " [test]"
+}
+
+// recordLines records the line count of each file of a package.
+// Test variants share files with their base package, so counts are keyed by file name.
+//
+// Params:
+//   - pkg: analyzed package
+func (r *AnalysisRunner) recordLines(pkg *packages.Package) {
+	r.linesMu.Lock()
+	defer r.linesMu.Unlock()
+	// Check for runners created without constructor
+	if r.lines == nil {
+		r.lines = map[string]int{}
+	}
+	// Record each parsed file
+	for _, file := range pkg.Syntax {
+		tokFile := pkg.Fset.File(file.Pos())
+		// Skip files without position information
+		if tokFile == nil {
+			continue
+		}
+		r.lines[tokFile.Name()] = tokFile.LineCount()
+	}
+}
+
+// SourceLines returns the number of files and lines analyzed so far.
+//
+// Returns:
+//   - int: number of distinct files
+//   - int: total number of lines
+func (r *AnalysisRunner) SourceLines() (int, int) {
+	r.linesMu.Lock()
+	defer r.linesMu.Unlock()
+	total := 0
+	// Sum line counts
+	for _, count := range r.lines {
+		total += count
+	}
+	// Return file and line counts
+	return len(r.lines), total
 }
 
 // runAnalyzerGroup runs a group of analyzers on a package.
+// Each run is isolated: a panic or an exceeded deadline becomes a
+// KTN-INTERNAL finding and the next analyzer runs. Cancelling ctx stops
+// the group, keeping the findings already reported.
 //
 // Params:
+//   - ctx: run context
 //   - pkg: package to analyze
 //   - fset: fileset
 //   - analyzers: analyzers to run
 //   - results: results map
 //   - diagChan: diagnostics channel
 func (r *AnalysisRunner) runAnalyzerGroup(
+	ctx context.Context,
 	pkg *packages.Package,
 	fset *token.FileSet,
 	analyzers []*analysis.Analyzer,
 	results map[*analysis.Analyzer]any,
 	diagChan chan<- DiagnosticResult,
 ) {
+	deadline := r.configuration().AnalyzerDeadline()
 	// Run each analyzer
 	for _, a := range analyzers {
+		// Stop once the run is cancelled
+		if ctx.Err() != nil {
+			// Keep findings reported so far
+			return
+		}
+		// Skip custom rules disabled by configuration
+		if code := ktn.CustomRuleCode(a); code != "" && !r.configuration().IsRuleEnabled(code) {
+			continue
+		}
 		pass := r.createPassParallel(a, pkg, fset, diagChan, results)
-		result, err := a.Run(pass)
+		failed, err := r.runRequired(ctx, a, pass.Files, pkg, fset, results)
+		// Skip analyzers whose requirements failed
+		if err != nil {
+			r.reportFailure(pkg, failed, err, deadline, diagChan)
+			continue
+		}
+		pass.ResultOf = passResults(a, results)
+
+		start := time.Now()
+		result, err := invoke(ctx, pass, deadline)
+		r.profiler.RecordAnalyzer(a.Name, time.Since(start))
 
 		// Handle errors
 		if err != nil {
-			fmt.Fprintf(r.stderr, "Error running analyzer %s on %s: %v\n", a.Name, pkg.PkgPath, err)
+			r.reportFailure(pkg, a, err, deadline, diagChan)
+		}
+		// Remember panics and timeouts so dependents are skipped
+		if isFailure(err) {
+			results[a] = err
+			continue
 		}
 
 		// Store result
@@ -202,6 +562,58 @@ func (r *AnalysisRunner) runAnalyzerGroup(
 	}
 }
 
+// reportFailure reports an analyzer failure: panics and exceeded deadlines
+// as KTN-INTERNAL findings, other errors on stderr. Cancellations and
+// already reported requirement failures are silent.
+//
+// Params:
+//   - pkg: analyzed package
+//   - a: failing analyzer
+//   - err: failure
+//   - deadline: configured deadline
+//   - diagChan: diagnostics channel
+func (r *AnalysisRunner) reportFailure(
+	pkg *packages.Package,
+	a *analysis.Analyzer,
+	err error,
+	deadline time.Duration,
+	diagChan chan<- DiagnosticResult,
+) {
+	// Ignore cancellations and requirements already reported
+	if errors.Is(err, errRequirementFailed) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		// Nothing to report
+		return
+	}
+	// Report panics and timeouts as findings
+	if result, ok := internalResult(pkg, a, err, deadline, r.configuration().Verbose); ok {
+		diagChan <- result
+		// Reported as a finding
+		return
+	}
+	fmt.Fprintf(r.stderr, "Error running analyzer %s on %s: %v\n", a.Name, pkg.PkgPath, err)
+}
+
+// passResults returns the ResultOf map of one pass: the run configuration
+// and the results of the analyzer requirements. Each pass owns its map, so
+// an abandoned pass never reads a map the runner keeps writing to.
+//
+// Params:
+//   - a: analyzer of the pass
+//   - results: results computed for the package
+//
+// Returns:
+//   - map[*analysis.Analyzer]any: results visible to the pass
+func passResults(a *analysis.Analyzer, results map[*analysis.Analyzer]any) map[*analysis.Analyzer]any {
+	own := make(map[*analysis.Analyzer]any, len(a.Requires)+1)
+	own[config.Analyzer] = results[config.Analyzer]
+	// Copy required results
+	for _, req := range a.Requires {
+		own[req] = results[req]
+	}
+	// Return pass results
+	return own
+}
+
 // createPassParallel creates an analysis pass for parallel execution.
 // Sends diagnostics to a channel instead of appending to a slice.
 //
@@ -210,7 +622,7 @@ func (r *AnalysisRunner) runAnalyzerGroup(
 //   - pkg: package to analyze
 //   - fset: fileset for positions
 //   - diagChan: channel for sending diagnostics
-//   - results: required analyzer results
+//   - results: analyzer results of the package
 //
 // Returns:
 //   - *analysis.Pass: created pass
@@ -222,7 +634,7 @@ func (r *AnalysisRunner) createPassParallel(
 	results map[*analysis.Analyzer]any,
 ) *analysis.Pass {
 	files := r.selectFiles(a, pkg, fset)
-	r.runRequired(a, files, pkg, fset, results)
+	cfg := r.configuration()
 
 	// Return created pass
 	return &analysis.Pass{
@@ -233,10 +645,25 @@ func (r *AnalysisRunner) createPassParallel(
 		TypesInfo: pkg.TypesInfo,
 		ResultOf:  results,
 		Report: func(diag analysis.Diagnostic) {
+			symbol := EnclosingSymbol(files, diag.Pos)
+			// Attribute findings in generated files to their generator
+			if label := r.generatorOf(fset.Position(diag.Pos).Filename); label != "" {
+				diag.Message = appendGeneratorLabel(diag.Message, label)
+			}
+			// Check exemptions by package or enclosing declaration
+			if cfg.IsSymbolExcluded(findingCode(a.Name, diag.Message), pkg.PkgPath, symbol) {
+				// Drop exempted finding
+				return
+			}
+			hotPath := r.classifyHotPath(&diag, pkg, files, symbol)
 			diagChan <- DiagnosticResult{
 				Diag:         diag,
 				Fset:         fset,
 				AnalyzerName: a.Name,
+				Package:      pkg.PkgPath,
+				Symbol:       symbol,
+				CPUShare:     r.cpuShare(&diag, pkg, files, symbol),
+				HotPath:      hotPath,
 			}
 		},
 		ReadFile: func(filename string) ([]byte, error) {
@@ -257,24 +684,30 @@ func (r *AnalysisRunner) createPassParallel(
 //   - []*ast.File: files to analyze
 func (r *AnalysisRunner) selectFiles(a *analysis.Analyzer, pkg *packages.Package, fset *token.FileSet) []*ast.File {
 	// First filter globally excluded files (applies to ALL analyzers)
-	files := r.filterExcludedFiles(pkg.Syntax, fset)
+	files := r.filterGeneratedFiles(a, r.filterExcludedFiles(pkg.Syntax, fset))
+	files = r.filterCustomRuleFiles(a, files, fset)
 
 	// Test analyzers need both test and non-test files (skip test file filtering)
-	if strings.HasPrefix(a.Name, "ktntest") {
+	if isTestAnalyzer(a) {
 		// Return all non-excluded files for test analyzers
 		return files
 	}
 
 	// Check force mode
-	cfg := config.Get()
-	// Return all files if force mode is enabled
-	if cfg != nil && cfg.ForceAllRulesOnTests {
-		// Return all non-excluded files
-		return files
+	cfg := r.configuration()
+	// Filter test files for other analyzers unless force mode is enabled
+	if cfg == nil || !cfg.ForceAllRulesOnTests {
+		// Return non-test files
+		return r.filterTestFiles(files, fset)
 	}
 
-	// Filter test files for other analyzers
-	return r.filterTestFiles(files, fset)
+	// Production files of test variants were analyzed with the base package
+	if isTestVariant(pkg) {
+		// Return test files only
+		return r.keepTestFiles(files, fset)
+	}
+	// Return all non-excluded files
+	return files
 }
 
 // filterExcludedFiles filters out globally excluded files.
@@ -286,7 +719,7 @@ func (r *AnalysisRunner) selectFiles(a *analysis.Analyzer, pkg *packages.Package
 // Returns:
 //   - []*ast.File: filtered files (excluding globally excluded)
 func (r *AnalysisRunner) filterExcludedFiles(files []*ast.File, fset *token.FileSet) []*ast.File {
-	cfg := config.Get()
+	cfg := r.configuration()
 	// Check if filtering should be skipped
 	if !r.shouldFilterExcluded(cfg, fset) {
 		return files
@@ -304,6 +737,104 @@ func (r *AnalysisRunner) filterExcludedFiles(files []*ast.File, fset *token.File
 	return filtered
 }
 
+// filterCustomRuleFiles filters out the files excluded for a custom rule.
+// Built-in analyzers check their own exclusions; custom rules registered
+// with ktn.RegisterRule get theirs applied here.
+//
+// Params:
+//   - a: analyzer
+//   - files: files to filter
+//   - fset: fileset for position
+//
+// Returns:
+//   - []*ast.File: files not excluded for the rule
+func (r *AnalysisRunner) filterCustomRuleFiles(a *analysis.Analyzer, files []*ast.File, fset *token.FileSet) []*ast.File {
+	code := ktn.CustomRuleCode(a)
+	// Keep files of built-in analyzers
+	if code == "" || fset == nil {
+		// Return files unchanged
+		return files
+	}
+
+	cfg := r.configuration()
+	filtered := make([]*ast.File, 0, len(files))
+	// Keep files not excluded for the rule
+	for _, file := range files {
+		filename := ruleFilename(fset, file)
+		// Skip excluded files
+		if cfg.IsFileExcluded(code, filename) {
+			r.logExcludedFile(filename)
+			continue
+		}
+		filtered = append(filtered, file)
+	}
+	// Return filtered files
+	return filtered
+}
+
+// customRuleGroups splits off the custom rules excluding files of a package.
+// Requirements such as the inspector are computed once per group, so a rule
+// seeing fewer files runs alone with its own results.
+//
+// Params:
+//   - pkg: analyzed package
+//   - analyzers: analyzers sharing a file set
+//
+// Returns:
+//   - [][]*analysis.Analyzer: shared group first, then one group per rule
+func (r *AnalysisRunner) customRuleGroups(pkg *packages.Package, analyzers []*analysis.Analyzer) [][]*analysis.Analyzer {
+	shared := make([]*analysis.Analyzer, 0, len(analyzers))
+	var excluding [][]*analysis.Analyzer
+	// Dispatch analyzers by file set
+	for _, a := range analyzers {
+		// Isolate rules that do not see every file
+		if r.excludesPackageFiles(a, pkg) {
+			excluding = append(excluding, []*analysis.Analyzer{a})
+			continue
+		}
+		shared = append(shared, a)
+	}
+	// Return groups with analyzers
+	return append([][]*analysis.Analyzer{shared}, excluding...)
+}
+
+// excludesPackageFiles reports whether a custom rule excludes files of a
+// package.
+//
+// Params:
+//   - a: analyzer
+//   - pkg: analyzed package
+//
+// Returns:
+//   - bool: true when the rule sees fewer files than the package holds
+func (r *AnalysisRunner) excludesPackageFiles(a *analysis.Analyzer, pkg *packages.Package) bool {
+	code := ktn.CustomRuleCode(a)
+	// Built-in analyzers check their own exclusions
+	if code == "" || pkg.Fset == nil {
+		// Return no exclusion
+		return false
+	}
+	cfg := r.configuration()
+	// Return whether a file is excluded for the rule
+	return slices.ContainsFunc(pkg.Syntax, func(file *ast.File) bool {
+		// Check the file against the rule exclusions
+		return cfg.IsFileExcluded(code, ruleFilename(pkg.Fset, file))
+	})
+}
+
+// ruleFilename returns the slash-separated path matched by rule exclusions.
+//
+// Params:
+//   - fset: fileset for position
+//   - file: parsed file
+//
+// Returns:
+//   - string: cleaned file path
+func ruleFilename(fset *token.FileSet, file *ast.File) string {
+	// Return cleaned path
+	return filepath.ToSlash(filepath.Clean(fset.Position(file.Pos()).Filename))
+}
+
 // shouldFilterExcluded checks if exclusion filtering should be applied.
 //
 // Params:
@@ -385,26 +916,61 @@ func (r *AnalysisRunner) filterTestFiles(files []*ast.File, fset *token.FileSet)
 	return filtered
 }
 
+// keepTestFiles keeps only test files.
+//
+// Params:
+//   - files: files to filter
+//   - fset: fileset for position
+//
+// Returns:
+//   - []*ast.File: test files
+func (r *AnalysisRunner) keepTestFiles(files []*ast.File, fset *token.FileSet) []*ast.File {
+	kept := make([]*ast.File, 0, len(files))
+	// Iterate over files
+	for _, file := range files {
+		pos := fset.Position(file.Pos())
+		// Keep test files
+		if strings.HasSuffix(pos.Filename, "_test.go") {
+			kept = append(kept, file)
+		}
+	}
+	// Return test files
+	return kept
+}
+
 // runRequired runs required analyzers first.
 // Caches results to avoid re-running the same analyzer multiple times per package.
+// A failed requirement is remembered, so it is reported once per package.
 //
 // Params:
+//   - ctx: run context
 //   - a: analyzer with requirements
 //   - files: files to analyze
 //   - pkg: package
 //   - fset: fileset
 //   - results: results map (modified in-place)
+//
+// Returns:
+//   - *analysis.Analyzer: failing requirement, nil on success
+//   - error: failure of the requirement, errRequirementFailed if already reported
 func (r *AnalysisRunner) runRequired(
+	ctx context.Context,
 	a *analysis.Analyzer,
 	files []*ast.File,
 	pkg *packages.Package,
 	fset *token.FileSet,
 	results map[*analysis.Analyzer]any,
-) {
+) (*analysis.Analyzer, error) {
+	deadline := r.configuration().AnalyzerDeadline()
 	// Run required analyzers
 	for _, req := range a.Requires {
 		// Skip if already computed for this package
-		if _, exists := results[req]; exists {
+		if prev, exists := results[req]; exists {
+			// Stop on a requirement that already failed
+			if isFailure(prev) {
+				// Return silent failure
+				return req, errRequirementFailed
+			}
 			continue
 		}
 
@@ -414,14 +980,24 @@ func (r *AnalysisRunner) runRequired(
 			Files:     files,
 			Pkg:       pkg.Types,
 			TypesInfo: pkg.TypesInfo,
-			ResultOf:  results,
+			ResultOf:  passResults(req, results),
 			Report:    func(analysis.Diagnostic) {},
 			ReadFile: func(filename string) ([]byte, error) {
 				// Return file content
 				return os.ReadFile(filename)
 			},
 		}
-		result, _ := req.Run(reqPass)
+		start := time.Now()
+		result, err := invoke(ctx, reqPass, deadline)
+		r.profiler.RecordAnalyzer(req.Name, time.Since(start))
+		// Remember panics and timeouts of the requirement
+		if isFailure(err) {
+			results[req] = err
+			// Return requirement failure
+			return req, err
+		}
 		results[req] = result
 	}
+	// Return success
+	return nil, nil
 }
@@ -3,10 +3,22 @@ package orchestrator_test
 
 import (
 	"bytes"
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn"
+	"github.com/kodflow/ktn-linter/pkg/config"
 	"github.com/kodflow/ktn-linter/pkg/orchestrator"
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
 	"golang.org/x/tools/go/packages"
 )
 
@@ -78,3 +90,449 @@ func TestAnalysisRunner_Run(t *testing.T) {
 		})
 	}
 }
+
+// TestAnalysisRunner_SourceLines tests line counting of analyzed files.
+func TestAnalysisRunner_SourceLines(t *testing.T) {
+	tests := []struct {
+		name      string
+		sources   map[string]string
+		wantFiles int
+		wantLines int
+	}{
+		{
+			name:      "no package analyzed",
+			sources:   map[string]string{},
+			wantFiles: 0,
+			wantLines: 0,
+		},
+		{
+			name: "two files",
+			sources: map[string]string{
+				"a.go": "package p\n\nvar A int\n",
+				"b.go": "package p\n",
+			},
+			wantFiles: 2,
+			wantLines: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			pkg := &packages.Package{PkgPath: "example.com/p", Fset: fset}
+			// Parse each source file
+			for name, src := range tt.sources {
+				file, err := parser.ParseFile(fset, name, src, 0)
+				// Check parse error
+				if err != nil {
+					t.Fatalf("parse %s: %v", name, err)
+				}
+				pkg.Syntax = append(pkg.Syntax, file)
+			}
+
+			runner := orchestrator.NewAnalysisRunner(&bytes.Buffer{}, false)
+			pkgs := []*packages.Package{}
+			// Only run when there is something to analyze
+			if len(pkg.Syntax) > 0 {
+				pkgs = append(pkgs, pkg)
+			}
+			runner.Run(pkgs, []*analysis.Analyzer{})
+
+			files, lines := runner.SourceLines()
+			// Verify counts
+			if files != tt.wantFiles || lines != tt.wantLines {
+				t.Errorf("SourceLines() = %d, %d, want %d, %d", files, lines, tt.wantFiles, tt.wantLines)
+			}
+		})
+	}
+}
+
+// TestAnalysisRunner_Run_testVariants tests that test variants do not analyze
+// production files twice.
+func TestAnalysisRunner_Run_testVariants(t *testing.T) {
+	tests := []struct {
+		name     string
+		forceAll bool
+		want     map[string]int
+	}{
+		{
+			name:     "production rules skip test files",
+			forceAll: false,
+			want: map[string]int{
+				"prod p.go": 1, "prod q.go": 1,
+				"test p.go": 1, "test p_internal_test.go": 1, "test p_external_test.go": 1,
+				"test q.go": 1, "test q_external_test.go": 1,
+			},
+		},
+		{
+			name:     "forced production rules see each test file once",
+			forceAll: true,
+			want: map[string]int{
+				"prod p.go": 1, "prod p_internal_test.go": 1, "prod p_external_test.go": 1,
+				"prod q.go": 1, "prod q_external_test.go": 1,
+				"test p.go": 1, "test p_internal_test.go": 1, "test p_external_test.go": 1,
+				"test q.go": 1, "test q_external_test.go": 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			files := map[string]string{
+				"go.mod":               "module variants\n\ngo 1.25\n",
+				"p/p.go":               "package p\n",
+				"p/p_internal_test.go": "package p\n",
+				"p/p_external_test.go": "package p_test\n",
+				"q/q.go":               "package q\n",
+				"q/q_external_test.go": "package q_test\n",
+			}
+			// Write the test module
+			for name, content := range files {
+				path := filepath.Join(dir, name)
+				// Check directory creation error
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				// Check write error
+				if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			cfg := config.DefaultConfig()
+			cfg.ForceAllRulesOnTests = tt.forceAll
+			orch := orchestrator.NewOrchestrator(&bytes.Buffer{}, false)
+			orch.SetConfig(cfg)
+			pkgs, err := orch.LoadPackagesFromDir(dir, []string{"./..."})
+			// Check load error
+			if err != nil {
+				t.Fatalf("LoadPackagesFromDir() error = %v", err)
+			}
+
+			analyzers := []*analysis.Analyzer{
+				fileReporter("prod", "prod"),
+				fileReporter("ktntestfiles", "test"),
+			}
+			got := map[string]int{}
+			// Count raw findings, before any deduplication
+			for _, d := range orch.RunAnalyzers(pkgs, analyzers) {
+				got[d.Diag.Message]++
+			}
+			// Verify each file is analyzed once per analyzer group
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("findings = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// fileReporter returns an analyzer reporting each analyzed file.
+//
+// Params:
+//   - name: analyzer name
+//   - prefix: message prefix
+//
+// Returns:
+//   - *analysis.Analyzer: reporting analyzer
+func fileReporter(name, prefix string) *analysis.Analyzer {
+	// Return analyzer reporting "prefix file.go" once per file
+	return &analysis.Analyzer{
+		Name: name,
+		Doc:  "reports analyzed files",
+		Run: func(pass *analysis.Pass) (any, error) {
+			// Report each file
+			for _, file := range pass.Files {
+				pass.Reportf(file.Package, "%s %s", prefix, filepath.Base(pass.Fset.File(file.Package).Name()))
+			}
+			return nil, nil
+		},
+	}
+}
+
+// TestAnalysisRunner_RunContext_isolation tests that panics and timeouts
+// become KTN-INTERNAL findings without stopping the other analyzers.
+func TestAnalysisRunner_RunContext_isolation(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	panicky := &analysis.Analyzer{
+		Name: "panicky",
+		Doc:  "panics",
+		Run:  func(*analysis.Pass) (any, error) { panic("boom") },
+	}
+	analyzers := []*analysis.Analyzer{
+		panicky,
+		{
+			Name: "slow",
+			Doc:  "never finishes in time",
+			Run: func(*analysis.Pass) (any, error) {
+				<-release
+				return nil, nil
+			},
+		},
+		{
+			Name:     "dependent",
+			Doc:      "requires the panicking analyzer",
+			Requires: []*analysis.Analyzer{panicky},
+			Run:      func(*analysis.Pass) (any, error) { return nil, nil },
+		},
+		fileReporter("prod", "prod"),
+	}
+
+	pkgs := loadSingleFileModule(t)
+	cfg := config.DefaultConfig()
+	cfg.AnalyzerTimeout = "50ms"
+	runner := orchestrator.NewAnalysisRunner(&bytes.Buffer{}, false)
+	runner.SetConfig(cfg)
+
+	got := map[string]int{}
+	// Count findings by rule code or message
+	for _, d := range runner.RunContext(context.Background(), pkgs, analyzers) {
+		key, _, _ := strings.Cut(d.Diag.Message, ":")
+		got[key]++
+	}
+	want := map[string]int{"KTN-INTERNAL-001": 1, "KTN-INTERNAL-002": 1, "prod p.go": 1}
+	// Verify each failure is reported once and other analyzers still run
+	if !maps.Equal(got, want) {
+		t.Errorf("findings = %v, want %v", got, want)
+	}
+}
+
+// TestAnalysisRunner_RunContext_cancelled tests that a cancelled run stops
+// before running analyzers.
+func TestAnalysisRunner_RunContext_cancelled(t *testing.T) {
+	pkgs := loadSingleFileModule(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	runner := orchestrator.NewAnalysisRunner(&bytes.Buffer{}, false)
+
+	got := runner.RunContext(ctx, pkgs, []*analysis.Analyzer{fileReporter("prod", "prod")})
+	// Verify no analyzer ran
+	if len(got) != 0 {
+		t.Errorf("expected no findings, got %d", len(got))
+	}
+}
+
+// inspectReporter returns an analyzer reporting each file of its inspector.
+//
+// Params:
+//   - name: analyzer name
+//   - prefix: message prefix
+//
+// Returns:
+//   - *analysis.Analyzer: reporting analyzer
+func inspectReporter(name, prefix string) *analysis.Analyzer {
+	// Return analyzer reporting "prefix file.go" once per inspected file
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      "reports inspected files",
+		Requires: []*analysis.Analyzer{inspect.Analyzer},
+		Run: func(pass *analysis.Pass) (any, error) {
+			insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+			// Report each inspected file
+			insp.Preorder([]ast.Node{(*ast.File)(nil)}, func(n ast.Node) {
+				file := n.(*ast.File)
+				pass.Reportf(file.Package, "%s %s", prefix, filepath.Base(pass.Fset.File(file.Package).Name()))
+			})
+			return nil, nil
+		},
+	}
+}
+
+// customReporter is the analyzer of the custom rule registered for runner tests.
+var customReporter *analysis.Analyzer = fileReporter("acmerun001", "ACME-RUN-001:")
+
+// customInspector is the inspector-based custom rule registered for runner tests.
+var customInspector *analysis.Analyzer = inspectReporter("acmerun002", "ACME-RUN-002:")
+
+// init registers the custom category and rule used by runner tests.
+func init() {
+	// Register the custom category
+	if err := ktn.RegisterCategory("run"); err != nil {
+		panic(err)
+	}
+	// Register the custom rule
+	if err := ktn.RegisterRule(&ktn.RuleSpec{Code: "ACME-RUN-001", Analyzer: customReporter}); err != nil {
+		panic(err)
+	}
+	// Register the inspector-based custom rule
+	if err := ktn.RegisterRule(&ktn.RuleSpec{Code: "ACME-RUN-002", Analyzer: customInspector}); err != nil {
+		panic(err)
+	}
+}
+
+// TestAnalysisRunner_Run_customRuleConfig tests that enabled and exclude
+// apply to custom rules that do not check the configuration themselves.
+func TestAnalysisRunner_Run_customRuleConfig(t *testing.T) {
+	disabled := false
+	tests := []struct {
+		name string
+		rule *config.RuleConfig
+		want int
+	}{
+		{name: "default configuration", rule: nil, want: 1},
+		{name: "disabled rule", rule: &config.RuleConfig{Enabled: &disabled}, want: 0},
+		{name: "excluded file", rule: &config.RuleConfig{Exclude: []string{"p.go"}}, want: 0},
+		{name: "other file excluded", rule: &config.RuleConfig{Exclude: []string{"q.go"}}, want: 1},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			// Configure the custom rule
+			if tt.rule != nil {
+				cfg.Rules = map[string]*config.RuleConfig{"ACME-RUN-001": tt.rule}
+			}
+			runner := orchestrator.NewAnalysisRunner(&bytes.Buffer{}, false)
+			runner.SetConfig(cfg)
+
+			got := runner.Run(loadSingleFileModule(t), []*analysis.Analyzer{customReporter})
+			// Verify findings count
+			if len(got) != tt.want {
+				t.Errorf("Run() = %d findings, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
+
+// TestAnalysisRunner_Run_customRuleInspector tests that a custom rule
+// excluding files gets its own inspector, whatever the analyzer order.
+func TestAnalysisRunner_Run_customRuleInspector(t *testing.T) {
+	builtin := inspectReporter("inspectprod", "prod")
+	tests := []struct {
+		name      string
+		analyzers []*analysis.Analyzer
+	}{
+		{name: "built-in rule first", analyzers: []*analysis.Analyzer{builtin, customInspector}},
+		{name: "custom rule first", analyzers: []*analysis.Analyzer{customInspector, builtin}},
+	}
+	want := map[string]int{"prod p.go": 1, "prod q.go": 1, "ACME-RUN-002: p.go": 1}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			files := map[string]string{"go.mod": "module pair\n\ngo 1.25\n", "p.go": "package p\n", "q.go": "package p\n"}
+			// Write the test module
+			for name, content := range files {
+				// Check write error
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			pkgs, err := orchestrator.NewOrchestrator(&bytes.Buffer{}, false).LoadPackagesFromDir(dir, []string{"./..."})
+			// Check load error
+			if err != nil {
+				t.Fatal(err)
+			}
+			cfg := config.DefaultConfig()
+			cfg.Rules = map[string]*config.RuleConfig{"ACME-RUN-002": {Exclude: []string{"q.go"}}}
+			runner := orchestrator.NewAnalysisRunner(&bytes.Buffer{}, false)
+			runner.SetConfig(cfg)
+
+			got := map[string]int{}
+			// Count findings by message
+			for _, d := range runner.Run(pkgs, tt.analyzers) {
+				got[d.Diag.Message]++
+			}
+			// Verify each rule inspected its own files
+			if !maps.Equal(got, want) {
+				t.Errorf("findings = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+// loadSingleFileModule loads a module holding a single package p.
+//
+// Params:
+//   - t: test context
+//
+// Returns:
+//   - []*packages.Package: loaded packages
+func loadSingleFileModule(t *testing.T) []*packages.Package {
+	t.Helper()
+	dir := t.TempDir()
+	// Write go.mod
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module single\n\ngo 1.25\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// Write the package file
+	if err := os.WriteFile(filepath.Join(dir, "p.go"), []byte("package p\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	orch := orchestrator.NewOrchestrator(&bytes.Buffer{}, false)
+	pkgs, err := orch.LoadPackagesFromDir(dir, []string{"./..."})
+	// Check load error
+	if err != nil {
+		t.Fatalf("LoadPackagesFromDir() error = %v", err)
+	}
+	// Return loaded packages
+	return pkgs
+}
+
+// TestAnalysisRunner_RunStream tests that findings are handed over package
+// by package and that analyzed packages are released.
+func TestAnalysisRunner_RunStream(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		batches int
+	}{
+		{name: "one batch per package", files: map[string]string{"a/a.go": "package a\n", "b/b.go": "package b\n"}, batches: 2},
+		{name: "single package", files: map[string]string{"a/a.go": "package a\n"}, batches: 1},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			files := maps.Clone(tt.files)
+			files["go.mod"] = "module stream\n\ngo 1.25\n"
+			// Write the test module
+			for name, content := range files {
+				path := filepath.Join(dir, name)
+				// Check directory creation error
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				// Check write error
+				if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			pkgs, err := orchestrator.NewOrchestrator(&bytes.Buffer{}, false).LoadPackagesFromDir(dir, []string{"./..."})
+			// Check load error
+			if err != nil {
+				t.Fatalf("LoadPackagesFromDir() error = %v", err)
+			}
+
+			var batches [][]orchestrator.DiagnosticResult
+			runner := orchestrator.NewAnalysisRunner(&bytes.Buffer{}, false)
+			runner.RunStream(context.Background(), pkgs, []*analysis.Analyzer{fileReporter("prod", "prod")}, func(diags []orchestrator.DiagnosticResult) {
+				batches = append(batches, diags)
+			})
+			// Verify one batch per package holding its own finding
+			if len(batches) != tt.batches {
+				t.Fatalf("batches = %d, want %d", len(batches), tt.batches)
+			}
+			// Verify each batch holds the finding of one package
+			for _, batch := range batches {
+				// Check batch size
+				if len(batch) != 1 {
+					t.Errorf("batch = %v, want one finding", batch)
+				}
+			}
+			// Verify analyzed packages are released
+			for _, pkg := range pkgs {
+				// Check released syntax and types
+				if pkg.Syntax != nil || pkg.TypesInfo != nil {
+					t.Errorf("package %s still holds its syntax or type information", pkg.PkgPath)
+				}
+			}
+		})
+	}
+}
@@ -3,7 +3,9 @@ package orchestrator
 
 import (
 	"bytes"
+	"context"
 	"go/ast"
+	"go/parser"
 	"go/token"
 	"strings"
 	"sync"
@@ -47,7 +49,7 @@ func TestAnalysisRunner_analyzePackageParallel(t *testing.T) {
 			diagChan := make(chan DiagnosticResult, 10)
 
 			// Should not panic
-			runner.analyzePackageParallel(pkg, []*analysis.Analyzer{}, results, diagChan)
+			runner.analyzePackageParallel(context.Background(), pkg, []*analysis.Analyzer{}, map[*ast.File]bool{}, results, diagChan)
 			close(diagChan)
 
 			// Verify verbose output
@@ -144,6 +146,58 @@ func TestAnalysisRunner_createPassParallel(t *testing.T) {
 	}
 }
 
+// TestAnalysisRunner_createPassParallel_excludeSymbols tests that findings
+// exempted by symbol or package never reach the channel.
+func TestAnalysisRunner_createPassParallel_excludeSymbols(t *testing.T) {
+	tests := []struct {
+		name     string
+		analyzer string
+		code     string
+		rule     *config.RuleConfig
+		message  string
+		expected bool
+	}{
+		{name: "no exclusion", analyzer: "test", code: "KTN-FUNC-006", rule: &config.RuleConfig{}, message: "KTN-FUNC-006: too many params", expected: true},
+		{name: "excluded symbol", analyzer: "test", code: "KTN-FUNC-006", rule: &config.RuleConfig{ExcludeSymbols: []string{"api.*Handler.ServeHTTP"}}, message: "KTN-FUNC-006: too many params", expected: false},
+		{name: "excluded package", analyzer: "test", code: "KTN-FUNC-006", rule: &config.RuleConfig{ExcludePackages: []string{"example.com/..."}}, message: "KTN-FUNC-006: too many params", expected: false},
+		{name: "other rule", analyzer: "test", code: "KTN-FUNC-006", rule: &config.RuleConfig{ExcludePackages: []string{"example.com/..."}}, message: "KTN-FUNC-001: error last", expected: true},
+		{name: "excluded modernize symbol", analyzer: "minmax", code: "KTN-MDRNZ-MINMAX", rule: &config.RuleConfig{ExcludeSymbols: []string{"api.*Handler.ServeHTTP"}}, message: "if statement can be modernized using max", expected: false},
+		{name: "other modernize rule", analyzer: "rangeint", code: "KTN-MDRNZ-MINMAX", rule: &config.RuleConfig{ExcludeSymbols: []string{"api.*Handler.ServeHTTP"}}, message: "for loop can be modernized using range over int", expected: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "api.go", "package api\n\nfunc (h *UserHandler) ServeHTTP() {}\n", 0)
+			// Verify parsing
+			if err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			cfg := config.DefaultConfig()
+			cfg.Rules[tt.code] = tt.rule
+			runner := NewAnalysisRunner(&bytes.Buffer{}, false)
+			runner.SetConfig(cfg)
+			pkg := &packages.Package{PkgPath: "example.com/pkg/api", Fset: fset, Syntax: []*ast.File{file}}
+			diagChan := make(chan DiagnosticResult, 1)
+			analyzer := &analysis.Analyzer{Name: tt.analyzer, Run: func(*analysis.Pass) (any, error) { return nil, nil }}
+
+			pass := runner.createPassParallel(analyzer, pkg, fset, diagChan, map[*analysis.Analyzer]any{})
+			pass.Report(analysis.Diagnostic{Pos: file.Decls[0].Pos(), Message: tt.message})
+			close(diagChan)
+			diag, reported := <-diagChan
+			// Verify the finding was kept or dropped
+			if reported != tt.expected {
+				t.Fatalf("reported = %v, want %v", reported, tt.expected)
+			}
+			// Verify the symbol is attached to kept findings
+			if reported && diag.Symbol != "UserHandler.ServeHTTP" {
+				t.Errorf("Symbol = %q, want %q", diag.Symbol, "UserHandler.ServeHTTP")
+			}
+		})
+	}
+}
+
 // TestAnalysisRunner_worker tests the worker method.
 func TestAnalysisRunner_worker(t *testing.T) {
 	tests := []struct {
@@ -168,7 +222,7 @@ func TestAnalysisRunner_worker(t *testing.T) {
 			}
 
 			pkgChan := make(chan *packages.Package, 1)
-			diagChan := make(chan DiagnosticResult, 10)
+			resultChan := make(chan packageResult, 10)
 			var wg sync.WaitGroup
 
 			pkgChan <- pkg
@@ -176,10 +230,15 @@ func TestAnalysisRunner_worker(t *testing.T) {
 
 			wg.Add(1)
 			// Worker will call wg.Done() via defer
-			runner.worker([]*analysis.Analyzer{}, pkgChan, diagChan, &wg, 0)
-			close(diagChan)
+			runner.worker(context.Background(), []*analysis.Analyzer{}, map[*ast.File]bool{}, pkgChan, resultChan, &wg)
+			close(resultChan)
 			// Wait for worker to complete
 			wg.Wait()
+
+			// Verify one result per package
+			if result := <-resultChan; result.pkg != pkg || len(result.diags) != 0 {
+				t.Errorf("worker result = %+v, want the package without findings", result)
+			}
 		})
 	}
 }
@@ -473,7 +532,7 @@ func TestAnalysisRunner_runRequired(t *testing.T) {
 			}
 
 			// Should not panic
-			runner.runRequired(analyzer, []*ast.File{}, pkg, fset, results)
+			runner.runRequired(context.Background(), analyzer, []*ast.File{}, pkg, fset, results)
 
 			// Verify required analyzer was run
 			if tt.wantRun && len(tt.requires) > 0 {
@@ -567,3 +626,170 @@ func TestAnalysisRunner_filterExcludedFiles(t *testing.T) {
 		})
 	}
 }
+
+// Test_isTestVariant tests detection of test variants.
+func Test_isTestVariant(t *testing.T) {
+	tests := []struct {
+		name string
+		pkg  *packages.Package
+		want bool
+	}{
+		{name: "base package", pkg: &packages.Package{ID: "m/p", PkgPath: "m/p"}, want: false},
+		{name: "package without ID", pkg: &packages.Package{PkgPath: "m/p"}, want: false},
+		{name: "internal test variant", pkg: &packages.Package{ID: "m/p [m/p.test]", PkgPath: "m/p"}, want: true},
+		{name: "external test package", pkg: &packages.Package{ID: "m/p_test [m/p.test]", PkgPath: "m/p_test"}, want: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify detection
+			if got := isTestVariant(tt.pkg); got != tt.want {
+				t.Errorf("isTestVariant(%q) = %v, want %v", tt.pkg.ID, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_isTestMain tests detection of generated test mains.
+func Test_isTestMain(t *testing.T) {
+	tests := []struct {
+		name string
+		pkg  *packages.Package
+		want bool
+	}{
+		{name: "generated test main", pkg: &packages.Package{ID: "m/p.test", PkgPath: "m/p.test", Name: "main"}, want: true},
+		{name: "regular main", pkg: &packages.Package{ID: "m/cmd", PkgPath: "m/cmd", Name: "main"}, want: false},
+		{name: "library", pkg: &packages.Package{ID: "m/p", PkgPath: "m/p", Name: "p"}, want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify detection
+			if got := isTestMain(tt.pkg); got != tt.want {
+				t.Errorf("isTestMain(%q) = %v, want %v", tt.pkg.ID, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_isTestAnalyzer tests detection of test analyzers.
+func Test_isTestAnalyzer(t *testing.T) {
+	tests := []struct {
+		name     string
+		analyzer string
+		want     bool
+	}{
+		{name: "test analyzer", analyzer: "ktntest001", want: true},
+		{name: "production analyzer", analyzer: "ktnfunc001", want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify detection
+			if got := isTestAnalyzer(&analysis.Analyzer{Name: tt.analyzer}); got != tt.want {
+				t.Errorf("isTestAnalyzer(%q) = %v, want %v", tt.analyzer, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_testedFiles tests collecting files covered by test variants.
+func Test_testedFiles(t *testing.T) {
+	prod, internal, external := &ast.File{}, &ast.File{}, &ast.File{}
+	tests := []struct {
+		name string
+		pkgs []*packages.Package
+		want []*ast.File
+	}{
+		{
+			name: "base package only",
+			pkgs: []*packages.Package{{ID: "m/p", PkgPath: "m/p", Syntax: []*ast.File{prod}}},
+			want: []*ast.File{},
+		},
+		{
+			name: "base and test variants",
+			pkgs: []*packages.Package{
+				{ID: "m/p", PkgPath: "m/p", Syntax: []*ast.File{prod}},
+				{ID: "m/p [m/p.test]", PkgPath: "m/p", Syntax: []*ast.File{prod, internal}},
+				{ID: "m/p_test [m/p.test]", PkgPath: "m/p_test", Syntax: []*ast.File{external}},
+			},
+			want: []*ast.File{prod, internal, external},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := testedFiles(tt.pkgs)
+			// Verify covered file count
+			if len(got) != len(tt.want) {
+				t.Errorf("testedFiles() has %d files, want %d", len(got), len(tt.want))
+			}
+			// Verify each expected file is covered
+			for _, file := range tt.want {
+				// Check coverage
+				if !got[file] {
+					t.Errorf("testedFiles() misses %p", file)
+				}
+			}
+		})
+	}
+}
+
+// TestAnalysisRunner_keepTestFiles tests keeping only test files.
+func TestAnalysisRunner_keepTestFiles(t *testing.T) {
+	tests := []struct {
+		name      string
+		filenames []string
+		wantLen   int
+	}{
+		{name: "mix of test and non-test files", filenames: []string{"main.go", "main_test.go", "util.go"}, wantLen: 1},
+		{name: "only non-test files", filenames: []string{"main.go"}, wantLen: 0},
+		{name: "no files", filenames: []string{}, wantLen: 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			runner := NewAnalysisRunner(&bytes.Buffer{}, false)
+			fset := token.NewFileSet()
+			files := make([]*ast.File, 0, len(tt.filenames))
+			// Register each file
+			for _, filename := range tt.filenames {
+				files = append(files, &ast.File{Package: fset.AddFile(filename, -1, 100).Pos(0)})
+			}
+
+			// Verify result length
+			if got := runner.keepTestFiles(files, fset); len(got) != tt.wantLen {
+				t.Errorf("expected %d files, got %d", tt.wantLen, len(got))
+			}
+		})
+	}
+}
+
+// Test_passResults tests the per-pass ResultOf map.
+func Test_passResults(t *testing.T) {
+	req := &analysis.Analyzer{Name: "req"}
+	other := &analysis.Analyzer{Name: "other"}
+	a := &analysis.Analyzer{Name: "a", Requires: []*analysis.Analyzer{req}}
+	cfg := config.DefaultConfig()
+	results := map[*analysis.Analyzer]any{config.Analyzer: cfg, req: "req result", other: "other result"}
+
+	got := passResults(a, results)
+	// Verify configuration and requirement results are visible
+	if got[config.Analyzer] != cfg || got[req] != "req result" {
+		t.Errorf("passResults() = %v", got)
+	}
+	// Verify unrelated results are not shared
+	if _, ok := got[other]; ok {
+		t.Error("unexpected result of an analyzer not required")
+	}
+	got[req] = "changed"
+	// Verify the map is owned by the pass
+	if results[req] != "req result" {
+		t.Error("passResults() shares the runner map")
+	}
+}
@@ -0,0 +1,236 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// SetJobs sets how many modules are loaded and analyzed at the same time
+// by multi-module runs. Build matrix runs always load one module at a time.
+//
+// Params:
+//   - jobs: concurrent modules (0 or less for GOMAXPROCS)
+func (o *Orchestrator) SetJobs(jobs int) {
+	o.jobs = jobs
+}
+
+// StreamAnalyzersContext runs analyzers on packages like RunAnalyzersContext,
+// but hands the findings of each package to emit as soon as it is analyzed.
+// The syntax trees and type information of analyzed packages are released,
+// so pkgs must not be analyzed again.
+//
+// Params:
+//   - ctx: cancellation context
+//   - pkgs: packages to analyze
+//   - analyzers: analyzers to run
+//   - emit: receives the findings of each package, never concurrently
+//
+// Returns:
+//   - error: ctx error when cancelled
+func (o *Orchestrator) StreamAnalyzersContext(ctx context.Context, pkgs []*packages.Package, analyzers []*analysis.Analyzer, emit func([]DiagnosticResult)) error {
+	o.runner.RunStream(ctx, pkgs, analyzers, func(diags []DiagnosticResult) {
+		emit(o.labelResults(diags))
+	})
+	// Return cancellation error, if any
+	return ctx.Err()
+}
+
+// StreamMultiModuleContext runs analysis across multiple modules like
+// RunMultiModuleContext, but hands the findings of each package to emit as
+// soon as it is analyzed. Up to the SetJobs limit of modules are loaded and
+// analyzed at the same time, so findings arrive in no particular order.
+//
+// Params:
+//   - ctx: cancellation context
+//   - paths: paths to analyze (may contain multiple modules)
+//   - opts: linting options
+//   - emit: receives the findings of each package, never concurrently
+//
+// Returns:
+//   - error: pipeline or cancellation error if any
+func (o *Orchestrator) StreamMultiModuleContext(ctx context.Context, paths []string, opts Options, emit func([]DiagnosticResult)) error {
+	o.discovery.SetSkipDirs(o.skipMatcher())
+
+	// Discover modules
+	modules, err := o.DiscoverModules(paths)
+	// Check for error
+	if err != nil {
+		// Return discovery error
+		return fmt.Errorf("discovering modules: %w", err)
+	}
+
+	// Select analyzers once
+	analyzers, err := o.SelectAnalyzers(opts)
+	// Check for error
+	if err != nil {
+		// Return selection error
+		return err
+	}
+
+	// Check if no modules found
+	if len(modules) == 0 {
+		// Fall back to standard load from current directory
+		return o.streamSingleModule(ctx, paths, analyzers, emit)
+	}
+
+	// Find the governing go.work, if any
+	ws, err := o.discovery.FindWorkspace(paths)
+	// Check for error
+	if err != nil {
+		// Return workspace error
+		return fmt.Errorf("discovering workspace: %w", err)
+	}
+
+	// Log if verbose
+	if o.verbose {
+		fmt.Fprintf(o.stderr, "Found %d Go module(s)\n", len(modules))
+	}
+
+	// Return after every module is analyzed
+	return o.streamModules(ctx, o.moduleUnits(ws, modules, paths), analyzers, emit)
+}
+
+// moduleUnits lists the loads of a multi-module run: the workspace modules
+// together, then each other module.
+//
+// Params:
+//   - ws: workspace, nil when none applies
+//   - modules: discovered module directories
+//   - paths: paths to analyze
+//
+// Returns:
+//   - []moduleUnit: loads to run
+func (o *Orchestrator) moduleUnits(ws *Workspace, modules, paths []string) []moduleUnit {
+	members, others := splitWorkspace(ws, modules)
+	units := make([]moduleUnit, 0, len(others)+1)
+	// Load workspace modules in one pass
+	if len(members) > 0 {
+		units = append(units, moduleUnit{
+			dir:      ws.Dir,
+			patterns: ws.Patterns(members),
+			label:    fmt.Sprintf("workspace: %s (%d module(s))", ws.Dir, len(members)),
+		})
+	}
+	// Load each remaining module on its own
+	for _, moduleRoot := range others {
+		units = append(units, moduleUnit{
+			dir:      moduleRoot,
+			patterns: o.discovery.ResolvePatterns(moduleRoot, paths),
+			label:    "module: " + moduleRoot,
+		})
+	}
+	// Return loads in discovery order
+	return units
+}
+
+// streamModules loads and analyzes modules, up to jobCount at a time.
+// Loading errors are logged in verbose mode and yield no diagnostics.
+//
+// Params:
+//   - ctx: cancellation context
+//   - units: loads to run
+//   - analyzers: analyzers to run
+//   - emit: receives the findings of each package
+//
+// Returns:
+//   - error: ctx error when cancelled
+func (o *Orchestrator) streamModules(ctx context.Context, units []moduleUnit, analyzers []*analysis.Analyzer, emit func([]DiagnosticResult)) error {
+	var emitMu sync.Mutex
+	serialized := func(diags []DiagnosticResult) {
+		emitMu.Lock()
+		defer emitMu.Unlock()
+		emit(diags)
+	}
+	slots := make(chan struct{}, o.jobCount())
+	var wg sync.WaitGroup
+
+	// Start each module once a slot is free
+	for _, unit := range units {
+		// Stop starting modules once cancelled
+		if ctx.Err() != nil {
+			break
+		}
+		slots <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-slots }()
+			o.streamModule(ctx, unit, analyzers, serialized)
+		})
+	}
+	wg.Wait()
+
+	// Return cancellation error, if any
+	return ctx.Err()
+}
+
+// streamModule loads and analyzes the packages of one module or workspace.
+//
+// Params:
+//   - ctx: cancellation context
+//   - unit: load to run
+//   - analyzers: analyzers to run
+//   - emit: receives the findings of each package
+func (o *Orchestrator) streamModule(ctx context.Context, unit moduleUnit, analyzers []*analysis.Analyzer, emit func([]DiagnosticResult)) {
+	// Log if verbose
+	if o.verbose {
+		fmt.Fprintf(o.stderr, "Analyzing %s\n", unit.label)
+	}
+	pkgs, err := o.LoadPackagesContext(ctx, unit.dir, unit.patterns)
+	// Check for error
+	if err != nil {
+		// Log warning and continue
+		if o.verbose {
+			fmt.Fprintf(o.stderr, "Warning: %v\n", err)
+		}
+		// No diagnostics for this module
+		return
+	}
+	_ = o.StreamAnalyzersContext(ctx, pkgs, analyzers, emit)
+}
+
+// streamSingleModule analyzes the packages of the current directory.
+//
+// Params:
+//   - ctx: cancellation context
+//   - patterns: package patterns
+//   - analyzers: analyzers to run
+//   - emit: receives the findings of each package
+//
+// Returns:
+//   - error: loading or cancellation error if any
+func (o *Orchestrator) streamSingleModule(ctx context.Context, patterns []string, analyzers []*analysis.Analyzer, emit func([]DiagnosticResult)) error {
+	// Load packages
+	pkgs, err := o.LoadPackagesContext(ctx, "", patterns)
+	// Check for error
+	if err != nil {
+		// Return loading error
+		return err
+	}
+	// Run analyzers
+	return o.StreamAnalyzersContext(ctx, pkgs, analyzers, emit)
+}
+
+// jobCount returns how many modules are loaded at the same time. Build
+// matrix loads record the positions they produce and run one at a time.
+//
+// Returns:
+//   - int: concurrent module loads
+func (o *Orchestrator) jobCount() int {
+	// Serialize build matrix loads
+	if len(o.builds) > 0 {
+		// Return one load at a time
+		return 1
+	}
+	// Use the configured limit
+	if o.jobs > 0 {
+		// Return configured jobs
+		return o.jobs
+	}
+	// Return one load per CPU
+	return runtime.GOMAXPROCS(0)
+}
@@ -0,0 +1,120 @@
+// External tests for streaming analysis.
+package orchestrator_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+)
+
+// TestOrchestrator_StreamMultiModuleContext tests that modules analyzed
+// concurrently stream the findings of a batch run.
+func TestOrchestrator_StreamMultiModuleContext(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"a/go.mod": "module example.com/a\n\ngo 1.25\n",
+		"a/a.go":   "package a\n\nvar X = 1\n\nfunc F() {}\n",
+		"b/go.mod": "module example.com/b\n\ngo 1.25\n",
+		"b/b.go":   "package b\n\nvar Y = 2\n\nfunc G() {}\n",
+	}
+	// Write the test modules
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		// Check directory creation error
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		// Check write error
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	batch, err := orchestrator.NewOrchestrator(&bytes.Buffer{}, false).RunMultiModuleContext(context.Background(), []string{dir}, orchestrator.Options{})
+	// Check batch run error
+	if err != nil || len(batch) == 0 {
+		t.Fatalf("RunMultiModuleContext() = %d findings, %v", len(batch), err)
+	}
+	want := findingFiles(batch)
+
+	tests := []struct {
+		name string
+		jobs int
+	}{
+		{name: "sequential", jobs: 1},
+		{name: "concurrent", jobs: 2},
+		{name: "default limit", jobs: 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			orch := orchestrator.NewOrchestrator(&bytes.Buffer{}, false)
+			orch.SetJobs(tt.jobs)
+			var streamed []orchestrator.DiagnosticResult
+			batches := 0
+			err := orch.StreamMultiModuleContext(context.Background(), []string{dir}, orchestrator.Options{}, func(diags []orchestrator.DiagnosticResult) {
+				batches++
+				streamed = append(streamed, diags...)
+			})
+			// Check stream error
+			if err != nil {
+				t.Fatalf("StreamMultiModuleContext() error = %v", err)
+			}
+			// Verify one batch per package
+			if batches != len(want) {
+				t.Errorf("batches = %d, want %d", batches, len(want))
+			}
+			// Verify the batch run findings are streamed
+			if len(streamed) != len(batch) || !slices.Equal(findingFiles(streamed), want) {
+				t.Errorf("streamed %d findings in %v, want %d in %v", len(streamed), findingFiles(streamed), len(batch), want)
+			}
+		})
+	}
+}
+
+// TestOrchestrator_StreamMultiModuleContext_cancelled tests that a cancelled
+// run streams nothing.
+func TestOrchestrator_StreamMultiModuleContext_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	dir := t.TempDir()
+	// Write a module
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/c\n\ngo 1.25\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	called := false
+	err := orchestrator.NewOrchestrator(&bytes.Buffer{}, false).StreamMultiModuleContext(ctx, []string{dir}, orchestrator.Options{}, func([]orchestrator.DiagnosticResult) {
+		called = true
+	})
+	// Verify the cancellation is reported without findings
+	if err == nil || called {
+		t.Errorf("StreamMultiModuleContext() = %v, emitted %v; want context error without findings", err, called)
+	}
+}
+
+// findingFiles lists the sorted base names of the files with findings.
+//
+// Params:
+//   - diags: findings
+//
+// Returns:
+//   - []string: file base names
+func findingFiles(diags []orchestrator.DiagnosticResult) []string {
+	var names []string
+	// Collect each file once
+	for _, d := range diags {
+		name := filepath.Base(d.Fset.Position(d.Diag.Pos).Filename)
+		// Skip files already listed
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	// Return sorted names
+	return names
+}
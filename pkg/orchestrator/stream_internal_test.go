@@ -0,0 +1,38 @@
+// Internal tests for streaming analysis.
+package orchestrator
+
+import (
+	"bytes"
+	"runtime"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/config"
+)
+
+// TestOrchestrator_jobCount tests the number of modules loaded at once.
+func TestOrchestrator_jobCount(t *testing.T) {
+	tests := []struct {
+		name   string
+		jobs   int
+		builds []config.BuildConfig
+		want   int
+	}{
+		{name: "configured limit", jobs: 3, want: 3},
+		{name: "default to GOMAXPROCS", jobs: 0, want: runtime.GOMAXPROCS(0)},
+		{name: "negative limit", jobs: -1, want: runtime.GOMAXPROCS(0)},
+		{name: "build matrix loads one at a time", jobs: 3, builds: []config.BuildConfig{{GOOS: "linux"}, {GOOS: "windows"}}, want: 1},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			orch := NewOrchestrator(&bytes.Buffer{}, false)
+			orch.SetJobs(tt.jobs)
+			orch.SetBuildMatrix(tt.builds)
+			// Verify the limit
+			if got := orch.jobCount(); got != tt.want {
+				t.Errorf("jobCount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// EnclosingSymbol returns the name of the top-level declaration containing pos.
+// Methods are qualified with their receiver type ("Type.Method").
+//
+// Params:
+//   - files: files of the analyzed package
+//   - pos: position to locate
+//
+// Returns:
+//   - string: declaration name, empty when pos is outside any declaration
+func EnclosingSymbol(files []*ast.File, pos token.Pos) string {
+	// Find the file containing the position
+	for _, file := range files {
+		// Skip files not containing pos
+		if pos < file.FileStart || pos > file.FileEnd {
+			continue
+		}
+		// Search top-level declarations
+		for _, decl := range file.Decls {
+			// Check declaration range
+			if pos >= decl.Pos() && pos <= decl.End() {
+				// Return declaration name
+				return declName(decl, pos)
+			}
+		}
+		// Position is at file level
+		return ""
+	}
+	// Position not found
+	return ""
+}
+
+// declName returns the name of a declaration.
+//
+// Params:
+//   - decl: top-level declaration
+//   - pos: position inside the declaration
+//
+// Returns:
+//   - string: declaration name
+func declName(decl ast.Decl, pos token.Pos) string {
+	// Dispatch on declaration kind
+	switch typed := decl.(type) {
+	// Functions and methods
+	case *ast.FuncDecl:
+		// Check for method receiver
+		if typed.Recv != nil && len(typed.Recv.List) > 0 {
+			// Return qualified method name
+			return receiverTypeName(typed.Recv.List[0].Type) + "." + typed.Name.Name
+		}
+		// Return function name
+		return typed.Name.Name
+	// Type, var and const declarations
+	case *ast.GenDecl:
+		// Return the spec containing pos
+		return specName(typed, pos)
+	// Bad declarations
+	default:
+		// No name available
+		return ""
+	}
+}
+
+// specName returns the name of the spec of a GenDecl containing pos.
+//
+// Params:
+//   - decl: generic declaration
+//   - pos: position inside the declaration
+//
+// Returns:
+//   - string: spec name, first spec when pos is on the keyword
+func specName(decl *ast.GenDecl, pos token.Pos) string {
+	name := ""
+	// Search specs, defaulting to the first named one
+	for _, spec := range decl.Specs {
+		current := ""
+		// Extract spec name
+		switch s := spec.(type) {
+		// Type declaration
+		case *ast.TypeSpec:
+			current = s.Name.Name
+		// Var or const declaration
+		case *ast.ValueSpec:
+			// Use first declared name
+			if len(s.Names) > 0 {
+				current = s.Names[0].Name
+			}
+		}
+		// Keep first name as fallback
+		if name == "" {
+			name = current
+		}
+		// Return spec containing pos
+		if pos >= spec.Pos() && pos <= spec.End() {
+			// Found enclosing spec
+			return current
+		}
+	}
+	// Return fallback name
+	return name
+}
+
+// receiverTypeName returns the base type name of a method receiver.
+//
+// Params:
+//   - expr: receiver type expression
+//
+// Returns:
+//   - string: type name without pointer or type parameters
+func receiverTypeName(expr ast.Expr) string {
+	// Unwrap receiver expression
+	switch t := expr.(type) {
+	// Pointer receiver
+	case *ast.StarExpr:
+		// Unwrap pointer
+		return receiverTypeName(t.X)
+	// Generic receiver with one type parameter
+	case *ast.IndexExpr:
+		// Unwrap type parameter
+		return receiverTypeName(t.X)
+	// Generic receiver with several type parameters
+	case *ast.IndexListExpr:
+		// Unwrap type parameters
+		return receiverTypeName(t.X)
+	// Named type
+	case *ast.Ident:
+		// Return type name
+		return t.Name
+	// Unsupported receiver
+	default:
+		// No name available
+		return ""
+	}
+}
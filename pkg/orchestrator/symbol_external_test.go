@@ -0,0 +1,116 @@
+// External tests for enclosing symbol resolution.
+package orchestrator_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+)
+
+// symbolTestSource is the file used to resolve enclosing symbols.
+const symbolTestSource string = `package p
+
+import "fmt"
+
+type Box[T any] struct{ v T }
+
+func (b *Box[T]) Get() T { return b.v }
+
+func Run() { fmt.Println("run") }
+
+var (
+	first  = 1
+	second = 2
+)
+`
+
+// TestEnclosingSymbol tests the EnclosingSymbol function.
+func TestEnclosingSymbol(t *testing.T) {
+	tests := []struct {
+		name   string
+		anchor string
+		want   string
+	}{
+		{
+			name:   "function body",
+			anchor: `"run"`,
+			want:   "Run",
+		},
+		{
+			name:   "generic pointer method",
+			anchor: "return b.v",
+			want:   "Box.Get",
+		},
+		{
+			name:   "type declaration",
+			anchor: "struct{",
+			want:   "Box",
+		},
+		{
+			name:   "second spec of var block",
+			anchor: "second",
+			want:   "second",
+		},
+		{
+			name:   "import is at file level",
+			anchor: `"fmt"`,
+			want:   "",
+		},
+		{
+			name:   "package clause is at file level",
+			anchor: "package p",
+			want:   "",
+		},
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "p.go", symbolTestSource, 0)
+	// Check parse error
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	tokFile := fset.File(file.Pos())
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			offset := strings.Index(symbolTestSource, tt.anchor)
+			// Check anchor exists
+			if offset < 0 {
+				t.Fatalf("anchor %q not found", tt.anchor)
+			}
+			got := orchestrator.EnclosingSymbol([]*ast.File{file}, tokFile.Pos(offset))
+			// Verify symbol
+			if got != tt.want {
+				t.Errorf("EnclosingSymbol() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestEnclosingSymbol_outsideFiles tests positions not covered by any file.
+func TestEnclosingSymbol_outsideFiles(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []*ast.File
+	}{
+		{
+			name:  "no files",
+			files: []*ast.File{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify empty result
+			if got := orchestrator.EnclosingSymbol(tt.files, token.Pos(1)); got != "" {
+				t.Errorf("EnclosingSymbol() = %q, want empty", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Internal tests for enclosing symbol resolution.
+package orchestrator
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+// Test_receiverTypeName tests the receiverTypeName function.
+func Test_receiverTypeName(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{name: "value receiver", expr: "T", want: "T"},
+		{name: "pointer receiver", expr: "*T", want: "T"},
+		{name: "generic receiver", expr: "*T[K]", want: "T"},
+		{name: "multi-parameter generic receiver", expr: "T[K, V]", want: "T"},
+		{name: "unsupported expression", expr: "pkg.T", want: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := parser.ParseExpr(tt.expr)
+			// Check parse error
+			if err != nil {
+				t.Fatalf("parse %q: %v", tt.expr, err)
+			}
+			// Verify name
+			if got := receiverTypeName(expr); got != tt.want {
+				t.Errorf("receiverTypeName(%q) = %q, want %q", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_declName tests the declName function.
+func Test_declName(t *testing.T) {
+	tests := []struct {
+		name string
+		decl ast.Decl
+		want string
+	}{
+		{
+			name: "bad declaration",
+			decl: &ast.BadDecl{},
+			want: "",
+		},
+		{
+			name: "function",
+			decl: &ast.FuncDecl{Name: ast.NewIdent("Run")},
+			want: "Run",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify name
+			if got := declName(tt.decl, token.NoPos); got != tt.want {
+				t.Errorf("declName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
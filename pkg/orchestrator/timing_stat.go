@@ -0,0 +1,23 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+import "time"
+
+// TimingStat aggregates the wall time of one analyzer, package or load.
+// Calls counts how many measurements were merged into Total.
+type TimingStat struct {
+	Name  string        `json:"name"`
+	Calls int           `json:"calls"`
+	Total time.Duration `json:"total"`
+	Max   time.Duration `json:"max"`
+}
+
+// add merges one measurement into the stat.
+//
+// Params:
+//   - elapsed: measured wall time
+func (s *TimingStat) add(elapsed time.Duration) {
+	s.Calls++
+	s.Total += elapsed
+	s.Max = max(s.Max, elapsed)
+}
@@ -13,6 +13,11 @@ type DiagnosticResult struct {
 	Diag         analysis.Diagnostic
 	Fset         *token.FileSet
 	AnalyzerName string
+	Package      string          // Import path of the analyzed package
+	Symbol       string          // Enclosing declaration (e.g. "Type.Method"), empty at file level
+	Build        string          // Build configuration(s) the finding is specific to, empty if shared
+	CPUShare     float64         // CPU share of the enclosing function, 0 when not ranked by a profile
+	HotPath      string          // Why the enclosing function is hot (e.g. "//ktn:hot"), empty when not escalated
 	cachedPos    *token.Position // Cached position to avoid repeated lookups
 }
 
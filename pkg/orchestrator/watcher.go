@@ -0,0 +1,149 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// goFileExt is the extension of Go source files.
+const goFileExt string = ".go"
+
+// FileWatcher detects changes to Go source files by polling modification times.
+// Extra files (such as the configuration file) are tracked alongside sources.
+type FileWatcher struct {
+	roots    []string
+	extra    []string
+	snapshot map[string]time.Time
+}
+
+// NewFileWatcher creates a FileWatcher and records the initial state.
+//
+// Params:
+//   - roots: directories searched recursively for .go files
+//   - extra: additional files to track (missing files are allowed)
+//
+// Returns:
+//   - *FileWatcher: watcher with its initial snapshot taken
+func NewFileWatcher(roots []string, extra []string) *FileWatcher {
+	w := &FileWatcher{
+		roots: roots,
+		extra: extra,
+	}
+	w.snapshot = w.scan()
+	// Return initialized watcher
+	return w
+}
+
+// Poll returns the files added, modified or removed since the last call.
+//
+// Returns:
+//   - []string: sorted absolute paths of changed files
+func (w *FileWatcher) Poll() []string {
+	current := w.scan()
+	changed := make([]string, 0, len(current))
+
+	// Detect added and modified files
+	for path, modTime := range current {
+		previous, ok := w.snapshot[path]
+		// Check for new or touched file
+		if !ok || !previous.Equal(modTime) {
+			changed = append(changed, path)
+		}
+	}
+
+	// Detect removed files
+	for path := range w.snapshot {
+		// Check if the file disappeared
+		if _, ok := current[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+
+	w.snapshot = current
+	sort.Strings(changed)
+	// Return changed files
+	return changed
+}
+
+// scan collects the modification time of every tracked file.
+//
+// Returns:
+//   - map[string]time.Time: modification time by absolute path
+func (w *FileWatcher) scan() map[string]time.Time {
+	files := make(map[string]time.Time, len(w.snapshot))
+
+	// Walk each root directory
+	for _, root := range w.roots {
+		w.scanDir(root, files)
+	}
+
+	// Track extra files individually
+	for _, path := range w.extra {
+		// Check if the file currently exists
+		if info, err := os.Stat(path); err == nil {
+			files[absPath(path)] = info.ModTime()
+		}
+	}
+
+	// Return collected state
+	return files
+}
+
+// scanDir records the .go files found under a directory.
+//
+// Params:
+//   - root: directory to walk
+//   - files: map receiving modification times
+func (w *FileWatcher) scanDir(root string, files map[string]time.Time) {
+	root = absPath(root)
+	// Walk directory tree, ignoring unreadable entries
+	_ = filepath.WalkDir(root, func(path string, entry os.DirEntry, walkErr error) error {
+		// Skip inaccessible entries
+		if walkErr != nil {
+			// Continue walking
+			return nil
+		}
+
+		// Skip directories outside the package hierarchy
+		if entry.IsDir() && path != root && isSkippedDir(entry.Name()) {
+			// Skip directory
+			return filepath.SkipDir
+		}
+
+		// Keep only Go source files
+		if entry.IsDir() || !strings.HasSuffix(path, goFileExt) {
+			// Continue walking
+			return nil
+		}
+
+		info, err := entry.Info()
+		// Check if the file vanished during the walk
+		if err == nil {
+			files[path] = info.ModTime()
+		}
+		// Continue walking
+		return nil
+	})
+}
+
+// absPath returns an absolute path, or the input when it cannot be resolved.
+//
+// Params:
+//   - path: path to resolve
+//
+// Returns:
+//   - string: absolute path
+func absPath(path string) string {
+	abs, err := filepath.Abs(path)
+	// Check for error
+	if err != nil {
+		// Keep original path
+		return path
+	}
+	// Return absolute path
+	return abs
+}
@@ -0,0 +1,103 @@
+// External tests for the file watcher.
+package orchestrator_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+)
+
+// TestFileWatcher_Poll tests the Poll method.
+func TestFileWatcher_Poll(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  func(t *testing.T, dir string)
+		wantLen int
+	}{
+		{
+			name:    "no change",
+			change:  func(t *testing.T, dir string) {},
+			wantLen: 0,
+		},
+		{
+			name: "modified file",
+			change: func(t *testing.T, dir string) {
+				future := time.Now().Add(time.Hour)
+				// Touch the existing file
+				if err := os.Chtimes(filepath.Join(dir, "a.go"), future, future); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantLen: 1,
+		},
+		{
+			name: "added and removed files",
+			change: func(t *testing.T, dir string) {
+				// Add a new file
+				if err := os.WriteFile(filepath.Join(dir, "b.go"), []byte("package a\n"), 0o600); err != nil {
+					t.Fatal(err)
+				}
+				// Remove the existing file
+				if err := os.Remove(filepath.Join(dir, "a.go")); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantLen: 2,
+		},
+		{
+			name: "ignored files",
+			change: func(t *testing.T, dir string) {
+				// Add a non-Go file and a vendored file
+				if err := os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("x"), 0o600); err != nil {
+					t.Fatal(err)
+				}
+				vendor := filepath.Join(dir, "vendor")
+				// Create vendor directory
+				if err := os.MkdirAll(vendor, 0o755); err != nil {
+					t.Fatal(err)
+				}
+				// Add a vendored Go file
+				if err := os.WriteFile(filepath.Join(vendor, "v.go"), []byte("package v\n"), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantLen: 0,
+		},
+		{
+			name: "extra file",
+			change: func(t *testing.T, dir string) {
+				// Create the tracked config file
+				if err := os.WriteFile(filepath.Join(dir, ".ktn-linter.yaml"), []byte("version: 1\n"), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantLen: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			// Create initial source file
+			if err := os.WriteFile(filepath.Join(dir, "a.go"), []byte("package a\n"), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			watcher := orchestrator.NewFileWatcher([]string{dir}, []string{filepath.Join(dir, ".ktn-linter.yaml")})
+
+			tt.change(t, dir)
+			changed := watcher.Poll()
+			// Verify number of changes
+			if len(changed) != tt.wantLen {
+				t.Errorf("Poll() = %v, want %d change(s)", changed, tt.wantLen)
+			}
+			// Verify state is reset after polling
+			if again := watcher.Poll(); len(again) != 0 {
+				t.Errorf("second Poll() = %v, want none", again)
+			}
+		})
+	}
+}
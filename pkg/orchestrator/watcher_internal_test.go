@@ -0,0 +1,53 @@
+// Internal tests for the file watcher.
+package orchestrator
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// Test_absPath tests the absPath function.
+func Test_absPath(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "relative path", path: "a/b.go"},
+		{name: "absolute path", path: "/tmp/b.go"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify result is absolute
+			if got := absPath(tt.path); !filepath.IsAbs(got) {
+				t.Errorf("absPath(%q) = %q, want absolute path", tt.path, got)
+			}
+		})
+	}
+}
+
+// Test_isSkippedDir tests the isSkippedDir function.
+func Test_isSkippedDir(t *testing.T) {
+	tests := []struct {
+		name string
+		dir  string
+		want bool
+	}{
+		{name: "hidden", dir: ".git", want: true},
+		{name: "vendor", dir: "vendor", want: true},
+		{name: "testdata", dir: "testdata", want: true},
+		{name: "regular", dir: "pkg", want: false},
+		{name: "current", dir: ".", want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify result
+			if got := isSkippedDir(tt.dir); got != tt.want {
+				t.Errorf("isSkippedDir(%q) = %v, want %v", tt.dir, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Package orchestrator coordinates the linting pipeline.
+package orchestrator
+
+import (
+	"path/filepath"
+	"slices"
+)
+
+// Workspace describes a go.work file and the modules it uses.
+// Its modules are loaded together so that cross-module types resolve.
+type Workspace struct {
+	Dir     string   // Directory containing go.work
+	Modules []string // Absolute directories of the "use" modules
+}
+
+// Contains reports whether a module directory is used by the workspace.
+//
+// Params:
+//   - moduleDir: absolute module directory
+//
+// Returns:
+//   - bool: true if the module is listed in go.work
+func (w *Workspace) Contains(moduleDir string) bool {
+	// Check listed modules
+	return slices.Contains(w.Modules, filepath.Clean(moduleDir))
+}
+
+// Patterns returns load patterns, relative to the workspace directory,
+// covering every package of the given modules.
+//
+// Params:
+//   - modules: absolute directories of workspace modules
+//
+// Returns:
+//   - []string: one "./dir/..." pattern per module
+func (w *Workspace) Patterns(modules []string) []string {
+	patterns := make([]string, 0, len(modules))
+	// Build one recursive pattern per module
+	for _, module := range modules {
+		rel, err := filepath.Rel(w.Dir, module)
+		// Fall back to the absolute directory
+		if err != nil {
+			rel = module
+		}
+		patterns = append(patterns, "./"+filepath.ToSlash(filepath.Join(rel, "...")))
+	}
+	// Return patterns
+	return patterns
+}
@@ -0,0 +1,60 @@
+// External tests for go.work workspaces.
+package orchestrator_test
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+)
+
+// TestWorkspace_Contains tests workspace membership.
+func TestWorkspace_Contains(t *testing.T) {
+	root := filepath.Join(string(filepath.Separator), "ws")
+	tests := []struct {
+		name   string
+		module string
+		want   bool
+	}{
+		{name: "listed module", module: filepath.Join(root, "a"), want: true},
+		{name: "unclean listed module", module: filepath.Join(root, "a") + string(filepath.Separator), want: true},
+		{name: "unlisted module", module: filepath.Join(root, "c"), want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			ws := &orchestrator.Workspace{Dir: root, Modules: []string{filepath.Join(root, "a")}}
+			// Verify membership
+			if got := ws.Contains(tt.module); got != tt.want {
+				t.Errorf("Contains(%q) = %v, want %v", tt.module, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestWorkspace_Patterns tests load pattern generation.
+func TestWorkspace_Patterns(t *testing.T) {
+	root := filepath.Join(string(filepath.Separator), "ws")
+	tests := []struct {
+		name    string
+		modules []string
+		want    []string
+	}{
+		{name: "no module", modules: []string{}, want: []string{}},
+		{name: "root module", modules: []string{root}, want: []string{"./..."}},
+		{name: "nested modules", modules: []string{filepath.Join(root, "a"), filepath.Join(root, "lib", "b")}, want: []string{"./a/...", "./lib/b/..."}},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			ws := &orchestrator.Workspace{Dir: root}
+			// Verify patterns
+			if got := ws.Patterns(tt.modules); !slices.Equal(got, tt.want) {
+				t.Errorf("Patterns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+)
+
+const (
+	// ChunkByFile groups task violations by source file.
+	ChunkByFile string = "file"
+	// ChunkByPackage groups task violations by package directory.
+	ChunkByPackage string = "package"
+	// ChunkByRule groups task violations by rule code.
+	ChunkByRule string = "rule"
+	// bytesPerToken approximates the size of a token in markdown output.
+	bytesPerToken int = 4
+	// taskIDFormat formats task identifiers from their number.
+	taskIDFormat string = "task-%03d"
+)
+
+// SplitTasks splits a prompt output into numbered, self-contained tasks.
+// Tasks follow the phase order; within a phase, one task is created per
+// scope, split again when it exceeds the token budget. Each violation
+// carries a source excerpt.
+//
+// Params:
+//   - output: prompt output to split
+//   - opts: grouping unit and token budget
+//
+// Returns:
+//   - []Task: ordered tasks
+//   - error: unknown grouping unit or negative budget
+func SplitTasks(output *PromptOutput, opts ChunkOptions) ([]Task, error) {
+	scopeOf, err := chunkScope(opts.By)
+	// Check grouping unit
+	if err != nil {
+		// Return unknown unit error
+		return []Task{}, err
+	}
+	// Check token budget
+	if opts.MaxTokens < 0 {
+		// Return invalid budget error
+		return []Task{}, fmt.Errorf("max tokens must be non-negative, got %d", opts.MaxTokens)
+	}
+	// Check empty output
+	if output == nil {
+		// Return no tasks
+		return []Task{}, nil
+	}
+
+	sources := sourceLines{}
+	tasks := []Task{}
+	blocked := false
+	// Split each phase in order
+	for i := range output.Phases {
+		phase := &output.Phases[i]
+		// Split each scope of the phase
+		for _, scoped := range phaseTasks(phase, scopeOf, blocked, sources) {
+			tasks = append(tasks, splitByBudget(scoped, opts.MaxTokens)...)
+		}
+		// Later phases wait for this one
+		blocked = blocked || countPhaseViolations(phase) > 0
+	}
+
+	// Number tasks in plan order
+	for i := range tasks {
+		tasks[i].Number = i + 1
+		tasks[i].ID = fmt.Sprintf(taskIDFormat, i+1)
+	}
+
+	// Return ordered tasks
+	return tasks, nil
+}
+
+// chunkScope returns the scope function of a grouping unit.
+//
+// Params:
+//   - by: grouping unit, empty for rule
+//
+// Returns:
+//   - func(string, string) string: scope of a rule code and file path
+//   - error: unknown grouping unit
+func chunkScope(by string) (func(code, path string) string, error) {
+	// Select scope by unit
+	switch by {
+	// Group by source file
+	case ChunkByFile:
+		// Return file scope
+		return func(_, path string) string { return path }, nil
+	// Group by package directory
+	case ChunkByPackage:
+		// Return directory scope
+		return func(_, path string) string { return filepath.Dir(path) }, nil
+	// Group by rule, the prompt's own organization
+	case ChunkByRule, "":
+		// Return rule scope
+		return func(code, _ string) string { return code }, nil
+	// Reject unknown units
+	default:
+		// Return unknown unit error
+		return nil, fmt.Errorf("unknown chunk unit %q (expected %s, %s or %s)", by, ChunkByFile, ChunkByPackage, ChunkByRule)
+	}
+}
+
+// phaseTasks groups the violations of a phase by scope.
+//
+// Params:
+//   - phase: phase to group
+//   - scopeOf: scope of a rule code and file path
+//   - blocked: whether earlier phases have violations
+//   - sources: source cache for excerpts
+//
+// Returns:
+//   - []Task: one unnumbered task per scope, sorted by scope
+func phaseTasks(phase *PhaseGroup, scopeOf func(code, path string) string, blocked bool, sources sourceLines) []Task {
+	byScope := map[string]*Task{}
+	// Distribute each violation to its scope
+	for i := range phase.Rules {
+		rule := &phase.Rules[i]
+		// Rules keep their order inside each scope
+		for _, violation := range rule.Violations {
+			scope := scopeOf(rule.Code, violation.FilePath)
+			task, exists := byScope[scope]
+			// Create scope task on first violation
+			if !exists {
+				task = &Task{Phase: phase.Phase, PhaseName: phase.Name, Scope: scope, Blocked: blocked, Rules: []RuleViolations{}}
+				byScope[scope] = task
+			}
+			violation.Excerpt = sources.excerpt(violation.FilePath, violation.Line)
+			appendViolation(task, rule, violation)
+		}
+	}
+
+	tasks := make([]Task, 0, len(byScope))
+	// Emit tasks by scope in a stable order
+	for _, scope := range slices.Sorted(maps.Keys(byScope)) {
+		tasks = append(tasks, *byScope[scope])
+	}
+
+	// Return scope tasks
+	return tasks
+}
+
+// appendViolation adds a violation to a task under its rule.
+//
+// Params:
+//   - task: task to extend
+//   - rule: rule metadata of the violation
+//   - violation: violation to add
+func appendViolation(task *Task, rule *RuleViolations, violation Violation) {
+	last := len(task.Rules) - 1
+	// Open a rule section when the rule changes
+	if last < 0 || task.Rules[last].Code != rule.Code {
+		section := *rule
+		section.Violations = []Violation{}
+		task.Rules = append(task.Rules, section)
+		last++
+	}
+	task.Rules[last].Violations = append(task.Rules[last].Violations, violation)
+}
+
+// splitByBudget splits a task whose estimated size exceeds the budget.
+// A single violation larger than the budget still gets its own task.
+//
+// Params:
+//   - task: scope task to split
+//   - maxTokens: token budget, 0 for no limit
+//
+// Returns:
+//   - []Task: task parts
+func splitByBudget(task Task, maxTokens int) []Task {
+	// Keep the task whole without budget
+	if maxTokens == 0 {
+		// Return unsplit task
+		return []Task{task}
+	}
+
+	header := estimateTokens(func(f *TaskFormatter) { f.writeTaskHeader(&task, 0) })
+	parts := []Task{}
+	current := task
+	current.Rules = []RuleViolations{}
+	used := header
+	// Fill parts rule by rule
+	for i := range task.Rules {
+		rule := &task.Rules[i]
+		context := estimateTokens(func(f *TaskFormatter) {
+			f.markdown.writeRule(&RuleViolations{Code: rule.Code, Category: rule.Category, Description: rule.Description, GoodExample: rule.GoodExample})
+		})
+		// Place each violation
+		for _, violation := range rule.Violations {
+			cost := estimateTokens(func(f *TaskFormatter) { f.markdown.writeViolation(violation) })
+			newSection := len(current.Rules) == 0 || current.Rules[len(current.Rules)-1].Code != rule.Code
+			// Rule context is repeated in each part
+			if newSection {
+				cost += context
+			}
+			// Start a new part when the budget is exceeded
+			if used+cost > maxTokens && len(current.Rules) > 0 {
+				parts = append(parts, current)
+				current.Rules = []RuleViolations{}
+				used = header
+				// The new part needs the rule context again
+				if !newSection {
+					cost += context
+				}
+			}
+			appendViolation(&current, rule, violation)
+			used += cost
+		}
+	}
+
+	// Return parts including the last one
+	return append(parts, current)
+}
+
+// estimateTokens estimates the tokens written by a render function.
+//
+// Params:
+//   - render: function writing to a task formatter
+//
+// Returns:
+//   - int: estimated token count
+func estimateTokens(render func(f *TaskFormatter)) int {
+	var buf bytes.Buffer
+	render(NewTaskFormatter(&buf))
+	// Round up to whole tokens
+	return (buf.Len() + bytesPerToken - 1) / bytesPerToken
+}
@@ -0,0 +1,168 @@
+// Package prompt_test provides black-box tests for task splitting.
+package prompt_test
+
+import (
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/prompt"
+)
+
+// chunkOutput builds a prompt output with two phases and two files.
+//
+// Returns:
+//   - *prompt.PromptOutput: output to split
+func chunkOutput() *prompt.PromptOutput {
+	// Return output with structural then local violations
+	return &prompt.PromptOutput{
+		TotalViolations: 4,
+		TotalRules:      2,
+		Phases: []prompt.PhaseGroup{
+			{
+				Phase: prompt.PhaseStructural,
+				Name:  "Structural Changes",
+				Rules: []prompt.RuleViolations{
+					{Code: "KTN-STRUCT-004", Violations: []prompt.Violation{{FilePath: "/src/b/b.go", Line: 3}}},
+				},
+			},
+			{
+				Phase: prompt.PhaseLocal,
+				Name:  "Local Fixes",
+				Rules: []prompt.RuleViolations{
+					{Code: "KTN-FUNC-001", Violations: []prompt.Violation{
+						{FilePath: "/src/a/a.go", Line: 1},
+						{FilePath: "/src/b/b.go", Line: 2},
+						{FilePath: "/src/a/x.go", Line: 4},
+					}},
+				},
+			},
+		},
+	}
+}
+
+// TestSplitTasks tests task grouping, numbering and phase blocking.
+//
+// Params:
+//   - t: testing object
+func TestSplitTasks(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        prompt.ChunkOptions
+		wantScopes  []string
+		wantBlocked []bool
+	}{
+		{
+			name:        "by rule",
+			opts:        prompt.ChunkOptions{By: prompt.ChunkByRule},
+			wantScopes:  []string{"KTN-STRUCT-004", "KTN-FUNC-001"},
+			wantBlocked: []bool{false, true},
+		},
+		{
+			name:        "by file",
+			opts:        prompt.ChunkOptions{By: prompt.ChunkByFile},
+			wantScopes:  []string{"/src/b/b.go", "/src/a/a.go", "/src/a/x.go", "/src/b/b.go"},
+			wantBlocked: []bool{false, true, true, true},
+		},
+		{
+			name:        "by package",
+			opts:        prompt.ChunkOptions{By: prompt.ChunkByPackage},
+			wantScopes:  []string{"/src/b", "/src/a", "/src/b"},
+			wantBlocked: []bool{false, true, true},
+		},
+		{
+			name:        "budget splits each violation",
+			opts:        prompt.ChunkOptions{MaxTokens: 1},
+			wantScopes:  []string{"KTN-STRUCT-004", "KTN-FUNC-001", "KTN-FUNC-001", "KTN-FUNC-001"},
+			wantBlocked: []bool{false, true, true, true},
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			tasks, err := prompt.SplitTasks(chunkOutput(), tt.opts)
+			// Verify no error
+			if err != nil {
+				t.Fatalf("SplitTasks() error = %v", err)
+			}
+			// Verify task count
+			if len(tasks) != len(tt.wantScopes) {
+				t.Fatalf("SplitTasks() = %d tasks, want %d", len(tasks), len(tt.wantScopes))
+			}
+			// Verify each task
+			for i, task := range tasks {
+				// Verify scope
+				if task.Scope != tt.wantScopes[i] {
+					t.Errorf("task %d scope = %q, want %q", i, task.Scope, tt.wantScopes[i])
+				}
+				// Verify blocking
+				if task.Blocked != tt.wantBlocked[i] {
+					t.Errorf("task %d blocked = %v, want %v", i, task.Blocked, tt.wantBlocked[i])
+				}
+				// Verify numbering
+				if task.Number != i+1 {
+					t.Errorf("task %d number = %d, want %d", i, task.Number, i+1)
+				}
+			}
+		})
+	}
+}
+
+// TestSplitTasks_errors tests invalid chunking options.
+//
+// Params:
+//   - t: testing object
+func TestSplitTasks_errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    prompt.ChunkOptions
+		wantErr bool
+	}{
+		{
+			name:    "unknown unit",
+			opts:    prompt.ChunkOptions{By: "function"},
+			wantErr: true,
+		},
+		{
+			name:    "negative budget",
+			opts:    prompt.ChunkOptions{MaxTokens: -1},
+			wantErr: true,
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := prompt.SplitTasks(chunkOutput(), tt.opts)
+			// Verify error expectation
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SplitTasks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestSplitTasks_nilOutput tests splitting a nil output.
+//
+// Params:
+//   - t: testing object
+func TestSplitTasks_nilOutput(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{name: "nil output yields no task"},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			tasks, err := prompt.SplitTasks(nil, prompt.ChunkOptions{})
+			// Verify empty plan
+			if err != nil || len(tasks) != 0 {
+				t.Errorf("SplitTasks(nil) = %v, %v, want no task", tasks, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+// Package prompt provides white-box tests for task splitting.
+package prompt
+
+import (
+	"testing"
+)
+
+// Test_chunkScope tests the scope of each grouping unit.
+//
+// Params:
+//   - t: testing object
+func Test_chunkScope(t *testing.T) {
+	tests := []struct {
+		name    string
+		by      string
+		want    string
+		wantErr bool
+	}{
+		{name: "file", by: ChunkByFile, want: "/src/a/a.go"},
+		{name: "package", by: ChunkByPackage, want: "/src/a"},
+		{name: "rule", by: ChunkByRule, want: "KTN-FUNC-001"},
+		{name: "default is rule", by: "", want: "KTN-FUNC-001"},
+		{name: "unknown", by: "line", wantErr: true},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			scopeOf, err := chunkScope(tt.by)
+			// Verify error expectation
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("chunkScope() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// Verify scope
+			if err == nil && scopeOf("KTN-FUNC-001", "/src/a/a.go") != tt.want {
+				t.Errorf("scope = %q, want %q", scopeOf("KTN-FUNC-001", "/src/a/a.go"), tt.want)
+			}
+		})
+	}
+}
+
+// Test_phaseTasks tests grouping a phase by scope.
+//
+// Params:
+//   - t: testing object
+func Test_phaseTasks(t *testing.T) {
+	phase := &PhaseGroup{
+		Phase: PhaseLocal,
+		Name:  "Local Fixes",
+		Rules: []RuleViolations{
+			{Code: "KTN-FUNC-001", Violations: []Violation{{FilePath: "/src/b.go"}, {FilePath: "/src/a.go"}}},
+			{Code: "KTN-VAR-001", Violations: []Violation{{FilePath: "/src/a.go"}}},
+		},
+	}
+	tests := []struct {
+		name      string
+		wantRules []int
+	}{
+		{name: "sorted scopes keep rule order", wantRules: []int{2, 1}},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			scopeOf, _ := chunkScope(ChunkByFile)
+			tasks := phaseTasks(phase, scopeOf, true, sourceLines{})
+			// Verify task count
+			if len(tasks) != len(tt.wantRules) {
+				t.Fatalf("phaseTasks() = %d tasks, want %d", len(tasks), len(tt.wantRules))
+			}
+			// Verify rule sections per task
+			for i, task := range tasks {
+				// Check rule sections
+				if len(task.Rules) != tt.wantRules[i] || !task.Blocked || task.PhaseName != phase.Name {
+					t.Errorf("task %d = %+v", i, task)
+				}
+			}
+			// Verify scope order
+			if tasks[0].Scope != "/src/a.go" {
+				t.Errorf("first scope = %q, want /src/a.go", tasks[0].Scope)
+			}
+		})
+	}
+}
+
+// Test_appendViolation tests rule sections of a task.
+//
+// Params:
+//   - t: testing object
+func Test_appendViolation(t *testing.T) {
+	tests := []struct {
+		name      string
+		codes     []string
+		wantRules int
+	}{
+		{name: "same rule shares a section", codes: []string{"KTN-A", "KTN-A"}, wantRules: 1},
+		{name: "rule change opens a section", codes: []string{"KTN-A", "KTN-B", "KTN-A"}, wantRules: 3},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			task := &Task{}
+			// Add one violation per code
+			for _, code := range tt.codes {
+				appendViolation(task, &RuleViolations{Code: code, Description: "desc"}, Violation{Line: 1})
+			}
+			// Verify sections
+			if len(task.Rules) != tt.wantRules {
+				t.Errorf("appendViolation() = %d sections, want %d", len(task.Rules), tt.wantRules)
+			}
+			// Verify metadata is kept
+			if task.Rules[0].Description != "desc" {
+				t.Errorf("section description = %q, want desc", task.Rules[0].Description)
+			}
+		})
+	}
+}
+
+// Test_splitByBudget tests splitting tasks by token budget.
+//
+// Params:
+//   - t: testing object
+func Test_splitByBudget(t *testing.T) {
+	task := Task{
+		Scope: "/src/a.go",
+		Rules: []RuleViolations{
+			{Code: "KTN-A", Violations: []Violation{{FilePath: "/src/a.go", Line: 1}, {FilePath: "/src/a.go", Line: 2}}},
+			{Code: "KTN-B", Violations: []Violation{{FilePath: "/src/a.go", Line: 3}}},
+		},
+	}
+	tests := []struct {
+		name      string
+		maxTokens int
+		wantParts int
+	}{
+		{name: "no budget", maxTokens: 0, wantParts: 1},
+		{name: "large budget", maxTokens: 100000, wantParts: 1},
+		{name: "tiny budget", maxTokens: 1, wantParts: 3},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			parts := splitByBudget(task, tt.maxTokens)
+			// Verify part count
+			if len(parts) != tt.wantParts {
+				t.Fatalf("splitByBudget() = %d parts, want %d", len(parts), tt.wantParts)
+			}
+			total := 0
+			// Count violations across parts
+			for i := range parts {
+				total += countTaskViolations(&parts[i])
+			}
+			// Verify nothing is lost
+			if total != 3 {
+				t.Errorf("splitByBudget() kept %d violations, want 3", total)
+			}
+		})
+	}
+}
+
+// Test_estimateTokens tests the token estimate of rendered output.
+//
+// Params:
+//   - t: testing object
+func Test_estimateTokens(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want int
+	}{
+		{name: "empty", text: "", want: 0},
+		{name: "rounded up", text: "12345", want: 2},
+		{name: "exact", text: "12345678", want: 2},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := estimateTokens(func(f *TaskFormatter) { f.writer.Write([]byte(tt.text)) })
+			// Verify estimate
+			if got != tt.want {
+				t.Errorf("estimateTokens() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
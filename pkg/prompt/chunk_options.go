@@ -0,0 +1,12 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+// ChunkOptions controls how a prompt is split into task files.
+// Violations are grouped by file, package or rule, then split again when a
+// group exceeds the token budget.
+type ChunkOptions struct {
+	// By is the grouping unit (ChunkByFile, ChunkByPackage or ChunkByRule).
+	By string
+	// MaxTokens is the estimated token budget per task, 0 for no limit.
+	MaxTokens int
+}
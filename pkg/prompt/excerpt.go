@@ -0,0 +1,80 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// excerptRadius is the number of lines shown around a violation.
+	excerptRadius int = 3
+	// excerptMarker flags the violation line in an excerpt.
+	excerptMarker string = ">"
+)
+
+// sourceLines caches source files split into lines, by path.
+type sourceLines map[string][]string
+
+// excerpt returns the numbered source lines around a violation.
+//
+// Params:
+//   - path: source file path
+//   - line: 1-based violation line
+//
+// Returns:
+//   - string: excerpt, empty if the file or line is unavailable
+func (s sourceLines) excerpt(path string, line int) string {
+	lines := s.load(path)
+	// Check line range
+	if line < 1 || line > len(lines) {
+		// Return no excerpt for unknown positions
+		return ""
+	}
+
+	first := max(1, line-excerptRadius)
+	last := min(len(lines), line+excerptRadius)
+	width := len(strconv.Itoa(last))
+	var builder strings.Builder
+	// Number each line, marking the violation
+	for current := first; current <= last; current++ {
+		marker := " "
+		// Mark the violation line
+		if current == line {
+			marker = excerptMarker
+		}
+		numbered := fmt.Sprintf("%s %*d | %s", marker, width, current, lines[current-1])
+		builder.WriteString(strings.TrimRight(numbered, " \t") + "\n")
+	}
+
+	// Return excerpt
+	return builder.String()
+}
+
+// load reads a source file once.
+//
+// Params:
+//   - path: source file path
+//
+// Returns:
+//   - []string: file lines, empty if unreadable
+func (s sourceLines) load(path string) []string {
+	// Check cache
+	if lines, ok := s[path]; ok {
+		// Return cached lines
+		return lines
+	}
+
+	lines := []string{}
+	data, err := os.ReadFile(path)
+	// Split readable files
+	if err == nil {
+		lines = strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	}
+	s[path] = lines
+
+	// Return file lines
+	return lines
+}
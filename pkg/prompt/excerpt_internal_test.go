@@ -0,0 +1,106 @@
+// Package prompt provides white-box tests for source excerpts.
+package prompt
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// Test_sourceLines_excerpt tests numbered excerpts around a line.
+//
+// Params:
+//   - t: testing object
+func Test_sourceLines_excerpt(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "a.go")
+	content := "package a\n\nfunc A() {\n\tx := 1\n\t_ = x\n}\n\nvar B = 2\n\nvar C = 3\n"
+	// Write source file
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		path string
+		line int
+		want string
+	}{
+		{
+			name: "first line clamps start",
+			path: path,
+			line: 1,
+			want: "> 1 | package a\n  2 |\n  3 | func A() {\n  4 | \tx := 1\n",
+		},
+		{
+			name: "last line clamps end",
+			path: path,
+			line: 10,
+			want: "   7 |\n   8 | var B = 2\n   9 |\n> 10 | var C = 3\n",
+		},
+		{
+			name: "line out of range",
+			path: path,
+			line: 11,
+			want: "",
+		},
+		{
+			name: "missing file",
+			path: filepath.Join(dir, "missing.go"),
+			line: 1,
+			want: "",
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			sources := sourceLines{}
+			got := sources.excerpt(tt.path, tt.line)
+			// Verify excerpt
+			if got != tt.want {
+				t.Errorf("excerpt() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_sourceLines_load tests that files are read once.
+//
+// Params:
+//   - t: testing object
+func Test_sourceLines_load(t *testing.T) {
+	tests := []struct {
+		name      string
+		wantLines int
+	}{
+		{
+			name:      "cached after first read",
+			wantLines: 2,
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "a.go")
+			// Write source file
+			if err := os.WriteFile(path, []byte("package a\nvar A = 1\n"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			sources := sourceLines{}
+			sources.load(path)
+			// Remove file to prove the cache is used
+			if err := os.Remove(path); err != nil {
+				t.Fatal(err)
+			}
+
+			// Verify cached lines
+			if got := len(sources.load(path)); got != tt.wantLines {
+				t.Errorf("load() = %d lines, want %d", got, tt.wantLines)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+// Finding is a recorded or re-linted violation of a task.
+// Findings are matched by rule, file and message, as fixes move lines.
+type Finding struct {
+	Code    string `json:"code"`
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
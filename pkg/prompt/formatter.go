@@ -4,6 +4,7 @@ package prompt
 import (
 	"fmt"
 	"io"
+	"strings"
 )
 
 // MarkdownFormatter formats prompt output as markdown.
@@ -276,4 +277,16 @@ func (f *MarkdownFormatter) writeViolation(v Violation) {
 		// Handle empty message case
 		fmt.Fprintf(f.writer, "- [ ] `%s:%d`\n", v.FilePath, v.Line)
 	}
+
+	// Source excerpt of split tasks, indented under the item
+	if v.Excerpt != "" {
+		fmt.Fprintln(f.writer)
+		fmt.Fprintln(f.writer, "  ```")
+		// Indent each excerpt line
+		for line := range strings.Lines(v.Excerpt) {
+			fmt.Fprint(f.writer, "  "+line)
+		}
+		fmt.Fprintln(f.writer, "  ```")
+		fmt.Fprintln(f.writer)
+	}
 }
@@ -384,6 +384,17 @@ func Test_MarkdownFormatter_writeViolation(t *testing.T) {
 			want:    "- [ ] `pkg/other.go:10`",
 			notWant: "- [ ] `pkg/other.go:10` -",
 		},
+		{
+			name: "with excerpt",
+			v: Violation{
+				FilePath: "pkg/test.go",
+				Line:     2,
+				Message:  "msg",
+				Excerpt:  "  1 | package test\n> 2 | var x = 1\n",
+			},
+			want:    "  ```\n    1 | package test\n  > 2 | var x = 1\n  ```\n",
+			notWant: "",
+		},
 	}
 
 	// Run test cases
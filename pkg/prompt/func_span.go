@@ -0,0 +1,10 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+// funcSpan is the line range of a function declaration.
+// Starts at the doc comment when the function has one.
+type funcSpan struct {
+	name  string
+	start int
+	end   int
+}
@@ -6,12 +6,10 @@ import (
 	"strings"
 
 	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+	"github.com/kodflow/ktn-linter/pkg/rulecode"
 	"github.com/kodflow/ktn-linter/pkg/rules"
 )
 
-// ktnPrefix is the standard prefix for rule codes.
-const ktnPrefix string = "KTN-"
-
 // Generator generates AI-optimized prompts from linter diagnostics.
 // Coordinates orchestrator, rule metadata extraction, and phase classification.
 type Generator struct {
@@ -30,6 +28,8 @@ type Generator struct {
 func NewGenerator(stderr io.Writer, verbose bool) *Generator {
 	// Create orchestrator
 	orch := orchestrator.NewOrchestrator(stderr, verbose)
+	// Prompts describe rule fixes: fail rather than prompt on broken packages
+	orch.SetStrictLoad(true)
 
 	// Return generator
 	return &Generator{
@@ -73,15 +73,29 @@ func (g *Generator) Generate(patterns []string, opts orchestrator.Options) (*Pro
 }
 
 // runLinter executes the linter and returns raw diagnostics.
+// Directory arguments and go.work roots go through multi-module discovery,
+// like the lint command.
 //
 // Params:
-//   - patterns: package patterns to analyze
+//   - patterns: package patterns or paths to analyze
 //   - opts: orchestrator options
 //
 // Returns:
 //   - []orchestrator.DiagnosticResult: raw diagnostics
 //   - error: linter error if any
 func (g *Generator) runLinter(patterns []string, opts orchestrator.Options) ([]orchestrator.DiagnosticResult, error) {
+	// Use multi-module approach for directory arguments
+	if orchestrator.NeedsModuleDiscovery(patterns) {
+		rawDiags, err := g.orch.RunMultiModule(patterns, opts)
+		// Check for error
+		if err != nil {
+			// Return empty slice for pipeline error
+			return []orchestrator.DiagnosticResult{}, err
+		}
+		// Return filtered diagnostics
+		return g.orch.FilterDiagnostics(rawDiags), nil
+	}
+
 	// Load packages
 	pkgs, err := g.orch.LoadPackages(patterns)
 	// Check for error
@@ -254,7 +268,7 @@ func buildModernizeCode(analyzerName string) string {
 	return ""
 }
 
-// extractRuleCode extracts KTN-XXX-YYY from a diagnostic message.
+// extractRuleCode extracts PREFIX-XXX-YYY from a diagnostic message.
 //
 // Params:
 //   - message: diagnostic message
@@ -262,29 +276,16 @@ func buildModernizeCode(analyzerName string) string {
 // Returns:
 //   - string: rule code or empty if not found
 func extractRuleCode(message string) string {
-	// Check for bracketed format [KTN-XXX-YYY]
-	if strings.HasPrefix(message, "["+ktnPrefix) {
-		endIdx := strings.Index(message, "]")
-		// Check if bracket found
-		if endIdx > 0 {
-			// Return extracted rule code from brackets
-			return message[1:endIdx]
-		}
+	// Check for bracketed or colon format
+	if code := rulecode.FromMessage(message); code != "" {
+		// Return recognized rule code
+		return code
 	}
 
-	// Check for colon format KTN-XXX-YYY:
-	if strings.HasPrefix(message, ktnPrefix) {
-		// Try colon separator first
-		if code, _, found := strings.Cut(message, ":"); found {
-			// Return rule code before colon
-			return code
-		}
-
-		// Try space separator
-		if code, _, found := strings.Cut(message, " "); found {
-			// Return rule code before space
-			return code
-		}
+	// Try space separator
+	if code, _, found := strings.Cut(message, " "); found && rulecode.IsCode(code) {
+		// Return rule code before space
+		return code
 	}
 
 	// Return empty string when no rule code found
@@ -299,26 +300,6 @@ func extractRuleCode(message string) string {
 // Returns:
 //   - string: message without rule code prefix
 func extractMessage(message string) string {
-	// Check for bracketed format [KTN-XXX-YYY]
-	if strings.HasPrefix(message, "["+ktnPrefix) {
-		endIdx := strings.Index(message, "]")
-		// Check if bracket found
-		if endIdx > 0 && len(message) > endIdx+1 {
-			// Return message after bracket
-			return strings.TrimSpace(message[endIdx+1:])
-		}
-	}
-
-	// Check for colon format KTN-XXX-YYY: message
-	if strings.HasPrefix(message, ktnPrefix) {
-		colonIdx := strings.Index(message, ":")
-		// Check if colon found
-		if colonIdx > 0 && len(message) > colonIdx+1 {
-			// Return message after colon
-			return strings.TrimSpace(message[colonIdx+1:])
-		}
-	}
-
-	// Return original message when no prefix found
-	return message
+	// Strip bracketed or colon code prefix
+	return rulecode.TrimCode(message)
 }
@@ -3,9 +3,12 @@ package prompt
 
 import (
 	"bytes"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+	"github.com/kodflow/ktn-linter/pkg/rulecode"
 )
 
 // Test_Generator_runLinter tests the runLinter private method.
@@ -13,6 +16,16 @@ import (
 // Params:
 //   - t: testing object
 func Test_Generator_runLinter(t *testing.T) {
+	moduleDir := t.TempDir()
+	// Create a standalone module for directory arguments
+	if err := os.WriteFile(filepath.Join(moduleDir, "go.mod"), []byte("module example.com/tmp\n\ngo 1.24\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// Create a source file
+	if err := os.WriteFile(filepath.Join(moduleDir, "tmp.go"), []byte("package tmp\n\nvar V = 1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
 	// Define test cases for runLinter
 	tests := []struct {
 		name        string
@@ -32,6 +45,12 @@ func Test_Generator_runLinter(t *testing.T) {
 			opts:        orchestrator.Options{},
 			expectError: true,
 		},
+		{
+			name:        "directory goes through module discovery",
+			patterns:    []string{moduleDir},
+			opts:        orchestrator.Options{},
+			expectError: false,
+		},
 		{
 			name:        "invalid analyzer returns error",
 			patterns:    []string{"github.com/kodflow/ktn-linter/pkg/prompt"},
@@ -169,7 +188,13 @@ func Test_extractRuleCode(t *testing.T) {
 			message: "KTN-VAR-001:",
 			want:    "KTN-VAR-001",
 		},
+		{
+			name:    "third-party prefix",
+			message: "ACME-SEC-001 hardcoded secret",
+			want:    "ACME-SEC-001",
+		},
 	}
+	rulecode.RegisterPrefix("ACME")
 
 	// Run all test cases
 	for _, tt := range tests {
@@ -0,0 +1,191 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+import (
+	"encoding/json"
+	"io"
+	"slices"
+)
+
+// jsonPlanVersion is the version of the JSON task plan format.
+const jsonPlanVersion string = "1"
+
+// JSONFormatter formats prompt tasks as a JSON plan.
+// Makes phase gating and task dependencies explicit for agent loops.
+type JSONFormatter struct {
+	writer io.Writer
+}
+
+// NewJSONFormatter creates a new JSON plan formatter.
+//
+// Params:
+//   - w: writer for output
+//
+// Returns:
+//   - *JSONFormatter: new formatter instance
+func NewJSONFormatter(w io.Writer) *JSONFormatter {
+	// Return new formatter instance
+	return &JSONFormatter{writer: w}
+}
+
+// Format writes the plan of a prompt output and its tasks.
+//
+// Params:
+//   - output: prompt output with phases
+//   - tasks: ordered tasks from SplitTasks
+//
+// Returns:
+//   - error: encoding or write error
+func (f *JSONFormatter) Format(output *PromptOutput, tasks []Task) error {
+	// Guard against nil output
+	if output == nil {
+		output = &PromptOutput{}
+	}
+
+	encoder := json.NewEncoder(f.writer)
+	encoder.SetIndent("", "  ")
+	// Keep source snippets readable
+	encoder.SetEscapeHTML(false)
+
+	// Write JSON plan
+	return encoder.Encode(BuildPlan(output, tasks))
+}
+
+// BuildPlan builds the JSON plan of a prompt output and its tasks.
+// Tasks of a phase depend on every task of the previous phase.
+//
+// Params:
+//   - output: prompt output with phases
+//   - tasks: ordered tasks from SplitTasks
+//
+// Returns:
+//   - JSONPlan: plan with phases, tasks and gating state
+func BuildPlan(output *PromptOutput, tasks []Task) JSONPlan {
+	plan := JSONPlan{
+		Version:         jsonPlanVersion,
+		TotalViolations: output.TotalViolations,
+		TotalRules:      output.TotalRules,
+		Phases:          make([]JSONPhase, 0, len(output.Phases)),
+		Tasks:           make([]JSONTask, 0, len(tasks)),
+	}
+
+	numbers := make(map[RulePhase]int, len(output.Phases))
+	blocked := false
+	// Describe phases in order
+	for i := range output.Phases {
+		phase := &output.Phases[i]
+		numbers[phase.Phase] = i + 1
+		plan.Phases = append(plan.Phases, buildJSONPhase(phase, i+1, blocked))
+		violations := countPhaseViolations(phase)
+		// The first phase with violations is active
+		if violations > 0 && !blocked {
+			plan.ActivePhase = i + 1
+		}
+		blocked = blocked || violations > 0
+	}
+
+	lines, spans := sourceLines{}, functionSpans{}
+	// Describe tasks with their dependencies
+	for i := range tasks {
+		task := buildJSONTask(&tasks[i], numbers[tasks[i].Phase], lines, spans)
+		// Skip tasks of phases absent from the output
+		if task.Phase == 0 {
+			continue
+		}
+		index := task.Phase - 1
+		plan.Phases[index].Tasks = append(plan.Phases[index].Tasks, task.ID)
+		// Depend on the tasks of the previous phase
+		if index > 0 {
+			task.DependsOn = slices.Clone(plan.Phases[index-1].Tasks)
+		}
+		// The first unblocked task is next
+		if plan.NextTask == "" && !task.Blocked {
+			plan.NextTask = task.ID
+		}
+		plan.Tasks = append(plan.Tasks, task)
+	}
+
+	// Return complete plan
+	return plan
+}
+
+// buildJSONPhase describes a phase.
+//
+// Params:
+//   - phase: phase group
+//   - number: 1-based phase position
+//   - blocked: whether earlier phases have violations
+//
+// Returns:
+//   - JSONPhase: phase without tasks
+func buildJSONPhase(phase *PhaseGroup, number int, blocked bool) JSONPhase {
+	codes := make([]string, 0, len(phase.Rules))
+	// List rule codes
+	for i := range phase.Rules {
+		codes = append(codes, phase.Rules[i].Code)
+	}
+
+	// Return phase description
+	return JSONPhase{
+		Number:      number,
+		Name:        phase.Name,
+		Description: phase.Description,
+		NeedsRerun:  phase.NeedsRerun,
+		Blocked:     blocked,
+		Violations:  countPhaseViolations(phase),
+		Rules:       codes,
+		Tasks:       []string{},
+	}
+}
+
+// buildJSONTask describes a task with violation snippets.
+//
+// Params:
+//   - task: task to describe
+//   - phase: 1-based phase position
+//   - lines: source line cache
+//   - spans: function declaration cache
+//
+// Returns:
+//   - JSONTask: task without dependencies
+func buildJSONTask(task *Task, phase int, lines sourceLines, spans functionSpans) JSONTask {
+	rules := make([]JSONRule, 0, len(task.Rules))
+	// Describe each rule section
+	for i := range task.Rules {
+		rule := &task.Rules[i]
+		violations := make([]JSONViolation, 0, len(rule.Violations))
+		// Describe each violation
+		for j := range rule.Violations {
+			violation := &rule.Violations[j]
+			function, snippet := violationSnippet(violation, lines, spans)
+			violations = append(violations, JSONViolation{
+				File:     violation.FilePath,
+				Line:     violation.Line,
+				Column:   violation.Column,
+				Message:  violation.Message,
+				Function: function,
+				Snippet:  snippet,
+			})
+		}
+		rules = append(rules, JSONRule{
+			Code:        rule.Code,
+			Category:    rule.Category,
+			Description: rule.Description,
+			GoodExample: rule.GoodExample,
+			Violations:  violations,
+		})
+	}
+
+	// Return task description
+	return JSONTask{
+		ID:         task.ID,
+		Number:     task.Number,
+		Phase:      phase,
+		PhaseName:  task.PhaseName,
+		Scope:      task.Scope,
+		Blocked:    task.Blocked,
+		DependsOn:  []string{},
+		Violations: countTaskViolations(task),
+		Rules:      rules,
+	}
+}
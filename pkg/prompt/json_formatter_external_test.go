@@ -0,0 +1,126 @@
+// Package prompt_test provides black-box tests for the JSON task plan.
+package prompt_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/prompt"
+)
+
+// TestNewJSONFormatter tests formatter creation.
+//
+// Params:
+//   - t: testing object
+func TestNewJSONFormatter(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{name: "creates formatter"},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify formatter is created
+			if prompt.NewJSONFormatter(&bytes.Buffer{}) == nil {
+				t.Error("NewJSONFormatter() returned nil")
+			}
+		})
+	}
+}
+
+// TestJSONFormatter_Format tests that the plan is valid JSON.
+//
+// Params:
+//   - t: testing object
+func TestJSONFormatter_Format(t *testing.T) {
+	tests := []struct {
+		name      string
+		output    *prompt.PromptOutput
+		wantTasks int
+	}{
+		{name: "plan with tasks", output: chunkOutput(), wantTasks: 2},
+		{name: "nil output", output: nil, wantTasks: 0},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			tasks, _ := prompt.SplitTasks(tt.output, prompt.ChunkOptions{})
+			var buf bytes.Buffer
+			// Verify encoding
+			if err := prompt.NewJSONFormatter(&buf).Format(tt.output, tasks); err != nil {
+				t.Fatalf("Format() error = %v", err)
+			}
+			var plan prompt.JSONPlan
+			// Verify JSON decodes back
+			if err := json.Unmarshal(buf.Bytes(), &plan); err != nil {
+				t.Fatalf("invalid JSON: %v", err)
+			}
+			// Verify tasks
+			if len(plan.Tasks) != tt.wantTasks || plan.Version != "1" {
+				t.Errorf("plan = %d tasks, version %q, want %d tasks", len(plan.Tasks), plan.Version, tt.wantTasks)
+			}
+		})
+	}
+}
+
+// TestBuildPlan tests phase gating and task dependencies.
+//
+// Params:
+//   - t: testing object
+func TestBuildPlan(t *testing.T) {
+	tests := []struct {
+		name        string
+		chunkBy     string
+		wantNext    string
+		wantActive  int
+		wantBlocked []bool
+		wantDepends [][]string
+	}{
+		{
+			name:        "tasks depend on the previous phase",
+			chunkBy:     prompt.ChunkByFile,
+			wantNext:    "task-001",
+			wantActive:  1,
+			wantBlocked: []bool{false, true, true, true},
+			wantDepends: [][]string{{}, {"task-001"}, {"task-001"}, {"task-001"}},
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			output := chunkOutput()
+			output.Phases[0].NeedsRerun = true
+			tasks, _ := prompt.SplitTasks(output, prompt.ChunkOptions{By: tt.chunkBy})
+			plan := prompt.BuildPlan(output, tasks)
+
+			// Verify gating summary
+			if plan.NextTask != tt.wantNext || plan.ActivePhase != tt.wantActive {
+				t.Errorf("next = %q, active = %d, want %q, %d", plan.NextTask, plan.ActivePhase, tt.wantNext, tt.wantActive)
+			}
+			// Verify phases
+			if !plan.Phases[0].NeedsRerun || plan.Phases[0].Blocked || !plan.Phases[1].Blocked {
+				t.Errorf("phases = %+v", plan.Phases)
+			}
+			// Verify phase task lists
+			if !slices.Equal(plan.Phases[1].Tasks, []string{"task-002", "task-003", "task-004"}) {
+				t.Errorf("phase 2 tasks = %v", plan.Phases[1].Tasks)
+			}
+			// Verify each task
+			for i, task := range plan.Tasks {
+				// Check blocking and dependencies
+				if task.Blocked != tt.wantBlocked[i] || !slices.Equal(task.DependsOn, tt.wantDepends[i]) {
+					t.Errorf("task %d = blocked %v, depends %v", i, task.Blocked, task.DependsOn)
+				}
+			}
+		})
+	}
+}
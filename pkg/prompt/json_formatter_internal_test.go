@@ -0,0 +1,78 @@
+// Package prompt provides white-box tests for the JSON task plan.
+package prompt
+
+import (
+	"slices"
+	"testing"
+)
+
+// Test_buildJSONPhase tests phase descriptions.
+//
+// Params:
+//   - t: testing object
+func Test_buildJSONPhase(t *testing.T) {
+	tests := []struct {
+		name    string
+		phase   PhaseGroup
+		blocked bool
+		want    JSONPhase
+	}{
+		{
+			name: "rules and counts",
+			phase: PhaseGroup{Name: "Local Fixes", NeedsRerun: false, Rules: []RuleViolations{
+				{Code: "KTN-A", Violations: make([]Violation, 2)},
+				{Code: "KTN-B", Violations: make([]Violation, 1)},
+			}},
+			blocked: true,
+			want:    JSONPhase{Number: 2, Name: "Local Fixes", Blocked: true, Violations: 3, Rules: []string{"KTN-A", "KTN-B"}},
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildJSONPhase(&tt.phase, 2, tt.blocked)
+			// Verify description
+			if got.Number != tt.want.Number || got.Name != tt.want.Name || got.Blocked != tt.want.Blocked ||
+				got.Violations != tt.want.Violations || !slices.Equal(got.Rules, tt.want.Rules) || len(got.Tasks) != 0 {
+				t.Errorf("buildJSONPhase() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_buildJSONTask tests task descriptions.
+//
+// Params:
+//   - t: testing object
+func Test_buildJSONTask(t *testing.T) {
+	tests := []struct {
+		name string
+		task Task
+	}{
+		{
+			name: "rule metadata and violations",
+			task: Task{ID: "task-007", Number: 7, PhaseName: "Local Fixes", Scope: "KTN-A", Rules: []RuleViolations{
+				{Code: "KTN-A", Category: "func", Description: "desc", Violations: []Violation{{FilePath: "/missing.go", Line: 3, Column: 2, Message: "msg"}}},
+			}},
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildJSONTask(&tt.task, 3, sourceLines{}, functionSpans{})
+			// Verify task fields
+			if got.ID != "task-007" || got.Phase != 3 || got.Violations != 1 || got.DependsOn == nil {
+				t.Errorf("buildJSONTask() = %+v", got)
+			}
+			rule := got.Rules[0]
+			// Verify rule and violation
+			if rule.Code != "KTN-A" || rule.Category != "func" || rule.Violations[0].Line != 3 || rule.Violations[0].Snippet != nil {
+				t.Errorf("rule = %+v", rule)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+// JSONPhase represents a phase of the plan in JSON format.
+// Blocked phases wait for earlier phases; NeedsRerun asks for a new plan once done.
+type JSONPhase struct {
+	Number      int      `json:"number"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	NeedsRerun  bool     `json:"needsRerun"`
+	Blocked     bool     `json:"blocked"`
+	Violations  int      `json:"violations"`
+	Rules       []string `json:"rules"`
+	Tasks       []string `json:"tasks"`
+}
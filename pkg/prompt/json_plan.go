@@ -0,0 +1,14 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+// JSONPlan is the machine-readable task plan for agent orchestrators.
+// Lists phases and tasks in execution order with their gating state.
+type JSONPlan struct {
+	Version         string      `json:"version"`
+	TotalViolations int         `json:"totalViolations"`
+	TotalRules      int         `json:"totalRules"`
+	ActivePhase     int         `json:"activePhase"`
+	NextTask        string      `json:"nextTask,omitempty"`
+	Phases          []JSONPhase `json:"phases"`
+	Tasks           []JSONTask  `json:"tasks"`
+}
@@ -0,0 +1,12 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+// JSONRule represents a rule and its violations within a task.
+// Carries the rule metadata needed to fix the violations without other context.
+type JSONRule struct {
+	Code        string          `json:"code"`
+	Category    string          `json:"category"`
+	Description string          `json:"description"`
+	GoodExample string          `json:"goodExample,omitempty"`
+	Violations  []JSONViolation `json:"violations"`
+}
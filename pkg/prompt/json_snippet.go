@@ -0,0 +1,10 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+// JSONSnippet represents a source range in JSON format.
+// Covers the enclosing function, or the lines around a violation outside functions.
+type JSONSnippet struct {
+	StartLine int    `json:"startLine"`
+	EndLine   int    `json:"endLine"`
+	Code      string `json:"code"`
+}
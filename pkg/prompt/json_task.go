@@ -0,0 +1,16 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+// JSONTask represents a self-contained task in JSON format.
+// A task can start once every task listed in DependsOn is done.
+type JSONTask struct {
+	ID         string     `json:"id"`
+	Number     int        `json:"number"`
+	Phase      int        `json:"phase"`
+	PhaseName  string     `json:"phaseName"`
+	Scope      string     `json:"scope"`
+	Blocked    bool       `json:"blocked"`
+	DependsOn  []string   `json:"dependsOn"`
+	Violations int        `json:"violations"`
+	Rules      []JSONRule `json:"rules"`
+}
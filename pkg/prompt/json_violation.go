@@ -0,0 +1,13 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+// JSONViolation represents a single violation in JSON format.
+// Includes the enclosing function and its source when available.
+type JSONViolation struct {
+	File     string       `json:"file"`
+	Line     int          `json:"line"`
+	Column   int          `json:"column"`
+	Message  string       `json:"message"`
+	Function string       `json:"function,omitempty"`
+	Snippet  *JSONSnippet `json:"snippet,omitempty"`
+}
@@ -0,0 +1,17 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+// PhaseProgress summarizes the verified tasks of a phase.
+// A completed phase with NeedsRerun asks for a new plan before going on.
+type PhaseProgress struct {
+	// Phase is the phase identifier.
+	Phase RulePhase
+	// Name is the human-readable phase name.
+	Name string
+	// Done is the number of verified tasks.
+	Done int
+	// Total is the number of tasks in the phase.
+	Total int
+	// NeedsRerun indicates if the plan must be regenerated after this phase.
+	NeedsRerun bool
+}
@@ -0,0 +1,159 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+)
+
+// maxSnippetLines caps function snippets; longer functions fall back to
+// the lines around the violation.
+const maxSnippetLines int = 80
+
+// functionSpans caches the function declarations of source files, by path.
+type functionSpans map[string][]funcSpan
+
+// enclosing returns the function declaration containing a line.
+//
+// Params:
+//   - path: source file path
+//   - line: 1-based line
+//
+// Returns:
+//   - funcSpan: enclosing function
+//   - bool: true if the line is inside a function
+func (s functionSpans) enclosing(path string, line int) (funcSpan, bool) {
+	// Search declarations of the file
+	for _, span := range s.load(path) {
+		// Check line range
+		if line >= span.start && line <= span.end {
+			// Return enclosing function
+			return span, true
+		}
+	}
+
+	// Return not found
+	return funcSpan{}, false
+}
+
+// load parses the function declarations of a file once.
+//
+// Params:
+//   - path: source file path
+//
+// Returns:
+//   - []funcSpan: declarations, empty if the file does not parse
+func (s functionSpans) load(path string) []funcSpan {
+	// Check cache
+	if spans, ok := s[path]; ok {
+		// Return cached declarations
+		return spans
+	}
+
+	spans := []funcSpan{}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, parser.ParseComments|parser.SkipObjectResolution)
+	// Collect declarations of parsable files
+	if err == nil {
+		// Keep function declarations only
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			// Skip other declarations
+			if !ok {
+				continue
+			}
+			start := funcDecl.Pos()
+			// Include the doc comment
+			if funcDecl.Doc != nil {
+				start = funcDecl.Doc.Pos()
+			}
+			spans = append(spans, funcSpan{
+				name:  funcName(funcDecl),
+				start: fset.Position(start).Line,
+				end:   fset.Position(funcDecl.End()).Line,
+			})
+		}
+	}
+	s[path] = spans
+
+	// Return file declarations
+	return spans
+}
+
+// funcName returns the qualified name of a function ("Type.Method").
+//
+// Params:
+//   - decl: function declaration
+//
+// Returns:
+//   - string: function or method name
+func funcName(decl *ast.FuncDecl) string {
+	// Plain functions have no receiver
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		// Return function name
+		return decl.Name.Name
+	}
+
+	recv := decl.Recv.List[0].Type
+	// Strip pointer and type parameters
+	for {
+		// Unwrap receiver expression
+		switch expr := recv.(type) {
+		// Pointer receiver
+		case *ast.StarExpr:
+			recv = expr.X
+		// Generic receiver with one type parameter
+		case *ast.IndexExpr:
+			recv = expr.X
+		// Generic receiver with several type parameters
+		case *ast.IndexListExpr:
+			recv = expr.X
+		// Named receiver type
+		case *ast.Ident:
+			// Return qualified method name
+			return expr.Name + "." + decl.Name.Name
+		// Unexpected receiver syntax
+		default:
+			// Return method name alone
+			return decl.Name.Name
+		}
+	}
+}
+
+// violationSnippet returns the enclosing function and source of a violation.
+// Functions longer than maxSnippetLines are replaced by the lines around
+// the violation.
+//
+// Params:
+//   - violation: violation to locate
+//   - lines: source line cache
+//   - spans: function declaration cache
+//
+// Returns:
+//   - string: enclosing function name, empty outside functions
+//   - *JSONSnippet: source range, nil if the file is unavailable
+func violationSnippet(violation *Violation, lines sourceLines, spans functionSpans) (string, *JSONSnippet) {
+	source := lines.load(violation.FilePath)
+	// Check line range
+	if violation.Line < 1 || violation.Line > len(source) {
+		// Return no snippet for unknown positions
+		return "", nil
+	}
+
+	span, inFunction := spans.enclosing(violation.FilePath, violation.Line)
+	start := max(1, violation.Line-excerptRadius)
+	end := min(len(source), violation.Line+excerptRadius)
+	// Prefer the whole enclosing function
+	if inFunction && span.end-span.start < maxSnippetLines {
+		start, end = span.start, min(len(source), span.end)
+	}
+
+	// Return function and source range
+	return span.name, &JSONSnippet{
+		StartLine: start,
+		EndLine:   end,
+		Code:      strings.Join(source[start-1:end], "\n") + "\n",
+	}
+}
@@ -0,0 +1,186 @@
+// Package prompt provides white-box tests for function snippets.
+package prompt
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// snippetSource declares a function, a method and a package-level variable.
+const snippetSource string = `package a
+
+// F is a function.
+func F() int {
+	return 1
+}
+
+var V = 2
+
+func (r *Repo[K]) Get() {
+	_ = r
+}
+`
+
+// writeSnippetSource writes snippetSource to a temporary file.
+//
+// Params:
+//   - t: testing object
+//
+// Returns:
+//   - string: file path
+func writeSnippetSource(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "a.go")
+	// Write source file
+	if err := os.WriteFile(path, []byte(snippetSource), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// Return file path
+	return path
+}
+
+// Test_functionSpans_enclosing tests locating the enclosing function.
+//
+// Params:
+//   - t: testing object
+func Test_functionSpans_enclosing(t *testing.T) {
+	path := writeSnippetSource(t)
+	tests := []struct {
+		name      string
+		path      string
+		line      int
+		wantName  string
+		wantStart int
+		wantFound bool
+	}{
+		{name: "inside function with doc", path: path, line: 5, wantName: "F", wantStart: 3, wantFound: true},
+		{name: "inside generic method", path: path, line: 11, wantName: "Repo.Get", wantStart: 10, wantFound: true},
+		{name: "package level", path: path, line: 8, wantFound: false},
+		{name: "unparsable file", path: filepath.Join(t.TempDir(), "missing.go"), line: 1, wantFound: false},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			span, found := functionSpans{}.enclosing(tt.path, tt.line)
+			// Verify lookup
+			if found != tt.wantFound || span.name != tt.wantName || (found && span.start != tt.wantStart) {
+				t.Errorf("enclosing() = %+v, %v, want %s@%d, %v", span, found, tt.wantName, tt.wantStart, tt.wantFound)
+			}
+		})
+	}
+}
+
+// Test_functionSpans_load tests that files are parsed once.
+//
+// Params:
+//   - t: testing object
+func Test_functionSpans_load(t *testing.T) {
+	tests := []struct {
+		name      string
+		wantSpans int
+	}{
+		{name: "cached after first parse", wantSpans: 2},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeSnippetSource(t)
+			spans := functionSpans{}
+			spans.load(path)
+			// Remove file to prove the cache is used
+			if err := os.Remove(path); err != nil {
+				t.Fatal(err)
+			}
+			// Verify cached spans
+			if got := len(spans.load(path)); got != tt.wantSpans {
+				t.Errorf("load() = %d spans, want %d", got, tt.wantSpans)
+			}
+		})
+	}
+}
+
+// Test_funcName tests qualified function names.
+//
+// Params:
+//   - t: testing object
+func Test_funcName(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{name: "function", src: "func F() {}", want: "F"},
+		{name: "value receiver", src: "func (t T) M() {}", want: "T.M"},
+		{name: "pointer receiver", src: "func (t *T) M() {}", want: "T.M"},
+		{name: "generic receiver", src: "func (t *T[K, V]) M() {}", want: "T.M"},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := parser.ParseFile(token.NewFileSet(), "a.go", "package a\n"+tt.src, 0)
+			// Verify parsing
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Verify name
+			if got := funcName(file.Decls[0].(*ast.FuncDecl)); got != tt.want {
+				t.Errorf("funcName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_violationSnippet tests function and fallback snippets.
+//
+// Params:
+//   - t: testing object
+func Test_violationSnippet(t *testing.T) {
+	path := writeSnippetSource(t)
+	tests := []struct {
+		name         string
+		violation    Violation
+		wantFunction string
+		wantStart    int
+		wantEnd      int
+		wantNil      bool
+	}{
+		{name: "whole function", violation: Violation{FilePath: path, Line: 5}, wantFunction: "F", wantStart: 3, wantEnd: 6},
+		{name: "lines around package level", violation: Violation{FilePath: path, Line: 8}, wantStart: 5, wantEnd: 11},
+		{name: "unknown line", violation: Violation{FilePath: path, Line: 99}, wantNil: true},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			function, snippet := violationSnippet(&tt.violation, sourceLines{}, functionSpans{})
+			// Verify missing snippet
+			if tt.wantNil {
+				// Check nil snippet
+				if snippet != nil {
+					t.Errorf("violationSnippet() = %+v, want nil", snippet)
+				}
+				return
+			}
+			// Verify range and function
+			if function != tt.wantFunction || snippet.StartLine != tt.wantStart || snippet.EndLine != tt.wantEnd {
+				t.Errorf("violationSnippet() = %q, %d-%d, want %q, %d-%d", function, snippet.StartLine, snippet.EndLine, tt.wantFunction, tt.wantStart, tt.wantEnd)
+			}
+			// Verify code matches the range
+			if strings.Count(snippet.Code, "\n") != tt.wantEnd-tt.wantStart+1 {
+				t.Errorf("snippet code has wrong line count: %q", snippet.Code)
+			}
+		})
+	}
+}
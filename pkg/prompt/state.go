@@ -0,0 +1,164 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+const (
+	// StateFileName is the default state file of the prompt task plan.
+	StateFileName string = ".ktn-prompt-state.json"
+	// TaskPending marks a task not verified yet.
+	TaskPending string = "pending"
+	// TaskDone marks a task whose verification passed.
+	TaskDone string = "done"
+	// TaskFailed marks a task whose last verification failed.
+	TaskFailed string = "failed"
+	// stateVersion is the version of the state file format.
+	stateVersion string = "1"
+	// stateFileMode is the permission of the state file.
+	stateFileMode os.FileMode = 0o644
+)
+
+// State tracks the progress of an agent through a task plan.
+// It is written when tasks are generated and updated by each verification.
+type State struct {
+	Version string       `json:"version"`
+	Tasks   []TaskRecord `json:"tasks"`
+}
+
+// NewState records the findings of a task plan, every task pending.
+//
+// Params:
+//   - tasks: ordered tasks from SplitTasks
+//
+// Returns:
+//   - *State: new state
+func NewState(tasks []Task) *State {
+	records := make([]TaskRecord, 0, len(tasks))
+	// Record each task
+	for i := range tasks {
+		task := &tasks[i]
+		findings := make([]Finding, 0, countTaskViolations(task))
+		// Record each violation
+		for _, rule := range task.Rules {
+			// Keep location and message
+			for _, violation := range rule.Violations {
+				findings = append(findings, Finding{Code: rule.Code, File: violation.FilePath, Line: violation.Line, Message: violation.Message})
+			}
+		}
+		records = append(records, TaskRecord{
+			ID:        task.ID,
+			Phase:     task.Phase,
+			PhaseName: task.PhaseName,
+			Scope:     task.Scope,
+			Status:    TaskPending,
+			Findings:  findings,
+		})
+	}
+
+	// Return pending state
+	return &State{Version: stateVersion, Tasks: records}
+}
+
+// LoadState reads a state file.
+//
+// Params:
+//   - path: state file path
+//
+// Returns:
+//   - *State: decoded state
+//   - error: read or decoding error
+func LoadState(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	// Check read error
+	if err != nil {
+		// Return read error
+		return nil, fmt.Errorf("reading prompt state: %w", err)
+	}
+
+	state := &State{}
+	// Check decoding error
+	if err := json.Unmarshal(data, state); err != nil {
+		// Return decoding error
+		return nil, fmt.Errorf("decoding prompt state %s: %w", path, err)
+	}
+
+	// Return decoded state
+	return state, nil
+}
+
+// Save writes the state file.
+//
+// Params:
+//   - path: state file path
+//
+// Returns:
+//   - error: encoding or write error
+func (s *State) Save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	// Check encoding error
+	if err != nil {
+		// Return encoding error
+		return fmt.Errorf("encoding prompt state: %w", err)
+	}
+
+	// Check write error
+	if err := os.WriteFile(path, append(data, '\n'), stateFileMode); err != nil {
+		// Return write error
+		return fmt.Errorf("writing prompt state: %w", err)
+	}
+
+	// Return success
+	return nil
+}
+
+// Task returns the record of a task.
+//
+// Params:
+//   - id: task identifier
+//
+// Returns:
+//   - *TaskRecord: task record, nil if unknown
+func (s *State) Task(id string) *TaskRecord {
+	// Search records
+	for i := range s.Tasks {
+		// Check identifier
+		if s.Tasks[i].ID == id {
+			// Return matching record
+			return &s.Tasks[i]
+		}
+	}
+
+	// Return not found
+	return nil
+}
+
+// Progress summarizes the tasks of each phase, in plan order.
+//
+// Returns:
+//   - []PhaseProgress: progress of each phase with tasks
+func (s *State) Progress() []PhaseProgress {
+	progress := make([]PhaseProgress, 0, phaseCount)
+	// Accumulate tasks by phase, phases being contiguous in the plan
+	for i := range s.Tasks {
+		record := &s.Tasks[i]
+		last := len(progress) - 1
+		// Open a phase when it changes
+		if last < 0 || progress[last].Phase != record.Phase {
+			_, _, needsRerun := GetPhaseInfo(record.Phase)
+			progress = append(progress, PhaseProgress{Phase: record.Phase, Name: record.PhaseName, NeedsRerun: needsRerun})
+			last++
+		}
+		progress[last].Total++
+		// Count verified tasks
+		if record.Status == TaskDone {
+			progress[last].Done++
+		}
+	}
+
+	// Return phase progress
+	return progress
+}
@@ -0,0 +1,219 @@
+// Package prompt_test provides black-box tests for the task state file.
+package prompt_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/prompt"
+)
+
+// stateTasks returns a plan of three tasks over two phases.
+//
+// Returns:
+//   - []prompt.Task: ordered tasks
+func stateTasks() []prompt.Task {
+	rule := func(code string) []prompt.RuleViolations {
+		// Return one rule with one violation
+		return []prompt.RuleViolations{{Code: code, Violations: []prompt.Violation{{FilePath: "/src/a.go", Line: 3, Message: "fix"}}}}
+	}
+	// Return the plan
+	return []prompt.Task{
+		{ID: "task-001", Phase: prompt.PhaseStructural, PhaseName: "Structural Changes", Rules: rule("KTN-STRUCT-004")},
+		{ID: "task-002", Phase: prompt.PhaseLocal, PhaseName: "Local Fixes", Rules: rule("KTN-FUNC-001")},
+		{ID: "task-003", Phase: prompt.PhaseLocal, PhaseName: "Local Fixes", Rules: rule("KTN-VAR-001")},
+	}
+}
+
+// TestNewState tests recording a plan.
+//
+// Params:
+//   - t: testing object
+func TestNewState(t *testing.T) {
+	tests := []struct {
+		name      string
+		tasks     []prompt.Task
+		wantTasks int
+	}{
+		{name: "every task recorded", tasks: stateTasks(), wantTasks: 3},
+		{name: "empty plan", tasks: nil, wantTasks: 0},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			state := prompt.NewState(tt.tasks)
+			// Verify records
+			if len(state.Tasks) != tt.wantTasks {
+				t.Fatalf("len(Tasks) = %d, want %d", len(state.Tasks), tt.wantTasks)
+			}
+			// Verify each record is pending with its findings
+			for _, record := range state.Tasks {
+				// Check status and findings
+				if record.Status != prompt.TaskPending || len(record.Findings) != 1 || record.Findings[0].Line != 3 {
+					t.Errorf("record = %+v, want pending with one finding", record)
+				}
+			}
+		})
+	}
+}
+
+// TestState_Save tests the state file round trip.
+//
+// Params:
+//   - t: testing object
+func TestState_Save(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    func(dir string) string
+		wantErr bool
+	}{
+		{name: "round trip", path: func(dir string) string { return filepath.Join(dir, prompt.StateFileName) }, wantErr: false},
+		{name: "missing directory", path: func(dir string) string { return filepath.Join(dir, "missing", "state.json") }, wantErr: true},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			path := tt.path(t.TempDir())
+			err := prompt.NewState(stateTasks()).Save(path)
+			// Verify write error
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Save() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// Verify read back
+			if !tt.wantErr {
+				state, err := prompt.LoadState(path)
+				// Check decoded state
+				if err != nil || len(state.Tasks) != 3 || state.Tasks[1].Findings[0].Code != "KTN-FUNC-001" {
+					t.Errorf("LoadState() = %+v, %v", state, err)
+				}
+			}
+		})
+	}
+}
+
+// TestLoadState tests reading invalid state files.
+//
+// Params:
+//   - t: testing object
+func TestLoadState(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "missing file", content: ""},
+		{name: "invalid json", content: "{"},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), prompt.StateFileName)
+			// Write the file when content is given
+			if tt.content != "" {
+				// Check write error
+				if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			// Verify error
+			if _, err := prompt.LoadState(path); err == nil {
+				t.Error("LoadState() expected error")
+			}
+		})
+	}
+}
+
+// TestState_Task tests looking up a task record.
+//
+// Params:
+//   - t: testing object
+func TestState_Task(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        string
+		wantFound bool
+	}{
+		{name: "known task", id: "task-002", wantFound: true},
+		{name: "unknown task", id: "task-009", wantFound: false},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			state := prompt.NewState(stateTasks())
+			record := state.Task(tt.id)
+			// Verify lookup
+			if (record != nil) != tt.wantFound {
+				t.Fatalf("Task(%q) = %v, wantFound %v", tt.id, record, tt.wantFound)
+			}
+			// Verify records are shared with the state
+			if record != nil {
+				record.Status = prompt.TaskDone
+				// Check update through the state
+				if state.Task(tt.id).Status != prompt.TaskDone {
+					t.Error("Task() should return the stored record")
+				}
+			}
+		})
+	}
+}
+
+// TestState_Progress tests the phase summary.
+//
+// Params:
+//   - t: testing object
+func TestState_Progress(t *testing.T) {
+	tests := []struct {
+		name string
+		done []string
+		want []prompt.PhaseProgress
+	}{
+		{
+			name: "nothing verified",
+			done: nil,
+			want: []prompt.PhaseProgress{
+				{Phase: prompt.PhaseStructural, Name: "Structural Changes", Done: 0, Total: 1, NeedsRerun: true},
+				{Phase: prompt.PhaseLocal, Name: "Local Fixes", Done: 0, Total: 2, NeedsRerun: false},
+			},
+		},
+		{
+			name: "structural phase done",
+			done: []string{"task-001", "task-003"},
+			want: []prompt.PhaseProgress{
+				{Phase: prompt.PhaseStructural, Name: "Structural Changes", Done: 1, Total: 1, NeedsRerun: true},
+				{Phase: prompt.PhaseLocal, Name: "Local Fixes", Done: 1, Total: 2, NeedsRerun: false},
+			},
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			state := prompt.NewState(stateTasks())
+			// Mark verified tasks
+			for _, id := range tt.done {
+				state.Task(id).Status = prompt.TaskDone
+			}
+			got := state.Progress()
+			// Verify phase count
+			if len(got) != len(tt.want) {
+				t.Fatalf("Progress() = %+v, want %+v", got, tt.want)
+			}
+			// Verify each phase
+			for i := range got {
+				// Check summary
+				if got[i] != tt.want[i] {
+					t.Errorf("Progress()[%d] = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+// Task is a self-contained chunk of the prompt.
+// Contains the violations of one scope within a phase, with their rule context.
+type Task struct {
+	// ID is the task identifier used as file name (e.g., task-001).
+	ID string
+	// Number is the 1-based position of the task in the plan.
+	Number int
+	// Phase is the phase of every rule in the task.
+	Phase RulePhase
+	// PhaseName is the human-readable phase name.
+	PhaseName string
+	// Scope is the file, package directory or rule code of the task.
+	Scope string
+	// Blocked indicates that earlier phases still have violations.
+	Blocked bool
+	// Rules contains the violations of the task grouped by rule.
+	Rules []RuleViolations
+}
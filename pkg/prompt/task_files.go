@@ -0,0 +1,92 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// taskDirPerm is the permission of the task directory (rwxr-xr-x).
+	taskDirPerm os.FileMode = 0o755
+	// taskIndexFile is the name of the task list file.
+	taskIndexFile string = "index.md"
+	// taskFileExt is the extension of task files.
+	taskFileExt string = ".md"
+	// taskFileGlob matches task files left by a previous run.
+	taskFileGlob string = "task-*.md"
+)
+
+// WriteTaskFiles writes the task list and one markdown file per task.
+// Task files from a previous run are removed first.
+//
+// Params:
+//   - dir: destination directory, created if missing
+//   - output: prompt output for summary stats
+//   - tasks: ordered tasks
+//
+// Returns:
+//   - error: directory or file write error
+func WriteTaskFiles(dir string, output *PromptOutput, tasks []Task) error {
+	// Create destination directory
+	if err := os.MkdirAll(dir, taskDirPerm); err != nil {
+		// Return directory error
+		return fmt.Errorf("creating task directory: %w", err)
+	}
+
+	stale, _ := filepath.Glob(filepath.Join(dir, taskFileGlob))
+	// Remove tasks of a previous run
+	for _, path := range stale {
+		// Check removal error
+		if err := os.Remove(path); err != nil {
+			// Return removal error
+			return fmt.Errorf("removing stale task: %w", err)
+		}
+	}
+
+	// Write the task list
+	if err := writeTaskFile(filepath.Join(dir, taskIndexFile), func(w io.Writer) {
+		NewTaskFormatter(w).FormatIndex(output, tasks)
+	}); err != nil {
+		// Return index error
+		return err
+	}
+
+	// Write each task
+	for i := range tasks {
+		task := &tasks[i]
+		// Check task write error
+		if err := writeTaskFile(filepath.Join(dir, task.ID+taskFileExt), func(w io.Writer) {
+			NewTaskFormatter(w).FormatTask(task, len(tasks))
+		}); err != nil {
+			// Return task error
+			return err
+		}
+	}
+
+	// Return success
+	return nil
+}
+
+// writeTaskFile creates a file and renders its content.
+//
+// Params:
+//   - path: file to create
+//   - render: function writing the content
+//
+// Returns:
+//   - error: create or close error
+func writeTaskFile(path string, render func(w io.Writer)) error {
+	file, err := os.Create(path)
+	// Check create error
+	if err != nil {
+		// Return create error
+		return fmt.Errorf("creating task file: %w", err)
+	}
+	render(file)
+
+	// Return close error, reporting failed writes
+	return file.Close()
+}
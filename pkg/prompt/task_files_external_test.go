@@ -0,0 +1,88 @@
+// Package prompt_test provides black-box tests for task files.
+package prompt_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/prompt"
+)
+
+// TestWriteTaskFiles tests writing the index and task files.
+//
+// Params:
+//   - t: testing object
+func TestWriteTaskFiles(t *testing.T) {
+	tests := []struct {
+		name      string
+		wantFiles []string
+	}{
+		{
+			name:      "index and one file per task, stale tasks removed",
+			wantFiles: []string{"index.md", "task-001.md", "task-002.md"},
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "tasks")
+			// Leave a task from a previous, larger run
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				t.Fatal(err)
+			}
+			// Write stale task
+			if err := os.WriteFile(filepath.Join(dir, "task-003.md"), []byte("stale"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			err := prompt.WriteTaskFiles(dir, &prompt.PromptOutput{TotalViolations: 3}, sampleTasks())
+			// Verify no error
+			if err != nil {
+				t.Fatalf("WriteTaskFiles() error = %v", err)
+			}
+			entries, _ := os.ReadDir(dir)
+			// Verify file count
+			if len(entries) != len(tt.wantFiles) {
+				t.Fatalf("WriteTaskFiles() wrote %d files, want %d", len(entries), len(tt.wantFiles))
+			}
+			// Verify file names
+			for i, entry := range entries {
+				// Check name
+				if entry.Name() != tt.wantFiles[i] {
+					t.Errorf("file %d = %q, want %q", i, entry.Name(), tt.wantFiles[i])
+				}
+			}
+		})
+	}
+}
+
+// TestWriteTaskFiles_error tests an unwritable destination.
+//
+// Params:
+//   - t: testing object
+func TestWriteTaskFiles_error(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{name: "destination is a file"},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "file")
+			// Create a file where the directory should be
+			if err := os.WriteFile(path, []byte{}, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			// Verify error
+			if err := prompt.WriteTaskFiles(path, &prompt.PromptOutput{}, sampleTasks()); err == nil {
+				t.Error("WriteTaskFiles() expected error")
+			}
+		})
+	}
+}
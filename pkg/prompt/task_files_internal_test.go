@@ -0,0 +1,41 @@
+// Package prompt provides white-box tests for task files.
+package prompt
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// Test_writeTaskFile tests creating and rendering a file.
+//
+// Params:
+//   - t: testing object
+func Test_writeTaskFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		subdir  string
+		wantErr bool
+	}{
+		{name: "writes content", subdir: "", wantErr: false},
+		{name: "missing directory", subdir: "missing", wantErr: true},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.subdir, "task.md")
+			err := writeTaskFile(path, func(w io.Writer) { io.WriteString(w, "content") })
+			// Verify error expectation
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("writeTaskFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// Verify content
+			if data, _ := os.ReadFile(path); !tt.wantErr && string(data) != "content" {
+				t.Errorf("file content = %q, want content", data)
+			}
+		})
+	}
+}
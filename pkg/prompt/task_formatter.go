@@ -0,0 +1,124 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+import (
+	"fmt"
+	"io"
+)
+
+// TaskFormatter formats split prompt tasks as markdown files.
+// Each task repeats the rule context so it can be handed over on its own.
+type TaskFormatter struct {
+	writer   io.Writer
+	markdown *MarkdownFormatter
+}
+
+// NewTaskFormatter creates a new task formatter.
+//
+// Params:
+//   - w: writer for output
+//
+// Returns:
+//   - *TaskFormatter: new formatter instance
+func NewTaskFormatter(w io.Writer) *TaskFormatter {
+	// Return formatter sharing the writer with the rule renderer
+	return &TaskFormatter{writer: w, markdown: NewMarkdownFormatter(w)}
+}
+
+// FormatIndex writes the ordered list of tasks.
+//
+// Params:
+//   - output: prompt output for summary stats
+//   - tasks: ordered tasks
+func (f *TaskFormatter) FormatIndex(output *PromptOutput, tasks []Task) {
+	// Guard against nil receiver, writer, or output
+	if f == nil || f.writer == nil || output == nil {
+		// Return early to avoid nil pointer dereference
+		return
+	}
+
+	fmt.Fprintln(f.writer, "# KTN-Linter Tasks")
+	fmt.Fprintln(f.writer)
+	fmt.Fprintf(f.writer, "**Total**: %d violations across %d rules, %d tasks\n\n",
+		output.TotalViolations, output.TotalRules, len(tasks))
+	fmt.Fprintln(f.writer, "Traitez les taches dans l'ordre. Chaque fichier est autonome : regle, exemple et extraits de code.")
+	fmt.Fprintln(f.writer, "Les taches bloquees (⏸️) attendent la fin des phases precedentes : re-generez alors les taches.")
+	fmt.Fprintln(f.writer)
+
+	// List each task
+	for i := range tasks {
+		task := &tasks[i]
+		status := ""
+		// Flag blocked tasks
+		if task.Blocked {
+			status = " ⏸️"
+		}
+		fmt.Fprintf(f.writer, "- [ ] [%s](%s.md)%s - %s - `%s` (%d violations)\n",
+			task.ID, task.ID, status, task.PhaseName, task.Scope, countTaskViolations(task))
+	}
+	fmt.Fprintln(f.writer)
+}
+
+// FormatTask writes a single self-contained task.
+//
+// Params:
+//   - task: task to write
+//   - total: number of tasks in the plan
+func (f *TaskFormatter) FormatTask(task *Task, total int) {
+	// Guard against nil receiver, writer, or task
+	if f == nil || f.writer == nil || task == nil {
+		// Return early to avoid nil pointer dereference
+		return
+	}
+
+	f.writeTaskHeader(task, total)
+
+	// Write each rule with its violations and excerpts
+	for i := range task.Rules {
+		f.markdown.writeRule(&task.Rules[i])
+	}
+}
+
+// writeTaskHeader writes the task title, scope and instructions.
+//
+// Params:
+//   - task: task to describe
+//   - total: number of tasks in the plan
+func (f *TaskFormatter) writeTaskHeader(task *Task, total int) {
+	fmt.Fprintf(f.writer, "# KTN-Linter Task %d/%d\n\n", task.Number, total)
+	fmt.Fprintf(f.writer, "**Phase**: %s\n\n", task.PhaseName)
+	fmt.Fprintf(f.writer, "**Scope**: `%s`\n\n", task.Scope)
+	fmt.Fprintf(f.writer, "**Total**: %d violations\n\n", countTaskViolations(task))
+
+	// Warn about earlier phases
+	if task.Blocked {
+		fmt.Fprintln(f.writer, "> ⚠️ **Completez les phases precedentes avant de traiter cette tache.**")
+		fmt.Fprintln(f.writer, "> Les fichiers peuvent changer suite aux corrections structurelles.")
+		fmt.Fprintln(f.writer)
+	}
+
+	fmt.Fprintln(f.writer, "## Instructions")
+	fmt.Fprintln(f.writer)
+	fmt.Fprintln(f.writer, "Corrigez uniquement les violations listees ci-dessous, puis cochez-les.")
+	fmt.Fprintln(f.writer, "Les lignes marquees `>` dans les extraits sont celles signalees par le linter.")
+	fmt.Fprintln(f.writer)
+	fmt.Fprintln(f.writer, "---")
+	fmt.Fprintln(f.writer)
+}
+
+// countTaskViolations counts the violations of a task.
+//
+// Params:
+//   - task: task to count
+//
+// Returns:
+//   - int: total violation count
+func countTaskViolations(task *Task) int {
+	count := 0
+	// Sum violations from all rules
+	for i := range task.Rules {
+		count += len(task.Rules[i].Violations)
+	}
+	// Return total count
+	return count
+}
@@ -0,0 +1,144 @@
+// Package prompt_test provides black-box tests for task formatting.
+package prompt_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/prompt"
+)
+
+// sampleTasks returns an unblocked and a blocked task.
+//
+// Returns:
+//   - []prompt.Task: tasks to format
+func sampleTasks() []prompt.Task {
+	// Return one task per phase
+	return []prompt.Task{
+		{
+			ID: "task-001", Number: 1, PhaseName: "Structural Changes", Scope: "pkg/a.go",
+			Rules: []prompt.RuleViolations{{Code: "KTN-STRUCT-004", Description: "One struct per file", Violations: []prompt.Violation{{FilePath: "pkg/a.go", Line: 3, Excerpt: "> 3 | type A struct{}\n"}}}},
+		},
+		{
+			ID: "task-002", Number: 2, PhaseName: "Local Fixes", Scope: "pkg/b.go", Blocked: true,
+			Rules: []prompt.RuleViolations{{Code: "KTN-FUNC-001", Violations: []prompt.Violation{{FilePath: "pkg/b.go", Line: 1}, {FilePath: "pkg/b.go", Line: 9}}}},
+		},
+	}
+}
+
+// TestNewTaskFormatter tests formatter creation.
+//
+// Params:
+//   - t: testing object
+func TestNewTaskFormatter(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{name: "creates formatter"},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify formatter is created
+			if prompt.NewTaskFormatter(&bytes.Buffer{}) == nil {
+				t.Error("NewTaskFormatter() returned nil")
+			}
+		})
+	}
+}
+
+// TestTaskFormatter_FormatIndex tests the task list.
+//
+// Params:
+//   - t: testing object
+func TestTaskFormatter_FormatIndex(t *testing.T) {
+	tests := []struct {
+		name   string
+		output *prompt.PromptOutput
+		want   []string
+	}{
+		{
+			name:   "lists tasks in order",
+			output: &prompt.PromptOutput{TotalViolations: 3, TotalRules: 2},
+			want: []string{
+				"**Total**: 3 violations across 2 rules, 2 tasks",
+				"- [ ] [task-001](task-001.md) - Structural Changes - `pkg/a.go` (1 violations)",
+				"- [ ] [task-002](task-002.md) ⏸️ - Local Fixes - `pkg/b.go` (2 violations)",
+			},
+		},
+		{
+			name:   "nil output writes nothing",
+			output: nil,
+			want:   []string{},
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			prompt.NewTaskFormatter(&buf).FormatIndex(tt.output, sampleTasks())
+			// Verify expected lines
+			for _, want := range tt.want {
+				// Check line presence
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("FormatIndex() missing %q in:\n%s", want, buf.String())
+				}
+			}
+			// Verify nil output is ignored
+			if tt.output == nil && buf.Len() != 0 {
+				t.Errorf("FormatIndex(nil) wrote %q", buf.String())
+			}
+		})
+	}
+}
+
+// TestTaskFormatter_FormatTask tests a self-contained task file.
+//
+// Params:
+//   - t: testing object
+func TestTaskFormatter_FormatTask(t *testing.T) {
+	tests := []struct {
+		name    string
+		index   int
+		want    []string
+		notWant string
+	}{
+		{
+			name:    "active task with excerpt",
+			index:   0,
+			want:    []string{"# KTN-Linter Task 1/2", "**Scope**: `pkg/a.go`", "### KTN-STRUCT-004", "**Description**: One struct per file", "  > 3 | type A struct{}"},
+			notWant: "Completez les phases precedentes",
+		},
+		{
+			name:  "blocked task",
+			index: 1,
+			want:  []string{"# KTN-Linter Task 2/2", "Completez les phases precedentes", "**Total**: 2 violations"},
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			tasks := sampleTasks()
+			prompt.NewTaskFormatter(&buf).FormatTask(&tasks[tt.index], len(tasks))
+			// Verify expected content
+			for _, want := range tt.want {
+				// Check content presence
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("FormatTask() missing %q in:\n%s", want, buf.String())
+				}
+			}
+			// Verify unwanted content
+			if tt.notWant != "" && strings.Contains(buf.String(), tt.notWant) {
+				t.Errorf("FormatTask() should not contain %q", tt.notWant)
+			}
+		})
+	}
+}
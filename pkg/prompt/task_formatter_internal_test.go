@@ -0,0 +1,69 @@
+// Package prompt provides white-box tests for task formatting.
+package prompt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// Test_TaskFormatter_writeTaskHeader tests the task header.
+//
+// Params:
+//   - t: testing object
+func Test_TaskFormatter_writeTaskHeader(t *testing.T) {
+	tests := []struct {
+		name string
+		task Task
+		want string
+	}{
+		{
+			name: "numbered title",
+			task: Task{Number: 3, PhaseName: "Local Fixes", Scope: "KTN-FUNC-001"},
+			want: "# KTN-Linter Task 3/7\n\n**Phase**: Local Fixes\n\n**Scope**: `KTN-FUNC-001`\n\n**Total**: 0 violations\n\n## Instructions",
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			NewTaskFormatter(&buf).writeTaskHeader(&tt.task, 7)
+			// Verify header
+			if !strings.HasPrefix(buf.String(), tt.want) {
+				t.Errorf("writeTaskHeader() = %q, want prefix %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+// Test_countTaskViolations tests violation counting across rules.
+//
+// Params:
+//   - t: testing object
+func Test_countTaskViolations(t *testing.T) {
+	tests := []struct {
+		name string
+		task Task
+		want int
+	}{
+		{name: "empty task", task: Task{}, want: 0},
+		{
+			name: "several rules",
+			task: Task{Rules: []RuleViolations{{Violations: make([]Violation, 2)}, {Violations: make([]Violation, 3)}}},
+			want: 5,
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify count
+			if got := countTaskViolations(&tt.task); got != tt.want {
+				t.Errorf("countTaskViolations() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+// TaskRecord is the state of a task in the state file.
+// Keeps the findings to fix and the outcome of the last verification.
+type TaskRecord struct {
+	ID        string    `json:"id"`
+	Phase     RulePhase `json:"phase"`
+	PhaseName string    `json:"phaseName"`
+	Scope     string    `json:"scope"`
+	Status    string    `json:"status"`
+	Findings  []Finding `json:"findings"`
+}
@@ -0,0 +1,51 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteVerdict writes the verdict of a verification and the plan progress.
+//
+// Params:
+//   - w: writer for output
+//   - verification: task verification
+//   - progress: phase progress after recording the verdict
+func WriteVerdict(w io.Writer, verification *Verification, progress []PhaseProgress) {
+	verdict := "FAIL"
+	// Pass on a clean re-lint
+	if verification.Passed() {
+		verdict = "PASS"
+	}
+	fmt.Fprintf(w, "%s: %s (%d resolved, %d remaining, %d introduced)\n",
+		verification.TaskID, verdict, len(verification.Resolved), len(verification.Remaining), len(verification.Introduced))
+	writeFindings(w, "remaining", verification.Remaining)
+	writeFindings(w, "introduced", verification.Introduced)
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Progress:")
+	// Write each phase
+	for i, phase := range progress {
+		fmt.Fprintf(w, "  Phase %d - %s: %d/%d\n", i+1, phase.Name, phase.Done, phase.Total)
+		// Structural phases invalidate the plan once complete
+		if phase.NeedsRerun && phase.Done == phase.Total {
+			fmt.Fprintln(w, "    Phase terminee : re-generez les taches avant de continuer.")
+		}
+	}
+}
+
+// writeFindings lists findings under a label, one line each.
+//
+// Params:
+//   - w: writer for output
+//   - label: list label
+//   - findings: findings to list
+func writeFindings(w io.Writer, label string, findings []Finding) {
+	// Write each finding
+	for _, finding := range findings {
+		summary, _, _ := strings.Cut(finding.Message, "\n")
+		fmt.Fprintf(w, "  %s %s:%d [%s] %s\n", label, finding.File, finding.Line, finding.Code, summary)
+	}
+}
@@ -0,0 +1,64 @@
+// Package prompt_test provides black-box tests for the verification verdict.
+package prompt_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/prompt"
+)
+
+// TestWriteVerdict tests the verdict and progress output.
+//
+// Params:
+//   - t: testing object
+func TestWriteVerdict(t *testing.T) {
+	finding := prompt.Finding{Code: "KTN-FUNC-001", File: "a.go", Line: 4, Message: "too long\ndetails"}
+	tests := []struct {
+		name         string
+		verification *prompt.Verification
+		progress     []prompt.PhaseProgress
+		want         []string
+		notWant      []string
+	}{
+		{
+			name:         "pass with completed structural phase",
+			verification: &prompt.Verification{TaskID: "task-001", Resolved: []prompt.Finding{finding}},
+			progress:     []prompt.PhaseProgress{{Name: "Structural Changes", Done: 1, Total: 1, NeedsRerun: true}},
+			want:         []string{"task-001: PASS (1 resolved, 0 remaining, 0 introduced)", "Phase 1 - Structural Changes: 1/1", "re-generez"},
+			notWant:      []string{"details"},
+		},
+		{
+			name:         "fail lists findings",
+			verification: &prompt.Verification{TaskID: "task-002", Remaining: []prompt.Finding{finding}},
+			progress:     []prompt.PhaseProgress{{Name: "Local Fixes", Done: 0, Total: 2}},
+			want:         []string{"task-002: FAIL", "remaining a.go:4 [KTN-FUNC-001] too long", "0/2"},
+			notWant:      []string{"re-generez", "details"},
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			prompt.WriteVerdict(&buf, tt.verification, tt.progress)
+			output := buf.String()
+			// Verify expected content
+			for _, want := range tt.want {
+				// Check presence
+				if !strings.Contains(output, want) {
+					t.Errorf("output missing %q:\n%s", want, output)
+				}
+			}
+			// Verify unexpected content
+			for _, notWant := range tt.notWant {
+				// Check absence
+				if strings.Contains(output, notWant) {
+					t.Errorf("output should not contain %q:\n%s", notWant, output)
+				}
+			}
+		})
+	}
+}
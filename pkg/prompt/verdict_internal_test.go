@@ -0,0 +1,39 @@
+// Package prompt provides white-box tests for the verification verdict.
+package prompt
+
+import (
+	"bytes"
+	"testing"
+)
+
+// Test_writeFindings tests listing findings on one line each.
+//
+// Params:
+//   - t: testing object
+func Test_writeFindings(t *testing.T) {
+	tests := []struct {
+		name     string
+		findings []Finding
+		want     string
+	}{
+		{name: "no findings", findings: nil, want: ""},
+		{
+			name:     "first message line only",
+			findings: []Finding{{Code: "KTN-VAR-001", File: "b.go", Line: 2, Message: "untyped\nmore"}},
+			want:     "  introduced b.go:2 [KTN-VAR-001] untyped\n",
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writeFindings(&buf, "introduced", tt.findings)
+			// Verify output
+			if buf.String() != tt.want {
+				t.Errorf("writeFindings() = %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
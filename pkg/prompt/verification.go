@@ -0,0 +1,24 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+// Verification is the outcome of re-linting a task.
+// Compares the recorded findings with the current ones on the task's files and rules.
+type Verification struct {
+	// TaskID is the verified task.
+	TaskID string
+	// Resolved contains recorded findings no longer reported.
+	Resolved []Finding
+	// Remaining contains recorded findings still reported.
+	Remaining []Finding
+	// Introduced contains findings absent from the record.
+	Introduced []Finding
+}
+
+// Passed reports whether the task is fixed without regressions.
+//
+// Returns:
+//   - bool: true if nothing remains and nothing was introduced
+func (v *Verification) Passed() bool {
+	// Pass only on a clean re-lint
+	return len(v.Remaining) == 0 && len(v.Introduced) == 0
+}
@@ -0,0 +1,36 @@
+// Package prompt_test provides black-box tests for verification results.
+package prompt_test
+
+import (
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/prompt"
+)
+
+// TestVerification_Passed tests the verdict of a verification.
+//
+// Params:
+//   - t: testing object
+func TestVerification_Passed(t *testing.T) {
+	finding := prompt.Finding{Code: "KTN-FUNC-001", File: "a.go", Line: 1}
+	tests := []struct {
+		name         string
+		verification prompt.Verification
+		want         bool
+	}{
+		{name: "all resolved", verification: prompt.Verification{Resolved: []prompt.Finding{finding}}, want: true},
+		{name: "still present", verification: prompt.Verification{Remaining: []prompt.Finding{finding}}, want: false},
+		{name: "regression", verification: prompt.Verification{Resolved: []prompt.Finding{finding}, Introduced: []prompt.Finding{finding}}, want: false},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify verdict
+			if got := tt.verification.Passed(); got != tt.want {
+				t.Errorf("Passed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
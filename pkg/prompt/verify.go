@@ -0,0 +1,269 @@
+// Package prompt provides AI-optimized prompt generation for KTN linter violations.
+package prompt
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+)
+
+// goModFile is the module file marking a module root.
+const goModFile string = "go.mod"
+
+// Verify re-lints the packages of a task and compares the findings of its
+// rules and files with the recorded ones. Findings recorded by other tasks
+// of the state, such as siblings split from the same scope, are not counted
+// as introduced.
+//
+// Params:
+//   - state: state holding the task
+//   - record: task record from the state
+//   - opts: orchestrator options
+//
+// Returns:
+//   - *Verification: resolved, remaining and introduced findings
+//   - error: linter error if any
+func (g *Generator) Verify(state *State, record *TaskRecord, opts orchestrator.Options) (*Verification, error) {
+	diagnostics, err := g.relint(taskDirs(record), opts)
+	// Check for error
+	if err != nil {
+		// Return nil for linter error
+		return nil, err
+	}
+
+	current := taskFindings(record, g.collectViolations(diagnostics))
+
+	// Return comparison with the record
+	return compareFindings(record, otherFindings(state, record), current), nil
+}
+
+// relint lints the given package directories, grouped by module.
+// Directories removed since the plan was generated are skipped.
+//
+// Params:
+//   - dirs: package directories
+//   - opts: orchestrator options
+//
+// Returns:
+//   - []orchestrator.DiagnosticResult: filtered diagnostics
+//   - error: linter error if any
+func (g *Generator) relint(dirs []string, opts orchestrator.Options) ([]orchestrator.DiagnosticResult, error) {
+	modules := map[string][]string{}
+	// Attach each directory to its module
+	for _, dir := range dirs {
+		// Skip removed directories
+		if _, err := os.Stat(dir); err != nil {
+			continue
+		}
+		root := moduleRoot(dir)
+		rel, err := filepath.Rel(root, dir)
+		// Check relative path
+		if err != nil {
+			// Return empty slice for path error
+			return []orchestrator.DiagnosticResult{}, fmt.Errorf("resolving package %s: %w", dir, err)
+		}
+		modules[root] = append(modules[root], "./"+filepath.ToSlash(rel))
+	}
+
+	analyzers, err := g.orch.SelectAnalyzers(opts)
+	// Check for error
+	if err != nil {
+		// Return empty slice for analyzer selection error
+		return []orchestrator.DiagnosticResult{}, err
+	}
+
+	var rawDiags []orchestrator.DiagnosticResult
+	// Load and analyze the packages of each module
+	for root, patterns := range modules {
+		pkgs, err := g.orch.LoadPackagesFromDir(root, patterns)
+		// Check for error
+		if err != nil {
+			// Return empty slice for load error
+			return []orchestrator.DiagnosticResult{}, err
+		}
+		rawDiags = append(rawDiags, g.orch.RunAnalyzers(pkgs, analyzers)...)
+	}
+
+	// Return filtered diagnostics
+	return g.orch.FilterDiagnostics(rawDiags), nil
+}
+
+// taskDirs returns the package directories of a task.
+//
+// Params:
+//   - record: task record
+//
+// Returns:
+//   - []string: sorted unique directories
+func taskDirs(record *TaskRecord) []string {
+	dirs := make([]string, 0, len(record.Findings))
+	// Collect the directory of each finding
+	for _, finding := range record.Findings {
+		dirs = append(dirs, filepath.Dir(finding.File))
+	}
+	slices.Sort(dirs)
+
+	// Return unique directories
+	return slices.Compact(dirs)
+}
+
+// moduleRoot returns the closest directory holding a go.mod.
+//
+// Params:
+//   - dir: package directory
+//
+// Returns:
+//   - string: module root, dir itself when none is found
+func moduleRoot(dir string) string {
+	// Walk up the directory tree
+	for current := dir; ; {
+		// Check for a module file
+		if _, err := os.Stat(filepath.Join(current, goModFile)); err == nil {
+			// Return module root
+			return current
+		}
+		parent := filepath.Dir(current)
+		// Stop at the filesystem root
+		if parent == current {
+			// Return the package directory
+			return dir
+		}
+		current = parent
+	}
+}
+
+// taskFindings keeps the current findings of the task's rules and files.
+//
+// Params:
+//   - record: task record
+//   - violations: current violations by rule code
+//
+// Returns:
+//   - []Finding: findings sorted by file, line and rule
+func taskFindings(record *TaskRecord, violations map[string]*RuleViolations) []Finding {
+	codes, files := map[string]bool{}, map[string]bool{}
+	// Index the scope of the task
+	for _, finding := range record.Findings {
+		codes[finding.Code] = true
+		files[finding.File] = true
+	}
+
+	findings := make([]Finding, 0, len(record.Findings))
+	// Keep violations in scope
+	for code, rv := range violations {
+		// Skip other rules
+		if !codes[code] {
+			continue
+		}
+		// Keep violations of the task's files
+		for _, violation := range rv.Violations {
+			// Skip other files
+			if !files[violation.FilePath] {
+				continue
+			}
+			findings = append(findings, Finding{Code: code, File: violation.FilePath, Line: violation.Line, Message: violation.Message})
+		}
+	}
+	slices.SortFunc(findings, func(a, b Finding) int {
+		// Order by location then rule
+		return cmp.Or(cmp.Compare(a.File, b.File), cmp.Compare(a.Line, b.Line), cmp.Compare(a.Code, b.Code))
+	})
+
+	// Return findings in scope
+	return findings
+}
+
+// otherFindings counts the findings recorded by the other tasks of a state.
+//
+// Params:
+//   - state: state holding the task
+//   - record: task to leave out
+//
+// Returns:
+//   - map[Finding]int: occurrences by line-independent identity
+func otherFindings(state *State, record *TaskRecord) map[Finding]int {
+	others := map[Finding]int{}
+	// Count the findings of every other task
+	for i := range state.Tasks {
+		// Skip the verified task
+		if state.Tasks[i].ID == record.ID {
+			continue
+		}
+		// Count each recorded finding
+		for _, finding := range state.Tasks[i].Findings {
+			others[findingKey(finding)]++
+		}
+	}
+
+	// Return findings of other tasks
+	return others
+}
+
+// compareFindings matches current findings with the recorded ones. Lines
+// move while fixing, so findings match on rule, file and message. Findings
+// left unmatched that another task recorded belong to that task.
+//
+// Params:
+//   - record: task record
+//   - others: findings recorded by other tasks, by identity
+//   - current: current findings in the task's scope
+//
+// Returns:
+//   - *Verification: comparison result
+func compareFindings(record *TaskRecord, others map[Finding]int, current []Finding) *Verification {
+	verification := &Verification{TaskID: record.ID, Resolved: []Finding{}, Remaining: []Finding{}, Introduced: []Finding{}}
+	pending := make(map[Finding]int, len(record.Findings))
+	// Count recorded findings by identity
+	for _, finding := range record.Findings {
+		pending[findingKey(finding)]++
+	}
+
+	matched := map[Finding]int{}
+	// Classify current findings
+	for _, finding := range current {
+		key := findingKey(finding)
+		// Consume a recorded occurrence if any
+		if matched[key] < pending[key] {
+			matched[key]++
+			verification.Remaining = append(verification.Remaining, finding)
+			continue
+		}
+		// Leave findings of other tasks to them
+		if others[key] > 0 {
+			others[key]--
+			continue
+		}
+		verification.Introduced = append(verification.Introduced, finding)
+	}
+
+	// Recorded findings not matched are resolved
+	for _, finding := range record.Findings {
+		key := findingKey(finding)
+		// Skip occurrences still present
+		if matched[key] > 0 {
+			matched[key]--
+			continue
+		}
+		verification.Resolved = append(verification.Resolved, finding)
+	}
+
+	// Return comparison
+	return verification
+}
+
+// findingKey drops the line of a finding to match it across edits.
+//
+// Params:
+//   - finding: finding to identify
+//
+// Returns:
+//   - Finding: finding without line
+func findingKey(finding Finding) Finding {
+	finding.Line = 0
+	// Return identity
+	return finding
+}
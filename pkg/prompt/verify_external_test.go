@@ -0,0 +1,47 @@
+// Package prompt_test provides black-box tests for task verification.
+package prompt_test
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+	"github.com/kodflow/ktn-linter/pkg/prompt"
+)
+
+// TestGenerator_Verify tests verifying tasks whose files are gone or whose
+// rules cannot be selected.
+//
+// Params:
+//   - t: testing object
+func TestGenerator_Verify(t *testing.T) {
+	removed := filepath.Join(t.TempDir(), "gone", "a.go")
+	record := &prompt.TaskRecord{ID: "task-001", Findings: []prompt.Finding{{Code: "KTN-FUNC-001", File: removed, Line: 1}}}
+	tests := []struct {
+		name        string
+		opts        orchestrator.Options
+		expectError bool
+		wantPassed  bool
+	}{
+		{name: "removed package resolves findings", opts: orchestrator.Options{}, expectError: false, wantPassed: true},
+		{name: "invalid analyzer returns error", opts: orchestrator.Options{OnlyRule: "INVALID-RULE-999"}, expectError: true, wantPassed: false},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			gen := prompt.NewGenerator(io.Discard, false)
+			verification, err := gen.Verify(&prompt.State{Tasks: []prompt.TaskRecord{*record}}, record, tt.opts)
+			// Verify error expectation
+			if (err != nil) != tt.expectError {
+				t.Fatalf("Verify() error = %v, expectError %v", err, tt.expectError)
+			}
+			// Verify verdict
+			if err == nil && verification.Passed() != tt.wantPassed {
+				t.Errorf("Passed() = %v, want %v", verification.Passed(), tt.wantPassed)
+			}
+		})
+	}
+}
@@ -0,0 +1,378 @@
+// Package prompt provides white-box tests for task verification.
+package prompt
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+)
+
+// Test_Generator_Verify tests re-linting a task in a temporary module.
+//
+// Params:
+//   - t: testing object
+func Test_Generator_Verify(t *testing.T) {
+	tests := []struct {
+		name           string
+		source         string
+		wantResolved   int
+		wantRemaining  int
+		wantIntroduced int
+	}{
+		{name: "untouched task", source: "package tmp\n\nvar V = 1\n", wantResolved: 0, wantRemaining: 1, wantIntroduced: 0},
+		{name: "fixed task", source: "package tmp\n\nvar V int = 1\n", wantResolved: 1, wantRemaining: 0, wantIntroduced: 0},
+		{name: "regression", source: "package tmp\n\nvar V int = 1\n\nvar W = 1\n", wantResolved: 1, wantRemaining: 0, wantIntroduced: 1},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			moduleDir := t.TempDir()
+			// Create a standalone module
+			if err := os.WriteFile(filepath.Join(moduleDir, "go.mod"), []byte("module example.com/tmp\n\ngo 1.24\n"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			file := filepath.Join(moduleDir, "tmp.go")
+			// Write the source of the plan
+			if err := os.WriteFile(file, []byte("package tmp\n\nvar V = 1\n"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			gen := NewGenerator(io.Discard, false)
+			opts := orchestrator.Options{OnlyRule: "KTN-VAR-001"}
+			output, err := gen.Generate([]string{moduleDir}, opts)
+			// Check plan generation
+			if err != nil {
+				t.Fatal(err)
+			}
+			tasks, _ := SplitTasks(output, ChunkOptions{})
+			state := NewState(tasks)
+			// Check the recorded task
+			if len(state.Tasks) != 1 {
+				t.Fatalf("NewState() = %+v, want one task", state)
+			}
+			// Write the source after the fix
+			if err := os.WriteFile(file, []byte(tt.source), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			verification, err := gen.Verify(state, &state.Tasks[0], opts)
+			// Check linter error
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			// Verify classification counts
+			if len(verification.Resolved) != tt.wantResolved || len(verification.Remaining) != tt.wantRemaining || len(verification.Introduced) != tt.wantIntroduced {
+				t.Errorf("Verify() = %+v", verification)
+			}
+		})
+	}
+}
+
+// Test_Generator_Verify_splitSiblings tests verifying one of the tasks split
+// from a scope by the token budget while its sibling is still pending.
+//
+// Params:
+//   - t: testing object
+func Test_Generator_Verify_splitSiblings(t *testing.T) {
+	tests := []struct {
+		name           string
+		task           int
+		wantPassed     bool
+		wantRemaining  int
+		wantIntroduced int
+	}{
+		{name: "fixed sibling passes", task: 0, wantPassed: true, wantRemaining: 0, wantIntroduced: 0},
+		{name: "pending sibling remains", task: 1, wantPassed: false, wantRemaining: 1, wantIntroduced: 0},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			moduleDir := t.TempDir()
+			// Create a standalone module
+			if err := os.WriteFile(filepath.Join(moduleDir, "go.mod"), []byte("module example.com/tmp\n\ngo 1.24\n"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			file := filepath.Join(moduleDir, "tmp.go")
+			// Write two findings of the same rule and file
+			if err := os.WriteFile(file, []byte("package tmp\n\nvar V = 1\n\nvar W = 1\n"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			gen := NewGenerator(io.Discard, false)
+			opts := orchestrator.Options{OnlyRule: "KTN-VAR-001"}
+			output, err := gen.Generate([]string{moduleDir}, opts)
+			// Check plan generation
+			if err != nil {
+				t.Fatal(err)
+			}
+			tasks, _ := SplitTasks(output, ChunkOptions{MaxTokens: 1})
+			state := NewState(tasks)
+			// Check the scope was split in two
+			if len(state.Tasks) != 2 {
+				t.Fatalf("NewState() = %+v, want two tasks", state)
+			}
+			// Fix the finding of the first task only
+			if err := os.WriteFile(file, []byte("package tmp\n\nvar V int = 1\n\nvar W = 1\n"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			verification, err := gen.Verify(state, &state.Tasks[tt.task], opts)
+			// Check linter error
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			// Verify verdict and classification
+			if verification.Passed() != tt.wantPassed || len(verification.Remaining) != tt.wantRemaining || len(verification.Introduced) != tt.wantIntroduced {
+				t.Errorf("Verify() = %+v", verification)
+			}
+		})
+	}
+}
+
+// Test_Generator_relint tests the error paths of re-linting.
+//
+// Params:
+//   - t: testing object
+func Test_Generator_relint(t *testing.T) {
+	tests := []struct {
+		name        string
+		dirs        []string
+		opts        orchestrator.Options
+		expectError bool
+	}{
+		{name: "removed directory skipped", dirs: []string{filepath.Join(t.TempDir(), "gone")}, opts: orchestrator.Options{}, expectError: false},
+		{name: "invalid analyzer returns error", dirs: nil, opts: orchestrator.Options{OnlyRule: "INVALID-RULE-999"}, expectError: true},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			gen := NewGenerator(io.Discard, false)
+			diags, err := gen.relint(tt.dirs, tt.opts)
+			// Verify error expectation
+			if (err != nil) != tt.expectError {
+				t.Fatalf("relint() error = %v, expectError %v", err, tt.expectError)
+			}
+			// Verify no diagnostics for skipped directories
+			if len(diags) != 0 {
+				t.Errorf("relint() = %d diagnostics, want none", len(diags))
+			}
+		})
+	}
+}
+
+// Test_taskDirs tests collecting the package directories of a task.
+//
+// Params:
+//   - t: testing object
+func Test_taskDirs(t *testing.T) {
+	tests := []struct {
+		name     string
+		findings []Finding
+		want     []string
+	}{
+		{name: "no findings", findings: nil, want: []string{}},
+		{
+			name:     "unique sorted directories",
+			findings: []Finding{{File: "/src/b/b.go"}, {File: "/src/a/a.go"}, {File: "/src/b/c.go"}},
+			want:     []string{"/src/a", "/src/b"},
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify directories
+			if got := taskDirs(&TaskRecord{Findings: tt.findings}); !slices.Equal(got, tt.want) {
+				t.Errorf("taskDirs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_moduleRoot tests finding the module of a package directory.
+//
+// Params:
+//   - t: testing object
+func Test_moduleRoot(t *testing.T) {
+	root := t.TempDir()
+	// Create a module with a nested package
+	if err := os.MkdirAll(filepath.Join(root, "mod", "pkg"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	// Create the module file
+	if err := os.WriteFile(filepath.Join(root, "mod", "go.mod"), []byte("module example.com/mod\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		dir  string
+		want string
+	}{
+		{name: "nested package", dir: filepath.Join(root, "mod", "pkg"), want: filepath.Join(root, "mod")},
+		{name: "module root", dir: filepath.Join(root, "mod"), want: filepath.Join(root, "mod")},
+		{name: "outside any module", dir: root, want: root},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify module root
+			if got := moduleRoot(tt.dir); got != tt.want {
+				t.Errorf("moduleRoot() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_taskFindings tests keeping the findings of a task's scope.
+//
+// Params:
+//   - t: testing object
+func Test_taskFindings(t *testing.T) {
+	record := &TaskRecord{Findings: []Finding{{Code: "KTN-FUNC-001", File: "a.go"}}}
+	tests := []struct {
+		name       string
+		violations map[string]*RuleViolations
+		want       []Finding
+	}{
+		{name: "nothing reported", violations: map[string]*RuleViolations{}, want: []Finding{}},
+		{
+			name: "other rules and files dropped",
+			violations: map[string]*RuleViolations{
+				"KTN-FUNC-001": {Violations: []Violation{{FilePath: "a.go", Line: 9, Message: "m2"}, {FilePath: "b.go", Line: 1}, {FilePath: "a.go", Line: 2, Message: "m1"}}},
+				"KTN-VAR-001":  {Violations: []Violation{{FilePath: "a.go", Line: 1}}},
+			},
+			want: []Finding{{Code: "KTN-FUNC-001", File: "a.go", Line: 2, Message: "m1"}, {Code: "KTN-FUNC-001", File: "a.go", Line: 9, Message: "m2"}},
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify findings in scope
+			if got := taskFindings(record, tt.violations); !slices.Equal(got, tt.want) {
+				t.Errorf("taskFindings() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_compareFindings tests matching current findings with the record.
+//
+// Params:
+//   - t: testing object
+func Test_compareFindings(t *testing.T) {
+	recorded := []Finding{
+		{Code: "KTN-FUNC-001", File: "a.go", Line: 3, Message: "long"},
+		{Code: "KTN-FUNC-001", File: "a.go", Line: 8, Message: "long"},
+	}
+	tests := []struct {
+		name           string
+		current        []Finding
+		others         map[Finding]int
+		wantResolved   int
+		wantRemaining  int
+		wantIntroduced int
+	}{
+		{name: "all fixed", current: nil, wantResolved: 2, wantRemaining: 0, wantIntroduced: 0},
+		{name: "moved line still present", current: []Finding{{Code: "KTN-FUNC-001", File: "a.go", Line: 5, Message: "long"}}, wantResolved: 1, wantRemaining: 1, wantIntroduced: 0},
+		{
+			name: "extra occurrence introduced",
+			current: []Finding{
+				{Code: "KTN-FUNC-001", File: "a.go", Line: 1, Message: "long"},
+				{Code: "KTN-FUNC-001", File: "a.go", Line: 2, Message: "long"},
+				{Code: "KTN-FUNC-001", File: "a.go", Line: 4, Message: "long"},
+			},
+			wantResolved: 0, wantRemaining: 2, wantIntroduced: 1,
+		},
+		{
+			name: "finding of a sibling task",
+			current: []Finding{
+				{Code: "KTN-FUNC-001", File: "a.go", Line: 12, Message: "other"},
+				{Code: "KTN-FUNC-001", File: "a.go", Line: 14, Message: "other"},
+			},
+			others:       map[Finding]int{{Code: "KTN-FUNC-001", File: "a.go", Message: "other"}: 1},
+			wantResolved: 2, wantRemaining: 0, wantIntroduced: 1,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := compareFindings(&TaskRecord{ID: "task-001", Findings: recorded}, tt.others, tt.current)
+			// Verify classification counts
+			if len(got.Resolved) != tt.wantResolved || len(got.Remaining) != tt.wantRemaining || len(got.Introduced) != tt.wantIntroduced {
+				t.Errorf("compareFindings() = %+v", got)
+			}
+		})
+	}
+}
+
+// Test_otherFindings tests counting the findings of the other tasks.
+//
+// Params:
+//   - t: testing object
+func Test_otherFindings(t *testing.T) {
+	state := &State{Tasks: []TaskRecord{
+		{ID: "task-001", Findings: []Finding{{Code: "C", File: "f", Line: 1, Message: "m"}}},
+		{ID: "task-002", Findings: []Finding{{Code: "C", File: "f", Line: 4, Message: "m"}, {Code: "C", File: "f", Line: 9, Message: "m"}}},
+	}}
+	tests := []struct {
+		name string
+		task int
+		want int
+	}{
+		{name: "first task sees sibling findings", task: 0, want: 2},
+		{name: "second task sees first task findings", task: 1, want: 1},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			others := otherFindings(state, &state.Tasks[tt.task])
+			// Verify count of the shared identity
+			if got := others[Finding{Code: "C", File: "f", Message: "m"}]; got != tt.want || len(others) != 1 {
+				t.Errorf("otherFindings() = %v, want %d occurrences", others, tt.want)
+			}
+		})
+	}
+}
+
+// Test_findingKey tests the line-independent identity of findings.
+//
+// Params:
+//   - t: testing object
+func Test_findingKey(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Finding
+		want bool
+	}{
+		{name: "moved finding", a: Finding{Code: "C", File: "f", Line: 1, Message: "m"}, b: Finding{Code: "C", File: "f", Line: 7, Message: "m"}, want: true},
+		{name: "other message", a: Finding{Code: "C", File: "f", Message: "m"}, b: Finding{Code: "C", File: "f", Message: "n"}, want: false},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify identity
+			if got := findingKey(tt.a) == findingKey(tt.b); got != tt.want {
+				t.Errorf("findingKey equality = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
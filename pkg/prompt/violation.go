@@ -12,4 +12,6 @@ type Violation struct {
 	Column int
 	// Message is the diagnostic message without the rule code prefix.
 	Message string
+	// Excerpt is the source around the violation, set when splitting tasks.
+	Excerpt string
 }
@@ -0,0 +1,402 @@
+// Package reportdiff compares two JSON lint reports.
+package reportdiff
+
+import (
+	"cmp"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/kodflow/ktn-linter/pkg/formatter"
+)
+
+// Compare matches the findings of an old and a new report.
+// Findings sharing rule, file, enclosing symbol and message are the same
+// finding: at the same position they are unchanged, elsewhere they moved,
+// paired in source order by least line distance. Symbols are ignored when
+// a report has none, as reports written before they existed. Files are
+// compared relative to the directory each report was written from, so
+// reports of distinct checkouts match; reports without base directory are
+// compared on files as written.
+//
+// Params:
+//   - before: old report
+//   - after: new report
+//
+// Returns:
+//   - *Report: new, fixed and moved findings with per-rule deltas
+func Compare(before, after *formatter.JSONReport) *Report {
+	useSymbols := hasSymbols(before.Results) && hasSymbols(after.Results)
+	beforeRoot, afterRoot := "", ""
+	// Compare files relative to the checkouts when both are known
+	if before.BaseDir != "" && after.BaseDir != "" {
+		beforeRoot, afterRoot = before.BaseDir, after.BaseDir
+	}
+	groups := make(map[matchKey]*matchGroup, len(before.Results))
+	var keys []matchKey
+
+	// Group old findings by identity
+	for _, result := range before.Results {
+		group := groupOf(groups, &keys, keyOf(result, useSymbols, beforeRoot))
+		group.before = append(group.before, result)
+	}
+	// Group new findings by identity
+	for _, result := range after.Results {
+		group := groupOf(groups, &keys, keyOf(result, useSymbols, afterRoot))
+		group.after = append(group.after, result)
+	}
+
+	report := &Report{
+		New:   []formatter.JSONResult{},
+		Fixed: []formatter.JSONResult{},
+		Moved: []Move{},
+	}
+	// Pair the findings of each identity
+	for _, key := range keys {
+		matchFindings(groups[key], report)
+	}
+	sortResults(report.New)
+	sortResults(report.Fixed)
+	slices.SortFunc(report.Moved, func(a, b Move) int {
+		// Order by new location
+		return compareResults(a.JSONResult, b.JSONResult)
+	})
+	report.Rules = ruleDeltas(before.Results, after.Results, report)
+
+	// Return comparison
+	return report
+}
+
+// hasSymbols reports whether a report records enclosing symbols.
+//
+// Params:
+//   - results: findings of the report
+//
+// Returns:
+//   - bool: true when at least one finding has a symbol
+func hasSymbols(results []formatter.JSONResult) bool {
+	// Return whether any finding has a symbol
+	return slices.ContainsFunc(results, func(result formatter.JSONResult) bool {
+		// Check symbol
+		return result.Symbol != ""
+	})
+}
+
+// keyOf returns the identity of a finding.
+//
+// Params:
+//   - result: finding
+//   - useSymbols: include the enclosing symbol
+//   - root: directory the report was written from (empty for none)
+//
+// Returns:
+//   - matchKey: identity ignoring line, column, CPU share and hot path
+func keyOf(result formatter.JSONResult, useSymbols bool, root string) matchKey {
+	key := matchKey{
+		rule:    result.RuleID,
+		file:    relativeFile(root, result.Location.File),
+		message: strings.TrimSpace(result.Message),
+	}
+	// Tell findings of distinct declarations apart
+	if useSymbols {
+		key.symbol = result.Symbol
+	}
+	// Return identity
+	return key
+}
+
+// relativeFile returns a finding file relative to the directory its report
+// was written from.
+//
+// Params:
+//   - root: report directory (empty for none)
+//   - file: file as written in the report
+//
+// Returns:
+//   - string: slash-separated relative file, or the file as written
+func relativeFile(root, file string) string {
+	// Keep files of reports without directory
+	if root == "" {
+		// Return file as written
+		return file
+	}
+	rel, err := filepath.Rel(root, file)
+	// Keep files unrelated to the directory
+	if err != nil {
+		// Return file as written
+		return file
+	}
+	// Return relative file
+	return filepath.ToSlash(rel)
+}
+
+// groupOf returns the group of a key, creating it on first use.
+//
+// Params:
+//   - groups: groups by key
+//   - keys: keys in first-seen order
+//   - key: finding identity
+//
+// Returns:
+//   - *matchGroup: group of the key
+func groupOf(groups map[matchKey]*matchGroup, keys *[]matchKey, key matchKey) *matchGroup {
+	group, ok := groups[key]
+	// Create the group on first use
+	if !ok {
+		group = &matchGroup{}
+		groups[key] = group
+		*keys = append(*keys, key)
+	}
+	// Return group
+	return group
+}
+
+// matchFindings pairs the old and new findings of one identity: same
+// position first, then nearest lines. Unpaired findings are fixed or new.
+//
+// Params:
+//   - group: findings sharing an identity
+//   - report: comparison to fill
+func matchFindings(group *matchGroup, report *Report) {
+	matched := make([]bool, len(group.before))
+	used := make([]bool, len(group.after))
+
+	// Pair findings left in place
+	for i := range group.before {
+		// Search the same position among new findings
+		for j := range group.after {
+			// Check unused finding at the same position
+			if !used[j] && samePosition(group.before[i].Location, group.after[j].Location) {
+				matched[i], used[j] = true, true
+				report.Unchanged++
+				break
+			}
+		}
+	}
+
+	// Pair moved findings in source order
+	for _, pair := range orderedPairs(group, matched, used) {
+		matched[pair.before], used[pair.after] = true, true
+		report.Moved = append(report.Moved, Move{
+			JSONResult: group.after[pair.after],
+			From:       group.before[pair.before].Location,
+		})
+	}
+
+	// Old findings left are fixed
+	for i := range group.before {
+		// Keep unpaired old findings
+		if !matched[i] {
+			report.Fixed = append(report.Fixed, group.before[i])
+		}
+	}
+	// New findings left are new
+	for j := range group.after {
+		// Keep unpaired new findings
+		if !used[j] {
+			report.New = append(report.New, group.after[j])
+		}
+	}
+}
+
+// samePosition reports whether two locations of the same file share their
+// line and column.
+//
+// Params:
+//   - a: first location
+//   - b: second location
+//
+// Returns:
+//   - bool: true at the same position
+func samePosition(a, b formatter.JSONLocation) bool {
+	// Return whether line and column match
+	return a.Line == b.Line && a.Column == b.Column
+}
+
+// orderedPairs pairs the unpaired findings of a group as moves.
+// Findings keep their relative order, as when a block of code shifts, and
+// the pairing minimizes the total line distance. Every finding on the
+// smaller side is paired.
+//
+// Params:
+//   - group: findings sharing an identity
+//   - matched: old findings already paired
+//   - used: new findings already paired
+//
+// Returns:
+//   - []movePair: moves from old to new findings
+func orderedPairs(group *matchGroup, matched, used []bool) []movePair {
+	olds := unpaired(group.before, matched)
+	news := unpaired(group.after, used)
+	// Nothing to pair when a side is empty
+	if len(olds) == 0 || len(news) == 0 {
+		// Return no move
+		return []movePair{}
+	}
+	swapped := len(olds) > len(news)
+	short, long := olds, news
+	// Align the smaller side on the larger one
+	if swapped {
+		short, long = news, olds
+	}
+	distance := func(i, j int) int {
+		before, after := short[i], long[j]
+		// Swap sides back when aligning new on old
+		if swapped {
+			before, after = long[j], short[i]
+		}
+		from, to := group.before[before].Location.Line, group.after[after].Location.Line
+		// Return line distance
+		return max(from-to, to-from)
+	}
+
+	// costs[i][j] is the best cost of pairing short[:i] within long[:j]
+	costs := make([][]int, len(short)+1)
+	// Allocate cost rows
+	for i := range costs {
+		costs[i] = make([]int, len(long)+1)
+	}
+	// Fill costs row by row
+	for i := 1; i <= len(short); i++ {
+		costs[i][i] = costs[i-1][i-1] + distance(i-1, i-1)
+		// Either skip long[j-1] or pair it with short[i-1]
+		for j := i + 1; j <= len(long); j++ {
+			costs[i][j] = min(costs[i][j-1], costs[i-1][j-1]+distance(i-1, j-1))
+		}
+	}
+
+	pairs := make([]movePair, 0, len(short))
+	// Walk back from the full alignment
+	for i, j := len(short), len(long); i > 0; j-- {
+		// Skip long[j-1] when it is not part of the best alignment
+		if j > i && costs[i][j] == costs[i][j-1] {
+			continue
+		}
+		pair := movePair{before: short[i-1], after: long[j-1]}
+		// Restore old and new sides
+		if swapped {
+			pair = movePair{before: long[j-1], after: short[i-1]}
+		}
+		pairs = append(pairs, pair)
+		i--
+	}
+	// Return moves
+	return pairs
+}
+
+// unpaired returns the indexes of unpaired findings in source order.
+//
+// Params:
+//   - results: findings of one side of a group
+//   - paired: findings already paired
+//
+// Returns:
+//   - []int: indexes of unpaired findings sorted by location
+func unpaired(results []formatter.JSONResult, paired []bool) []int {
+	var indexes []int
+	// Collect unpaired findings
+	for i := range results {
+		// Skip paired findings
+		if !paired[i] {
+			indexes = append(indexes, i)
+		}
+	}
+	slices.SortStableFunc(indexes, func(a, b int) int {
+		// Order by location
+		return compareResults(results[a], results[b])
+	})
+	// Return unpaired findings
+	return indexes
+}
+
+// ruleDeltas computes the change of each rule with new or fixed findings.
+//
+// Params:
+//   - before: old findings
+//   - after: new findings
+//   - report: comparison with new and fixed findings
+//
+// Returns:
+//   - []RuleDelta: changed rules sorted by rule code
+func ruleDeltas(before, after []formatter.JSONResult, report *Report) []RuleDelta {
+	deltas := make(map[string]*RuleDelta, len(report.New)+len(report.Fixed))
+	// Count added findings
+	for _, result := range report.New {
+		deltaOf(deltas, result.RuleID).Added++
+	}
+	// Count fixed findings
+	for _, result := range report.Fixed {
+		deltaOf(deltas, result.RuleID).Fixed++
+	}
+	// Count old findings of changed rules
+	for _, result := range before {
+		// Skip unchanged rules
+		if delta, ok := deltas[result.RuleID]; ok {
+			delta.Old++
+		}
+	}
+	// Count new findings of changed rules
+	for _, result := range after {
+		// Skip unchanged rules
+		if delta, ok := deltas[result.RuleID]; ok {
+			delta.New++
+		}
+	}
+
+	rules := make([]RuleDelta, 0, len(deltas))
+	// Collect changed rules
+	for _, delta := range deltas {
+		rules = append(rules, *delta)
+	}
+	slices.SortFunc(rules, func(a, b RuleDelta) int {
+		// Order by rule code
+		return cmp.Compare(a.RuleID, b.RuleID)
+	})
+	// Return changed rules
+	return rules
+}
+
+// deltaOf returns the delta of a rule, creating it on first use.
+//
+// Params:
+//   - deltas: deltas by rule code
+//   - rule: rule code
+//
+// Returns:
+//   - *RuleDelta: delta of the rule
+func deltaOf(deltas map[string]*RuleDelta, rule string) *RuleDelta {
+	delta, ok := deltas[rule]
+	// Create the delta on first use
+	if !ok {
+		delta = &RuleDelta{RuleID: rule}
+		deltas[rule] = delta
+	}
+	// Return delta
+	return delta
+}
+
+// sortResults orders findings by location then rule.
+//
+// Params:
+//   - results: findings to sort in place
+func sortResults(results []formatter.JSONResult) {
+	slices.SortFunc(results, compareResults)
+}
+
+// compareResults orders two findings by file, line, column and rule.
+//
+// Params:
+//   - a: first finding
+//   - b: second finding
+//
+// Returns:
+//   - int: negative, zero or positive as a sorts before, with or after b
+func compareResults(a, b formatter.JSONResult) int {
+	// Return location then rule order
+	return cmp.Or(
+		cmp.Compare(a.Location.File, b.Location.File),
+		cmp.Compare(a.Location.Line, b.Location.Line),
+		cmp.Compare(a.Location.Column, b.Location.Column),
+		cmp.Compare(a.RuleID, b.RuleID),
+	)
+}
@@ -0,0 +1,232 @@
+// Package reportdiff_test provides tests for the reportdiff package.
+package reportdiff_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/formatter"
+	"github.com/kodflow/ktn-linter/pkg/reportdiff"
+)
+
+// finding builds a JSON result for comparison tests.
+//
+// Params:
+//   - rule: rule code
+//   - line: line number
+//   - symbol: enclosing symbol
+//   - message: finding message
+//
+// Returns:
+//   - formatter.JSONResult: finding in a.go
+func finding(rule string, line int, symbol, message string) formatter.JSONResult {
+	// Return finding
+	return formatter.JSONResult{
+		RuleID:   rule,
+		Level:    "warning",
+		Message:  message,
+		Location: formatter.JSONLocation{File: "a.go", Line: line, Column: 1},
+		Symbol:   symbol,
+	}
+}
+
+// ranked sets the CPU share of a finding.
+//
+// Params:
+//   - result: finding
+//   - share: CPU share of the enclosing function
+//
+// Returns:
+//   - formatter.JSONResult: ranked finding
+func ranked(result formatter.JSONResult, share float64) formatter.JSONResult {
+	result.CPUShare = share
+	// Return ranked finding
+	return result
+}
+
+// escalated sets why the function enclosing a finding is hot.
+//
+// Params:
+//   - result: finding
+//   - reason: hot path reason
+//
+// Returns:
+//   - formatter.JSONResult: escalated finding
+func escalated(result formatter.JSONResult, reason string) formatter.JSONResult {
+	result.HotPath = reason
+	// Return escalated finding
+	return result
+}
+
+// reportOf wraps findings in a JSON report.
+//
+// Params:
+//   - results: findings of the report
+//
+// Returns:
+//   - *formatter.JSONReport: report holding results
+func reportOf(results ...formatter.JSONResult) *formatter.JSONReport {
+	// Return report
+	return &formatter.JSONReport{Results: results}
+}
+
+// inCheckout moves the findings of a report under a checkout directory.
+//
+// Params:
+//   - dir: checkout directory
+//   - recorded: record the directory in the report
+//   - report: report with relative files
+//
+// Returns:
+//   - *formatter.JSONReport: report with absolute files
+func inCheckout(dir string, recorded bool, report *formatter.JSONReport) *formatter.JSONReport {
+	// Prefix each file with the checkout
+	for i := range report.Results {
+		report.Results[i].Location.File = filepath.Join(dir, report.Results[i].Location.File)
+	}
+	// Record the directory the report was written from
+	if recorded {
+		report.BaseDir = dir
+	}
+	// Return moved report
+	return report
+}
+
+// TestCompare tests the Compare function.
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name          string
+		before        *formatter.JSONReport
+		after         *formatter.JSONReport
+		wantNew       int
+		wantFixed     int
+		wantMoved     int
+		wantUnchanged int
+	}{
+		{
+			name:          "unchanged finding",
+			before:        reportOf(finding("KTN-FUNC-001", 10, "Run", "too long")),
+			after:         reportOf(finding("KTN-FUNC-001", 10, "Run", "too long")),
+			wantUnchanged: 1,
+		},
+		{
+			name:      "finding shifted by an edit above",
+			before:    reportOf(finding("KTN-FUNC-001", 10, "Run", "too long")),
+			after:     reportOf(finding("KTN-FUNC-001", 14, "Run", "too long")),
+			wantMoved: 1,
+		},
+		{
+			name:      "new and fixed findings",
+			before:    reportOf(finding("KTN-FUNC-001", 10, "Run", "too long")),
+			after:     reportOf(finding("KTN-VAR-001", 10, "Run", "bad name")),
+			wantNew:   1,
+			wantFixed: 1,
+		},
+		{
+			name:      "finding in another symbol",
+			before:    reportOf(finding("KTN-FUNC-001", 10, "Run", "too long")),
+			after:     reportOf(finding("KTN-FUNC-001", 10, "Stop", "too long")),
+			wantNew:   1,
+			wantFixed: 1,
+		},
+		{
+			name:      "old report without symbols",
+			before:    reportOf(finding("KTN-FUNC-001", 10, "", "too long")),
+			after:     reportOf(finding("KTN-FUNC-001", 12, "Run", "too long")),
+			wantMoved: 1,
+		},
+		{
+			name:          "CPU share ignored",
+			before:        reportOf(ranked(finding("KTN-PERF-001", 10, "Run", "alloc"), 0.12)),
+			after:         reportOf(ranked(finding("KTN-PERF-001", 10, "Run", "alloc"), 0.305)),
+			wantUnchanged: 1,
+		},
+		{
+			name:          "hot path ignored",
+			before:        reportOf(finding("KTN-VAR-012", 10, "Run", "alloc")),
+			after:         reportOf(escalated(finding("KTN-VAR-012", 10, "Run", "alloc"), "pattern api.[Encode]")),
+			wantUnchanged: 1,
+		},
+		{
+			name:          "reports of distinct checkouts",
+			before:        inCheckout("/ci/build-1", true, reportOf(finding("KTN-FUNC-001", 10, "Run", "too long"))),
+			after:         inCheckout("/ci/build-2", true, reportOf(finding("KTN-FUNC-001", 10, "Run", "too long"), finding("KTN-FUNC-001", 30, "Run", "too long"))),
+			wantNew:       1,
+			wantUnchanged: 1,
+		},
+		{
+			name:      "old report without base directory",
+			before:    inCheckout("/ci/build-1", false, reportOf(finding("KTN-FUNC-001", 10, "Run", "too long"))),
+			after:     inCheckout("/ci/build-2", true, reportOf(finding("KTN-FUNC-001", 10, "Run", "too long"))),
+			wantNew:   1,
+			wantFixed: 1,
+		},
+		{
+			name:    "extra occurrence is new",
+			before:  reportOf(finding("KTN-FUNC-001", 10, "Run", "too long")),
+			after:   reportOf(finding("KTN-FUNC-001", 10, "Run", "too long"), finding("KTN-FUNC-001", 20, "Run", "too long")),
+			wantNew: 1, wantUnchanged: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			report := reportdiff.Compare(tt.before, tt.after)
+			// Check counts
+			if len(report.New) != tt.wantNew || len(report.Fixed) != tt.wantFixed ||
+				len(report.Moved) != tt.wantMoved || report.Unchanged != tt.wantUnchanged {
+				t.Errorf("Compare() = new %d, fixed %d, moved %d, unchanged %d; want %d, %d, %d, %d",
+					len(report.New), len(report.Fixed), len(report.Moved), report.Unchanged,
+					tt.wantNew, tt.wantFixed, tt.wantMoved, tt.wantUnchanged)
+			}
+		})
+	}
+}
+
+// TestCompare_NearestMove tests that moves pair the nearest findings.
+func TestCompare_NearestMove(t *testing.T) {
+	before := reportOf(finding("KTN-FUNC-001", 10, "Run", "too long"), finding("KTN-FUNC-001", 50, "Run", "too long"))
+	after := reportOf(finding("KTN-FUNC-001", 53, "Run", "too long"), finding("KTN-FUNC-001", 12, "Run", "too long"))
+
+	report := reportdiff.Compare(before, after)
+	// Check move count
+	if len(report.Moved) != 2 {
+		t.Fatalf("Compare() moved = %d, want 2", len(report.Moved))
+	}
+	// Check pairs, sorted by new location
+	if report.Moved[0].From.Line != 10 || report.Moved[0].Location.Line != 12 ||
+		report.Moved[1].From.Line != 50 || report.Moved[1].Location.Line != 53 {
+		t.Errorf("Compare() moves = %+v, want 10->12 and 50->53", report.Moved)
+	}
+}
+
+// TestCompare_Rules tests the per-rule deltas.
+func TestCompare_Rules(t *testing.T) {
+	before := reportOf(
+		finding("KTN-FUNC-001", 10, "Run", "too long"),
+		finding("KTN-VAR-001", 20, "Run", "bad name"),
+	)
+	after := reportOf(
+		finding("KTN-FUNC-001", 10, "Run", "too long"),
+		finding("KTN-VAR-002", 30, "Run", "shadowed"),
+		finding("KTN-VAR-002", 40, "Stop", "shadowed"),
+	)
+
+	report := reportdiff.Compare(before, after)
+	want := []reportdiff.RuleDelta{
+		{RuleID: "KTN-VAR-001", Old: 1, New: 0, Added: 0, Fixed: 1},
+		{RuleID: "KTN-VAR-002", Old: 0, New: 2, Added: 2, Fixed: 0},
+	}
+	// Check changed rules only
+	if len(report.Rules) != len(want) {
+		t.Fatalf("Compare() rules = %+v, want %+v", report.Rules, want)
+	}
+	// Check each rule
+	for i := range want {
+		// Compare delta
+		if report.Rules[i] != want[i] {
+			t.Errorf("Rules[%d] = %+v, want %+v", i, report.Rules[i], want[i])
+		}
+	}
+}
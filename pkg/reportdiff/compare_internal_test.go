@@ -0,0 +1,122 @@
+// Package reportdiff provides internal tests for finding matching.
+package reportdiff
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/formatter"
+)
+
+// Test_keyOf tests the keyOf function.
+func Test_keyOf(t *testing.T) {
+	result := formatter.JSONResult{
+		RuleID:   "KTN-PERF-001",
+		Message:  "alloc\ndetails ",
+		Location: formatter.JSONLocation{File: "/src/app/pkg/a.go", Line: 3},
+		Symbol:   "Run",
+		HotPath:  "//ktn:hot",
+	}
+	tests := []struct {
+		name       string
+		useSymbols bool
+		root       string
+		want       matchKey
+	}{
+		{
+			name:       "with symbols",
+			useSymbols: true,
+			want:       matchKey{rule: "KTN-PERF-001", file: "/src/app/pkg/a.go", symbol: "Run", message: "alloc\ndetails"},
+		},
+		{
+			name:       "without symbols",
+			useSymbols: false,
+			want:       matchKey{rule: "KTN-PERF-001", file: "/src/app/pkg/a.go", message: "alloc\ndetails"},
+		},
+		{
+			name:       "relative to the report directory",
+			useSymbols: true,
+			root:       "/src/app",
+			want:       matchKey{rule: "KTN-PERF-001", file: "pkg/a.go", symbol: "Run", message: "alloc\ndetails"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Check key
+			if got := keyOf(result, tt.useSymbols, tt.root); got != tt.want {
+				t.Errorf("keyOf() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_orderedPairs tests the orderedPairs function.
+func Test_orderedPairs(t *testing.T) {
+	tests := []struct {
+		name    string
+		before  []int
+		after   []int
+		matched []bool
+		want    []movePair
+	}{
+		{
+			name:    "block shifted together keeps order",
+			before:  []int{282, 283},
+			after:   []int{279, 280},
+			matched: []bool{false, false},
+			want:    []movePair{{before: 1, after: 1}, {before: 0, after: 0}},
+		},
+		{
+			name:    "extra new finding skipped",
+			before:  []int{10},
+			after:   []int{12, 40},
+			matched: []bool{false},
+			want:    []movePair{{before: 0, after: 0}},
+		},
+		{
+			name:    "extra old finding skipped",
+			before:  []int{10, 40},
+			after:   []int{43},
+			matched: []bool{false, false},
+			want:    []movePair{{before: 1, after: 0}},
+		},
+		{
+			name:    "paired findings ignored",
+			before:  []int{10, 30},
+			after:   []int{31},
+			matched: []bool{false, true},
+			want:    []movePair{{before: 0, after: 0}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			group := &matchGroup{before: atLines(tt.before), after: atLines(tt.after)}
+			got := orderedPairs(group, tt.matched, make([]bool, len(tt.after)))
+			// Check pairs
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("orderedPairs() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// atLines builds findings at the given lines.
+//
+// Params:
+//   - lines: line numbers
+//
+// Returns:
+//   - []formatter.JSONResult: one finding per line
+func atLines(lines []int) []formatter.JSONResult {
+	results := make([]formatter.JSONResult, 0, len(lines))
+	// Build each finding
+	for _, line := range lines {
+		results = append(results, formatter.JSONResult{Location: formatter.JSONLocation{Line: line}})
+	}
+	// Return findings
+	return results
+}
@@ -0,0 +1,10 @@
+// Package reportdiff compares two JSON lint reports.
+package reportdiff
+
+import "github.com/kodflow/ktn-linter/pkg/formatter"
+
+// matchGroup holds the findings of both reports sharing a match key.
+type matchGroup struct {
+	before []formatter.JSONResult
+	after  []formatter.JSONResult
+}
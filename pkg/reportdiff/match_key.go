@@ -0,0 +1,10 @@
+// Package reportdiff compares two JSON lint reports.
+package reportdiff
+
+// matchKey identifies a finding regardless of its line and column.
+type matchKey struct {
+	rule    string
+	file    string
+	symbol  string
+	message string
+}
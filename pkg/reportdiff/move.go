@@ -0,0 +1,11 @@
+// Package reportdiff compares two JSON lint reports.
+package reportdiff
+
+import "github.com/kodflow/ktn-linter/pkg/formatter"
+
+// Move is a finding of the old report found at another location in the
+// new report.
+type Move struct {
+	formatter.JSONResult
+	From formatter.JSONLocation `json:"from"`
+}
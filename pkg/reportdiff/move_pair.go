@@ -0,0 +1,8 @@
+// Package reportdiff compares two JSON lint reports.
+package reportdiff
+
+// movePair pairs an old finding with the new finding it moved to.
+type movePair struct {
+	before int // Index of the old finding
+	after  int // Index of the new finding
+}
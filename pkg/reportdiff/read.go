@@ -0,0 +1,35 @@
+// Package reportdiff compares two JSON lint reports.
+package reportdiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kodflow/ktn-linter/pkg/formatter"
+)
+
+// ReadReport reads a report written by lint --json.
+//
+// Params:
+//   - path: report file
+//
+// Returns:
+//   - *formatter.JSONReport: parsed report
+//   - error: read or decoding error if any
+func ReadReport(path string) (*formatter.JSONReport, error) {
+	data, err := os.ReadFile(path)
+	// Check read error
+	if err != nil {
+		// Return read error
+		return nil, fmt.Errorf("reading report: %w", err)
+	}
+	var report formatter.JSONReport
+	// Check decoding error
+	if err := json.Unmarshal(data, &report); err != nil {
+		// Return decoding error
+		return nil, fmt.Errorf("decoding report %s: %w", path, err)
+	}
+	// Return parsed report
+	return &report, nil
+}
@@ -0,0 +1,50 @@
+// Package reportdiff_test provides tests for the reportdiff package.
+package reportdiff_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/reportdiff"
+)
+
+// TestReadReport tests the ReadReport function.
+func TestReadReport(t *testing.T) {
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.json")
+	invalid := filepath.Join(dir, "invalid.json")
+	// Write valid report
+	if err := os.WriteFile(valid, []byte(`{"results":[{"ruleId":"KTN-A","level":"error","message":"m","location":{"file":"a.go","line":1,"column":1}}]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// Write invalid report
+	if err := os.WriteFile(invalid, []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name        string
+		path        string
+		wantErr     bool
+		wantResults int
+	}{
+		{name: "valid report", path: valid, wantResults: 1},
+		{name: "invalid JSON", path: invalid, wantErr: true},
+		{name: "missing file", path: filepath.Join(dir, "missing.json"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := reportdiff.ReadReport(tt.path)
+			// Check error
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadReport() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// Check results of valid reports
+			if err == nil && len(report.Results) != tt.wantResults {
+				t.Errorf("ReadReport() results = %d, want %d", len(report.Results), tt.wantResults)
+			}
+		})
+	}
+}
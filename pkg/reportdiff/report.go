@@ -0,0 +1,39 @@
+// Package reportdiff compares two JSON lint reports.
+package reportdiff
+
+import (
+	"github.com/kodflow/ktn-linter/pkg/formatter"
+	"github.com/kodflow/ktn-linter/pkg/severity"
+)
+
+// Report is the comparison of an old and a new JSON report.
+// Findings are matched on rule, file, enclosing symbol and message, so
+// findings shifted by edits elsewhere in the file are moved, not new.
+type Report struct {
+	New       []formatter.JSONResult `json:"new"`
+	Fixed     []formatter.JSONResult `json:"fixed"`
+	Moved     []Move                 `json:"moved"`
+	Unchanged int                    `json:"unchanged"`
+	Rules     []RuleDelta            `json:"rules"`
+}
+
+// CountNew counts the new findings at or above a severity.
+//
+// Params:
+//   - minimum: lowest severity counted
+//
+// Returns:
+//   - int: number of new findings at or above minimum
+func (r *Report) CountNew(minimum severity.Level) int {
+	count := 0
+	// Count new findings by level
+	for i := range r.New {
+		level, _ := severity.ParseLevel(r.New[i].Level)
+		// Keep findings at or above the threshold
+		if level >= minimum {
+			count++
+		}
+	}
+	// Return count
+	return count
+}
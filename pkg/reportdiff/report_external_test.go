@@ -0,0 +1,47 @@
+// Package reportdiff_test provides tests for the reportdiff package.
+package reportdiff_test
+
+import (
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/formatter"
+	"github.com/kodflow/ktn-linter/pkg/reportdiff"
+	"github.com/kodflow/ktn-linter/pkg/severity"
+)
+
+// TestReport_CountNew tests the CountNew method.
+func TestReport_CountNew(t *testing.T) {
+	report := &reportdiff.Report{New: []formatter.JSONResult{
+		{RuleID: "KTN-A", Level: "info"},
+		{RuleID: "KTN-B", Level: "warning"},
+		{RuleID: "KTN-C", Level: "error"},
+	}}
+	tests := []struct {
+		name    string
+		minimum severity.Level
+		want    int
+	}{
+		{name: "info", minimum: severity.SeverityInfo, want: 3},
+		{name: "warning", minimum: severity.SeverityWarning, want: 2},
+		{name: "error", minimum: severity.SeverityError, want: 1},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Check count
+			if got := report.CountNew(tt.minimum); got != tt.want {
+				t.Errorf("CountNew() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRuleDelta_Delta tests the Delta method.
+func TestRuleDelta_Delta(t *testing.T) {
+	delta := reportdiff.RuleDelta{RuleID: "KTN-A", Old: 5, New: 2}
+	// Check net change
+	if got := delta.Delta(); got != -3 {
+		t.Errorf("Delta() = %d, want -3", got)
+	}
+}
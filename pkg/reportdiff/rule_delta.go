@@ -0,0 +1,21 @@
+// Package reportdiff compares two JSON lint reports.
+package reportdiff
+
+// RuleDelta holds the change of the findings of one rule.
+// Only rules with new or fixed findings are reported.
+type RuleDelta struct {
+	RuleID string `json:"ruleId"`
+	Old    int    `json:"old"`
+	New    int    `json:"new"`
+	Added  int    `json:"added"`
+	Fixed  int    `json:"fixed"`
+}
+
+// Delta returns the change of the number of findings.
+//
+// Returns:
+//   - int: new count minus old count
+func (d RuleDelta) Delta() int {
+	// Return net change
+	return d.New - d.Old
+}
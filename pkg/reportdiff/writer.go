@@ -0,0 +1,172 @@
+// Package reportdiff compares two JSON lint reports.
+package reportdiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/kodflow/ktn-linter/pkg/formatter"
+)
+
+const (
+	// tabPadding is the column padding of text tables.
+	tabPadding int = 2
+)
+
+// WriteText writes a human-readable comparison.
+//
+// Params:
+//   - w: destination writer
+//   - report: comparison to write
+//
+// Returns:
+//   - error: write error if any
+func WriteText(w io.Writer, report *Report) error {
+	tw := tabwriter.NewWriter(w, 0, 0, tabPadding, ' ', 0)
+
+	fmt.Fprintf(tw, "New: %d, fixed: %d, moved: %d, unchanged: %d\n",
+		len(report.New), len(report.Fixed), len(report.Moved), report.Unchanged)
+	writeTextSection(tw, "NEW", report.New)
+	writeTextSection(tw, "FIXED", report.Fixed)
+	// Print moved findings
+	if len(report.Moved) > 0 {
+		fmt.Fprintln(tw, "\nMOVED")
+		// Print each move
+		for _, move := range report.Moved {
+			fmt.Fprintf(tw, "%s -> %s: [%s] %s\n",
+				formatLocation(move.From), formatLocation(move.Location), move.RuleID, firstLine(move.Message))
+		}
+	}
+
+	// Print rule deltas
+	if len(report.Rules) > 0 {
+		fmt.Fprintln(tw, "\nRULE\tOLD\tNEW\tDELTA\t")
+		// Print each changed rule
+		for _, rule := range report.Rules {
+			fmt.Fprintf(tw, "%s\t%d\t%d\t%+d\t\n", rule.RuleID, rule.Old, rule.New, rule.Delta())
+		}
+	}
+
+	// Flush table
+	return tw.Flush()
+}
+
+// WriteMarkdown writes the comparison as Markdown for pull request comments.
+//
+// Params:
+//   - w: destination writer
+//   - report: comparison to write
+//
+// Returns:
+//   - error: write error if any
+func WriteMarkdown(w io.Writer, report *Report) error {
+	var sb strings.Builder
+
+	sb.WriteString("## ktn-linter diff\n\n")
+	fmt.Fprintf(&sb, "**%d new**, **%d fixed**, %d moved, %d unchanged\n",
+		len(report.New), len(report.Fixed), len(report.Moved), report.Unchanged)
+	// Print rule deltas
+	if len(report.Rules) > 0 {
+		sb.WriteString("\n| Rule | Old | New | Delta |\n|---|---:|---:|---:|\n")
+		// Print each changed rule
+		for _, rule := range report.Rules {
+			fmt.Fprintf(&sb, "| %s | %d | %d | %+d |\n", rule.RuleID, rule.Old, rule.New, rule.Delta())
+		}
+	}
+	writeMarkdownSection(&sb, "New findings", report.New)
+	writeMarkdownSection(&sb, "Fixed findings", report.Fixed)
+	// Print moved findings
+	if len(report.Moved) > 0 {
+		sb.WriteString("\n### Moved findings\n\n")
+		// Print each move
+		for _, move := range report.Moved {
+			fmt.Fprintf(&sb, "- `%s` → `%s` **%s** %s\n",
+				formatLocation(move.From), formatLocation(move.Location), move.RuleID, firstLine(move.Message))
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	// Return write error
+	return err
+}
+
+// WriteJSON writes the comparison as indented JSON.
+//
+// Params:
+//   - w: destination writer
+//   - report: comparison to write
+//
+// Returns:
+//   - error: encoding error if any
+func WriteJSON(w io.Writer, report *Report) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	// Encode report
+	return encoder.Encode(report)
+}
+
+// writeTextSection prints a titled list of findings.
+//
+// Params:
+//   - w: destination writer
+//   - title: section title
+//   - results: findings to print
+func writeTextSection(w io.Writer, title string, results []formatter.JSONResult) {
+	// Skip empty sections
+	if len(results) == 0 {
+		// Nothing to print
+		return
+	}
+	fmt.Fprintf(w, "\n%s\n", title)
+	// Print each finding
+	for _, result := range results {
+		fmt.Fprintf(w, "%s: [%s] %s\n", formatLocation(result.Location), result.RuleID, firstLine(result.Message))
+	}
+}
+
+// writeMarkdownSection prints a titled Markdown list of findings.
+//
+// Params:
+//   - sb: destination builder
+//   - title: section title
+//   - results: findings to print
+func writeMarkdownSection(sb *strings.Builder, title string, results []formatter.JSONResult) {
+	// Skip empty sections
+	if len(results) == 0 {
+		// Nothing to print
+		return
+	}
+	fmt.Fprintf(sb, "\n### %s\n\n", title)
+	// Print each finding
+	for _, result := range results {
+		fmt.Fprintf(sb, "- `%s` **%s** %s\n", formatLocation(result.Location), result.RuleID, firstLine(result.Message))
+	}
+}
+
+// formatLocation formats a location as file:line:column.
+//
+// Params:
+//   - location: finding location
+//
+// Returns:
+//   - string: formatted location
+func formatLocation(location formatter.JSONLocation) string {
+	// Return compact location
+	return fmt.Sprintf("%s:%d:%d", location.File, location.Line, location.Column)
+}
+
+// firstLine returns the first line of a message.
+//
+// Params:
+//   - message: finding message
+//
+// Returns:
+//   - string: message up to the first newline
+func firstLine(message string) string {
+	line, _, _ := strings.Cut(message, "\n")
+	// Return first line
+	return line
+}
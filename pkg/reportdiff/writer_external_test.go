@@ -0,0 +1,114 @@
+// Package reportdiff_test provides tests for the reportdiff package.
+package reportdiff_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/reportdiff"
+)
+
+// sampleDiff returns a comparison with one finding of each kind.
+//
+// Returns:
+//   - *reportdiff.Report: comparison of two small reports
+func sampleDiff() *reportdiff.Report {
+	before := reportOf(
+		finding("KTN-FUNC-001", 10, "Run", "too long"),
+		finding("KTN-VAR-001", 20, "Run", "bad name"),
+	)
+	after := reportOf(
+		finding("KTN-FUNC-001", 15, "Run", "too long"),
+		finding("KTN-VAR-002", 30, "Stop", "shadowed\nhint"),
+	)
+	// Return comparison
+	return reportdiff.Compare(before, after)
+}
+
+// TestWriters tests the text and Markdown writers.
+func TestWriters(t *testing.T) {
+	tests := []struct {
+		name    string
+		write   func(*bytes.Buffer, *reportdiff.Report) error
+		want    []string
+		notWant []string
+	}{
+		{
+			name: "text",
+			write: func(buf *bytes.Buffer, report *reportdiff.Report) error {
+				// Write text
+				return reportdiff.WriteText(buf, report)
+			},
+			want: []string{
+				"New: 1, fixed: 1, moved: 1, unchanged: 0",
+				"a.go:30:1: [KTN-VAR-002] shadowed",
+				"a.go:20:1: [KTN-VAR-001] bad name",
+				"a.go:10:1 -> a.go:15:1: [KTN-FUNC-001] too long",
+				"KTN-VAR-002  0    1    +1",
+			},
+			notWant: []string{"hint"},
+		},
+		{
+			name: "markdown",
+			write: func(buf *bytes.Buffer, report *reportdiff.Report) error {
+				// Write Markdown
+				return reportdiff.WriteMarkdown(buf, report)
+			},
+			want: []string{
+				"## ktn-linter diff",
+				"**1 new**, **1 fixed**, 1 moved, 0 unchanged",
+				"| KTN-VAR-001 | 1 | 0 | -1 |",
+				"### New findings",
+				"- `a.go:30:1` **KTN-VAR-002** shadowed",
+				"- `a.go:10:1` → `a.go:15:1` **KTN-FUNC-001** too long",
+			},
+			notWant: []string{"hint"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			// Check write error
+			if err := tt.write(&buf, sampleDiff()); err != nil {
+				t.Fatalf("write error = %v", err)
+			}
+			output := buf.String()
+			// Check expected fragments
+			for _, want := range tt.want {
+				// Verify fragment presence
+				if !strings.Contains(output, want) {
+					t.Errorf("output missing %q:\n%s", want, output)
+				}
+			}
+			// Check unexpected fragments
+			for _, notWant := range tt.notWant {
+				// Verify fragment absence
+				if strings.Contains(output, notWant) {
+					t.Errorf("output contains %q:\n%s", notWant, output)
+				}
+			}
+		})
+	}
+}
+
+// TestWriteJSON tests the WriteJSON function.
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	// Check write error
+	if err := reportdiff.WriteJSON(&buf, sampleDiff()); err != nil {
+		t.Fatalf("WriteJSON() error = %v", err)
+	}
+	var decoded reportdiff.Report
+	// Check output decodes back
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	// Check moved origin survives encoding
+	if len(decoded.Moved) != 1 || decoded.Moved[0].From.Line != 10 {
+		t.Errorf("decoded moves = %+v, want one from line 10", decoded.Moved)
+	}
+}
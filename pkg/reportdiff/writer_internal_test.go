@@ -0,0 +1,40 @@
+// Package reportdiff provides internal tests for comparison writers.
+package reportdiff
+
+import (
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/formatter"
+)
+
+// Test_firstLine tests the firstLine function.
+func Test_firstLine(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{name: "single line", message: "too long", want: "too long"},
+		{name: "multiple lines", message: "too long\nsplit the function", want: "too long"},
+		{name: "empty", message: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Check first line
+			if got := firstLine(tt.message); got != tt.want {
+				t.Errorf("firstLine() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_formatLocation tests the formatLocation function.
+func Test_formatLocation(t *testing.T) {
+	location := formatter.JSONLocation{File: "pkg/a.go", Line: 12, Column: 3}
+	// Check compact location
+	if got := formatLocation(location); got != "pkg/a.go:12:3" {
+		t.Errorf("formatLocation() = %q, want %q", got, "pkg/a.go:12:3")
+	}
+}
@@ -0,0 +1,190 @@
+// Package rulecode recognizes rule codes in analyzer docs and diagnostics.
+//
+// A rule code has the form PREFIX-CATEGORY-ID, e.g. "KTN-FUNC-001". The KTN
+// prefix is always known; rules registered by other packages add their own
+// prefix (e.g. "ACME-SEC-001") with RegisterPrefix.
+package rulecode
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+const (
+	// DefaultPrefix is the prefix of the built-in KTN rules.
+	DefaultPrefix string = "KTN"
+	// codePartsCount is the number of dash-separated parts of a code.
+	codePartsCount int = 3
+)
+
+// prefixes holds the known code prefixes.
+var prefixes map[string]bool = map[string]bool{DefaultPrefix: true}
+
+// RegisterPrefix makes codes using prefix recognized as rule codes.
+// It is not safe for concurrent use and is meant for init functions.
+//
+// Params:
+//   - prefix: code prefix without dash (e.g. "ACME")
+func RegisterPrefix(prefix string) {
+	prefixes[strings.ToUpper(prefix)] = true
+}
+
+// Prefixes returns the known code prefixes, sorted.
+//
+// Returns:
+//   - []string: known prefixes
+func Prefixes() []string {
+	// Return sorted prefixes
+	return slices.Sorted(maps.Keys(prefixes))
+}
+
+// IsCode reports whether s is a rule code with a known prefix.
+//
+// Params:
+//   - s: candidate code (e.g. "KTN-FUNC-001")
+//
+// Returns:
+//   - bool: true for well-formed codes with a known prefix
+func IsCode(s string) bool {
+	prefix, _, _ := strings.Cut(s, "-")
+	// Check the prefix then the shape
+	return prefixes[prefix] && IsWellFormed(s)
+}
+
+// IsWellFormed reports whether s has the PREFIX-CATEGORY-ID shape, whatever
+// its prefix: three non-empty parts made of [A-Z0-9_].
+//
+// Params:
+//   - s: candidate code (e.g. "ACME-SEC-001")
+//
+// Returns:
+//   - bool: true for well-formed codes
+func IsWellFormed(s string) bool {
+	parts := strings.Split(s, "-")
+	// Check the number of parts
+	if len(parts) != codePartsCount {
+		// Not a rule code
+		return false
+	}
+	// Check each part is a non-empty uppercase identifier
+	for _, part := range parts {
+		// Reject empty or lowercase parts
+		if part == "" || strings.IndexFunc(part, isNotCodeRune) != -1 {
+			// Not a rule code
+			return false
+		}
+	}
+	// Well-formed rule code
+	return true
+}
+
+// isNotCodeRune reports whether r cannot appear in a rule code part.
+//
+// Params:
+//   - r: rune to check
+//
+// Returns:
+//   - bool: true outside [A-Z0-9_]
+func isNotCodeRune(r rune) bool {
+	// Accept uppercase letters, digits and underscores
+	return (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '_'
+}
+
+// Prefix returns the prefix of a rule code.
+//
+// Params:
+//   - code: rule code (e.g. "ACME-SEC-001")
+//
+// Returns:
+//   - string: prefix (e.g. "ACME"), empty if code is not a rule code
+func Prefix(code string) string {
+	// Check code validity
+	if !IsCode(code) {
+		// Not a rule code
+		return ""
+	}
+	prefix, _, _ := strings.Cut(code, "-")
+	// Return prefix
+	return prefix
+}
+
+// Category returns the lowercase category of a rule code.
+//
+// Params:
+//   - code: rule code (e.g. "KTN-FUNC-001")
+//
+// Returns:
+//   - string: category (e.g. "func"), empty if code is not a rule code
+func Category(code string) string {
+	// Check code validity
+	if !IsCode(code) {
+		// Not a rule code
+		return ""
+	}
+	// Return the middle part
+	return strings.ToLower(strings.Split(code, "-")[1])
+}
+
+// FromMessage extracts the rule code of a diagnostic message, either
+// bracketed anywhere ("[KTN-VAR-001] msg") or leading ("KTN-VAR-001: msg").
+//
+// Params:
+//   - message: diagnostic message
+//
+// Returns:
+//   - string: rule code, empty if the message has none
+func FromMessage(message string) string {
+	// Look for a bracketed code
+	for rest := message; ; {
+		start := strings.IndexByte(rest, '[')
+		// Stop without opening bracket
+		if start == -1 {
+			break
+		}
+		end := strings.IndexByte(rest[start:], ']')
+		// Stop without closing bracket
+		if end == -1 {
+			break
+		}
+		// Check the bracketed text
+		if code := rest[start+1 : start+end]; IsCode(code) {
+			// Return bracketed code
+			return code
+		}
+		rest = rest[start+1:]
+	}
+
+	// Look for a leading "CODE:" code
+	if code, _, found := strings.Cut(message, ":"); found && IsCode(code) {
+		// Return leading code
+		return code
+	}
+	// No code found
+	return ""
+}
+
+// TrimCode removes the leading rule code of a diagnostic message.
+//
+// Params:
+//   - message: diagnostic message ("[CODE] msg" or "CODE: msg")
+//
+// Returns:
+//   - string: message without its leading code, unchanged without one
+func TrimCode(message string) string {
+	// Bracketed form
+	if rest, ok := strings.CutPrefix(message, "["); ok {
+		// Check the bracketed text is a code
+		if code, text, found := strings.Cut(rest, "]"); found && IsCode(code) && text != "" {
+			// Return text after the code
+			return strings.TrimSpace(text)
+		}
+	}
+	// Prefixed form
+	if code, text, found := strings.Cut(message, ":"); found && IsCode(code) && text != "" {
+		// Return text after the separator
+		return strings.TrimSpace(text)
+	}
+	// Return message unchanged
+	return message
+}
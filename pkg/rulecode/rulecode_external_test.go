@@ -0,0 +1,186 @@
+// External tests for rule code parsing.
+package rulecode_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/rulecode"
+)
+
+// init registers the prefix used by custom rule tests.
+func init() {
+	rulecode.RegisterPrefix("acme")
+}
+
+// TestRegisterPrefix tests prefix registration.
+func TestRegisterPrefix(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+	}{
+		{name: "default prefix", prefix: rulecode.DefaultPrefix},
+		{name: "registered prefix is uppercased", prefix: "ACME"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify the prefix is known
+			if !slices.Contains(rulecode.Prefixes(), tt.prefix) {
+				t.Errorf("Prefixes() = %v, want %q", rulecode.Prefixes(), tt.prefix)
+			}
+		})
+	}
+}
+
+// TestIsCode tests rule code recognition.
+func TestIsCode(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want bool
+	}{
+		{name: "ktn rule", code: "KTN-FUNC-001", want: true},
+		{name: "custom rule", code: "ACME-SEC-001", want: true},
+		{name: "modernize rule", code: "KTN-MDRNZ-STRINGSCUT", want: true},
+		{name: "unknown prefix", code: "FOO-SEC-001", want: false},
+		{name: "lowercase", code: "KTN-func-001", want: false},
+		{name: "missing number", code: "KTN-FUNC", want: false},
+		{name: "empty part", code: "KTN--001", want: false},
+		{name: "too many parts", code: "KTN-FUNC-001-X", want: false},
+		{name: "empty", code: "", want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify recognition
+			if got := rulecode.IsCode(tt.code); got != tt.want {
+				t.Errorf("IsCode(%q) = %v, want %v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestIsWellFormed tests rule code shape checks.
+func TestIsWellFormed(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want bool
+	}{
+		{name: "ktn rule", code: "KTN-FUNC-001", want: true},
+		{name: "unregistered prefix", code: "FOO-SEC-001", want: true},
+		{name: "lowercase", code: "foo-sec-001", want: false},
+		{name: "two parts", code: "FOO-001", want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify shape check
+			if got := rulecode.IsWellFormed(tt.code); got != tt.want {
+				t.Errorf("IsWellFormed(%q) = %v, want %v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestPrefix tests prefix extraction.
+func TestPrefix(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want string
+	}{
+		{name: "ktn rule", code: "KTN-FUNC-001", want: "KTN"},
+		{name: "custom rule", code: "ACME-SEC-001", want: "ACME"},
+		{name: "invalid code", code: "FUNC-001", want: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify prefix
+			if got := rulecode.Prefix(tt.code); got != tt.want {
+				t.Errorf("Prefix(%q) = %q, want %q", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCategory tests category extraction.
+func TestCategory(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want string
+	}{
+		{name: "ktn rule", code: "KTN-FUNC-001", want: "func"},
+		{name: "custom rule", code: "ACME-SEC-001", want: "sec"},
+		{name: "invalid code", code: "KTN-FUNC", want: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify category
+			if got := rulecode.Category(tt.code); got != tt.want {
+				t.Errorf("Category(%q) = %q, want %q", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFromMessage tests code extraction from messages.
+func TestFromMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{name: "prefixed", message: "KTN-VAR-001: some message", want: "KTN-VAR-001"},
+		{name: "bracketed", message: "[KTN-VAR-001] some message", want: "KTN-VAR-001"},
+		{name: "bracketed later", message: "see [link] then [ACME-SEC-001] msg", want: "ACME-SEC-001"},
+		{name: "space separated", message: "ACME-SEC-002 some message", want: ""},
+		{name: "code only", message: "KTN-FUNC-001", want: ""},
+		{name: "unknown prefix", message: "FOO-SEC-001: msg", want: ""},
+		{name: "no code", message: "some message", want: ""},
+		{name: "unclosed bracket", message: "[KTN-VAR-001 msg", want: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify extraction
+			if got := rulecode.FromMessage(tt.message); got != tt.want {
+				t.Errorf("FromMessage(%q) = %q, want %q", tt.message, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestTrimCode tests code removal from messages.
+func TestTrimCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{name: "prefixed", message: "KTN-VAR-001: some message", want: "some message"},
+		{name: "bracketed", message: "[ACME-SEC-001] some message", want: "some message"},
+		{name: "no code", message: "note: some message", want: "note: some message"},
+		{name: "code only", message: "KTN-VAR-001:", want: "KTN-VAR-001:"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify trimming
+			if got := rulecode.TrimCode(tt.message); got != tt.want {
+				t.Errorf("TrimCode(%q) = %q, want %q", tt.message, got, tt.want)
+			}
+		})
+	}
+}
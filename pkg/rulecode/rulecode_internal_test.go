@@ -0,0 +1,31 @@
+// Internal tests for rule code parsing.
+package rulecode
+
+import (
+	"testing"
+)
+
+// Test_isNotCodeRune tests the accepted code characters.
+func Test_isNotCodeRune(t *testing.T) {
+	tests := []struct {
+		name string
+		r    rune
+		want bool
+	}{
+		{name: "uppercase letter", r: 'F', want: false},
+		{name: "digit", r: '7', want: false},
+		{name: "underscore", r: '_', want: false},
+		{name: "lowercase letter", r: 'f', want: true},
+		{name: "colon", r: ':', want: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify rune classification
+			if got := isNotCodeRune(tt.r); got != tt.want {
+				t.Errorf("isNotCodeRune(%q) = %v, want %v", tt.r, got, tt.want)
+			}
+		})
+	}
+}
@@ -15,6 +15,10 @@ const (
 	testdataSuffix string = "testdata/src"
 	// goodFileName is the standard name for good example files.
 	goodFileName string = "good.go"
+	// ktnPrefix is the prefix of the built-in rule codes.
+	ktnPrefix string = "KTN-"
+	// codePartsCount is the expected number of parts after KTN- prefix.
+	codePartsCount int = 2
 )
 
 // GetTestdataPath returns the path to testdata directory for a rule.
@@ -118,6 +122,12 @@ func (e *InvalidCodeError) Error() string {
 // Returns:
 //   - string: content of good.go file or empty if not found
 func LoadGoodExample(code string) string {
+	// Use the example registered with third-party rules
+	if spec := customRule(code); spec != nil {
+		// Return registered example
+		return spec.GoodExample
+	}
+
 	// Get testdata path
 	testdataPath, err := GetTestdataPath(code)
 	// Check for errors
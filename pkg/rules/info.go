@@ -8,23 +8,18 @@ import (
 	"golang.org/x/tools/go/analysis"
 
 	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn"
-)
-
-const (
-	// ktnPrefix is the standard prefix for rule codes.
-	ktnPrefix string = "KTN-"
-	// codePartsCount is the expected number of parts after KTN- prefix.
-	codePartsCount int = 2
+	"github.com/kodflow/ktn-linter/pkg/rulecode"
 )
 
 // RuleInfo contains complete information about a KTN rule.
 // It includes the rule code, category, analyzer name, description and example.
 type RuleInfo struct {
-	Code        string // KTN-FUNC-001
-	Category    string // func
-	Name        string // ktnfunc001
-	Description string // Short description
-	GoodExample string // Content from good.go
+	Code               string // KTN-FUNC-001
+	Category           string // func
+	Name               string // ktnfunc001
+	Description        string // Short description
+	GoodExample        string // Content from good.go
+	RemediationMinutes int    // Estimated effort to fix one violation
 }
 
 // RulesOutput is the complete output structure for the rules command.
@@ -35,7 +30,8 @@ type RulesOutput struct {
 	Rules      []RuleInfo // All rules (filtered if requested)
 }
 
-// ExtractRuleCode extracts the KTN code from an analyzer Doc field.
+// ExtractRuleCode extracts the rule code from an analyzer Doc field.
+// Codes of registered third-party rules (e.g. "ACME-SEC-001") are accepted.
 //
 // Params:
 //   - doc: analyzer Doc field (format: "KTN-XXX-YYY: description")
@@ -43,12 +39,6 @@ type RulesOutput struct {
 // Returns:
 //   - string: rule code (e.g., "KTN-FUNC-001") or empty if invalid format
 func ExtractRuleCode(doc string) string {
-	// Check prefix
-	if !strings.HasPrefix(doc, ktnPrefix) {
-		// Not a KTN rule
-		return ""
-	}
-
 	// Use strings.Cut to split on colon
 	code, _, found := strings.Cut(doc, ":")
 	// Check if colon was found
@@ -58,7 +48,7 @@ func ExtractRuleCode(doc string) string {
 	}
 
 	// Validate format
-	if !isValidRuleCode(code) {
+	if !rulecode.IsCode(code) {
 		// Invalid code format
 		return ""
 	}
@@ -86,7 +76,7 @@ func extractCodeWithoutColon(doc string) string {
 	// Check if first part looks like a rule code
 	firstPart := parts[0]
 	// Validate format
-	if isValidRuleCode(firstPart) {
+	if rulecode.IsCode(firstPart) {
 		// Return the code
 		return firstPart
 	}
@@ -95,28 +85,6 @@ func extractCodeWithoutColon(doc string) string {
 	return ""
 }
 
-// isValidRuleCode checks if a string is a valid KTN rule code format.
-//
-// Params:
-//   - code: potential rule code to validate
-//
-// Returns:
-//   - bool: true if valid format (KTN-XXX-YYY)
-func isValidRuleCode(code string) bool {
-	// Must start with KTN-
-	if !strings.HasPrefix(code, ktnPrefix) {
-		// Invalid prefix
-		return false
-	}
-
-	// Remove prefix and check parts
-	rest := code[len(ktnPrefix):]
-	parts := strings.Split(rest, "-")
-
-	// Must have exactly 2 parts: CATEGORY and NUMBER
-	return len(parts) == codePartsCount
-}
-
 // ExtractDescription extracts the description from an analyzer Doc field.
 //
 // Params:
@@ -138,6 +106,7 @@ func ExtractDescription(doc string) string {
 }
 
 // ExtractCategory extracts category name from rule code.
+// Third-party rules report the category they were registered with.
 //
 // Params:
 //   - code: rule code (e.g., "KTN-FUNC-001")
@@ -145,24 +114,33 @@ func ExtractDescription(doc string) string {
 // Returns:
 //   - string: category in lowercase (e.g., "func") or empty if invalid
 func ExtractCategory(code string) string {
-	// Check prefix
-	if !strings.HasPrefix(code, ktnPrefix) {
-		// Invalid code
-		return ""
+	// Check registered third-party rules
+	if spec := customRule(code); spec != nil {
+		// Return registered category
+		return spec.Category
 	}
+	// Return category part of the code
+	return rulecode.Category(code)
+}
 
-	// Remove prefix
-	rest := code[len(ktnPrefix):]
-	// Split by dash
-	parts := strings.Split(rest, "-")
-	// Check parts count
-	if len(parts) != codePartsCount {
-		// Invalid format
-		return ""
+// customRule returns the registered third-party rule of a code.
+//
+// Params:
+//   - code: rule code
+//
+// Returns:
+//   - *ktn.RuleSpec: registered rule or nil
+func customRule(code string) *ktn.RuleSpec {
+	// Search registered rules
+	for _, spec := range ktn.GetCustomRules() {
+		// Compare codes
+		if spec.Code == code {
+			// Return matching rule
+			return spec
+		}
 	}
-
-	// Return category in lowercase
-	return strings.ToLower(parts[0])
+	// Not a third-party rule
+	return nil
 }
 
 // GetAllRuleInfos extracts information from all available KTN rules.
@@ -255,6 +233,22 @@ func analyzersToRuleInfos(analyzers []*analysis.Analyzer) []RuleInfo {
 // Returns:
 //   - RuleInfo: extracted rule information
 func analyzerToRuleInfo(a *analysis.Analyzer) RuleInfo {
+	// Use the registration of third-party rules
+	for _, spec := range ktn.GetCustomRules() {
+		// Match the analyzer
+		if spec.Analyzer == a {
+			// Return registered information
+			return RuleInfo{
+				Code:               spec.Code,
+				Category:           spec.Category,
+				Name:               a.Name,
+				Description:        ExtractDescription(spec.Description),
+				GoodExample:        "", // Loaded separately if needed
+				RemediationMinutes: RemediationMinutes(spec.Code),
+			}
+		}
+	}
+
 	// Extract code from Doc
 	code := ExtractRuleCode(a.Doc)
 	// Extract description from Doc
@@ -264,11 +258,12 @@ func analyzerToRuleInfo(a *analysis.Analyzer) RuleInfo {
 
 	// Build RuleInfo
 	return RuleInfo{
-		Code:        code,
-		Category:    category,
-		Name:        a.Name,
-		Description: description,
-		GoodExample: "", // Loaded separately if needed
+		Code:               code,
+		Category:           category,
+		Name:               a.Name,
+		Description:        description,
+		GoodExample:        "", // Loaded separately if needed
+		RemediationMinutes: RemediationMinutes(code),
 	}
 }
 
@@ -291,6 +286,9 @@ func GetCategories() []string {
 		"var",
 	}
 
-	// Already sorted alphabetically
+	// Add third-party categories, keeping the list sorted
+	categories = append(categories, ktn.GetCustomCategories()...)
+	sort.Strings(categories)
+	// Return sorted categories
 	return categories
 }
@@ -3,9 +3,66 @@ package rules_test
 import (
 	"testing"
 
+	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn"
 	"github.com/kodflow/ktn-linter/pkg/rules"
+	"golang.org/x/tools/go/analysis"
 )
 
+// customEffort is the remediation effort of the registered test rule.
+const customEffort int = 42
+
+// init registers a third-party rule in the built-in func category.
+func init() {
+	// Register the custom rule
+	if err := ktn.RegisterRule(&ktn.RuleSpec{
+		Code: "ACME-FUNC-001",
+		Analyzer: &analysis.Analyzer{
+			Name: "acmefunc001",
+			Doc:  "ACME-FUNC-001: no naked goroutines",
+			Run:  func(*analysis.Pass) (any, error) { return nil, nil },
+		},
+		RemediationMinutes: customEffort,
+		GoodExample:        "go worker(ctx)",
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// TestGetRuleInfoByCode_custom tests third-party rule information.
+func TestGetRuleInfoByCode_custom(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func() bool
+	}{
+		{name: "info from registration", check: func() bool {
+			info := rules.GetRuleInfoByCode("ACME-FUNC-001")
+			return info != nil && info.Category == "func" && info.Description == "no naked goroutines" && info.RemediationMinutes == customEffort
+		}},
+		{name: "listed in its category", check: func() bool {
+			for _, info := range rules.GetRuleInfosByCategory("func") {
+				// Look for the custom rule
+				if info.Code == "ACME-FUNC-001" {
+					return true
+				}
+			}
+			return false
+		}},
+		{name: "good example", check: func() bool { return rules.LoadGoodExample("ACME-FUNC-001") == "go worker(ctx)" }},
+		{name: "code extracted from doc", check: func() bool { return rules.ExtractRuleCode("ACME-FUNC-001: x") == "ACME-FUNC-001" }},
+		{name: "known to config validation", check: func() bool { return rules.RuleCatalog().Codes["ACME-FUNC-001"] }},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify the rule is first-class
+			if !tt.check() {
+				t.Error("third-party rule not handled as expected")
+			}
+		})
+	}
+}
+
 func TestExtractRuleCode(t *testing.T) {
 	tests := []struct {
 		name string
@@ -3,53 +3,64 @@ package rules
 import (
 	"testing"
 
+	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn"
 	"golang.org/x/tools/go/analysis"
 )
 
-func Test_isValidRuleCode(t *testing.T) {
+// init registers the third-party rule looked up by Test_customRule.
+func init() {
+	// Register the custom rule, category taken from its code
+	if err := ktn.RegisterRule(&ktn.RuleSpec{
+		Code:        "ACME-FUNC-002",
+		Description: "no global mutexes",
+		Analyzer: &analysis.Analyzer{
+			Name: "acmefunc002",
+			Doc:  "ACME-FUNC-002: no global mutexes",
+			Run:  func(*analysis.Pass) (any, error) { return nil, nil },
+		},
+	}); err != nil {
+		panic(err)
+	}
+}
+
+func Test_customRule(t *testing.T) {
 	tests := []struct {
-		name string
-		code string
-		want bool
+		name            string
+		code            string
+		want            bool
+		wantCategory    string
+		wantDescription string
 	}{
 		{
-			name: "valid code",
+			name: "built-in rule",
 			code: "KTN-FUNC-001",
-			want: true,
-		},
-		{
-			name: "valid var code",
-			code: "KTN-VAR-002",
-			want: true,
-		},
-		{
-			name: "missing prefix",
-			code: "FUNC-001",
 			want: false,
 		},
 		{
-			name: "missing number",
-			code: "KTN-FUNC",
+			name: "unknown code",
+			code: "ACME-SEC-999",
 			want: false,
 		},
 		{
-			name: "empty code",
-			code: "",
-			want: false,
-		},
-		{
-			name: "too many parts",
-			code: "KTN-FUNC-001-EXTRA",
-			want: false,
+			name:            "registered rule",
+			code:            "ACME-FUNC-002",
+			want:            true,
+			wantCategory:    "func",
+			wantDescription: "no global mutexes",
 		},
 	}
 
 	for _, tt := range tests {
 		tt := tt // Capture range variable
 		t.Run(tt.name, func(t *testing.T) {
-			got := isValidRuleCode(tt.code)
-			if got != tt.want {
-				t.Errorf("isValidRuleCode(%q) = %v, want %v", tt.code, got, tt.want)
+			got := customRule(tt.code)
+			if (got != nil) != tt.want {
+				t.Fatalf("customRule(%q) = %v, want found %v", tt.code, got, tt.want)
+			}
+			// Check the registered metadata
+			if got != nil && (got.Code != tt.code || got.Category != tt.wantCategory || got.Description != tt.wantDescription) {
+				t.Errorf("customRule(%q) = %s %s %q, want %s %s %q",
+					tt.code, got.Code, got.Category, got.Description, tt.code, tt.wantCategory, tt.wantDescription)
 			}
 		})
 	}
@@ -0,0 +1,22 @@
+// Package rules provides rule information extraction and formatting utilities.
+package rules
+
+import "github.com/kodflow/ktn-linter/pkg/analyzer/ktn"
+
+// RemediationMinutes returns the estimated effort to fix one violation of a
+// rule: the effort the rule declares, else the effort of its category.
+//
+// Params:
+//   - code: rule code (e.g., "KTN-FUNC-005")
+//
+// Returns:
+//   - int: estimated effort in minutes
+func RemediationMinutes(code string) int {
+	// Check effort declared by the rule
+	if minutes, ok := ktn.GetRuleRemediation()[code]; ok {
+		// Return rule effort
+		return minutes
+	}
+	// Return category effort
+	return ktn.GetCategoryRemediation(ExtractCategory(code))
+}
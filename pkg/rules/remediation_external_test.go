@@ -0,0 +1,55 @@
+// External tests for remediation effort metadata.
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/rules"
+)
+
+// TestRemediationMinutes tests the RemediationMinutes function.
+func TestRemediationMinutes(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want int
+	}{
+		{name: "rule override", code: "KTN-FUNC-005", want: 30},
+		{name: "category default", code: "KTN-COMMENT-001", want: 2},
+		{name: "modernize category", code: "KTN-MDRNZ-ANY", want: 2},
+		{name: "unknown category", code: "KTN-FOO-001", want: 5},
+		{name: "not a rule code", code: "UNKNOWN", want: 5},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify effort
+			if got := rules.RemediationMinutes(tt.code); got != tt.want {
+				t.Errorf("RemediationMinutes(%q) = %d, want %d", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestGetAllRuleInfos_remediation tests that every rule declares an effort.
+func TestGetAllRuleInfos_remediation(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{name: "every rule has a positive effort"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Check each rule
+			for _, info := range rules.GetAllRuleInfos() {
+				// Verify effort is set
+				if info.RemediationMinutes <= 0 {
+					t.Errorf("%s: RemediationMinutes = %d, want > 0", info.Code, info.RemediationMinutes)
+				}
+			}
+		})
+	}
+}
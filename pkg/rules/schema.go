@@ -0,0 +1,229 @@
+// Package rules provides rule information extraction and formatting utilities.
+package rules
+
+import (
+	"reflect"
+
+	"github.com/kodflow/ktn-linter/pkg/analyzer/ktn"
+	"github.com/kodflow/ktn-linter/pkg/config"
+)
+
+const (
+	// schemaDraft is the JSON Schema dialect used by GenerateSchema.
+	schemaDraft string = "http://json-schema.org/draft-07/schema#"
+	// schemaTitle is the title of the generated configuration schema.
+	schemaTitle string = "ktn-linter configuration"
+	// schemaRulesPath is the dotted path of the per-rule configuration map.
+	schemaRulesPath string = "rules"
+	// schemaGeneratedPath is the dotted path of the generated-code policy.
+	schemaGeneratedPath string = "generated"
+	// schemaUpdatePath is the dotted path of the upgrade release source.
+	schemaUpdatePath string = "update"
+	// schemaFragmentCapacity is the usual number of keys in a schema fragment.
+	schemaFragmentCapacity int = 4
+)
+
+// schemaDescriptions documents configuration keys by dotted yaml path.
+var schemaDescriptions map[string]string = map[string]string{
+	"version":                     "Configuration format version (0 or 1).",
+	"exclude":                     "Glob patterns of files excluded from all rules.",
+	"skip_dirs":                   "Gitignore-style patterns of directories never linted (relative to the working directory).",
+	"rules":                       "Per-rule configuration indexed by rule code.",
+	"force_all_rules_on_tests":    "Run every rule on *_test.go files, not only KTN-TEST-* rules.",
+	"build_matrix":                "Build configurations (goos, goarch, tags) linted in addition to the host one.",
+	"build_matrix[].name":         "Label shown next to findings specific to this configuration.",
+	"build_matrix[].goos":         "Target operating system (default: host).",
+	"build_matrix[].goarch":       "Target architecture (default: host).",
+	"build_matrix[].tags":         "Additional build tags.",
+	"analyzer_timeout":            "Maximum run time of one analyzer on one package, as a Go duration (e.g. \"30s\"); empty disables it.",
+	"generated":                   "Linting of files with a \"Code generated ... DO NOT EDIT.\" header: report (default), skip or only-comment-rules.",
+	"generated_source":            "Label findings in generated files with the //go:generate directive producing them.",
+	"hot_paths":                   "Hot-path definition: performance findings (KTN-VAR-010/011/012/014) are escalated inside hot functions and demoted elsewhere.",
+	"hot_paths.enabled":           "Classify findings with //ktn:hot annotations and HTTP handlers only.",
+	"hot_paths.functions":         "Hot declarations, with the exclude_symbols pattern syntax (e.g. \"api.*Handler.*\").",
+	"hot_paths.profile":           "pprof CPU profile; functions above profile_threshold are hot.",
+	"hot_paths.profile_threshold": "Minimal cumulative CPU share, in percent, of a hot function of the profile (default: 1).",
+	"update":                      "Release source of the upgrade command.",
+	"update.source":               "\"github\" (default), base URL of a mirror serving index.json, or local directory.",
+	"update.channel":              "Release channel: stable (default) or prerelease.",
+	"update.proxy":                "HTTP proxy URL for downloads (default: HTTPS_PROXY/HTTP_PROXY environment).",
+	"update.max_size_mb":          "Maximum size of a downloaded binary in MiB (default: 256).",
+	"rules.enabled":               "Whether the rule is active (default: true).",
+	"rules.threshold":             "Numeric threshold for rules that support one.",
+	"rules.exclude":               "Glob patterns of files excluded from this rule.",
+	"rules.exclude_symbols":       "Qualified declarations exempted from this rule (e.g. \"pkg/api.*Handler.ServeHTTP\").",
+	"rules.exclude_packages":      "Import paths exempted from this rule (\"/...\" includes subpackages).",
+}
+
+// GenerateSchema builds the JSON Schema of the configuration file.
+// Top-level keys are derived from config.Config and each known rule code
+// gets its own property, with the default threshold when it has one.
+//
+// Returns:
+//   - map[string]any: JSON Schema document ready to be marshaled
+func GenerateSchema() map[string]any {
+	schema := typeSchema(reflect.TypeFor[config.Config](), "")
+	schema["$schema"] = schemaDraft
+	schema["title"] = schemaTitle
+
+	properties := schema["properties"].(map[string]any)
+	rulesSchema := properties[schemaRulesPath].(map[string]any)
+	infos := GetAllRuleInfos()
+	ruleProperties := make(map[string]any, len(infos))
+	thresholds := ktn.GetRuleThresholds()
+	// Add one property per known rule
+	for _, info := range infos {
+		ruleProperties[info.Code] = ruleSchema(info, thresholds)
+	}
+	rulesSchema["properties"] = ruleProperties
+	rulesSchema["additionalProperties"] = false
+	generated := properties[schemaGeneratedPath].(map[string]any)
+	generated["enum"] = []string{config.GeneratedReport, config.GeneratedSkip, config.GeneratedOnlyCommentRules}
+	updateSchema := properties[schemaUpdatePath].(map[string]any)
+	channel := updateSchema["properties"].(map[string]any)["channel"].(map[string]any)
+	channel["enum"] = []string{config.UpdateChannelStable, config.UpdateChannelPrerelease}
+
+	// Return complete schema
+	return schema
+}
+
+// RuleCatalog returns the known rules for strict config validation.
+//
+// Returns:
+//   - config.RuleCatalog: known rule codes and default thresholds
+func RuleCatalog() config.RuleCatalog {
+	infos := GetAllRuleInfos()
+	codes := make(map[string]bool, len(infos))
+	// Collect rule codes
+	for _, info := range infos {
+		codes[info.Code] = true
+	}
+	// Return catalog
+	return config.RuleCatalog{
+		Codes:      codes,
+		Thresholds: ktn.GetRuleThresholds(),
+	}
+}
+
+// ruleSchema builds the schema of a single rule entry.
+//
+// Params:
+//   - info: rule information
+//   - thresholds: default thresholds by rule code
+//
+// Returns:
+//   - map[string]any: rule entry schema
+func ruleSchema(info RuleInfo, thresholds map[string]int) map[string]any {
+	schema := typeSchema(reflect.TypeFor[config.RuleConfig](), schemaRulesPath)
+	schema["description"] = info.Description
+	properties := schema["properties"].(map[string]any)
+
+	defaultValue, ok := thresholds[info.Code]
+	// Check if the rule supports a threshold
+	if !ok {
+		delete(properties, "threshold")
+		// Return schema without threshold
+		return schema
+	}
+	threshold := properties["threshold"].(map[string]any)
+	threshold["default"] = defaultValue
+	threshold["minimum"] = 0
+	// Return schema with threshold default
+	return schema
+}
+
+// typeSchema converts a Go type into a JSON Schema fragment.
+//
+// Params:
+//   - typ: Go type
+//   - path: dotted yaml path used to look up descriptions
+//
+// Returns:
+//   - map[string]any: schema fragment
+func typeSchema(typ reflect.Type, path string) map[string]any {
+	// Dereference pointer types
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	schema := make(map[string]any, schemaFragmentCapacity)
+	// Attach description when documented
+	if desc, ok := schemaDescriptions[path]; ok {
+		schema["description"] = desc
+	}
+
+	// Dispatch on the Go kind
+	switch typ.Kind() {
+	// Structs become objects with known properties
+	case reflect.Struct:
+		schema["type"] = "object"
+		schema["properties"] = structProperties(typ, path)
+		schema["additionalProperties"] = false
+	// Maps become objects with typed values
+	case reflect.Map:
+		schema["type"] = "object"
+		schema["additionalProperties"] = typeSchema(typ.Elem(), path)
+	// Slices become arrays
+	case reflect.Slice:
+		schema["type"] = "array"
+		schema["items"] = typeSchema(typ.Elem(), path+"[]")
+	// Booleans
+	case reflect.Bool:
+		schema["type"] = "boolean"
+	// Integers
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		schema["type"] = "integer"
+	// Floats
+	case reflect.Float32, reflect.Float64:
+		schema["type"] = "number"
+	// Everything else is treated as a string
+	default:
+		schema["type"] = "string"
+	}
+
+	// Return fragment
+	return schema
+}
+
+// structProperties builds the schema properties of a struct type.
+//
+// Params:
+//   - typ: struct type
+//   - path: dotted yaml path of the struct
+//
+// Returns:
+//   - map[string]any: properties indexed by yaml key
+func structProperties(typ reflect.Type, path string) map[string]any {
+	properties := make(map[string]any, typ.NumField())
+	// Collect serialized fields
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		name := config.YAMLFieldName(field)
+		// Skip fields that are not part of the file format
+		if name == "" {
+			continue
+		}
+		properties[name] = typeSchema(field.Type, joinSchemaPath(path, name))
+	}
+	// Return properties
+	return properties
+}
+
+// joinSchemaPath appends a key to a dotted yaml path.
+//
+// Params:
+//   - path: parent path
+//   - key: child key
+//
+// Returns:
+//   - string: joined path
+func joinSchemaPath(path, key string) string {
+	// Check root path
+	if path == "" {
+		// Return key alone
+		return key
+	}
+	// Return dotted path
+	return path + "." + key
+}
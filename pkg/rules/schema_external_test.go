@@ -0,0 +1,80 @@
+// External tests for configuration schema generation.
+package rules_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/rules"
+)
+
+// TestGenerateSchema tests the GenerateSchema function.
+func TestGenerateSchema(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		wantThreshold bool
+		wantDefault   float64
+	}{
+		{name: "rule with threshold", code: "KTN-FUNC-005", wantThreshold: true, wantDefault: 35},
+		{name: "rule without threshold", code: "KTN-FUNC-001", wantThreshold: false},
+	}
+
+	data, err := json.Marshal(rules.GenerateSchema())
+	// Verify schema serializes
+	if err != nil {
+		t.Fatalf("marshal schema: %v", err)
+	}
+	var schema map[string]any
+	// Verify schema round-trips
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("unmarshal schema: %v", err)
+	}
+	props := schema["properties"].(map[string]any)
+	ruleProps := props["rules"].(map[string]any)["properties"].(map[string]any)
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			rule, ok := ruleProps[tt.code].(map[string]any)
+			// Verify rule is listed
+			if !ok {
+				t.Fatalf("rule %s missing from schema", tt.code)
+			}
+			options := rule["properties"].(map[string]any)
+			threshold, hasThreshold := options["threshold"].(map[string]any)
+			// Verify threshold presence
+			if hasThreshold != tt.wantThreshold {
+				t.Fatalf("threshold present = %v, want %v", hasThreshold, tt.wantThreshold)
+			}
+			// Verify default value
+			if tt.wantThreshold && threshold["default"] != tt.wantDefault {
+				t.Errorf("default = %v, want %v", threshold["default"], tt.wantDefault)
+			}
+		})
+	}
+}
+
+// TestRuleCatalog tests the RuleCatalog function.
+func TestRuleCatalog(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want bool
+	}{
+		{name: "known rule", code: "KTN-FUNC-001", want: true},
+		{name: "unknown rule", code: "KTN-FUNC-999", want: false},
+		{name: "custom rule", code: "ACME-FUNC-001", want: true},
+	}
+
+	catalog := rules.RuleCatalog()
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify membership
+			if catalog.Codes[tt.code] != tt.want {
+				t.Errorf("Codes[%s] = %v, want %v", tt.code, catalog.Codes[tt.code], tt.want)
+			}
+		})
+	}
+}
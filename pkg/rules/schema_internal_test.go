@@ -0,0 +1,55 @@
+// Internal tests for configuration schema generation.
+package rules
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test_typeSchema tests the typeSchema function.
+func Test_typeSchema(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  reflect.Type
+		want string
+	}{
+		{name: "bool", typ: reflect.TypeFor[bool](), want: "boolean"},
+		{name: "int pointer", typ: reflect.TypeFor[*int](), want: "integer"},
+		{name: "string slice", typ: reflect.TypeFor[[]string](), want: "array"},
+		{name: "map", typ: reflect.TypeFor[map[string]int](), want: "object"},
+		{name: "string", typ: reflect.TypeFor[string](), want: "string"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify JSON type
+			if got := typeSchema(tt.typ, "")["type"]; got != tt.want {
+				t.Errorf("type = %v, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_joinSchemaPath tests the joinSchemaPath function.
+func Test_joinSchemaPath(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		key  string
+		want string
+	}{
+		{name: "root", path: "", key: "rules", want: "rules"},
+		{name: "nested", path: "rules", key: "enabled", want: "rules.enabled"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify joined path
+			if got := joinSchemaPath(tt.path, tt.key); got != tt.want {
+				t.Errorf("joinSchemaPath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 // Package severity defines severity levels for lint rules.
 package severity
 
+import "strings"
+
 const (
 	// SeverityInfo recommandations et style
 	SeverityInfo Level = iota
@@ -10,6 +12,13 @@ const (
 	SeverityError
 )
 
+const (
+	// CategoryHotPath marque un finding situé dans un chemin chaud (sévérité relevée)
+	CategoryHotPath string = "hot-path"
+	// CategoryColdPath marque un finding hors des chemins chauds (sévérité abaissée)
+	CategoryColdPath string = "cold-path"
+)
+
 // Level représente le niveau de sévérité d'une règle
 type Level int
 
@@ -124,6 +133,15 @@ var rulesSeverity map[string]Level = map[string]Level{
 	"KTN-GENERIC-003": SeverityInfo,    // Import obsolete golang.org/x/exp/constraints
 	"KTN-GENERIC-005": SeverityWarning, // Type parameter masque identifiant predeclare
 	"KTN-GENERIC-006": SeverityWarning, // Operateurs ordered/arithmetiques sans cmp.Ordered
+
+	// LOAD - Packages non analysables (3 règles)
+	"KTN-LOAD-001": SeverityError, // Package impossible à charger
+	"KTN-LOAD-002": SeverityError, // Erreur de syntaxe
+	"KTN-LOAD-003": SeverityError, // Erreur de typage
+
+	// INTERNAL - Analyseurs en échec (2 règles)
+	"KTN-INTERNAL-001": SeverityError, // Panique d'un analyseur
+	"KTN-INTERNAL-002": SeverityError, // Délai d'un analyseur dépassé
 }
 
 // GetSeverity retourne le niveau de sévérité d'une règle.
@@ -143,6 +161,65 @@ func GetSeverity(ruleCode string) Level {
 	return SeverityWarning
 }
 
+// ForDiagnostic retourne la sévérité d'un finding : celle de sa règle,
+// relevée d'un niveau dans un chemin chaud et abaissée d'un niveau ailleurs.
+//
+// Params:
+//   - ruleCode: code de la règle (ex: "KTN-VAR-012")
+//   - category: catégorie du diagnostic (CategoryHotPath, CategoryColdPath ou vide)
+//
+// Returns:
+//   - Level: niveau de sévérité du finding
+func ForDiagnostic(ruleCode, category string) Level {
+	level := GetSeverity(ruleCode)
+	// Ajustement selon le chemin d'exécution
+	switch category {
+	// Chemin chaud
+	case CategoryHotPath:
+		// Retour du niveau relevé
+		return min(level+1, SeverityError)
+	// Chemin froid
+	case CategoryColdPath:
+		// Retour du niveau abaissé
+		return max(level-1, SeverityInfo)
+	// Aucune classification
+	default:
+		// Retour du niveau de la règle
+		return level
+	}
+}
+
+// Register définit le niveau de sévérité d'une règle personnalisée.
+// Non sûr en concurrence : à appeler depuis une fonction init.
+//
+// Params:
+//   - ruleCode: code de la règle (ex: "ACME-SEC-001")
+//   - level: niveau de sévérité
+func Register(ruleCode string, level Level) {
+	rulesSeverity[ruleCode] = level
+}
+
+// ParseLevel convertit le nom d'un niveau, sans tenir compte de la casse.
+//
+// Params:
+//   - name: nom du niveau ("info", "warning" ou "error")
+//
+// Returns:
+//   - Level: niveau correspondant
+//   - bool: false si le nom est inconnu
+func ParseLevel(name string) (Level, bool) {
+	// Recherche du niveau portant ce nom
+	for _, level := range []Level{SeverityInfo, SeverityWarning, SeverityError} {
+		// Comparaison insensible à la casse
+		if strings.EqualFold(name, level.String()) {
+			// Retour du niveau trouvé
+			return level, true
+		}
+	}
+	// Nom inconnu
+	return SeverityWarning, false
+}
+
 // ColorCode retourne le code couleur ANSI pour un niveau.
 //
 // Returns:
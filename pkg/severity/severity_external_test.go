@@ -54,6 +54,78 @@ func TestGetSeverity(t *testing.T) {
 	}
 }
 
+// TestForDiagnostic tests hot and cold path adjustments.
+func TestForDiagnostic(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		category string
+		want     severity.Level
+	}{
+		{name: "unclassified keeps rule level", code: "KTN-VAR-012", category: "", want: severity.SeverityWarning},
+		{name: "hot path escalates", code: "KTN-VAR-012", category: severity.CategoryHotPath, want: severity.SeverityError},
+		{name: "cold path demotes", code: "KTN-VAR-012", category: severity.CategoryColdPath, want: severity.SeverityInfo},
+		{name: "error stays error", code: "KTN-VAR-001", category: severity.CategoryHotPath, want: severity.SeverityError},
+		{name: "info stays info", code: "KTN-VAR-013", category: severity.CategoryColdPath, want: severity.SeverityInfo},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			if got := severity.ForDiagnostic(tt.code, tt.category); got != tt.want {
+				t.Errorf("ForDiagnostic(%q, %q) = %v, want %v", tt.code, tt.category, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRegister tests custom rule severities.
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name     string
+		ruleCode string
+		level    severity.Level
+	}{
+		{name: "custom error rule", ruleCode: "ACME-SEC-001", level: severity.SeverityError},
+		{name: "custom info rule", ruleCode: "ACME-STYLE-001", level: severity.SeverityInfo},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			severity.Register(tt.ruleCode, tt.level)
+			if got := severity.GetSeverity(tt.ruleCode); got != tt.level {
+				t.Errorf("GetSeverity() = %v, want %v", got, tt.level)
+			}
+		})
+	}
+}
+
+// TestParseLevel tests the ParseLevel function.
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		want   severity.Level
+		wantOK bool
+	}{
+		{name: "lower case info", input: "info", want: severity.SeverityInfo, wantOK: true},
+		{name: "mixed case warning", input: "Warning", want: severity.SeverityWarning, wantOK: true},
+		{name: "upper case error", input: "ERROR", want: severity.SeverityError, wantOK: true},
+		{name: "unknown name", input: "fatal", want: severity.SeverityWarning, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := severity.ParseLevel(tt.input)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("ParseLevel(%q) = %v, %v, want %v, %v", tt.input, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
 // TestLevel_ColorCode tests the ColorCode method of Level type.
 func TestLevel_ColorCode(t *testing.T) {
 	tests := []struct {
@@ -0,0 +1,199 @@
+// Package stats computes rule-level metrics and technical-debt summaries.
+package stats
+
+import (
+	"cmp"
+	"math"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+	"github.com/kodflow/ktn-linter/pkg/rulecode"
+	"github.com/kodflow/ktn-linter/pkg/rules"
+	"github.com/kodflow/ktn-linter/pkg/severity"
+)
+
+const (
+	// linesPerKLOC is the number of lines in a KLOC.
+	linesPerKLOC float64 = 1000
+	// unknownCode is the code of findings without a rule code.
+	unknownCode string = "UNKNOWN"
+	// densityPrecision rounds densities to two decimals.
+	densityPrecision float64 = 100
+)
+
+// Collect computes a report from normalized diagnostics.
+//
+// Params:
+//   - results: filtered and deduplicated diagnostics
+//   - opts: source size and presentation options
+//
+// Returns:
+//   - *Report: computed report
+func Collect(results []orchestrator.DiagnosticResult, opts Options) *Report {
+	byRule := map[string]int{}
+	byCategory := map[string]int{}
+	byPackage := map[string]int{}
+	bySeverity := map[string]int{}
+	byFile := map[string]int{}
+	byFunction := map[string]int{}
+
+	// Tally every finding
+	for i := range results {
+		code := RuleCode(results[i].Diag.Message)
+		byRule[code]++
+		byCategory[cmp.Or(rules.ExtractCategory(code), strings.ToLower(unknownCode))]++
+		byPackage[cmp.Or(results[i].Package, unknownCode)]++
+		bySeverity[severity.ForDiagnostic(code, results[i].Diag.Category).String()]++
+		file := relativePath(results[i].Position().Filename, opts.BaseDir)
+		byFile[file]++
+		// Count function-level findings
+		if results[i].Symbol != "" {
+			byFunction[qualifiedSymbol(results[i].Package, results[i].Symbol)]++
+		}
+	}
+
+	report := &Report{
+		GeneratedAt:  time.Now(),
+		Total:        len(results),
+		Files:        opts.Files,
+		Lines:        opts.Lines,
+		Rules:        ruleStats(byRule),
+		Categories:   sortedCounts(byCategory, 0),
+		Packages:     sortedCounts(byPackage, 0),
+		Severities:   sortedCounts(bySeverity, 0),
+		TopFiles:     sortedCounts(byFile, opts.Top),
+		TopFunctions: sortedCounts(byFunction, opts.Top),
+	}
+	// Sum remediation effort
+	for _, rule := range report.Rules {
+		report.EffortMinutes += rule.EffortMinutes
+	}
+	// Compute density when source size is known
+	if opts.Lines > 0 {
+		density := float64(report.Total) * linesPerKLOC / float64(opts.Lines)
+		report.DensityPerKLOC = math.Round(density*densityPrecision) / densityPrecision
+	}
+	// Return report
+	return report
+}
+
+// RuleCode extracts the rule code of a diagnostic message.
+// Both "KTN-XXX-001: msg" and "[KTN-XXX-001] msg" forms are recognized, with
+// any registered code prefix.
+//
+// Params:
+//   - message: diagnostic message
+//
+// Returns:
+//   - string: rule code, "UNKNOWN" if absent
+func RuleCode(message string) string {
+	// Look for a known rule code
+	if code := rulecode.FromMessage(message); code != "" {
+		// Return found code
+		return code
+	}
+	// No code found
+	return unknownCode
+}
+
+// ruleStats builds per-rule metrics sorted by effort then count.
+//
+// Params:
+//   - byRule: finding count by rule code
+//
+// Returns:
+//   - []RuleStat: rule metrics
+func ruleStats(byRule map[string]int) []RuleStat {
+	stats := make([]RuleStat, 0, len(byRule))
+	// Build each rule entry
+	for code, count := range byRule {
+		stats = append(stats, RuleStat{
+			Code:          code,
+			Category:      rules.ExtractCategory(code),
+			Severity:      severity.GetSeverity(code).String(),
+			Count:         count,
+			EffortMinutes: count * rules.RemediationMinutes(code),
+		})
+	}
+	slices.SortFunc(stats, func(a, b RuleStat) int {
+		// Most expensive rules first, then most frequent, then by code
+		return cmp.Or(
+			cmp.Compare(b.EffortMinutes, a.EffortMinutes),
+			cmp.Compare(b.Count, a.Count),
+			cmp.Compare(a.Code, b.Code),
+		)
+	})
+	// Return sorted rules
+	return stats
+}
+
+// sortedCounts converts a count map to a slice sorted by decreasing count.
+//
+// Params:
+//   - counts: count by name
+//   - limit: maximum number of entries (0 = all)
+//
+// Returns:
+//   - []CountStat: sorted entries
+func sortedCounts(counts map[string]int, limit int) []CountStat {
+	entries := make([]CountStat, 0, len(counts))
+	// Convert map entries
+	for name, count := range counts {
+		entries = append(entries, CountStat{Name: name, Count: count})
+	}
+	slices.SortFunc(entries, func(a, b CountStat) int {
+		// Highest count first, then by name
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Name, b.Name))
+	})
+	// Apply limit
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	// Return sorted entries
+	return entries
+}
+
+// qualifiedSymbol prefixes a symbol with the last element of its package path.
+//
+// Params:
+//   - pkgPath: import path of the package
+//   - symbol: declaration name
+//
+// Returns:
+//   - string: "pkg.Symbol" or symbol alone when the package is unknown
+func qualifiedSymbol(pkgPath, symbol string) string {
+	// Keep bare symbol without package
+	if pkgPath == "" {
+		// Return symbol alone
+		return symbol
+	}
+	// Return qualified name
+	return pkgPath + "." + symbol
+}
+
+// relativePath makes a file name relative to a base directory when possible.
+//
+// Params:
+//   - path: file name
+//   - base: base directory (empty keeps path unchanged)
+//
+// Returns:
+//   - string: relative or original path
+func relativePath(path, base string) string {
+	// Keep path when no base is set
+	if base == "" {
+		// Return unchanged path
+		return path
+	}
+	rel, err := filepath.Rel(base, path)
+	// Keep paths outside base unchanged
+	if err != nil || strings.HasPrefix(rel, "..") {
+		// Return unchanged path
+		return path
+	}
+	// Return relative path
+	return rel
+}
@@ -0,0 +1,140 @@
+// External tests for report computation.
+package stats_test
+
+import (
+	"go/token"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/orchestrator"
+	"github.com/kodflow/ktn-linter/pkg/stats"
+	"golang.org/x/tools/go/analysis"
+)
+
+// newResults builds diagnostics located in a fake file.
+//
+// Params:
+//   - t: test context
+//   - entries: message and symbol pairs
+//
+// Returns:
+//   - []orchestrator.DiagnosticResult: diagnostics in /src/a.go
+func newResults(t *testing.T, entries [][2]string) []orchestrator.DiagnosticResult {
+	t.Helper()
+	fset := token.NewFileSet()
+	file := fset.AddFile("/src/a.go", -1, 100)
+	results := make([]orchestrator.DiagnosticResult, 0, len(entries))
+	// Build one diagnostic per entry
+	for i, entry := range entries {
+		results = append(results, orchestrator.DiagnosticResult{
+			Diag:    analysis.Diagnostic{Pos: file.Pos(i), Message: entry[0]},
+			Fset:    fset,
+			Package: "example.com/p",
+			Symbol:  entry[1],
+		})
+	}
+	// Return built results
+	return results
+}
+
+// TestCollect tests the Collect function.
+func TestCollect(t *testing.T) {
+	tests := []struct {
+		name        string
+		entries     [][2]string
+		opts        stats.Options
+		wantTotal   int
+		wantDensity float64
+		wantEffort  int
+		wantFirst   string
+		wantFuncs   int
+	}{
+		{
+			name:        "empty input",
+			entries:     [][2]string{},
+			opts:        stats.Options{Lines: 1000},
+			wantTotal:   0,
+			wantDensity: 0,
+			wantEffort:  0,
+			wantFirst:   "",
+			wantFuncs:   0,
+		},
+		{
+			name: "rules sorted by effort",
+			entries: [][2]string{
+				{"KTN-COMMENT-001: missing comment", "Run"},
+				{"KTN-COMMENT-001: missing comment", "Run"},
+				{"KTN-FUNC-005: too long", "Run"},
+				{"[KTN-VAR-001] bad name", ""},
+			},
+			opts:        stats.Options{Files: 1, Lines: 3000, Top: 1, BaseDir: "/src"},
+			wantTotal:   4,
+			wantDensity: 1.33,
+			wantEffort:  2*2 + 30 + 3,
+			wantFirst:   "KTN-FUNC-005",
+			wantFuncs:   1,
+		},
+		{
+			name:        "unknown source size",
+			entries:     [][2]string{{"plain message", ""}},
+			opts:        stats.Options{},
+			wantTotal:   1,
+			wantDensity: 0,
+			wantEffort:  5,
+			wantFirst:   "UNKNOWN",
+			wantFuncs:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			report := stats.Collect(newResults(t, tt.entries), tt.opts)
+
+			// Verify totals
+			if report.Total != tt.wantTotal || report.EffortMinutes != tt.wantEffort {
+				t.Errorf("Total=%d Effort=%d, want %d and %d", report.Total, report.EffortMinutes, tt.wantTotal, tt.wantEffort)
+			}
+			// Verify density
+			if report.DensityPerKLOC != tt.wantDensity {
+				t.Errorf("DensityPerKLOC = %v, want %v", report.DensityPerKLOC, tt.wantDensity)
+			}
+			// Verify rule order
+			if len(report.Rules) > 0 && report.Rules[0].Code != tt.wantFirst {
+				t.Errorf("first rule = %s, want %s", report.Rules[0].Code, tt.wantFirst)
+			}
+			// Verify top functions limit
+			if len(report.TopFunctions) != tt.wantFuncs {
+				t.Errorf("TopFunctions = %v, want %d entries", report.TopFunctions, tt.wantFuncs)
+			}
+			// Verify relative file names
+			if tt.opts.BaseDir != "" && len(report.TopFiles) > 0 && report.TopFiles[0].Name != "a.go" {
+				t.Errorf("TopFiles[0] = %s, want a.go", report.TopFiles[0].Name)
+			}
+		})
+	}
+}
+
+// TestRuleCode tests the RuleCode function.
+func TestRuleCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{name: "prefixed code", message: "KTN-FUNC-001: message", want: "KTN-FUNC-001"},
+		{name: "bracketed code", message: "error [KTN-VAR-002] message", want: "KTN-VAR-002"},
+		{name: "modernize code", message: "KTN-MDRNZ-ANY: use any", want: "KTN-MDRNZ-ANY"},
+		{name: "no code", message: "message", want: "UNKNOWN"},
+		{name: "prefix without separator", message: "KTN-FUNC-001 message", want: "UNKNOWN"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify code
+			if got := stats.RuleCode(tt.message); got != tt.want {
+				t.Errorf("RuleCode(%q) = %q, want %q", tt.message, got, tt.want)
+			}
+		})
+	}
+}
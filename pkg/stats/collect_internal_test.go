@@ -0,0 +1,92 @@
+// Internal tests for report computation.
+package stats
+
+import "testing"
+
+// Test_sortedCounts tests the sortedCounts function.
+func Test_sortedCounts(t *testing.T) {
+	tests := []struct {
+		name   string
+		counts map[string]int
+		limit  int
+		want   []string
+	}{
+		{
+			name:   "sorted by count then name",
+			counts: map[string]int{"b": 1, "a": 1, "c": 3},
+			limit:  0,
+			want:   []string{"c", "a", "b"},
+		},
+		{
+			name:   "limited",
+			counts: map[string]int{"b": 1, "a": 2},
+			limit:  1,
+			want:   []string{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := sortedCounts(tt.counts, tt.limit)
+			// Verify length
+			if len(got) != len(tt.want) {
+				t.Fatalf("sortedCounts() = %v, want %v", got, tt.want)
+			}
+			// Verify order
+			for i, name := range tt.want {
+				// Check entry name
+				if got[i].Name != name {
+					t.Errorf("entry %d = %s, want %s", i, got[i].Name, name)
+				}
+			}
+		})
+	}
+}
+
+// Test_relativePath tests the relativePath function.
+func Test_relativePath(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		base string
+		want string
+	}{
+		{name: "no base", path: "/src/a.go", base: "", want: "/src/a.go"},
+		{name: "inside base", path: "/src/pkg/a.go", base: "/src", want: "pkg/a.go"},
+		{name: "outside base", path: "/other/a.go", base: "/src", want: "/other/a.go"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify path
+			if got := relativePath(tt.path, tt.base); got != tt.want {
+				t.Errorf("relativePath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_qualifiedSymbol tests the qualifiedSymbol function.
+func Test_qualifiedSymbol(t *testing.T) {
+	tests := []struct {
+		name    string
+		pkgPath string
+		symbol  string
+		want    string
+	}{
+		{name: "with package", pkgPath: "example.com/p", symbol: "T.M", want: "example.com/p.T.M"},
+		{name: "without package", pkgPath: "", symbol: "Run", want: "Run"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify name
+			if got := qualifiedSymbol(tt.pkgPath, tt.symbol); got != tt.want {
+				t.Errorf("qualifiedSymbol() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
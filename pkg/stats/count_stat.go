@@ -0,0 +1,9 @@
+// Package stats computes rule-level metrics and technical-debt summaries.
+package stats
+
+// CountStat is a named finding count (category, package, file, function...).
+// Used by every ranked section of a Report.
+type CountStat struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
@@ -0,0 +1,11 @@
+// Package stats computes rule-level metrics and technical-debt summaries.
+package stats
+
+// Options controls how a Report is computed.
+// Source size comes from the runner since diagnostics alone cannot give it.
+type Options struct {
+	Files   int    // Number of analyzed files
+	Lines   int    // Number of analyzed lines
+	Top     int    // Number of entries in top files/functions (0 = all)
+	BaseDir string // Directory file names are made relative to (empty = absolute)
+}
@@ -0,0 +1,41 @@
+// Package stats computes rule-level metrics and technical-debt summaries.
+package stats
+
+import "time"
+
+// Report is the technical-debt summary of an analysis.
+// Sections are sorted by decreasing count, rules by decreasing effort.
+type Report struct {
+	GeneratedAt    time.Time   `json:"generatedAt"`
+	Total          int         `json:"total"`
+	Files          int         `json:"files"`
+	Lines          int         `json:"lines"`
+	DensityPerKLOC float64     `json:"densityPerKloc"`
+	EffortMinutes  int         `json:"effortMinutes"`
+	Rules          []RuleStat  `json:"rules"`
+	Categories     []CountStat `json:"categories"`
+	Packages       []CountStat `json:"packages"`
+	Severities     []CountStat `json:"severities"`
+	TopFiles       []CountStat `json:"topFiles"`
+	TopFunctions   []CountStat `json:"topFunctions"`
+}
+
+// Snapshot returns the dated history entry of the report.
+//
+// Returns:
+//   - Snapshot: totals and per-rule counts of the report
+func (r *Report) Snapshot() Snapshot {
+	byRule := make(map[string]int, len(r.Rules))
+	// Index counts by rule code
+	for _, rule := range r.Rules {
+		byRule[rule.Code] = rule.Count
+	}
+	// Return snapshot
+	return Snapshot{
+		Date:           r.GeneratedAt.Format(time.DateOnly),
+		Total:          r.Total,
+		DensityPerKLOC: r.DensityPerKLOC,
+		EffortMinutes:  r.EffortMinutes,
+		ByRule:         byRule,
+	}
+}
@@ -0,0 +1,57 @@
+// External tests for the report type.
+package stats_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kodflow/ktn-linter/pkg/stats"
+)
+
+// TestReport_Snapshot tests the Snapshot method.
+func TestReport_Snapshot(t *testing.T) {
+	tests := []struct {
+		name   string
+		report stats.Report
+		want   stats.Snapshot
+	}{
+		{
+			name: "totals and per-rule counts",
+			report: stats.Report{
+				GeneratedAt:    time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC),
+				Total:          3,
+				DensityPerKLOC: 1.5,
+				EffortMinutes:  12,
+				Rules: []stats.RuleStat{
+					{Code: "KTN-VAR-001", Count: 2},
+					{Code: "KTN-FUNC-001", Count: 1},
+				},
+			},
+			want: stats.Snapshot{
+				Date:           "2026-03-04",
+				Total:          3,
+				DensityPerKLOC: 1.5,
+				EffortMinutes:  12,
+				ByRule:         map[string]int{"KTN-VAR-001": 2, "KTN-FUNC-001": 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.report.Snapshot()
+			// Verify scalar fields
+			if got.Date != tt.want.Date || got.Total != tt.want.Total || got.EffortMinutes != tt.want.EffortMinutes {
+				t.Errorf("Snapshot() = %+v, want %+v", got, tt.want)
+			}
+			// Verify per-rule counts
+			for code, count := range tt.want.ByRule {
+				// Check each rule
+				if got.ByRule[code] != count {
+					t.Errorf("ByRule[%s] = %d, want %d", code, got.ByRule[code], count)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+// Package stats computes rule-level metrics and technical-debt summaries.
+package stats
+
+// RuleStat holds the metrics of a single rule.
+// Effort is the rule remediation estimate multiplied by the finding count.
+type RuleStat struct {
+	Code          string `json:"code"`
+	Category      string `json:"category"`
+	Severity      string `json:"severity"`
+	Count         int    `json:"count"`
+	EffortMinutes int    `json:"effortMinutes"`
+}
@@ -0,0 +1,102 @@
+// Package stats computes rule-level metrics and technical-debt summaries.
+package stats
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+)
+
+// historyFileMode is the permission of created history files.
+const historyFileMode os.FileMode = 0o644
+
+// Snapshot is one dated entry of the history file.
+// It keeps the totals and per-rule counts needed to compare two runs.
+type Snapshot struct {
+	Date           string         `json:"date"`
+	Total          int            `json:"total"`
+	DensityPerKLOC float64        `json:"densityPerKloc"`
+	EffortMinutes  int            `json:"effortMinutes"`
+	ByRule         map[string]int `json:"byRule"`
+}
+
+// AppendHistory appends a snapshot to a history file, one JSON object per line.
+//
+// Params:
+//   - path: history file path (created if missing)
+//   - snap: snapshot to append
+//
+// Returns:
+//   - error: write error if any
+func AppendHistory(path string, snap Snapshot) error {
+	data, err := json.Marshal(snap)
+	// Check encoding error
+	if err != nil {
+		// Return encoding error
+		return fmt.Errorf("encoding snapshot: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, historyFileMode)
+	// Check open error
+	if err != nil {
+		// Return open error
+		return fmt.Errorf("opening history: %w", err)
+	}
+
+	_, err = file.Write(append(data, '\n'))
+	// Check write error
+	if err != nil {
+		_ = file.Close()
+		// Return write error
+		return fmt.Errorf("writing history: %w", err)
+	}
+	// Return close error
+	return file.Close()
+}
+
+// ReadHistory reads every snapshot of a history file.
+//
+// Params:
+//   - path: history file path
+//
+// Returns:
+//   - []Snapshot: snapshots in file order (empty if the file does not exist)
+//   - error: read or decoding error if any
+func ReadHistory(path string) ([]Snapshot, error) {
+	file, err := os.Open(path)
+	// A missing history is an empty history
+	if errors.Is(err, fs.ErrNotExist) {
+		// Return empty history
+		return []Snapshot{}, nil
+	}
+	// Check open error
+	if err != nil {
+		// Return open error
+		return []Snapshot{}, fmt.Errorf("opening history: %w", err)
+	}
+	defer file.Close()
+
+	snapshots := []Snapshot{}
+	scanner := bufio.NewScanner(file)
+	line := 0
+	// Decode one snapshot per line
+	for scanner.Scan() {
+		line++
+		// Skip blank lines
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var snap Snapshot
+		// Check decoding error
+		if err := json.Unmarshal(scanner.Bytes(), &snap); err != nil {
+			// Return decoding error with line number
+			return []Snapshot{}, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		snapshots = append(snapshots, snap)
+	}
+	// Return snapshots and scan error
+	return snapshots, scanner.Err()
+}
@@ -0,0 +1,96 @@
+// External tests for the history file.
+package stats_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/stats"
+)
+
+// TestAppendHistory tests appending and reading back snapshots.
+func TestAppendHistory(t *testing.T) {
+	tests := []struct {
+		name      string
+		snapshots []stats.Snapshot
+	}{
+		{
+			name: "two runs",
+			snapshots: []stats.Snapshot{
+				{Date: "2026-01-01", Total: 5, ByRule: map[string]int{"KTN-VAR-001": 5}},
+				{Date: "2026-01-02", Total: 3, ByRule: map[string]int{"KTN-VAR-001": 3}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "history.jsonl")
+			// Append each snapshot
+			for _, snap := range tt.snapshots {
+				// Check append error
+				if err := stats.AppendHistory(path, snap); err != nil {
+					t.Fatalf("AppendHistory() error = %v", err)
+				}
+			}
+
+			got, err := stats.ReadHistory(path)
+			// Check read error
+			if err != nil {
+				t.Fatalf("ReadHistory() error = %v", err)
+			}
+			// Verify count
+			if len(got) != len(tt.snapshots) {
+				t.Fatalf("ReadHistory() returned %d snapshots, want %d", len(got), len(tt.snapshots))
+			}
+			// Verify order and content
+			for i, snap := range tt.snapshots {
+				// Check each snapshot
+				if got[i].Date != snap.Date || got[i].Total != snap.Total || got[i].ByRule["KTN-VAR-001"] != snap.ByRule["KTN-VAR-001"] {
+					t.Errorf("snapshot %d = %+v, want %+v", i, got[i], snap)
+				}
+			}
+		})
+	}
+}
+
+// TestReadHistory tests reading history files.
+func TestReadHistory(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		create  bool
+		wantLen int
+		wantErr bool
+	}{
+		{name: "missing file", create: false, wantLen: 0, wantErr: false},
+		{name: "blank lines are skipped", content: "\n{\"date\":\"2026-01-01\"}\n\n", create: true, wantLen: 1, wantErr: false},
+		{name: "invalid line", content: "{\"date\":\"2026-01-01\"}\nnot json\n", create: true, wantLen: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "history.jsonl")
+			// Write fixture when needed
+			if tt.create {
+				// Check write error
+				if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := stats.ReadHistory(path)
+			// Verify error
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadHistory() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// Verify count
+			if len(got) != tt.wantLen {
+				t.Errorf("ReadHistory() returned %d snapshots, want %d", len(got), tt.wantLen)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+// Package stats computes rule-level metrics and technical-debt summaries.
+package stats
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+)
+
+const (
+	// minutesPerHour converts effort minutes to hours.
+	minutesPerHour int = 60
+	// tabPadding is the column padding of text tables.
+	tabPadding int = 2
+	// densityFormat is the decimal precision of densities.
+	densityFormat string = "%.2f"
+	// densityDecimals is the decimal precision of CSV densities.
+	densityDecimals int = 2
+	// float64Bits is the bit size passed to strconv for float64 values.
+	float64Bits int = 64
+)
+
+// WriteText writes a human-readable report.
+//
+// Params:
+//   - w: destination writer
+//   - report: report to write
+//   - previous: last history snapshot to compare with (nil for none)
+//
+// Returns:
+//   - error: write error if any
+func WriteText(w io.Writer, report *Report, previous *Snapshot) error {
+	tw := tabwriter.NewWriter(w, 0, 0, tabPadding, ' ', 0)
+
+	fmt.Fprintf(tw, "Findings:\t%d\n", report.Total)
+	fmt.Fprintf(tw, "Files:\t%d\n", report.Files)
+	fmt.Fprintf(tw, "Lines:\t%d\n", report.Lines)
+	fmt.Fprintf(tw, "Density:\t"+densityFormat+" per KLOC\n", report.DensityPerKLOC)
+	fmt.Fprintf(tw, "Estimated effort:\t%s\n", formatEffort(report.EffortMinutes))
+	// Show trend against the previous snapshot
+	if previous != nil {
+		fmt.Fprintf(tw, "Trend since %s:\t%+d findings, %+.2f per KLOC, %s effort\n",
+			previous.Date,
+			report.Total-previous.Total,
+			report.DensityPerKLOC-previous.DensityPerKLOC,
+			formatEffortDelta(report.EffortMinutes-previous.EffortMinutes))
+	}
+
+	fmt.Fprintln(tw, "\nRULE\tCATEGORY\tSEVERITY\tCOUNT\tEFFORT\t")
+	// Print each rule
+	for _, rule := range report.Rules {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%s\t%s\n",
+			rule.Code, rule.Category, rule.Severity, rule.Count,
+			formatEffort(rule.EffortMinutes), ruleDelta(rule, previous))
+	}
+
+	writeCountSection(tw, "CATEGORY", report.Categories)
+	writeCountSection(tw, "SEVERITY", report.Severities)
+	writeCountSection(tw, "PACKAGE", report.Packages)
+	writeCountSection(tw, "TOP FILE", report.TopFiles)
+	writeCountSection(tw, "TOP FUNCTION", report.TopFunctions)
+
+	// Flush table
+	return tw.Flush()
+}
+
+// WriteJSON writes the report as indented JSON.
+//
+// Params:
+//   - w: destination writer
+//   - report: report to write
+//
+// Returns:
+//   - error: encoding error if any
+func WriteJSON(w io.Writer, report *Report) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	// Encode report
+	return encoder.Encode(report)
+}
+
+// WriteCSV writes the report as CSV rows, one per metric.
+// Columns are: section, name, category, severity, count, effort_minutes.
+//
+// Params:
+//   - w: destination writer
+//   - report: report to write
+//
+// Returns:
+//   - error: write error if any
+func WriteCSV(w io.Writer, report *Report) error {
+	cw := csv.NewWriter(w)
+	rows := [][]string{
+		{"section", "name", "category", "severity", "count", "effort_minutes"},
+		{"summary", "findings", "", "", strconv.Itoa(report.Total), strconv.Itoa(report.EffortMinutes)},
+		{"summary", "files", "", "", strconv.Itoa(report.Files), ""},
+		{"summary", "lines", "", "", strconv.Itoa(report.Lines), ""},
+		{"summary", "density_per_kloc", "", "", strconv.FormatFloat(report.DensityPerKLOC, 'f', densityDecimals, float64Bits), ""},
+	}
+	// Add rule rows
+	for _, rule := range report.Rules {
+		rows = append(rows, []string{"rule", rule.Code, rule.Category, rule.Severity,
+			strconv.Itoa(rule.Count), strconv.Itoa(rule.EffortMinutes)})
+	}
+	rows = appendCountRows(rows, "category", report.Categories)
+	rows = appendCountRows(rows, "severity", report.Severities)
+	rows = appendCountRows(rows, "package", report.Packages)
+	rows = appendCountRows(rows, "file", report.TopFiles)
+	rows = appendCountRows(rows, "function", report.TopFunctions)
+
+	// Write and flush rows
+	return cw.WriteAll(rows)
+}
+
+// writeCountSection prints a two-column count table.
+//
+// Params:
+//   - w: destination writer
+//   - title: header of the name column
+//   - entries: entries to print
+func writeCountSection(w io.Writer, title string, entries []CountStat) {
+	// Skip empty sections
+	if len(entries) == 0 {
+		// Nothing to print
+		return
+	}
+	fmt.Fprintf(w, "\n%s\tCOUNT\t\n", title)
+	// Print each entry
+	for _, entry := range entries {
+		fmt.Fprintf(w, "%s\t%d\t\n", entry.Name, entry.Count)
+	}
+}
+
+// appendCountRows appends CSV rows for a count section.
+//
+// Params:
+//   - rows: rows built so far
+//   - section: section name
+//   - entries: entries to add
+//
+// Returns:
+//   - [][]string: rows with the section appended
+func appendCountRows(rows [][]string, section string, entries []CountStat) [][]string {
+	// Add one row per entry
+	for _, entry := range entries {
+		rows = append(rows, []string{section, entry.Name, "", "", strconv.Itoa(entry.Count), ""})
+	}
+	// Return extended rows
+	return rows
+}
+
+// ruleDelta formats the count change of a rule since a snapshot.
+//
+// Params:
+//   - rule: current rule metrics
+//   - previous: snapshot to compare with (nil for none)
+//
+// Returns:
+//   - string: signed delta, empty when unchanged or without snapshot
+func ruleDelta(rule RuleStat, previous *Snapshot) string {
+	// No comparison without snapshot
+	if previous == nil {
+		// Return empty delta
+		return ""
+	}
+	delta := rule.Count - previous.ByRule[rule.Code]
+	// Hide unchanged rules
+	if delta == 0 {
+		// Return empty delta
+		return ""
+	}
+	// Return signed delta
+	return fmt.Sprintf("(%+d)", delta)
+}
+
+// formatEffort formats minutes as hours and minutes.
+//
+// Params:
+//   - minutes: effort in minutes
+//
+// Returns:
+//   - string: formatted effort (e.g., "2h05m")
+func formatEffort(minutes int) string {
+	// Keep short efforts in minutes
+	if minutes < minutesPerHour {
+		// Return minutes only
+		return fmt.Sprintf("%dm", minutes)
+	}
+	// Return hours and minutes
+	return fmt.Sprintf("%dh%02dm", minutes/minutesPerHour, minutes%minutesPerHour)
+}
+
+// formatEffortDelta formats a signed effort change.
+//
+// Params:
+//   - minutes: effort change in minutes
+//
+// Returns:
+//   - string: signed formatted effort
+func formatEffortDelta(minutes int) string {
+	// Format negative changes
+	if minutes < 0 {
+		// Return negative effort
+		return "-" + formatEffort(-minutes)
+	}
+	// Return positive effort
+	return "+" + formatEffort(minutes)
+}
@@ -0,0 +1,137 @@
+// External tests for report writers.
+package stats_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/stats"
+)
+
+// sampleReport returns a small report used by writer tests.
+//
+// Returns:
+//   - *stats.Report: report with one rule and one file
+func sampleReport() *stats.Report {
+	// Return sample report
+	return &stats.Report{
+		Total:          2,
+		Files:          1,
+		Lines:          100,
+		DensityPerKLOC: 20,
+		EffortMinutes:  75,
+		Rules:          []stats.RuleStat{{Code: "KTN-FUNC-005", Category: "func", Severity: "WARNING", Count: 2, EffortMinutes: 75}},
+		Categories:     []stats.CountStat{{Name: "func", Count: 2}},
+		TopFiles:       []stats.CountStat{{Name: "a.go", Count: 2}},
+	}
+}
+
+// TestWriteText tests the WriteText function.
+func TestWriteText(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous *stats.Snapshot
+		want     []string
+		notWant  []string
+	}{
+		{
+			name:     "without history",
+			previous: nil,
+			want:     []string{"Findings:", "20.00 per KLOC", "1h15m", "KTN-FUNC-005", "TOP FILE"},
+			notWant:  []string{"Trend since", "TOP FUNCTION"},
+		},
+		{
+			name:     "with history",
+			previous: &stats.Snapshot{Date: "2026-01-01", Total: 5, EffortMinutes: 90, ByRule: map[string]int{"KTN-FUNC-005": 5}},
+			want:     []string{"Trend since 2026-01-01", "-3 findings", "-15m effort", "(-3)"},
+			notWant:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			// Check write error
+			if err := stats.WriteText(&buf, sampleReport(), tt.previous); err != nil {
+				t.Fatalf("WriteText() error = %v", err)
+			}
+			// Verify expected content
+			for _, want := range tt.want {
+				// Check substring
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("output missing %q:\n%s", want, buf.String())
+				}
+			}
+			// Verify absent content
+			for _, notWant := range tt.notWant {
+				// Check substring
+				if strings.Contains(buf.String(), notWant) {
+					t.Errorf("output contains %q:\n%s", notWant, buf.String())
+				}
+			}
+		})
+	}
+}
+
+// TestWriteJSON tests the WriteJSON function.
+func TestWriteJSON(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{name: "round trip"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			// Check write error
+			if err := stats.WriteJSON(&buf, sampleReport()); err != nil {
+				t.Fatalf("WriteJSON() error = %v", err)
+			}
+			var got stats.Report
+			// Check decoding error
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("invalid JSON: %v", err)
+			}
+			// Verify content
+			if got.Total != 2 || len(got.Rules) != 1 || got.Rules[0].EffortMinutes != 75 {
+				t.Errorf("decoded report = %+v", got)
+			}
+		})
+	}
+}
+
+// TestWriteCSV tests the WriteCSV function.
+func TestWriteCSV(t *testing.T) {
+	tests := []struct {
+		name     string
+		wantRows int
+	}{
+		{name: "header, summary, rule and sections", wantRows: 1 + 4 + 1 + 2},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			// Check write error
+			if err := stats.WriteCSV(&buf, sampleReport()); err != nil {
+				t.Fatalf("WriteCSV() error = %v", err)
+			}
+			rows, err := csv.NewReader(&buf).ReadAll()
+			// Check parse error
+			if err != nil {
+				t.Fatalf("invalid CSV: %v", err)
+			}
+			// Verify row count
+			if len(rows) != tt.wantRows {
+				t.Errorf("got %d rows, want %d: %v", len(rows), tt.wantRows, rows)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Internal tests for report writers.
+package stats
+
+import "testing"
+
+// Test_formatEffort tests the formatEffort function.
+func Test_formatEffort(t *testing.T) {
+	tests := []struct {
+		name    string
+		minutes int
+		want    string
+	}{
+		{name: "minutes only", minutes: 45, want: "45m"},
+		{name: "hours and minutes", minutes: 125, want: "2h05m"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify format
+			if got := formatEffort(tt.minutes); got != tt.want {
+				t.Errorf("formatEffort(%d) = %q, want %q", tt.minutes, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_formatEffortDelta tests the formatEffortDelta function.
+func Test_formatEffortDelta(t *testing.T) {
+	tests := []struct {
+		name    string
+		minutes int
+		want    string
+	}{
+		{name: "increase", minutes: 61, want: "+1h01m"},
+		{name: "decrease", minutes: -5, want: "-5m"},
+		{name: "unchanged", minutes: 0, want: "+0m"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify format
+			if got := formatEffortDelta(tt.minutes); got != tt.want {
+				t.Errorf("formatEffortDelta(%d) = %q, want %q", tt.minutes, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test_ruleDelta tests the ruleDelta function.
+func Test_ruleDelta(t *testing.T) {
+	tests := []struct {
+		name     string
+		count    int
+		previous *Snapshot
+		want     string
+	}{
+		{name: "no snapshot", count: 3, previous: nil, want: ""},
+		{name: "unchanged", count: 3, previous: &Snapshot{ByRule: map[string]int{"R": 3}}, want: ""},
+		{name: "new rule", count: 2, previous: &Snapshot{ByRule: map[string]int{}}, want: "(+2)"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Verify delta
+			if got := ruleDelta(RuleStat{Code: "R", Count: tt.count}, tt.previous); got != tt.want {
+				t.Errorf("ruleDelta() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
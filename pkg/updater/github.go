@@ -0,0 +1,144 @@
+// Package updater provides self-update functionality for ktn-linter binary.
+package updater
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Latest returns the newest GitHub release of a channel. Stable releases
+// come from the "latest release" endpoint; prereleases from the list of
+// recent releases.
+//
+// Params:
+//   - channel: release channel
+//
+// Returns:
+//   - string: latest version tag
+//   - error: any API error
+func (s *githubSource) Latest(channel string) (string, error) {
+	// Check channel
+	if channel != ChannelPrerelease {
+		var release releaseInfo
+		// Fetch the latest stable release
+		if err := s.getJSON(fmt.Sprintf(apiURL, repoOwner, repoName), &release); err != nil {
+			// Return API error
+			return "", err
+		}
+		// Return the version tag
+		return release.TagName, nil
+	}
+
+	var releases []releaseInfo
+	// Fetch recent releases
+	if err := s.getJSON(fmt.Sprintf(releasesURL, repoOwner, repoName), &releases); err != nil {
+		// Return API error
+		return "", err
+	}
+	candidates := make([]indexRelease, 0, len(releases))
+	// Keep published releases
+	for _, release := range releases {
+		// Skip drafts
+		if release.Draft {
+			continue
+		}
+		candidates = append(candidates, indexRelease{Version: release.TagName, Prerelease: release.Prerelease})
+	}
+	// Return newest release of the channel
+	return pickLatest(candidates, channel)
+}
+
+// Resolve returns the release tag of a version; GitHub tags carry the
+// "v" prefix.
+//
+// Params:
+//   - version: release version
+//
+// Returns:
+//   - string: release tag
+//   - error: always nil
+func (s *githubSource) Resolve(version string) (string, error) {
+	// Return the tag spelling
+	return normalizeVersion(version), nil
+}
+
+// Open downloads a release asset from GitHub.
+//
+// Params:
+//   - version: release tag
+//   - asset: asset name
+//
+// Returns:
+//   - io.ReadCloser: asset content
+//   - int64: announced size, -1 when unknown
+//   - error: request or status error
+func (s *githubSource) Open(version, asset string) (io.ReadCloser, int64, error) {
+	// Download from the release URL
+	return openHTTP(s.client, assetURL(version, asset))
+}
+
+// getJSON decodes a GitHub API response.
+//
+// Params:
+//   - url: API URL
+//   - target: decoded value
+//
+// Returns:
+//   - error: request, status or decoding error
+func (s *githubSource) getJSON(url string, target any) error {
+	// Make HTTP request to GitHub API
+	resp, err := s.client.Get(url)
+	// Handle HTTP request errors
+	if err != nil {
+		// Return wrapped HTTP error
+		return fmt.Errorf("fetching release info: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Validate response status code
+	if resp.StatusCode != http.StatusOK {
+		// Return error for non-200 status
+		return fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+
+	// Decode JSON body
+	if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+		// Return wrapped decode error
+		return fmt.Errorf("parsing release info: %w", err)
+	}
+
+	// Return success
+	return nil
+}
+
+// openHTTP starts downloading a URL.
+//
+// Params:
+//   - client: HTTP client
+//   - url: asset URL
+//
+// Returns:
+//   - io.ReadCloser: response body
+//   - int64: announced size, -1 when unknown
+//   - error: request or status error
+func openHTTP(client *http.Client, url string) (io.ReadCloser, int64, error) {
+	// Make HTTP request to download the asset
+	resp, err := client.Get(url)
+	// Handle download request errors
+	if err != nil {
+		// Return wrapped download error
+		return nil, 0, fmt.Errorf("downloading %s: %w", url, err)
+	}
+
+	// Validate download response status
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		// Return error for failed download
+		return nil, 0, fmt.Errorf("download failed: status %d", resp.StatusCode)
+	}
+
+	// Return response body
+	return resp.Body, resp.ContentLength, nil
+}
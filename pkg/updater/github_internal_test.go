@@ -0,0 +1,126 @@
+// Package updater provides self-update functionality for ktn-linter binary.
+package updater
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestGithubSource_Latest tests the stable and prerelease channels.
+func TestGithubSource_Latest(t *testing.T) {
+	tests := []struct {
+		name           string
+		channel        string
+		latest         string
+		releases       string
+		want           string
+		wantErrContain string
+	}{
+		{name: "stable uses latest release", channel: ChannelStable, latest: `{"tag_name":"v1.4.0"}`, want: "v1.4.0"},
+		{
+			name:     "prerelease skips drafts",
+			channel:  ChannelPrerelease,
+			releases: `[{"tag_name":"v1.6.0","draft":true},{"tag_name":"v1.5.0-rc.1","prerelease":true},{"tag_name":"v1.4.0"}]`,
+			want:     "v1.5.0-rc.1",
+		},
+		{name: "invalid release list", channel: ChannelPrerelease, releases: `{`, wantErrContain: "parsing release info"},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// Serve the endpoint requested
+				if strings.HasSuffix(r.URL.Path, "/latest") {
+					_, _ = w.Write([]byte(tt.latest))
+					return
+				}
+				_, _ = w.Write([]byte(tt.releases))
+			}))
+			defer server.Close()
+			source := &githubSource{client: &http.Client{Transport: &mockTransport{url: server.URL, client: server.Client()}}}
+
+			got, err := source.Latest(tt.channel)
+			// Check error expectation
+			if tt.wantErrContain != "" {
+				// Expect matching error
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrContain) {
+					t.Errorf("Latest() error = %v, want %q", err, tt.wantErrContain)
+				}
+				return
+			}
+			// Check version
+			if err != nil || got != tt.want {
+				t.Errorf("Latest() = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+
+// TestGithubSource_Resolve tests spelling versions as release tags.
+func TestGithubSource_Resolve(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		want    string
+	}{
+		{name: "tag kept", version: "v1.4.0", want: "v1.4.0"},
+		{name: "prefix added", version: "1.4.0", want: "v1.4.0"},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&githubSource{}).Resolve(tt.version)
+			// Check release tag
+			if err != nil || got != tt.want {
+				t.Errorf("Resolve(%q) = %q, %v, want %q", tt.version, got, err, tt.want)
+			}
+		})
+	}
+}
+
+// TestOpenHTTP tests starting asset downloads.
+func TestOpenHTTP(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         int
+		wantErrContain string
+	}{
+		{name: "asset found", status: http.StatusOK},
+		{name: "asset missing", status: http.StatusNotFound, wantErrContain: "download failed: status 404"},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte("asset"))
+			}))
+			defer server.Close()
+
+			body, size, err := openHTTP(server.Client(), server.URL+"/asset")
+			// Check error expectation
+			if tt.wantErrContain != "" {
+				// Expect status error
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrContain) {
+					t.Errorf("openHTTP() error = %v, want %q", err, tt.wantErrContain)
+				}
+				return
+			}
+			defer body.Close()
+			data, _ := io.ReadAll(body)
+			// Check content and size
+			if err != nil || string(data) != "asset" || size != int64(len("asset")) {
+				t.Errorf("openHTTP() = %q, %d, %v", data, size, err)
+			}
+		})
+	}
+}
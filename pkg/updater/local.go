@@ -0,0 +1,80 @@
+// Package updater provides self-update functionality for ktn-linter binary.
+package updater
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Latest returns the newest release of a channel listed by the local index.
+//
+// Params:
+//   - channel: release channel
+//
+// Returns:
+//   - string: newest version
+//   - error: read, decoding or empty channel error
+func (s *localSource) Latest(channel string) (string, error) {
+	file, err := os.Open(s.index)
+	// Handle index read errors
+	if err != nil {
+		// Return wrapped read error
+		return "", fmt.Errorf("reading %s: %w", indexFile, err)
+	}
+	defer file.Close()
+
+	// Return newest release of the index
+	return latestInIndex(file, channel)
+}
+
+// Resolve returns a version as listed by the local index.
+//
+// Params:
+//   - version: release version
+//
+// Returns:
+//   - string: version spelled as in the index
+//   - error: read, decoding or unlisted version error
+func (s *localSource) Resolve(version string) (string, error) {
+	file, err := os.Open(s.index)
+	// Handle index read errors
+	if err != nil {
+		// Return wrapped read error
+		return "", fmt.Errorf("reading %s: %w", indexFile, err)
+	}
+	defer file.Close()
+
+	// Return the listed spelling
+	return findInIndex(file, version)
+}
+
+// Open opens a release asset stored as <dir>/<version>/<asset>.
+//
+// Params:
+//   - version: release version
+//   - asset: asset name
+//
+// Returns:
+//   - io.ReadCloser: asset content
+//   - int64: file size
+//   - error: open error
+func (s *localSource) Open(version, asset string) (io.ReadCloser, int64, error) {
+	file, err := os.Open(filepath.Join(s.dir, version, asset))
+	// Handle open errors
+	if err != nil {
+		// Return open error
+		return nil, 0, err
+	}
+	info, err := file.Stat()
+	// Handle stat errors
+	if err != nil {
+		file.Close()
+		// Return stat error
+		return nil, 0, err
+	}
+
+	// Return file and size
+	return file, info.Size(), nil
+}
@@ -0,0 +1,58 @@
+// Package updater provides self-update functionality for ktn-linter binary.
+package updater
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+)
+
+// TestLocalSource tests reading a local release directory.
+func TestLocalSource(t *testing.T) {
+	dir := writeReleaseDir(t, `{"releases":[{"version":"v1.2.0"},{"version":"v1.3.0-rc.1","prerelease":true}]}`, map[string]string{"v1.2.0/checksums.txt": "sums"})
+	tests := []struct {
+		name        string
+		source      *localSource
+		channel     string
+		wantLatest  string
+		wantErr     bool
+		asset       string
+		wantContent string
+	}{
+		{name: "stable channel", source: &localSource{dir: dir, index: filepath.Join(dir, indexFile)}, channel: ChannelStable, wantLatest: "v1.2.0", asset: checksumsAsset, wantContent: "sums"},
+		{name: "prerelease channel", source: &localSource{dir: dir, index: filepath.Join(dir, indexFile)}, channel: ChannelPrerelease, wantLatest: "v1.3.0-rc.1", asset: checksumsAsset, wantContent: "sums"},
+		{name: "missing index and asset", source: &localSource{dir: dir, index: filepath.Join(dir, "missing.json")}, channel: ChannelStable, wantErr: true, asset: "missing"},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			latest, err := tt.source.Latest(tt.channel)
+			// Check index result
+			if (err != nil) != tt.wantErr || latest != tt.wantLatest {
+				t.Errorf("Latest() = %q, %v, want %q", latest, err, tt.wantLatest)
+			}
+			resolved, err := tt.source.Resolve("1.2.0")
+			// Check index spelling
+			if (err != nil) != tt.wantErr || (!tt.wantErr && resolved != "v1.2.0") {
+				t.Errorf("Resolve() = %q, %v", resolved, err)
+			}
+			body, size, err := tt.source.Open("v1.2.0", tt.asset)
+			// Check missing asset
+			if tt.wantErr {
+				// Expect open error
+				if err == nil {
+					t.Error("Open() expected error")
+				}
+				return
+			}
+			defer body.Close()
+			data, _ := io.ReadAll(body)
+			// Check asset content and size
+			if err != nil || string(data) != tt.wantContent || size != int64(len(tt.wantContent)) {
+				t.Errorf("Open() = %q, %d, %v", data, size, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Package updater provides self-update functionality for ktn-linter binary.
+package updater
+
+import (
+	"io"
+)
+
+// Latest returns the newest release of a channel listed by the mirror index.
+//
+// Params:
+//   - channel: release channel
+//
+// Returns:
+//   - string: newest version
+//   - error: download, decoding or empty channel error
+func (s *httpSource) Latest(channel string) (string, error) {
+	body, _, err := openHTTP(s.client, s.baseURL+"/"+indexFile)
+	// Handle index download errors
+	if err != nil {
+		// Return download error
+		return "", err
+	}
+	defer body.Close()
+
+	// Return newest release of the index
+	return latestInIndex(body, channel)
+}
+
+// Resolve returns a version as listed by the mirror index.
+//
+// Params:
+//   - version: release version
+//
+// Returns:
+//   - string: version spelled as in the index
+//   - error: download, decoding or unlisted version error
+func (s *httpSource) Resolve(version string) (string, error) {
+	body, _, err := openHTTP(s.client, s.baseURL+"/"+indexFile)
+	// Handle index download errors
+	if err != nil {
+		// Return download error
+		return "", err
+	}
+	defer body.Close()
+
+	// Return the listed spelling
+	return findInIndex(body, version)
+}
+
+// Open downloads a release asset from <base>/<version>/<asset>.
+//
+// Params:
+//   - version: release version
+//   - asset: asset name
+//
+// Returns:
+//   - io.ReadCloser: asset content
+//   - int64: announced size, -1 when unknown
+//   - error: request or status error
+func (s *httpSource) Open(version, asset string) (io.ReadCloser, int64, error) {
+	// Download from the mirror layout
+	return openHTTP(s.client, s.baseURL+"/"+version+"/"+asset)
+}
@@ -0,0 +1,65 @@
+// Package updater provides self-update functionality for ktn-linter binary.
+package updater
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestHTTPSource tests reading a mirror index and its assets.
+func TestHTTPSource(t *testing.T) {
+	tests := []struct {
+		name        string
+		index       string
+		wantLatest  string
+		wantErr     bool
+		wantContent string
+	}{
+		{name: "mirror with releases", index: `{"releases":[{"version":"v1.2.0"},{"version":"v1.3.0"}]}`, wantLatest: "v1.3.0", wantContent: "binary"},
+		{name: "mirror without index", index: "", wantErr: true, wantContent: "binary"},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			// Serve the index when present
+			if tt.index != "" {
+				mux.HandleFunc("/ktn/index.json", func(w http.ResponseWriter, _ *http.Request) {
+					_, _ = w.Write([]byte(tt.index))
+				})
+			}
+			mux.HandleFunc("/ktn/v1.3.0/ktn-linter-linux-amd64", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("binary"))
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+			source := &httpSource{client: server.Client(), baseURL: server.URL + "/ktn"}
+
+			latest, err := source.Latest(ChannelStable)
+			// Check index result
+			if (err != nil) != tt.wantErr || latest != tt.wantLatest {
+				t.Errorf("Latest() = %q, %v, want %q", latest, err, tt.wantLatest)
+			}
+			resolved, err := source.Resolve("1.3.0")
+			// Check index spelling
+			if (err != nil) != tt.wantErr || (!tt.wantErr && resolved != "v1.3.0") {
+				t.Errorf("Resolve() = %q, %v", resolved, err)
+			}
+			body, _, err := source.Open("v1.3.0", "ktn-linter-linux-amd64")
+			// Check asset download
+			if err != nil {
+				t.Fatalf("Open() error = %v", err)
+			}
+			defer body.Close()
+			data, _ := io.ReadAll(body)
+			// Check asset content
+			if string(data) != tt.wantContent {
+				t.Errorf("Open() content = %q, want %q", data, tt.wantContent)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+// Package updater provides self-update functionality for ktn-linter binary.
+package updater
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Source specifications and channels.
+const (
+	// SourceGitHub selects the GitHub releases of ktn-linter.
+	SourceGitHub string = "github"
+	// ChannelStable only considers stable releases.
+	ChannelStable string = "stable"
+	// ChannelPrerelease also considers prereleases.
+	ChannelPrerelease string = "prerelease"
+	// SourceEnv is the environment variable overriding the update source.
+	SourceEnv string = "KTN_LINTER_UPDATE_SOURCE"
+	// indexFile is the release index of mirrors and local directories.
+	indexFile string = "index.json"
+	// fileScheme prefixes local sources given as URLs.
+	fileScheme string = "file://"
+)
+
+// NewSource resolves a source specification: empty or "github" for
+// GitHub, an http(s) URL for a mirror, otherwise a local directory holding
+// index.json or the index file itself.
+//
+// Params:
+//   - spec: source specification
+//   - client: HTTP client of remote sources
+//
+// Returns:
+//   - Source: release source
+//   - error: missing local source
+func NewSource(spec string, client *http.Client) (Source, error) {
+	// Dispatch on the specification form
+	switch {
+	// GitHub releases
+	case spec == "" || spec == SourceGitHub:
+		// Return GitHub source
+		return &githubSource{client: client}, nil
+	// HTTP mirror
+	case strings.HasPrefix(spec, "http://") || strings.HasPrefix(spec, "https://"):
+		// Return mirror source
+		return &httpSource{client: client, baseURL: strings.TrimSuffix(spec, "/")}, nil
+	// Local directory or index file
+	default:
+		// Return local source
+		return newLocalSource(strings.TrimPrefix(spec, fileScheme))
+	}
+}
+
+// newLocalSource resolves a local directory or index file.
+//
+// Params:
+//   - path: directory or index.json path
+//
+// Returns:
+//   - Source: local source
+//   - error: missing path
+func newLocalSource(path string) (Source, error) {
+	info, err := os.Stat(path)
+	// Check the source exists
+	if err != nil {
+		// Return wrapped stat error
+		return nil, fmt.Errorf("update source: %w", err)
+	}
+	// Index file given directly
+	if !info.IsDir() {
+		// Return source rooted at the index directory
+		return &localSource{dir: filepath.Dir(path), index: path}, nil
+	}
+	// Return source of the directory index
+	return &localSource{dir: path, index: filepath.Join(path, indexFile)}, nil
+}
+
+// latestInIndex decodes a release index and returns its newest release.
+//
+// Params:
+//   - r: index content
+//   - channel: release channel
+//
+// Returns:
+//   - string: newest version
+//   - error: decoding error or no matching release
+func latestInIndex(r io.Reader, channel string) (string, error) {
+	index, err := decodeIndex(r)
+	// Handle decoding errors
+	if err != nil {
+		// Return decode error
+		return "", err
+	}
+	// Return newest release of the channel
+	return pickLatest(index.Releases, channel)
+}
+
+// findInIndex decodes a release index and returns a version as it lists
+// it, both sides compared with their "v" prefix normalized.
+//
+// Params:
+//   - r: index content
+//   - version: release version, "v" prefix optional
+//
+// Returns:
+//   - string: version spelled as in the index
+//   - error: decoding error or unlisted version
+func findInIndex(r io.Reader, version string) (string, error) {
+	index, err := decodeIndex(r)
+	// Handle decoding errors
+	if err != nil {
+		// Return decode error
+		return "", err
+	}
+	target := normalizeVersion(version)
+	// Look for the listed release
+	for _, release := range index.Releases {
+		// Match regardless of the "v" prefix
+		if normalizeVersion(release.Version) == target {
+			// Return the index spelling
+			return release.Version, nil
+		}
+	}
+	// Return unlisted version error
+	return "", fmt.Errorf("release %s not listed in %s", target, indexFile)
+}
+
+// decodeIndex decodes a release index.
+//
+// Params:
+//   - r: index content
+//
+// Returns:
+//   - releaseIndex: decoded index
+//   - error: decoding error
+func decodeIndex(r io.Reader) (releaseIndex, error) {
+	var index releaseIndex
+	// Decode JSON index
+	if err := json.NewDecoder(r).Decode(&index); err != nil {
+		// Return wrapped decode error
+		return releaseIndex{}, fmt.Errorf("parsing %s: %w", indexFile, err)
+	}
+	// Return decoded index
+	return index, nil
+}
+
+// pickLatest returns the newest release of a channel.
+//
+// Params:
+//   - releases: candidate releases
+//   - channel: release channel
+//
+// Returns:
+//   - string: newest version
+//   - error: no matching release
+func pickLatest(releases []indexRelease, channel string) (string, error) {
+	latest := ""
+	// Keep the highest version of the channel
+	for _, release := range releases {
+		// Skip prereleases on the stable channel
+		if release.Prerelease && channel != ChannelPrerelease {
+			continue
+		}
+		// Keep newer versions
+		if latest == "" || compareVersions(release.Version, latest) > 0 {
+			latest = release.Version
+		}
+	}
+	// Check a release was found
+	if latest == "" {
+		// Return empty channel error
+		return "", fmt.Errorf("no %s release available", channelName(channel))
+	}
+	// Return newest version
+	return latest, nil
+}
+
+// channelName returns the display name of a channel.
+//
+// Params:
+//   - channel: configured channel, empty for stable
+//
+// Returns:
+//   - string: channel name
+func channelName(channel string) string {
+	// Default to stable
+	if channel == "" {
+		// Return stable channel
+		return ChannelStable
+	}
+	// Return configured channel
+	return channel
+}
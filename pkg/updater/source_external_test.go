@@ -0,0 +1,48 @@
+// Package updater_test provides black-box tests for release sources.
+package updater_test
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kodflow/ktn-linter/pkg/updater"
+)
+
+// TestNewSource tests resolving source specifications.
+func TestNewSource(t *testing.T) {
+	dir := t.TempDir()
+	// Write an empty index
+	if err := os.WriteFile(filepath.Join(dir, "index.json"), []byte(`{"releases":[]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr bool
+	}{
+		{name: "default github", spec: "", wantErr: false},
+		{name: "explicit github", spec: updater.SourceGitHub, wantErr: false},
+		{name: "http mirror", spec: "https://artifacts.example.com/ktn-linter/", wantErr: false},
+		{name: "local directory", spec: dir, wantErr: false},
+		{name: "file url", spec: "file://" + dir, wantErr: false},
+		{name: "missing directory", spec: filepath.Join(dir, "missing"), wantErr: true},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			source, err := updater.NewSource(tt.spec, http.DefaultClient)
+			// Check error expectation
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewSource(%q) error = %v, wantErr %v", tt.spec, err, tt.wantErr)
+			}
+			// Check source is set on success
+			if !tt.wantErr && source == nil {
+				t.Errorf("NewSource(%q) returned nil source", tt.spec)
+			}
+		})
+	}
+}
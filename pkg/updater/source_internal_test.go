@@ -0,0 +1,200 @@
+// Package updater provides self-update functionality for ktn-linter binary.
+package updater
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeReleaseDir writes a local release directory with an index and assets.
+//
+// Params:
+//   - t: testing object
+//   - index: index.json content
+//   - assets: asset contents by "<version>/<asset>" path
+//
+// Returns:
+//   - string: release directory
+func writeReleaseDir(t *testing.T, index string, assets map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{indexFile: index}
+	// Merge assets with the index
+	for name, content := range assets {
+		files[name] = content
+	}
+	// Write each file
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		// Create the version directory
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		// Write the file
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Return release directory
+	return dir
+}
+
+// TestNewLocalSource tests resolving local directories and index files.
+func TestNewLocalSource(t *testing.T) {
+	dir := writeReleaseDir(t, `{"releases":[]}`, nil)
+	tests := []struct {
+		name      string
+		path      string
+		wantIndex string
+		wantErr   bool
+	}{
+		{name: "directory", path: dir, wantIndex: filepath.Join(dir, indexFile)},
+		{name: "index file", path: filepath.Join(dir, indexFile), wantIndex: filepath.Join(dir, indexFile)},
+		{name: "missing path", path: filepath.Join(dir, "missing"), wantErr: true},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			source, err := newLocalSource(tt.path)
+			// Check error expectation
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newLocalSource() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// Check resolved index
+			if !tt.wantErr && (source.(*localSource).index != tt.wantIndex || source.(*localSource).dir != dir) {
+				t.Errorf("newLocalSource() = %+v", source)
+			}
+		})
+	}
+}
+
+// TestLatestInIndex tests decoding release indexes.
+func TestLatestInIndex(t *testing.T) {
+	index := `{"releases":[{"version":"v1.2.0"},{"version":"v1.10.0"},{"version":"v1.11.0-rc.1","prerelease":true}]}`
+	tests := []struct {
+		name           string
+		index          string
+		channel        string
+		want           string
+		wantErrContain string
+	}{
+		{name: "stable by semver", index: index, channel: "", want: "v1.10.0"},
+		{name: "prerelease channel", index: index, channel: ChannelPrerelease, want: "v1.11.0-rc.1"},
+		{name: "invalid index", index: "{", channel: "", wantErrContain: "parsing index.json"},
+		{name: "no stable release", index: `{"releases":[{"version":"v2.0.0-rc.1","prerelease":true}]}`, channel: ChannelStable, wantErrContain: "no stable release"},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := latestInIndex(strings.NewReader(tt.index), tt.channel)
+			// Check error expectation
+			if tt.wantErrContain != "" {
+				// Expect matching error
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrContain) {
+					t.Errorf("latestInIndex() error = %v, want %q", err, tt.wantErrContain)
+				}
+				return
+			}
+			// Check version
+			if err != nil || got != tt.want {
+				t.Errorf("latestInIndex() = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+
+// TestFindInIndex tests resolving versions against release indexes.
+func TestFindInIndex(t *testing.T) {
+	index := `{"releases":[{"version":"v1.2.0"},{"version":"1.3.0"}]}`
+	tests := []struct {
+		name           string
+		index          string
+		version        string
+		want           string
+		wantErrContain string
+	}{
+		{name: "prefixed on both sides", index: index, version: "v1.2.0", want: "v1.2.0"},
+		{name: "unprefixed target", index: index, version: "1.2.0", want: "v1.2.0"},
+		{name: "unprefixed index entry", index: index, version: "v1.3.0", want: "1.3.0"},
+		{name: "unlisted version", index: index, version: "v1.4.0", wantErrContain: "release v1.4.0 not listed"},
+		{name: "invalid index", index: "{", version: "v1.2.0", wantErrContain: "parsing index.json"},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findInIndex(strings.NewReader(tt.index), tt.version)
+			// Check error expectation
+			if tt.wantErrContain != "" {
+				// Expect matching error
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrContain) {
+					t.Errorf("findInIndex() error = %v, want %q", err, tt.wantErrContain)
+				}
+				return
+			}
+			// Check version
+			if err != nil || got != tt.want {
+				t.Errorf("findInIndex() = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+
+// TestPickLatest tests choosing the newest release of a channel.
+func TestPickLatest(t *testing.T) {
+	releases := []indexRelease{{Version: "v1.0.0"}, {Version: "v1.1.0-beta", Prerelease: true}}
+	tests := []struct {
+		name     string
+		releases []indexRelease
+		channel  string
+		want     string
+		wantErr  bool
+	}{
+		{name: "stable", releases: releases, channel: ChannelStable, want: "v1.0.0"},
+		{name: "prerelease", releases: releases, channel: ChannelPrerelease, want: "v1.1.0-beta"},
+		{name: "tenth release candidate", releases: []indexRelease{{Version: "v2.0.0-rc.10", Prerelease: true}, {Version: "v2.0.0-rc.9", Prerelease: true}}, channel: ChannelPrerelease, want: "v2.0.0-rc.10"},
+		{name: "empty", releases: nil, channel: "", wantErr: true},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pickLatest(tt.releases, tt.channel)
+			// Check result
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("pickLatest() = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+
+// TestChannelName tests channel display names.
+func TestChannelName(t *testing.T) {
+	tests := []struct {
+		name    string
+		channel string
+		want    string
+	}{
+		{name: "default", channel: "", want: ChannelStable},
+		{name: "prerelease", channel: ChannelPrerelease, want: ChannelPrerelease},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Check name
+			if got := channelName(tt.channel); got != tt.want {
+				t.Errorf("channelName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
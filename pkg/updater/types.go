@@ -2,13 +2,70 @@
 package updater
 
 import (
+	"io"
 	"net/http"
 )
 
 // releaseInfo represents the GitHub release API response structure.
 // It contains the tag name which is used to determine the latest version.
 type releaseInfo struct {
-	TagName string `json:"tag_name"`
+	TagName    string `json:"tag_name"`
+	Prerelease bool   `json:"prerelease"`
+	Draft      bool   `json:"draft"`
+}
+
+// releaseIndex is the index.json of a mirror or local release directory.
+// Assets of a release are stored under <base>/<version>/<asset>.
+type releaseIndex struct {
+	Releases []indexRelease `json:"releases"`
+}
+
+// indexRelease is one release listed by a release index.
+type indexRelease struct {
+	Version    string `json:"version"`
+	Prerelease bool   `json:"prerelease"`
+}
+
+// Source provides the releases of ktn-linter and their assets.
+// Implementations read GitHub, an HTTP mirror or a local directory.
+type Source interface {
+	// Latest returns the newest release version of a channel.
+	Latest(channel string) (string, error)
+	// Resolve returns a version as spelled by the source's asset paths.
+	Resolve(version string) (string, error)
+	// Open opens a release asset and returns its size, -1 when unknown.
+	Open(version, asset string) (io.ReadCloser, int64, error)
+}
+
+// githubSource reads releases from the GitHub API and release downloads.
+type githubSource struct {
+	client *http.Client
+}
+
+// httpSource reads releases from an HTTP directory with an index.json.
+type httpSource struct {
+	client  *http.Client
+	baseURL string
+}
+
+// localSource reads releases from a local directory with an index.json.
+type localSource struct {
+	dir   string
+	index string
+}
+
+// Options configures where and how the updater downloads releases.
+// Zero values select GitHub, the stable channel, proxies from the
+// environment and the default size cap.
+type Options struct {
+	// Source is "github", an http(s) mirror URL or a local directory or index file.
+	Source string
+	// Channel is ChannelStable or ChannelPrerelease.
+	Channel string
+	// Proxy is the URL of the HTTP proxy, overriding HTTPS_PROXY/HTTP_PROXY.
+	Proxy string
+	// MaxSize caps the downloaded binary in bytes.
+	MaxSize int64
 }
 
 // UpdateInfo contains comprehensive information about available updates.
@@ -22,6 +79,11 @@ type UpdateInfo struct {
 // Updater handles self-update logic for the ktn-linter binary.
 // It manages version checking via GitHub API and binary replacement.
 type Updater struct {
-	version string
-	client  *http.Client
+	version   string
+	client    *http.Client
+	execPath  string
+	publicKey string
+	source    Source
+	channel   string
+	maxSize   int64
 }
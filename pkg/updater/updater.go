@@ -1,17 +1,19 @@
 // Package updater provides self-update functionality for ktn-linter binary.
-// It checks GitHub releases for newer versions and downloads/replaces the binary.
+// It checks GitHub releases for newer versions, verifies the downloaded
+// binary against the release checksums and replaces it, keeping a backup.
 package updater
 
 import (
-	"encoding/json"
+	"bytes"
+	"cmp"
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"runtime"
-	"strconv"
-	"strings"
 	"time"
 )
 
@@ -21,22 +23,30 @@ const (
 	repoOwner string = "kodflow"
 	// repoName is the GitHub repository name.
 	repoName string = "ktn-linter"
-	// apiURL is the GitHub releases API endpoint.
+	// apiURL is the GitHub latest release API endpoint.
 	apiURL string = "https://api.github.com/repos/%s/%s/releases/latest"
+	// releasesURL is the GitHub recent releases API endpoint.
+	releasesURL string = "https://api.github.com/repos/%s/%s/releases"
 	// downloadURL is the release asset download URL pattern.
 	downloadURL string = "https://github.com/%s/%s/releases/download/%s/%s"
 	// httpTimeout is the timeout for HTTP requests.
 	httpTimeout time.Duration = 30 * time.Second
-	// semverMajorIdx is the index of major version component.
-	semverMajorIdx int = 0
-	// semverMinorIdx is the index of minor version component.
-	semverMinorIdx int = 1
-	// semverPatchIdx is the index of patch version component.
-	semverPatchIdx int = 2
 	// semverComponents is the number of semver components.
 	semverComponents int = 3
+	// identifierBase is the base of numeric prerelease identifiers.
+	identifierBase int = 10
+	// identifierBits is the size of numeric prerelease identifiers.
+	identifierBits int = 64
 	// executablePerm is the permission for executable files.
 	executablePerm os.FileMode = 0755
+	// maxBinarySize caps the downloaded binary by default (256 MiB).
+	maxBinarySize int64 = 256 << 20
+	// maxChecksumsSize caps checksums.txt and its signature (64 KiB).
+	maxChecksumsSize int64 = 64 << 10
+	// prevSuffix names the backup of the replaced binary.
+	prevSuffix string = ".prev"
+	// rollbackSuffix names the binary moved aside during a rollback.
+	rollbackSuffix string = ".rollback"
 )
 
 // NewUpdater creates a new updater instance.
@@ -49,11 +59,65 @@ const (
 func NewUpdater(version string) *Updater {
 	// Return configured updater with timeout
 	return &Updater{
-		version: version,
-		client:  &http.Client{Timeout: httpTimeout},
+		version:   version,
+		client:    &http.Client{Timeout: httpTimeout},
+		publicKey: releasePublicKey,
 	}
 }
 
+// NewUpdaterWithOptions creates an updater reading releases from a
+// configured source and channel, through an optional proxy.
+//
+// Params:
+//   - version: current binary version (empty means dev build)
+//   - opts: source, channel, proxy and size cap
+//
+// Returns:
+//   - *Updater: configured updater instance
+//   - error: invalid channel, proxy or source
+func NewUpdaterWithOptions(version string, opts Options) (*Updater, error) {
+	// Validate channel
+	if opts.Channel != "" && opts.Channel != ChannelStable && opts.Channel != ChannelPrerelease {
+		// Return channel error
+		return nil, fmt.Errorf("unknown channel %q (want %s or %s)", opts.Channel, ChannelStable, ChannelPrerelease)
+	}
+	// Validate size cap
+	if opts.MaxSize < 0 {
+		// Return size error
+		return nil, fmt.Errorf("invalid download size cap %d", opts.MaxSize)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// Override proxies from the environment
+	if opts.Proxy != "" {
+		proxyURL, err := url.Parse(opts.Proxy)
+		// Check proxy URL
+		if err != nil || proxyURL.Host == "" {
+			// Return proxy error
+			return nil, fmt.Errorf("invalid proxy URL %q", opts.Proxy)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	client := &http.Client{Timeout: httpTimeout, Transport: transport}
+
+	source, err := NewSource(opts.Source, client)
+	// Check source
+	if err != nil {
+		// Return source error
+		return nil, err
+	}
+
+	// Return configured updater
+	return &Updater{
+		version:   version,
+		client:    client,
+		publicKey: releasePublicKey,
+		source:    source,
+		channel:   opts.Channel,
+		maxSize:   opts.MaxSize,
+	}, nil
+}
+
 // CheckForUpdate checks if an update is available.
 //
 // Returns:
@@ -117,39 +181,67 @@ func (u *Updater) Upgrade() (UpdateInfo, error) {
 	return info, nil
 }
 
-// getLatestVersion fetches the latest release version from GitHub.
+// UpgradeTo downloads and applies a pinned version, newer or older than
+// the current one.
+//
+// Params:
+//   - target: version to install ("v" prefix optional)
 //
 // Returns:
-//   - string: latest version tag
-//   - error: any API error
-func (u *Updater) getLatestVersion() (string, error) {
-	// Build API URL for latest release
-	url := fmt.Sprintf(apiURL, repoOwner, repoName)
-	// Make HTTP request to GitHub API
-	resp, err := u.client.Get(url)
-	// Handle HTTP request errors
-	if err != nil {
-		// Return wrapped HTTP error
-		return "", fmt.Errorf("fetching release info: %w", err)
+//   - UpdateInfo: information about the update
+//   - error: any download or replacement error
+func (u *Updater) UpgradeTo(target string) (UpdateInfo, error) {
+	target = normalizeVersion(target)
+	info := UpdateInfo{
+		Available:      compareVersions(target, u.version) != 0,
+		CurrentVersion: u.version,
+		LatestVersion:  target,
+	}
+	// Skip when already installed
+	if !info.Available {
+		// Return info indicating already on this version
+		return info, nil
 	}
-	defer resp.Body.Close()
 
-	// Validate response status code
-	if resp.StatusCode != http.StatusOK {
-		// Return error for non-200 status
-		return "", fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	version, err := u.releaseSource().Resolve(target)
+	// Handle versions the source does not list
+	if err != nil {
+		// Return resolve error
+		return info, err
 	}
 
-	// Parse JSON response
-	var release releaseInfo
-	// Decode JSON body
-	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
-		// Return wrapped decode error
-		return "", fmt.Errorf("parsing release info: %w", err)
+	// Download and replace binary with the pinned version
+	if err := u.downloadAndReplace(version); err != nil {
+		// Return error from download
+		return info, err
 	}
 
-	// Return the version tag
-	return release.TagName, nil
+	// Return success info
+	return info, nil
+}
+
+// getLatestVersion fetches the latest release version of the channel.
+//
+// Returns:
+//   - string: latest version tag
+//   - error: any source error
+func (u *Updater) getLatestVersion() (string, error) {
+	// Ask the release source
+	return u.releaseSource().Latest(u.channel)
+}
+
+// releaseSource returns the configured source, GitHub by default.
+//
+// Returns:
+//   - Source: release source
+func (u *Updater) releaseSource() Source {
+	// Default to GitHub releases
+	if u.source == nil {
+		// Return GitHub source sharing the client
+		return &githubSource{client: u.client}
+	}
+	// Return configured source
+	return u.source
 }
 
 // isNewer checks if the given version is newer than current.
@@ -160,86 +252,190 @@ func (u *Updater) getLatestVersion() (string, error) {
 // Returns:
 //   - bool: true if latest is newer
 func (u *Updater) isNewer(latest string) bool {
-	// Parse both versions into components
-	current := u.parseVersion(u.version)
-	remote := u.parseVersion(latest)
+	// Compare with the current version
+	return compareVersions(latest, u.version) > 0
+}
+
+// downloadAndReplace downloads the new binary, checks it against the
+// release checksums and replaces the current one, keeping it as .prev.
+//
+// Params:
+//   - version: version to download
+//
+// Returns:
+//   - error: any download, verification or replacement error
+func (u *Updater) downloadAndReplace(version string) error {
+	// Fetch the release checksums
+	checksums, err := u.fetch(version, checksumsAsset, maxChecksumsSize)
+	// Handle checksums download errors
+	if err != nil {
+		// Return wrapped checksums error
+		return fmt.Errorf("downloading %s: %w", checksumsAsset, err)
+	}
 
-	// Compare major version first
-	if remote[semverMajorIdx] > current[semverMajorIdx] {
-		// Remote has higher major version
-		return true
+	// Check the checksums signature when a release key is embedded
+	if u.publicKey != "" {
+		signature, err := u.fetch(version, checksumsAsset+signatureSuffix, maxChecksumsSize)
+		// Handle signature download errors
+		if err != nil {
+			// Return wrapped signature error
+			return fmt.Errorf("downloading signature: %w", err)
+		}
+		// Handle signature mismatch
+		if err := verifySignature(checksums, signature, u.publicKey); err != nil {
+			// Return verification error
+			return err
+		}
 	}
-	// Check if current major is higher
-	if remote[semverMajorIdx] < current[semverMajorIdx] {
-		// Current has higher major version
-		return false
+
+	// Get platform-specific binary name and its expected digest
+	binaryName := u.getBinaryName()
+	want, err := expectedChecksum(checksums, binaryName)
+	// Handle missing checksum
+	if err != nil {
+		// Return checksum error
+		return err
 	}
 
-	// Major versions equal, compare minor
-	if remote[semverMinorIdx] > current[semverMinorIdx] {
-		// Remote has higher minor version
-		return true
+	// Get path to current executable
+	execPath, err := u.executable()
+	// Handle path resolution errors
+	if err != nil {
+		// Return path error
+		return err
+	}
+
+	// Download and verify the new binary next to the current one
+	tmpPath, err := u.downloadBinary(version, binaryName, filepath.Dir(execPath), want)
+	// Handle download errors
+	if err != nil {
+		// Return download error
+		return err
 	}
-	// Check if current minor is higher
-	if remote[semverMinorIdx] < current[semverMinorIdx] {
-		// Current has higher minor version
-		return false
+
+	// Swap binaries, keeping the current one as backup
+	if err := install(tmpPath, execPath); err != nil {
+		// Return replacement error
+		return err
 	}
 
-	// Major and minor equal, compare patch
-	return remote[semverPatchIdx] > current[semverPatchIdx]
+	// Return nil on success
+	return nil
 }
 
-// parseVersion parses a semver string into components.
+// fetch downloads a small release asset.
 //
 // Params:
-//   - v: version string (e.g., "v1.2.3")
+//   - version: release version
+//   - asset: asset name
+//   - limit: maximum accepted size in bytes
 //
 // Returns:
-//   - [3]int: major, minor, patch as integers
-func (u *Updater) parseVersion(v string) [semverComponents]int {
-	// Remove optional 'v' prefix
-	v = strings.TrimPrefix(v, "v")
-	// Split by dots
-	parts := strings.Split(v, ".")
-
-	// Initialize result array
-	var result [semverComponents]int
-	// Iterate over version parts
-	for i := 0; i < semverComponents && i < len(parts); i++ {
-		// Parse integer, defaulting to 0 on error
-		result[i], _ = strconv.Atoi(parts[i])
-	}
-	// Return parsed version
-	return result
+//   - []byte: asset content
+//   - error: download or size error
+func (u *Updater) fetch(version, asset string, limit int64) ([]byte, error) {
+	body, _, err := u.releaseSource().Open(version, asset)
+	// Handle download errors
+	if err != nil {
+		// Return download error
+		return []byte{}, err
+	}
+	defer body.Close()
+
+	// Read one byte past the limit to detect oversized assets
+	data, err := io.ReadAll(io.LimitReader(body, limit+1))
+	// Handle read errors
+	if err != nil {
+		// Return read error
+		return []byte{}, err
+	}
+	// Reject oversized assets
+	if int64(len(data)) > limit {
+		// Return size error
+		return []byte{}, fmt.Errorf("%s exceeds %d bytes", asset, limit)
+	}
+
+	// Return asset content
+	return data, nil
 }
 
-// downloadAndReplace downloads the new binary and replaces the current one.
+// downloadBinary downloads the new binary to a temporary executable file
+// and checks its SHA-256 and size.
 //
 // Params:
-//   - version: version to download
+//   - version: release version
+//   - asset: binary asset name
+//   - dir: directory of the temporary file
+//   - want: expected SHA-256 digest
 //
 // Returns:
-//   - error: any download or replacement error
-func (u *Updater) downloadAndReplace(version string) error {
-	// Get platform-specific binary name
-	binaryName := u.getBinaryName()
-	// Build download URL
-	url := fmt.Sprintf(downloadURL, repoOwner, repoName, version, binaryName)
+//   - string: temporary file path
+//   - error: download, size or checksum error
+func (u *Updater) downloadBinary(version, asset, dir string, want []byte) (string, error) {
+	limit := cmp.Or(u.maxSize, maxBinarySize)
+	body, size, err := u.releaseSource().Open(version, asset)
+	// Handle download errors
+	if err != nil {
+		// Return download error
+		return "", err
+	}
+	defer body.Close()
 
-	// Make HTTP request to download binary
-	resp, err := u.client.Get(url)
-	// Handle download request errors
+	// Reject announced oversized binaries before writing
+	if size > limit {
+		// Return size error
+		return "", fmt.Errorf("binary exceeds %d bytes", limit)
+	}
+
+	// Create temporary file for download
+	tmpFile, err := os.CreateTemp(dir, "ktn-linter-update-*")
+	// Handle temp file creation errors
 	if err != nil {
-		// Return wrapped download error
-		return fmt.Errorf("downloading binary: %w", err)
+		// Return wrapped temp file error
+		return "", fmt.Errorf("creating temp file: %w", err)
 	}
-	defer resp.Body.Close()
+	tmpPath := tmpFile.Name()
 
-	// Validate download response status
-	if resp.StatusCode != http.StatusOK {
-		// Return error for failed download
-		return fmt.Errorf("download failed: status %d", resp.StatusCode)
+	// Copy downloaded content to temp file while hashing it
+	hash := sha256.New()
+	written, err := io.Copy(io.MultiWriter(tmpFile, hash), io.LimitReader(body, limit+1))
+	tmpFile.Close()
+	// Handle write, size and checksum errors
+	switch {
+	// Write failed
+	case err != nil:
+		err = fmt.Errorf("writing temp file: %w", err)
+	// Body longer than announced or than the limit
+	case written > limit:
+		err = fmt.Errorf("binary exceeds %d bytes", limit)
+	// Content differs from the release
+	case !bytes.Equal(hash.Sum(nil), want):
+		err = fmt.Errorf("checksum mismatch for %s", asset)
+	// Valid binary
+	default:
+		err = os.Chmod(tmpPath, executablePerm)
+	}
+	// Discard invalid downloads
+	if err != nil {
+		os.Remove(tmpPath)
+		// Return download error
+		return "", err
+	}
+
+	// Return verified binary
+	return tmpPath, nil
+}
+
+// executable returns the resolved path of the binary to replace.
+//
+// Returns:
+//   - string: executable path
+//   - error: path resolution error
+func (u *Updater) executable() (string, error) {
+	// Use the configured path if any
+	if u.execPath != "" {
+		// Return configured path
+		return u.execPath, nil
 	}
 
 	// Get path to current executable
@@ -247,48 +443,41 @@ func (u *Updater) downloadAndReplace(version string) error {
 	// Handle path resolution errors
 	if err != nil {
 		// Return wrapped path error
-		return fmt.Errorf("getting executable path: %w", err)
+		return "", fmt.Errorf("getting executable path: %w", err)
 	}
 	// Resolve any symlinks in path
 	execPath, err = filepath.EvalSymlinks(execPath)
 	// Handle symlink resolution errors
 	if err != nil {
 		// Return wrapped symlink error
-		return fmt.Errorf("resolving symlinks: %w", err)
+		return "", fmt.Errorf("resolving symlinks: %w", err)
 	}
 
-	// Create temporary file for download
-	tmpFile, err := os.CreateTemp(filepath.Dir(execPath), "ktn-linter-update-*")
-	// Handle temp file creation errors
-	if err != nil {
-		// Return wrapped temp file error
-		return fmt.Errorf("creating temp file: %w", err)
-	}
-	tmpPath := tmpFile.Name()
-
-	// Copy downloaded content to temp file
-	_, err = io.Copy(tmpFile, resp.Body)
-	tmpFile.Close()
-	// Handle write errors
-	if err != nil {
-		os.Remove(tmpPath)
-		// Return wrapped write error
-		return fmt.Errorf("writing temp file: %w", err)
-	}
+	// Return resolved path
+	return execPath, nil
+}
 
-	// Set executable permissions on temp file
-	err = os.Chmod(tmpPath, executablePerm)
-	// Handle chmod errors
-	if err != nil {
+// install moves the verified binary in place, keeping the current one as
+// the .prev backup.
+//
+// Params:
+//   - tmpPath: verified binary
+//   - execPath: binary to replace
+//
+// Returns:
+//   - error: replacement error
+func install(tmpPath, execPath string) error {
+	prevPath := execPath + prevSuffix
+	// Keep the current binary as backup
+	if err := os.Rename(execPath, prevPath); err != nil {
 		os.Remove(tmpPath)
-		// Return wrapped chmod error
-		return fmt.Errorf("setting permissions: %w", err)
+		// Return wrapped backup error
+		return fmt.Errorf("backing up binary: %w", err)
 	}
 
-	// Atomically replace old binary with new one
-	err = os.Rename(tmpPath, execPath)
-	// Handle rename errors
-	if err != nil {
+	// Move new binary in place
+	if err := os.Rename(tmpPath, execPath); err != nil {
+		os.Rename(prevPath, execPath)
 		os.Remove(tmpPath)
 		// Return wrapped rename error
 		return fmt.Errorf("replacing binary: %w", err)
@@ -298,6 +487,62 @@ func (u *Updater) downloadAndReplace(version string) error {
 	return nil
 }
 
+// Rollback restores the binary saved by the last upgrade. The replaced
+// binary becomes the new backup, so a second rollback undoes the first.
+//
+// Returns:
+//   - string: path of the restored binary
+//   - error: missing backup or replacement error
+func (u *Updater) Rollback() (string, error) {
+	// Get path to current executable
+	execPath, err := u.executable()
+	// Handle path resolution errors
+	if err != nil {
+		// Return path error
+		return "", err
+	}
+	prevPath := execPath + prevSuffix
+	// Check for a backup
+	if _, err := os.Stat(prevPath); err != nil {
+		// Return missing backup error
+		return "", fmt.Errorf("no previous binary: %w", err)
+	}
+
+	asidePath := execPath + rollbackSuffix
+	// Move the current binary aside
+	if err := os.Rename(execPath, asidePath); err != nil {
+		// Return wrapped rename error
+		return "", fmt.Errorf("moving current binary: %w", err)
+	}
+	// Restore the backup
+	if err := os.Rename(prevPath, execPath); err != nil {
+		os.Rename(asidePath, execPath)
+		// Return wrapped restore error
+		return "", fmt.Errorf("restoring previous binary: %w", err)
+	}
+	// Keep the replaced binary as the new backup
+	if err := os.Rename(asidePath, prevPath); err != nil {
+		// Return wrapped backup error
+		return "", fmt.Errorf("backing up binary: %w", err)
+	}
+
+	// Return restored binary
+	return execPath, nil
+}
+
+// assetURL builds the download URL of a release asset.
+//
+// Params:
+//   - version: release tag
+//   - asset: asset name
+//
+// Returns:
+//   - string: download URL
+func assetURL(version, asset string) string {
+	// Build download URL
+	return fmt.Sprintf(downloadURL, repoOwner, repoName, version, asset)
+}
+
 // getBinaryName returns the binary name for the current platform.
 //
 // Returns:
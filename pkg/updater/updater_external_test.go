@@ -2,6 +2,8 @@
 package updater_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/kodflow/ktn-linter/pkg/updater"
@@ -147,3 +149,93 @@ func TestUpdater_Upgrade(t *testing.T) {
 		})
 	}
 }
+
+// TestUpdater_Rollback tests that rollback fails without a backup.
+func TestUpdater_Rollback(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+	}{
+		{name: "test binary has no backup", version: "v1.0.0"},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			u := updater.NewUpdater(tt.version)
+			// Expect missing backup error
+			if _, err := u.Rollback(); err == nil {
+				t.Error("Rollback() should fail without a .prev binary")
+			}
+		})
+	}
+}
+
+// TestNewUpdaterWithOptions tests validating updater options.
+func TestNewUpdaterWithOptions(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		name    string
+		opts    updater.Options
+		wantErr bool
+	}{
+		{name: "defaults", opts: updater.Options{}, wantErr: false},
+		{name: "mirror with proxy", opts: updater.Options{Source: "https://mirror.example.com/ktn", Channel: updater.ChannelPrerelease, Proxy: "http://proxy.example.com:3128", MaxSize: 1 << 20}, wantErr: false},
+		{name: "unknown channel", opts: updater.Options{Channel: "nightly"}, wantErr: true},
+		{name: "invalid proxy", opts: updater.Options{Proxy: "::"}, wantErr: true},
+		{name: "negative size cap", opts: updater.Options{MaxSize: -1}, wantErr: true},
+		{name: "missing local source", opts: updater.Options{Source: filepath.Join(dir, "missing")}, wantErr: true},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := updater.NewUpdaterWithOptions("v1.0.0", tt.opts)
+			// Check error expectation
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewUpdaterWithOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// Check updater on success
+			if !tt.wantErr && u == nil {
+				t.Error("NewUpdaterWithOptions() returned nil updater")
+			}
+		})
+	}
+}
+
+// TestUpdater_UpgradeTo tests pinned versions without touching the binary.
+func TestUpdater_UpgradeTo(t *testing.T) {
+	dir := t.TempDir()
+	// Write an empty local index
+	if err := os.WriteFile(filepath.Join(dir, "index.json"), []byte(`{"releases":[]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name          string
+		target        string
+		wantAvailable bool
+		wantErr       bool
+	}{
+		{name: "current version is a no-op", target: "1.0.0", wantAvailable: false, wantErr: false},
+		{name: "missing release fails", target: "v0.9.0", wantAvailable: true, wantErr: true},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := updater.NewUpdaterWithOptions("v1.0.0", updater.Options{Source: dir})
+			// Check updater creation
+			if err != nil {
+				t.Fatal(err)
+			}
+			info, err := u.UpgradeTo(tt.target)
+			// Check result
+			if (err != nil) != tt.wantErr || info.Available != tt.wantAvailable {
+				t.Errorf("UpgradeTo(%q) = %+v, %v", tt.target, info, err)
+			}
+		})
+	}
+}
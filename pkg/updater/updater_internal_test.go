@@ -2,78 +2,21 @@
 package updater
 
 import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path"
+	"path/filepath"
 	"strings"
 	"testing"
 )
 
-// TestUpdater_parseVersion tests version parsing for various formats.
-func TestUpdater_parseVersion(t *testing.T) {
-	u := NewUpdater("v1.0.0")
-
-	tests := []struct {
-		name     string
-		version  string
-		expected [3]int
-	}{
-		{
-			name:     "standard version with v prefix",
-			version:  "v1.2.3",
-			expected: [3]int{1, 2, 3},
-		},
-		{
-			name:     "version without v prefix",
-			version:  "1.2.3",
-			expected: [3]int{1, 2, 3},
-		},
-		{
-			name:     "major version only",
-			version:  "v2",
-			expected: [3]int{2, 0, 0},
-		},
-		{
-			name:     "major and minor only",
-			version:  "v2.5",
-			expected: [3]int{2, 5, 0},
-		},
-		{
-			name:     "empty version string",
-			version:  "",
-			expected: [3]int{0, 0, 0},
-		},
-		{
-			name:     "invalid version format",
-			version:  "invalid",
-			expected: [3]int{0, 0, 0},
-		},
-		{
-			name:     "version with extra parts",
-			version:  "v1.2.3.4",
-			expected: [3]int{1, 2, 3},
-		},
-		{
-			name:     "version with leading zeros",
-			version:  "v01.02.03",
-			expected: [3]int{1, 2, 3},
-		},
-	}
-
-	// Run each test case
-	for _, tt := range tests {
-		tt := tt // Capture range variable
-		t.Run(tt.name, func(t *testing.T) {
-			result := u.parseVersion(tt.version)
-			// Check result matches expected
-			if result != tt.expected {
-				t.Errorf("parseVersion(%q) = %v, want %v", tt.version, result, tt.expected)
-			}
-		})
-	}
-}
-
 // TestUpdater_isNewer tests version comparison logic for various scenarios.
 func TestUpdater_isNewer(t *testing.T) {
 	tests := []struct {
@@ -438,3 +381,394 @@ func TestUpdater_downloadAndReplace(t *testing.T) {
 		})
 	}
 }
+
+// newReleaseServer serves release assets by name and returns an updater
+// whose requests are redirected to it, replacing a binary in a temp dir.
+//
+// Params:
+//   - t: testing object
+//   - assets: asset contents by name
+//
+// Returns:
+//   - *Updater: updater bound to the test release
+//   - string: path of the binary to replace
+func newReleaseServer(t *testing.T, assets map[string]string) (*Updater, string) {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, ok := assets[path.Base(r.URL.Path)]
+		// Unknown assets are missing from the release
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(content))
+	}))
+	t.Cleanup(server.Close)
+
+	execPath := filepath.Join(t.TempDir(), "ktn-linter")
+	// Write the current binary
+	if err := os.WriteFile(execPath, []byte("old"), executablePerm); err != nil {
+		t.Fatal(err)
+	}
+	u := &Updater{
+		version:  "v1.0.0",
+		client:   &http.Client{Transport: &mockTransport{url: server.URL, client: server.Client()}},
+		execPath: execPath,
+	}
+	// Return updater and binary path
+	return u, execPath
+}
+
+// checksumLine returns the sha256sum line of an asset.
+//
+// Params:
+//   - name: asset name
+//   - content: asset content
+//
+// Returns:
+//   - string: checksums.txt line
+func checksumLine(name, content string) string {
+	sum := sha256.Sum256([]byte(content))
+	// Return sha256sum format
+	return hex.EncodeToString(sum[:]) + "  " + name + "\n"
+}
+
+// TestUpdater_downloadAndReplace_release tests upgrades against a release server.
+func TestUpdater_downloadAndReplace_release(t *testing.T) {
+	binaryName := (&Updater{}).getBinaryName()
+	publicKey, privateKey, _ := ed25519.GenerateKey(nil)
+	key := base64.StdEncoding.EncodeToString(publicKey)
+	checksums := checksumLine(binaryName, "new")
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte(checksums)))
+	tests := []struct {
+		name           string
+		assets         map[string]string
+		publicKey      string
+		wantErrContain string
+	}{
+		{
+			name:   "verified binary replaced",
+			assets: map[string]string{checksumsAsset: checksums, binaryName: "new"},
+		},
+		{
+			name:      "signed checksums accepted",
+			assets:    map[string]string{checksumsAsset: checksums, checksumsAsset + signatureSuffix: signature, binaryName: "new"},
+			publicKey: key,
+		},
+		{
+			name:           "checksum mismatch",
+			assets:         map[string]string{checksumsAsset: checksums, binaryName: "tampered"},
+			wantErrContain: "checksum mismatch",
+		},
+		{
+			name:           "binary missing from checksums",
+			assets:         map[string]string{checksumsAsset: checksumLine("other", "new"), binaryName: "new"},
+			wantErrContain: "no checksum",
+		},
+		{
+			name:           "checksums missing",
+			assets:         map[string]string{binaryName: "new"},
+			wantErrContain: "downloading checksums.txt",
+		},
+		{
+			name:           "signature missing",
+			assets:         map[string]string{checksumsAsset: checksums, binaryName: "new"},
+			publicKey:      key,
+			wantErrContain: "downloading signature",
+		},
+		{
+			name:           "signature from another key",
+			assets:         map[string]string{checksumsAsset: checksums, checksumsAsset + signatureSuffix: signature, binaryName: "new"},
+			publicKey:      base64.StdEncoding.EncodeToString(make([]byte, ed25519.PublicKeySize)),
+			wantErrContain: "signature mismatch",
+		},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			u, execPath := newReleaseServer(t, tt.assets)
+			u.publicKey = tt.publicKey
+			err := u.downloadAndReplace("v1.1.0")
+			current, _ := os.ReadFile(execPath)
+
+			// Check rejected upgrades
+			if tt.wantErrContain != "" {
+				// Expect matching error and untouched binary
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrContain) || string(current) != "old" {
+					t.Errorf("downloadAndReplace() error = %v, binary %q, want %q and old binary", err, current, tt.wantErrContain)
+				}
+				return
+			}
+			prev, _ := os.ReadFile(execPath + prevSuffix)
+			// Expect new binary and backup
+			if err != nil || string(current) != "new" || string(prev) != "old" {
+				t.Errorf("downloadAndReplace() error = %v, binary %q, backup %q", err, current, prev)
+			}
+		})
+	}
+}
+
+// TestUpdater_downloadBinary_size tests the binary size limit.
+func TestUpdater_downloadBinary_size(t *testing.T) {
+	tests := []struct {
+		name          string
+		contentLength string
+		body          string
+		maxSize       int64
+	}{
+		{name: "announced oversized binary", contentLength: "999999999999", body: "", maxSize: 0},
+		{name: "body over configured cap", contentLength: "", body: "abcd", maxSize: 3},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				// Announce a size when requested
+				if tt.contentLength != "" {
+					w.Header().Set("Content-Length", tt.contentLength)
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+			u := &Updater{source: &httpSource{client: server.Client(), baseURL: server.URL}, maxSize: tt.maxSize}
+			dir := t.TempDir()
+
+			_, err := u.downloadBinary("v1.1.0", "bin", dir, nil)
+			entries, _ := os.ReadDir(dir)
+			// Expect rejection without leftover file
+			if err == nil || !strings.Contains(err.Error(), "exceeds") || len(entries) != 0 {
+				t.Errorf("downloadBinary() error = %v, files %d", err, len(entries))
+			}
+		})
+	}
+}
+
+// TestUpdater_fetch tests downloading small release assets.
+func TestUpdater_fetch(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		limit          int64
+		wantErrContain string
+	}{
+		{name: "within limit", body: "abc", limit: 3},
+		{name: "over limit", body: "abcd", limit: 3, wantErrContain: "checksums.txt exceeds 3 bytes"},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			// Store the asset in a local release directory
+			if err := os.MkdirAll(filepath.Join(dir, "v1.1.0"), 0o755); err != nil {
+				t.Fatal(err)
+			}
+			// Write the asset
+			if err := os.WriteFile(filepath.Join(dir, "v1.1.0", checksumsAsset), []byte(tt.body), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			u := &Updater{source: &localSource{dir: dir}}
+
+			data, err := u.fetch("v1.1.0", checksumsAsset, tt.limit)
+			// Check error expectation
+			if tt.wantErrContain != "" {
+				// Expect size error
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrContain) {
+					t.Errorf("fetch() error = %v, want %q", err, tt.wantErrContain)
+				}
+				return
+			}
+			// Expect content
+			if err != nil || string(data) != tt.body {
+				t.Errorf("fetch() = %q, %v", data, err)
+			}
+		})
+	}
+}
+
+// TestUpdater_Rollback_backup tests restoring and re-restoring the backup.
+func TestUpdater_Rollback_backup(t *testing.T) {
+	tests := []struct {
+		name       string
+		backup     bool
+		wantErr    bool
+		wantBinary string
+		wantPrev   string
+	}{
+		{name: "backup restored", backup: true, wantBinary: "old", wantPrev: "new"},
+		{name: "no backup", backup: false, wantErr: true, wantBinary: "new"},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			execPath := filepath.Join(t.TempDir(), "ktn-linter")
+			// Write the upgraded binary
+			if err := os.WriteFile(execPath, []byte("new"), executablePerm); err != nil {
+				t.Fatal(err)
+			}
+			// Write the backup when requested
+			if tt.backup {
+				// Check write error
+				if err := os.WriteFile(execPath+prevSuffix, []byte("old"), executablePerm); err != nil {
+					t.Fatal(err)
+				}
+			}
+			u := &Updater{execPath: execPath}
+
+			restored, err := u.Rollback()
+			// Check error expectation
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Rollback() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			current, _ := os.ReadFile(execPath)
+			prev, _ := os.ReadFile(execPath + prevSuffix)
+			// Check swapped binaries
+			if string(current) != tt.wantBinary || string(prev) != tt.wantPrev {
+				t.Errorf("binary %q, backup %q, want %q and %q", current, prev, tt.wantBinary, tt.wantPrev)
+			}
+			// Check restored path
+			if !tt.wantErr && restored != execPath {
+				t.Errorf("Rollback() = %q, want %q", restored, execPath)
+			}
+		})
+	}
+}
+
+// TestInstall tests keeping the replaced binary as backup.
+func TestInstall(t *testing.T) {
+	tests := []struct {
+		name    string
+		missing bool
+		wantErr bool
+	}{
+		{name: "binary swapped", missing: false, wantErr: false},
+		{name: "missing binary", missing: true, wantErr: true},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			execPath, tmpPath := filepath.Join(dir, "ktn-linter"), filepath.Join(dir, "update")
+			// Write the downloaded binary
+			if err := os.WriteFile(tmpPath, []byte("new"), executablePerm); err != nil {
+				t.Fatal(err)
+			}
+			// Write the current binary unless missing
+			if !tt.missing {
+				// Check write error
+				if err := os.WriteFile(execPath, []byte("old"), executablePerm); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := install(tmpPath, execPath)
+			// Check error expectation
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("install() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// Check the temporary file is gone either way
+			if _, statErr := os.Stat(tmpPath); statErr == nil {
+				t.Error("install() left the temporary file")
+			}
+		})
+	}
+}
+
+// TestAssetURL tests release asset URLs.
+func TestAssetURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		asset   string
+		want    string
+	}{
+		{name: "checksums", version: "v1.2.3", asset: checksumsAsset, want: "https://github.com/kodflow/ktn-linter/releases/download/v1.2.3/checksums.txt"},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Check URL
+			if got := assetURL(tt.version, tt.asset); got != tt.want {
+				t.Errorf("assetURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestUpdater_UpgradeTo_local tests pinning a release from a local directory.
+func TestUpdater_UpgradeTo_local(t *testing.T) {
+	u, err := NewUpdaterWithOptions("v1.0.0", Options{})
+	// Check updater creation
+	if err != nil {
+		t.Fatal(err)
+	}
+	name := u.getBinaryName()
+	dir := writeReleaseDir(t, `{"releases":[{"version":"v1.1.0-rc.1","prerelease":true},{"version":"v0.9.0"},{"version":"0.8.0"}]}`, map[string]string{
+		"0.8.0/" + name:                 "unprefixed binary",
+		"0.8.0/" + checksumsAsset:       checksumLine(name, "unprefixed binary"),
+		"v0.9.0/" + name:                "old binary",
+		"v0.9.0/" + checksumsAsset:      checksumLine(name, "old binary"),
+		"v1.1.0-rc.1/" + name:           "candidate",
+		"v1.1.0-rc.1/" + checksumsAsset: checksumLine(name, "candidate"),
+	})
+	tests := []struct {
+		name    string
+		channel string
+		target  string
+		want    string
+	}{
+		{name: "pinned downgrade", channel: ChannelStable, target: "0.9.0", want: "old binary"},
+		{name: "index version without v prefix", channel: ChannelStable, target: "v0.8.0", want: "unprefixed binary"},
+		{name: "latest prerelease", channel: ChannelPrerelease, target: "", want: "candidate"},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := NewUpdaterWithOptions("v1.0.0", Options{Source: dir, Channel: tt.channel})
+			// Check updater creation
+			if err != nil {
+				t.Fatal(err)
+			}
+			u.execPath = filepath.Join(t.TempDir(), "ktn-linter")
+			// Write the current binary
+			if err := os.WriteFile(u.execPath, []byte("current"), 0o755); err != nil {
+				t.Fatal(err)
+			}
+			var info UpdateInfo
+			// Pin or follow the channel
+			if tt.target != "" {
+				info, err = u.UpgradeTo(tt.target)
+			} else {
+				info, err = u.Upgrade()
+			}
+			// Check upgrade result
+			if err != nil || !info.Available {
+				t.Fatalf("upgrade = %+v, %v", info, err)
+			}
+			got, err := os.ReadFile(u.execPath)
+			// Check installed binary
+			if err != nil || string(got) != tt.want {
+				t.Errorf("installed %q, %v; want %q", got, err, tt.want)
+			}
+			prev, err := os.ReadFile(u.execPath + prevSuffix)
+			// Check kept backup
+			if err != nil || string(prev) != "current" {
+				t.Errorf("backup %q, %v", prev, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Package updater provides self-update functionality for ktn-linter binary.
+package updater
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Release verification constants.
+const (
+	// checksumsAsset is the release asset listing SHA-256 sums.
+	checksumsAsset string = "checksums.txt"
+	// signatureSuffix names the signature asset of the checksums.
+	signatureSuffix string = ".sig"
+	// checksumFields is the number of fields of a sha256sum line.
+	checksumFields int = 2
+)
+
+// releasePublicKey is the base64 ed25519 key signing checksums.txt, set at
+// build time with -ldflags "-X .../pkg/updater.releasePublicKey=...".
+// Signatures are not checked when empty.
+var releasePublicKey string
+
+// expectedChecksum finds the SHA-256 of an asset in a sha256sum listing.
+//
+// Params:
+//   - checksums: content of checksums.txt
+//   - asset: asset name
+//
+// Returns:
+//   - []byte: expected digest
+//   - error: missing or malformed entry
+func expectedChecksum(checksums []byte, asset string) ([]byte, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	// Scan "<hex>  <name>" lines
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		// Skip lines of other assets; "*" marks binary mode
+		if len(fields) != checksumFields || strings.TrimPrefix(fields[1], "*") != asset {
+			continue
+		}
+		sum, err := hex.DecodeString(fields[0])
+		// Check digest encoding
+		if err != nil {
+			// Return decoding error
+			return []byte{}, fmt.Errorf("invalid checksum for %s: %w", asset, err)
+		}
+		// Return expected digest
+		return sum, nil
+	}
+
+	// Return missing entry
+	return []byte{}, fmt.Errorf("no checksum for %s in %s", asset, checksumsAsset)
+}
+
+// verifySignature checks the ed25519 signature of the checksums.
+//
+// Params:
+//   - checksums: content of checksums.txt
+//   - signature: base64 signature asset
+//   - publicKey: base64 ed25519 public key
+//
+// Returns:
+//   - error: invalid key, signature or mismatch
+func verifySignature(checksums, signature []byte, publicKey string) error {
+	key, err := base64.StdEncoding.DecodeString(publicKey)
+	// Check embedded key
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		// Return key error
+		return errors.New("invalid release public key")
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+	// Check signature encoding
+	if err != nil || len(sig) != ed25519.SignatureSize {
+		// Return encoding error
+		return fmt.Errorf("invalid %s%s", checksumsAsset, signatureSuffix)
+	}
+	// Check signature
+	if !ed25519.Verify(key, checksums, sig) {
+		// Return mismatch
+		return fmt.Errorf("%s signature mismatch", checksumsAsset)
+	}
+
+	// Return success
+	return nil
+}
@@ -0,0 +1,90 @@
+// Package updater provides self-update functionality for ktn-linter binary.
+package updater
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// TestExpectedChecksum tests looking up an asset in checksums.txt.
+func TestExpectedChecksum(t *testing.T) {
+	sum := sha256.Sum256([]byte("binary"))
+	listing := hex.EncodeToString(sum[:]) + "  ktn-linter-linux-amd64\n" + strings.Repeat("0", 64) + " *ktn-linter-windows-amd64.exe\n"
+	tests := []struct {
+		name           string
+		checksums      string
+		asset          string
+		want           []byte
+		wantErrContain string
+	}{
+		{name: "text mode entry", checksums: listing, asset: "ktn-linter-linux-amd64", want: sum[:]},
+		{name: "binary mode entry", checksums: listing, asset: "ktn-linter-windows-amd64.exe", want: make([]byte, sha256.Size)},
+		{name: "missing entry", checksums: listing, asset: "ktn-linter-plan9-386", wantErrContain: "no checksum"},
+		{name: "malformed digest", checksums: "zz  ktn-linter-linux-amd64\n", asset: "ktn-linter-linux-amd64", wantErrContain: "invalid checksum"},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expectedChecksum([]byte(tt.checksums), tt.asset)
+			// Check error expectation
+			if tt.wantErrContain != "" {
+				// Expect matching error
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrContain) {
+					t.Errorf("expectedChecksum() error = %v, want %q", err, tt.wantErrContain)
+				}
+				return
+			}
+			// Check digest
+			if err != nil || !bytes.Equal(got, tt.want) {
+				t.Errorf("expectedChecksum() = %x, %v, want %x", got, err, tt.want)
+			}
+		})
+	}
+}
+
+// TestVerifySignature tests the ed25519 signature of checksums.txt.
+func TestVerifySignature(t *testing.T) {
+	publicKey, privateKey, _ := ed25519.GenerateKey(nil)
+	key := base64.StdEncoding.EncodeToString(publicKey)
+	checksums := []byte("abc  ktn-linter-linux-amd64\n")
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, checksums)) + "\n"
+	tests := []struct {
+		name           string
+		checksums      []byte
+		signature      string
+		key            string
+		wantErrContain string
+	}{
+		{name: "valid signature", checksums: checksums, signature: signature, key: key},
+		{name: "tampered checksums", checksums: []byte("abd  ktn-linter-linux-amd64\n"), signature: signature, key: key, wantErrContain: "signature mismatch"},
+		{name: "malformed signature", checksums: checksums, signature: "not-base64!", key: key, wantErrContain: "invalid checksums.txt.sig"},
+		{name: "invalid public key", checksums: checksums, signature: signature, key: "c2hvcnQ=", wantErrContain: "invalid release public key"},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifySignature(tt.checksums, []byte(tt.signature), tt.key)
+			// Check valid case
+			if tt.wantErrContain == "" {
+				// Expect no error
+				if err != nil {
+					t.Errorf("verifySignature() unexpected error = %v", err)
+				}
+				return
+			}
+			// Expect matching error
+			if err == nil || !strings.Contains(err.Error(), tt.wantErrContain) {
+				t.Errorf("verifySignature() error = %v, want %q", err, tt.wantErrContain)
+			}
+		})
+	}
+}
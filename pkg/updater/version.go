@@ -0,0 +1,145 @@
+// Package updater provides self-update functionality for ktn-linter binary.
+package updater
+
+import (
+	"cmp"
+	"strconv"
+	"strings"
+)
+
+// normalizeVersion spells a version with its "v" prefix, the form used to
+// compare user targets with release tags and index entries.
+//
+// Params:
+//   - version: version string, "v" prefix optional
+//
+// Returns:
+//   - string: version with a single "v" prefix
+func normalizeVersion(version string) string {
+	// Return the prefixed version
+	return "v" + strings.TrimPrefix(version, "v")
+}
+
+// splitVersion parses a semver string into its numeric core and its
+// prerelease suffix ("v1.2.3-rc.1" gives [1 2 3] and "rc.1").
+//
+// Params:
+//   - version: version string
+//
+// Returns:
+//   - [3]int: major, minor, patch, 0 when missing or invalid
+//   - string: prerelease suffix, empty for releases
+func splitVersion(version string) ([semverComponents]int, string) {
+	// Remove optional 'v' prefix and build metadata
+	version, _, _ = strings.Cut(strings.TrimPrefix(version, "v"), "+")
+	version, prerelease, _ := strings.Cut(version, "-")
+	// Split by dots
+	parts := strings.Split(version, ".")
+
+	// Initialize result array
+	var core [semverComponents]int
+	// Iterate over version parts
+	for i := 0; i < semverComponents && i < len(parts); i++ {
+		// Parse integer, defaulting to 0 on error
+		core[i], _ = strconv.Atoi(parts[i])
+	}
+	// Return parsed version
+	return core, prerelease
+}
+
+// compareVersions orders two semver strings; a release sorts after its
+// prereleases.
+//
+// Params:
+//   - a: first version
+//   - b: second version
+//
+// Returns:
+//   - int: negative when a < b, 0 when equal, positive when a > b
+func compareVersions(a, b string) int {
+	coreA, preA := splitVersion(a)
+	coreB, preB := splitVersion(b)
+	// Compare major, minor and patch
+	for i := range semverComponents {
+		// Stop at the first difference
+		if coreA[i] != coreB[i] {
+			// Return numeric order
+			return cmp.Compare(coreA[i], coreB[i])
+		}
+	}
+	// Order releases after prereleases
+	switch {
+	// Same prerelease or both releases
+	case preA == preB:
+		// Return equal
+		return 0
+	// a is the release
+	case preA == "":
+		// Return a newer
+		return 1
+	// b is the release
+	case preB == "":
+		// Return b newer
+		return -1
+	// Both prereleases
+	default:
+		// Return semver order of the suffixes
+		return comparePrereleases(preA, preB)
+	}
+}
+
+// comparePrereleases orders two prerelease suffixes by their dot-separated
+// identifiers, as semver does: "rc.9" sorts before "rc.10".
+//
+// Params:
+//   - a: first prerelease suffix
+//   - b: second prerelease suffix
+//
+// Returns:
+//   - int: negative when a < b, 0 when equal, positive when a > b
+func comparePrereleases(a, b string) int {
+	idsA, idsB := strings.Split(a, "."), strings.Split(b, ".")
+	// Compare identifiers in order
+	for i := range min(len(idsA), len(idsB)) {
+		// Stop at the first difference
+		if order := compareIdentifiers(idsA[i], idsB[i]); order != 0 {
+			// Return identifier order
+			return order
+		}
+	}
+	// Return shorter suffixes first ("rc" before "rc.1")
+	return cmp.Compare(len(idsA), len(idsB))
+}
+
+// compareIdentifiers orders two prerelease identifiers: numeric identifiers
+// compare as numbers and sort before alphanumeric ones, compared lexically.
+//
+// Params:
+//   - a: first identifier
+//   - b: second identifier
+//
+// Returns:
+//   - int: negative when a < b, 0 when equal, positive when a > b
+func compareIdentifiers(a, b string) int {
+	numA, errA := strconv.ParseUint(a, identifierBase, identifierBits)
+	numB, errB := strconv.ParseUint(b, identifierBase, identifierBits)
+	// Order by kind and value
+	switch {
+	// Both numeric
+	case errA == nil && errB == nil:
+		// Return numeric order
+		return cmp.Compare(numA, numB)
+	// Only a is numeric
+	case errA == nil:
+		// Return a older
+		return -1
+	// Only b is numeric
+	case errB == nil:
+		// Return b older
+		return 1
+	// Both alphanumeric
+	default:
+		// Return lexical order
+		return cmp.Compare(a, b)
+	}
+}
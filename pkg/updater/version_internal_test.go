@@ -0,0 +1,144 @@
+// Package updater provides self-update functionality for ktn-linter binary.
+package updater
+
+import (
+	"testing"
+)
+
+// TestNormalizeVersion tests prefixing versions with "v".
+func TestNormalizeVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		want    string
+	}{
+		{name: "prefixed", version: "v1.2.3", want: "v1.2.3"},
+		{name: "unprefixed", version: "1.2.3", want: "v1.2.3"},
+		{name: "prerelease", version: "1.2.3-rc.1", want: "v1.2.3-rc.1"},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Check normalized version
+			if got := normalizeVersion(tt.version); got != tt.want {
+				t.Errorf("normalizeVersion(%q) = %q, want %q", tt.version, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSplitVersion tests parsing version cores and prerelease suffixes.
+func TestSplitVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		version    string
+		wantCore   [3]int
+		wantSuffix string
+	}{
+		{name: "release", version: "v1.2.3", wantCore: [3]int{1, 2, 3}, wantSuffix: ""},
+		{name: "prerelease", version: "v1.2.3-rc.1", wantCore: [3]int{1, 2, 3}, wantSuffix: "rc.1"},
+		{name: "build metadata", version: "1.2.3+linux", wantCore: [3]int{1, 2, 3}, wantSuffix: ""},
+		{name: "invalid", version: "dev", wantCore: [3]int{0, 0, 0}, wantSuffix: ""},
+		{name: "version without v prefix", version: "1.2.3", wantCore: [3]int{1, 2, 3}, wantSuffix: ""},
+		{name: "major version only", version: "v2", wantCore: [3]int{2, 0, 0}, wantSuffix: ""},
+		{name: "major and minor only", version: "v2.5", wantCore: [3]int{2, 5, 0}, wantSuffix: ""},
+		{name: "empty version string", version: "", wantCore: [3]int{0, 0, 0}, wantSuffix: ""},
+		{name: "version with extra parts", version: "v1.2.3.4", wantCore: [3]int{1, 2, 3}, wantSuffix: ""},
+		{name: "version with leading zeros", version: "v01.02.03", wantCore: [3]int{1, 2, 3}, wantSuffix: ""},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			core, suffix := splitVersion(tt.version)
+			// Check parsed parts
+			if core != tt.wantCore || suffix != tt.wantSuffix {
+				t.Errorf("splitVersion(%q) = %v, %q, want %v, %q", tt.version, core, suffix, tt.wantCore, tt.wantSuffix)
+			}
+		})
+	}
+}
+
+// TestCompareVersions tests semver ordering.
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want int
+	}{
+		{name: "equal", a: "v1.2.3", b: "1.2.3", want: 0},
+		{name: "newer patch", a: "v1.2.4", b: "v1.2.3", want: 1},
+		{name: "older minor", a: "v1.1.9", b: "v1.2.0", want: -1},
+		{name: "release after prerelease", a: "v1.3.0", b: "v1.3.0-rc.1", want: 1},
+		{name: "prerelease before release", a: "v1.3.0-rc.1", b: "v1.3.0", want: -1},
+		{name: "prerelease order", a: "v1.3.0-rc.2", b: "v1.3.0-rc.1", want: 1},
+		{name: "numeric prerelease identifiers", a: "v1.3.0-rc.10", b: "v1.3.0-rc.9", want: 1},
+		{name: "numeric before alphanumeric", a: "v1.3.0-1", b: "v1.3.0-alpha", want: -1},
+		{name: "alphanumeric identifiers", a: "v1.3.0-beta", b: "v1.3.0-alpha.5", want: 1},
+		{name: "fewer identifiers first", a: "v1.3.0-rc", b: "v1.3.0-rc.1", want: -1},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Check order
+			if got := compareVersions(tt.a, tt.b); got != tt.want {
+				t.Errorf("compareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestComparePrereleases tests ordering prerelease suffixes.
+func TestComparePrereleases(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want int
+	}{
+		{name: "equal", a: "rc.1", b: "rc.1", want: 0},
+		{name: "numeric identifier", a: "rc.10", b: "rc.9", want: 1},
+		{name: "more identifiers", a: "rc.1.1", b: "rc.1", want: 1},
+		{name: "alphanumeric identifier", a: "alpha.2", b: "beta.1", want: -1},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Check order
+			if got := comparePrereleases(tt.a, tt.b); got != tt.want {
+				t.Errorf("comparePrereleases(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCompareIdentifiers tests ordering prerelease identifiers.
+func TestCompareIdentifiers(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want int
+	}{
+		{name: "numbers", a: "10", b: "9", want: 1},
+		{name: "number before word", a: "9", b: "rc", want: -1},
+		{name: "word after number", a: "rc", b: "9", want: 1},
+		{name: "words", a: "beta", b: "alpha", want: 1},
+	}
+
+	// Run each test case
+	for _, tt := range tests {
+		tt := tt // Capture range variable
+		t.Run(tt.name, func(t *testing.T) {
+			// Check order
+			if got := compareIdentifiers(tt.a, tt.b); got != tt.want {
+				t.Errorf("compareIdentifiers(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}